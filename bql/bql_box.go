@@ -1,12 +1,17 @@
 package bql
 
 import (
+	"fmt"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"io/ioutil"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 )
@@ -80,6 +85,24 @@ func (b *bqlBox) Init(ctx *core.Context) error {
 }
 
 func (b *bqlBox) Process(ctx *core.Context, t *core.Tuple, s core.Writer) error {
+	return b.process(ctx, t, s, b.execPlan.Process)
+}
+
+// progress advances the time of the execution plan with a tuple processed
+// by another shard of the same statement and emits the results of it, e.g.
+// groups changed by expired tuples. It does nothing when the plan isn't an
+// execution.ProgressPlan.
+func (b *bqlBox) progress(ctx *core.Context, t *core.Tuple, s core.Writer) error {
+	pp, ok := b.execPlan.(execution.ProgressPlan)
+	if !ok {
+		return nil
+	}
+	return b.process(ctx, t, s, pp.Progress)
+}
+
+// process feeds the tuple into the execution plan with the given function
+// and emits the results.
+func (b *bqlBox) process(ctx *core.Context, t *core.Tuple, s core.Writer, process func(t *core.Tuple) ([]data.Map, error)) error {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
@@ -94,15 +117,32 @@ func (b *bqlBox) Process(ctx *core.Context, t *core.Tuple, s core.Writer) error
 	}
 
 	// feed tuple into plan
-	resultData, err := b.execPlan.Process(t)
+	resultData, err := process(t)
 	if err != nil {
 		return err
 	}
 
+	// when the plan holds back tuples until the watermark has passed,
+	// results are complete up to the plan's watermark. emitted tuples
+	// keep the timestamp of the input tuple and carry the watermark.
+	// however, the watermark of an emitted tuple doesn't exceed its
+	// timestamp. otherwise, the following results having the same
+	// timestamp would be regarded as late by the destination.
+	var watermark time.Time
+	if wp, ok := b.execPlan.(execution.WatermarkPlan); ok {
+		watermark = wp.Watermark()
+	}
+
 	// emit result data as tuples
 	for _, data := range resultData {
 		tup := t.ShallowCopy()
 		tup.Data = data
+		if !watermark.IsZero() {
+			tup.Watermark = watermark
+			if tup.Timestamp.Before(watermark) {
+				tup.Watermark = tup.Timestamp
+			}
+		}
 		// This method can't tell if data was originally shared by some tuples.
 		// Therefore, TFSharedData flag cannot be cleared here. Data of some
 		// Tuples can be shared when they have reference types such as Blob,
@@ -228,6 +268,162 @@ func (b *bqlBox) Terminate(ctx *core.Context) error {
 	return nil
 }
 
+// SaveCheckpoint saves the tuples retained by the execution plan, e.g. in
+// windows, and the number of emitted tuples. Plans which don't retain
+// tuples don't have anything to be saved.
+func (b *bqlBox) SaveCheckpoint(ctx *core.Context, w io.Writer) error {
+	b.mutex.Lock()
+	ts := b.retainedTuples()
+	b.mutex.Unlock()
+
+	b.timeEmitterMutex.Lock()
+	m := data.Map{
+		"tuples":     encodeTuples(ts),
+		"emit_count": data.Int(b.emitCount),
+		"gen_count":  data.Int(b.genCount),
+	}
+	b.timeEmitterMutex.Unlock()
+	return writeBoxState(w, m)
+}
+
+// LoadCheckpoint rebuilds the state of the execution plan by processing the
+// saved tuples again. Results of them are discarded because they have
+// already been emitted before the checkpoint was taken.
+func (b *bqlBox) LoadCheckpoint(ctx *core.Context, r io.Reader) error {
+	m, err := readBoxState(r)
+	if err != nil {
+		return err
+	}
+	ts, err := decodeTuples(m)
+	if err != nil {
+		return err
+	}
+
+	b.timeEmitterMutex.Lock()
+	if v, err := m.Get(data.MustCompilePath("emit_count")); err == nil {
+		b.emitCount, _ = data.AsInt(v)
+	}
+	if v, err := m.Get(data.MustCompilePath("gen_count")); err == nil {
+		b.genCount, _ = data.AsInt(v)
+	}
+	b.timeEmitterMutex.Unlock()
+	return b.replay(ts)
+}
+
+// retainedTuples returns the tuples retained by the execution plan. The
+// caller must lock b.mutex.
+func (b *bqlBox) retainedTuples() []*core.Tuple {
+	if rp, ok := b.execPlan.(execution.RetainingPlan); ok {
+		return rp.RetainedTuples()
+	}
+	return nil
+}
+
+// replay processes tuples restored from a checkpoint without emitting
+// results.
+func (b *bqlBox) replay(ts []*core.Tuple) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, t := range ts {
+		if _, err := b.execPlan.Process(t); err != nil {
+			return fmt.Errorf("cannot process a restored tuple: %v", err)
+		}
+	}
+	return nil
+}
+
+func writeBoxState(w io.Writer, m data.Map) error {
+	d, err := data.MarshalMsgpack(m)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(d)
+	return err
+}
+
+func readBoxState(r io.Reader) (data.Map, error) {
+	d, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return data.UnmarshalMsgpack(d)
+}
+
+// encodeTuples converts tuples to a data.Array so that they can be saved
+// with data.MarshalMsgpack. Times are saved as nanoseconds since the Unix
+// epoch.
+func encodeTuples(ts []*core.Tuple) data.Array {
+	a := make(data.Array, len(ts))
+	for i, t := range ts {
+		m := data.Map{
+			"data":           t.Data,
+			"input_name":     data.String(t.InputName),
+			"timestamp":      data.Int(t.Timestamp.UnixNano()),
+			"proc_timestamp": data.Int(t.ProcTimestamp.UnixNano()),
+		}
+		if !t.Watermark.IsZero() {
+			m["watermark"] = data.Int(t.Watermark.UnixNano())
+		}
+		a[i] = m
+	}
+	return a
+}
+
+// decodeTuples restores tuples saved by encodeTuples in the "tuples" field
+// of the state. Tuples are sorted by their timestamps.
+func decodeTuples(state data.Map) ([]*core.Tuple, error) {
+	v, ok := state["tuples"]
+	if !ok {
+		return nil, nil
+	}
+	a, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("tuples must be an array: %v", err)
+	}
+
+	ts := make([]*core.Tuple, len(a))
+	for i, e := range a {
+		m, err := data.AsMap(e)
+		if err != nil {
+			return nil, fmt.Errorf("a saved tuple must be a map: %v", err)
+		}
+		t := &core.Tuple{}
+		if t.Data, err = data.AsMap(m["data"]); err != nil {
+			return nil, fmt.Errorf("data of a saved tuple must be a map: %v", err)
+		}
+		if t.InputName, err = data.AsString(m["input_name"]); err != nil {
+			return nil, fmt.Errorf("input_name of a saved tuple must be a string: %v", err)
+		}
+		for _, f := range []struct {
+			name string
+			t    *time.Time
+		}{
+			{"timestamp", &t.Timestamp},
+			{"proc_timestamp", &t.ProcTimestamp},
+			{"watermark", &t.Watermark},
+		} {
+			v, ok := m[f.name]
+			if !ok {
+				continue
+			}
+			n, err := data.AsInt(v)
+			if err != nil {
+				return nil, fmt.Errorf("%v of a saved tuple must be an integer: %v", f.name, err)
+			}
+			*f.t = time.Unix(0, n).In(time.UTC)
+		}
+		ts[i] = t
+	}
+	sort.Stable(tuplesByTimestamp(ts))
+	return ts, nil
+}
+
+type tuplesByTimestamp []*core.Tuple
+
+func (t tuplesByTimestamp) Len() int           { return len(t) }
+func (t tuplesByTimestamp) Less(i, j int) bool { return t[i].Timestamp.Before(t[j].Timestamp) }
+func (t tuplesByTimestamp) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
 func (b *bqlBox) callRemoveMeIgnoringPanic() {
 	defer func() {
 		recover()
@@ -1,11 +1,16 @@
 package bql
 
 import (
+	"bytes"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	_ "gopkg.in/sensorbee/sensorbee.v0/bql/udf/builtin"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io/ioutil"
+	"sync"
 	"testing"
 	"time"
 )
@@ -677,6 +682,32 @@ func TestBQLBoxUDSF(t *testing.T) {
 	})
 }
 
+func TestBQLBoxSubquery(t *testing.T) {
+	Convey("Given a topology using a subquery", t, func() {
+		tb, err := setupTopology(`CREATE STREAM box AS SELECT ISTREAM x:int, x:twice
+			FROM (SELECT ISTREAM int, int * 2 AS twice FROM source [RANGE 1 TUPLES]
+			      WHERE int % 2 = 0) [RANGE 1 TUPLES] AS x`, false)
+		So(err, ShouldBeNil)
+		dt := tb.Topology()
+		Reset(func() {
+			dt.Stop()
+		})
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+
+		Convey("When 4 tuples are emitted by the source", func() {
+			Convey("Then the sink should receive the tuples computed by the subquery", func() {
+				si.Wait(2)
+				So(si.len(), ShouldEqual, 2)
+				So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Int(2), "twice": data.Int(4)})
+				So(si.get(1).Data, ShouldResemble, data.Map{"int": data.Int(4), "twice": data.Int(8)})
+			})
+		})
+	})
+}
+
 func TestBQLBoxSourceUDSF(t *testing.T) {
 	Convey("Given a topology using a UDSF running in the source mode", t, func() {
 		// TODO: This is a super dirty hack. Although pause/resume of streams
@@ -710,3 +741,141 @@ func TestBQLBoxSourceUDSF(t *testing.T) {
 		})
 	})
 }
+
+func TestBQLBoxCheckpoint(t *testing.T) {
+	ctx := core.NewContext(nil)
+	newBox := func(s string, shards int) core.CheckpointableBox {
+		stmt, _, err := parser.New().ParseStmt(s)
+		So(err, ShouldBeNil)
+		sel := stmt.(parser.SelectStmt)
+		reg := udf.CopyGlobalUDFRegistry(ctx)
+		var b core.CheckpointableBox
+		if shards > 1 {
+			b, err = newShardedBox("box", &sel, reg, shards)
+			So(err, ShouldBeNil)
+		} else {
+			b = NewBQLBox(&sel, reg)
+		}
+		So(b.(core.StatefulBox).Init(ctx), ShouldBeNil)
+		return b
+	}
+	process := func(b core.Box, ts []*core.Tuple) []data.Map {
+		var m sync.Mutex
+		var res []data.Map
+		w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+			m.Lock()
+			defer m.Unlock()
+			res = append(res, t.Data)
+			return nil
+		})
+		for _, t := range ts {
+			t.InputName = "s"
+			So(b.Process(ctx, t, w), ShouldBeNil)
+		}
+		if sb, ok := b.(*shardedBox); ok {
+			// wait until all shards process tuples
+			So(sb.SaveCheckpoint(ctx, ioutil.Discard), ShouldBeNil)
+		}
+		return res
+	}
+
+	Convey("Given a BQL box having a window", t, func() {
+		s := `SELECT RSTREAM count(*) AS c FROM s [RANGE 3 TUPLES]`
+		b := newBox(s, 1)
+		Reset(func() {
+			b.(core.StatefulBox).Terminate(ctx)
+		})
+		process(b, mkTuples(2))
+
+		Convey("When saving its state and loading it to a new box", func() {
+			buf := bytes.NewBuffer(nil)
+			So(b.SaveCheckpoint(ctx, buf), ShouldBeNil)
+			b2 := newBox(s, 1)
+			defer b2.(core.StatefulBox).Terminate(ctx)
+			So(b2.LoadCheckpoint(ctx, buf), ShouldBeNil)
+
+			Convey("Then the new box should have tuples in the window", func() {
+				res := process(b2, mkTuples(1))
+				So(res, ShouldResemble, []data.Map{{"c": data.Int(3)}})
+			})
+		})
+	})
+
+	Convey("Given a sharded BQL box", t, func() {
+		s := `SELECT ISTREAM s:int, count(*) AS c FROM s [RANGE 3600 SECONDS] GROUP BY s:int`
+		b := newBox(s, 4)
+		Reset(func() {
+			b.(core.StatefulBox).Terminate(ctx)
+		})
+		process(b, mkTuples(4))
+
+		Convey("When loading its state to a box with a different number of shards", func() {
+			buf := bytes.NewBuffer(nil)
+			So(b.SaveCheckpoint(ctx, buf), ShouldBeNil)
+			b2 := newBox(s, 2)
+			defer b2.(core.StatefulBox).Terminate(ctx)
+			So(b2.LoadCheckpoint(ctx, buf), ShouldBeNil)
+
+			Convey("Then groups should be restored", func() {
+				ts := mkTuples(4)
+				res := process(b2, ts[1:2])
+				So(res, ShouldResemble, []data.Map{{"int": data.Int(2), "c": data.Int(2)}})
+			})
+		})
+	})
+}
+
+func TestBQLBoxWatermark(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a BQL box having a TUMBLING window", t, func() {
+		stmt, _, err := parser.New().ParseStmt(`SELECT RSTREAM count(*) AS c FROM s [TUMBLING 2 SECONDS]`)
+		So(err, ShouldBeNil)
+		sel := stmt.(parser.SelectStmt)
+		b := NewBQLBox(&sel, udf.CopyGlobalUDFRegistry(ctx))
+		So(b.Init(ctx), ShouldBeNil)
+		Reset(func() {
+			b.Terminate(ctx)
+		})
+
+		Convey("When feeding it with tuples having a watermark after their timestamps", func() {
+			secs := []int{1, 0, 2, 5}
+			wms := []int{0, 0, 3, 4}
+			ts := mkTuples(len(secs))
+			for i, t := range ts {
+				t.InputName = "s"
+				t.Timestamp = time.Date(2015, time.April, 10, 10, 23, secs[i], 0, time.UTC)
+				t.Watermark = time.Date(2015, time.April, 10, 10, 23, wms[i], 0, time.UTC)
+			}
+			var res []*core.Tuple
+			w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+				res = append(res, t)
+				return nil
+			})
+			for _, t := range ts[:3] {
+				So(b.Process(ctx, t, w), ShouldBeNil)
+			}
+
+			Convey("Then the emitted tuple should keep the timestamp of the input", func() {
+				So(len(res), ShouldEqual, 1)
+				So(res[0].Data, ShouldResemble, data.Map{"c": data.Int(2)})
+				So(res[0].Timestamp, ShouldResemble, ts[2].Timestamp)
+			})
+
+			Convey("Then the watermark of the emitted tuple should not exceed its timestamp", func() {
+				So(res[0].Watermark, ShouldResemble, ts[2].Timestamp)
+			})
+
+			Convey("And feeding it with a tuple advancing the watermark", func() {
+				So(b.Process(ctx, ts[3], w), ShouldBeNil)
+
+				Convey("Then the emitted tuple should have the watermark of the box", func() {
+					So(len(res), ShouldEqual, 2)
+					So(res[1].Data, ShouldResemble, data.Map{"c": data.Int(1)})
+					So(res[1].Timestamp, ShouldResemble, ts[3].Timestamp)
+					So(res[1].Watermark, ShouldResemble, ts[3].Watermark)
+				})
+			})
+		})
+	})
+}
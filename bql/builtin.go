@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"gopkg.in/natefinch/lumberjack.v2"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
@@ -40,6 +41,90 @@ func init() {
 	MustRegisterGlobalSinkCreator("uds", SinkCreatorFunc(createSharedStateSink))
 }
 
+// keyedTable is a shared state holding rows identified by a key field.
+// It can be joined with a stream using JOIN TABLE, and it can be updated
+// via the uds sink. Each tuple written to the table replaces the row
+// having the same key.
+type keyedTable struct {
+	key data.Path
+
+	m sync.RWMutex
+	// rows holds the rows of the table keyed by the hash of their keys.
+	// rows is nil after the table is terminated.
+	rows map[data.HashValue][]data.Map
+}
+
+func createKeyedTable(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	v, ok := params["key"]
+	if !ok {
+		return nil, fmt.Errorf("cannot find 'key' parameter")
+	}
+	key, err := data.AsString(v)
+	if err != nil {
+		return nil, fmt.Errorf("'key' parameter must be a string: %v", err)
+	}
+	path, err := data.CompilePath(key)
+	if err != nil {
+		return nil, fmt.Errorf("'key' parameter has an invalid path: %v", err)
+	}
+	return &keyedTable{
+		key:  path,
+		rows: map[data.HashValue][]data.Map{},
+	}, nil
+}
+
+func (t *keyedTable) Write(ctx *core.Context, tuple *core.Tuple) error {
+	k, err := tuple.Data.Get(t.key)
+	if err != nil {
+		return fmt.Errorf("a tuple written to the table doesn't have the key: %v", err)
+	}
+	if k.Type() == data.TypeNull {
+		return errors.New("the key of a tuple written to the table must not be null")
+	}
+	row := tuple.Data.Copy()
+	h := data.Hash(k)
+
+	t.m.Lock()
+	defer t.m.Unlock()
+	if t.rows == nil {
+		return errors.New("the table has already been terminated")
+	}
+	bucket := t.rows[h]
+	for i, r := range bucket {
+		if rk, err := r.Get(t.key); err == nil && data.Equal(k, rk) {
+			bucket[i] = row
+			return nil
+		}
+	}
+	t.rows[h] = append(bucket, row)
+	return nil
+}
+
+func (t *keyedTable) Lookup(ctx *core.Context, key data.Value) (data.Map, error) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	if t.rows == nil {
+		return nil, errors.New("the table has already been terminated")
+	}
+	for _, r := range t.rows[data.Hash(key)] {
+		if rk, err := r.Get(t.key); err == nil && data.Equal(key, rk) {
+			return r, nil
+		}
+	}
+	return nil, core.NotExistError(fmt.Errorf("the table doesn't have a row for the key %v", key))
+}
+
+func (t *keyedTable) Terminate(ctx *core.Context) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.rows = nil
+	return nil
+}
+
+func init() {
+	udf.MustRegisterGlobalUDSCreator("keyed_table", udf.UDSCreatorFunc(createKeyedTable))
+}
+
 type readerSource struct {
 	filename string
 	tsField  data.Path
@@ -55,10 +140,19 @@ type readerSource struct {
 	// When its value is less than or equal to 0, the source tries to emit
 	// tuples as fast as possible.
 	interval time.Duration
-	stopCh   chan struct{}
+
+	// watermarkDelay is the allowed lateness of tuples. When its value is
+	// greater than or equal to 0, each tuple gets a watermark which is the
+	// latest timestamp emitted so far minus watermarkDelay. When the value
+	// is less than 0, tuples don't have watermarks.
+	watermarkDelay time.Duration
+	stopCh         chan struct{}
 }
 
 func (s *readerSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	if s.watermarkDelay >= 0 {
+		w = core.NewWatermarkWriter(w, s.watermarkDelay)
+	}
 	for r := int64(0); s.repeat < 0 || r <= s.repeat; r++ {
 		if err := s.generateStream(ctx, w); err != nil {
 			return err
@@ -162,10 +256,12 @@ func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (c
 		TimestampField string
 		Repeat         int64
 		Interval       time.Duration
+		WatermarkDelay time.Duration
 	}{
 		Rewindable:     false,
 		TimestampField: "",
 		Repeat:         0,
+		WatermarkDelay: -1,
 	}
 	dec := data.NewDecoder(nil)
 	if err := dec.Decode(params, v); err != nil {
@@ -181,12 +277,13 @@ func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (c
 	}
 
 	s := &readerSource{
-		filename: v.Path,
-		tsField:  tsField,
-		ioParams: ioParams,
-		repeat:   v.Repeat,
-		interval: v.Interval,
-		stopCh:   make(chan struct{}),
+		filename:       v.Path,
+		tsField:        tsField,
+		ioParams:       ioParams,
+		repeat:         v.Repeat,
+		interval:       v.Interval,
+		watermarkDelay: v.WatermarkDelay,
+		stopCh:         make(chan struct{}),
 	}
 	if v.Rewindable {
 		return core.NewRewindableSource(s), nil
@@ -222,6 +319,22 @@ func (s *writerSink) Write(ctx *core.Context, t *core.Tuple) error {
 	return err
 }
 
+func (s *writerSink) WriteBatch(ctx *core.Context, ts []*core.Tuple) error {
+	var buf bytes.Buffer
+	for _, t := range ts {
+		buf.WriteString(t.Data.String())
+		buf.WriteByte('\n')
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.w == nil {
+		return errors.New("the sink is already closed")
+	}
+	_, err := s.w.Write(buf.Bytes())
+	return err
+}
+
 func (s *writerSink) Close(ctx *core.Context) error {
 	s.m.Lock()
 	defer s.m.Unlock()
@@ -244,7 +357,6 @@ func createStdoutSink(ctx *core.Context, ioParams *IOParams, params data.Map) (c
 
 func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
 	// TODO: currently this sink isn't secure because it accepts any path.
-	// TODO: support buffering
 	// TODO: provide "format" parameter to support output formats other than "jsonl".
 	//       "jsonl" should be the default value.
 	// TODO: support "compression" parameter with values like "gz".
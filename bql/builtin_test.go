@@ -22,6 +22,7 @@ type testFileWriter struct {
 	c   *sync.Cond
 	cnt int
 	tss []time.Time
+	wms []time.Time
 }
 
 func (w *testFileWriter) Write(ctx *core.Context, t *core.Tuple) error {
@@ -29,6 +30,7 @@ func (w *testFileWriter) Write(ctx *core.Context, t *core.Tuple) error {
 	defer w.m.Unlock()
 	w.cnt++
 	w.tss = append(w.tss, t.Timestamp)
+	w.wms = append(w.wms, t.Watermark)
 	w.c.Broadcast()
 	return nil
 }
@@ -220,6 +222,44 @@ func TestFileSource(t *testing.T) {
 			})
 		})
 
+		Convey("When reading the file without a watermark_delay parameter", func() {
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			err = s.GenerateStream(ctx, w)
+			So(err, ShouldBeNil)
+
+			Convey("Then tuples shouldn't have watermarks", func() {
+				So(w.wms, ShouldHaveLength, 3)
+				for _, wm := range w.wms {
+					So(wm.IsZero(), ShouldBeTrue)
+				}
+			})
+		})
+
+		Convey("When reading the file with a watermark_delay parameter", func() {
+			params["timestamp_field"] = data.String("ts")
+			params["watermark_delay"] = data.Int(2)
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			err = s.GenerateStream(ctx, w)
+			So(err, ShouldBeNil)
+
+			Convey("Then tuples should have watermarks delayed from their timestamps", func() {
+				So(w.wms, ShouldHaveLength, 3)
+				for i, wm := range w.wms {
+					So(wm, ShouldResemble, w.tss[i].Add(-2*time.Second))
+				}
+			})
+		})
+
 		Convey("When creating a file source with invalid parameters", func() {
 			Convey("Then missing path parameter should result in an error", func() {
 				delete(params, "path")
@@ -256,6 +296,12 @@ func TestFileSource(t *testing.T) {
 				_, err := createFileSource(ctx, &IOParams{}, params)
 				So(err, ShouldNotBeNil)
 			})
+
+			Convey("Then invalid watermark_delay value should result in an error", func() {
+				params["watermark_delay"] = data.String("a")
+				_, err := createFileSource(ctx, &IOParams{}, params)
+				So(err, ShouldNotBeNil)
+			})
 		})
 	})
 }
@@ -299,6 +345,20 @@ func TestFileSink(t *testing.T) {
 					actualByte, err := ioutil.ReadFile(fn)
 					So(err, ShouldBeNil)
 					So(string(actualByte), ShouldEqual, `{"k":-1}
+`)
+				})
+			})
+			Convey("And when write a batch of tuples to the sink", func() {
+				ts := []*core.Tuple{
+					core.NewTuple(data.Map{"k": data.Int(1)}),
+					core.NewTuple(data.Map{"k": data.Int(2)}),
+				}
+				So(si.(core.BatchSink).WriteBatch(ctx, ts), ShouldBeNil)
+				Convey("Then the tuples should be written in the file", func() {
+					actualByte, err := ioutil.ReadFile(fn)
+					So(err, ShouldBeNil)
+					So(string(actualByte), ShouldEqual, `{"k":1}
+{"k":2}
 `)
 				})
 			})
@@ -428,3 +488,74 @@ func TestFileSink(t *testing.T) {
 		})
 	})
 }
+
+func TestKeyedTable(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a keyed_table state updated through a uds sink", t, func() {
+		s, err := createKeyedTable(ctx, data.Map{"key": data.String("id")})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("devices", "keyed_table", s), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("devices")
+		})
+		table := s.(core.KeyedSharedState)
+
+		sink, err := createSharedStateSink(ctx, &IOParams{}, data.Map{"name": data.String("devices")})
+		So(err, ShouldBeNil)
+
+		Convey("When writing rows to the sink", func() {
+			So(sink.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1), "name": data.String("a")})), ShouldBeNil)
+			So(sink.Write(ctx, core.NewTuple(data.Map{"id": data.Int(2), "name": data.String("b")})), ShouldBeNil)
+
+			Convey("Then rows should be looked up by the key", func() {
+				r, err := table.Lookup(ctx, data.Int(2))
+				So(err, ShouldBeNil)
+				So(r, ShouldResemble, data.Map{"id": data.Int(2), "name": data.String("b")})
+			})
+
+			Convey("Then looking up a missing key should fail with NotExistError", func() {
+				_, err := table.Lookup(ctx, data.Int(3))
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("And writing a row having an existing key", func() {
+				So(sink.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1), "name": data.String("c")})), ShouldBeNil)
+
+				Convey("Then the row should be replaced", func() {
+					r, err := table.Lookup(ctx, data.Int(1))
+					So(err, ShouldBeNil)
+					So(r, ShouldResemble, data.Map{"id": data.Int(1), "name": data.String("c")})
+				})
+			})
+		})
+
+		Convey("When writing a row without the key", func() {
+			err := sink.Write(ctx, core.NewTuple(data.Map{"name": data.String("a")}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the table is terminated", func() {
+			So(s.Terminate(ctx), ShouldBeNil)
+
+			Convey("Then writing and looking up rows should fail", func() {
+				So(sink.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1)})), ShouldNotBeNil)
+				_, err := table.Lookup(ctx, data.Int(1))
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given parameters without a key", t, func() {
+		Convey("When creating a keyed_table state", func() {
+			_, err := createKeyedTable(ctx, data.Map{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,366 @@
+package execution
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// analyticFuncArities holds the minimum and maximum number of
+// parameters of the functions that can be called with an OVER clause.
+var analyticFuncArities = map[string][2]int{
+	"row_number":  {0, 0},
+	"rank":        {0, 0},
+	"dense_rank":  {0, 0},
+	"lag":         {1, 3},
+	"lead":        {1, 3},
+	"first_value": {1, 1},
+	"last_value":  {1, 1},
+	"nth_value":   {2, 2},
+}
+
+// parserAnalyticFuncAppToFlatExpr converts the call of an analytic
+// function such as `lag(a) OVER (PARTITION BY b ORDER BY c)` to an
+// analyticFuncAppAST. The parameters and the expressions in the OVER
+// clause must be flat expressions.
+func parserAnalyticFuncAppToFlatExpr(obj parser.FuncAppAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	name := string(obj.Function)
+	arity, ok := analyticFuncArities[name]
+	if !ok {
+		return nil, fmt.Errorf("function '%s' cannot be used with OVER", name)
+	}
+	if n := len(obj.Expressions); n < arity[0] || n > arity[1] {
+		return nil, fmt.Errorf("analytic function '%s' cannot take %d parameter(s)", name, n)
+	}
+	if len(obj.Ordering) > 0 || obj.Distinct {
+		return nil, fmt.Errorf("you cannot use ORDER BY or DISTINCT in "+
+			"analytic function '%s'", name)
+	}
+	toFlat := func(e parser.Expression) (FlatExpression, error) {
+		expr, err := ParserExprToFlatExpr(e, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregate functions cannot be used in analytic functions")
+			} else if strings.HasPrefix(err.Error(), "you cannot use analytic") {
+				err = fmt.Errorf("analytic functions cannot be nested")
+			}
+			return nil, err
+		}
+		return expr, nil
+	}
+
+	res := analyticFuncAppAST{Function: obj.Function}
+	for _, e := range obj.Expressions {
+		expr, err := toFlat(e)
+		if err != nil {
+			return nil, err
+		}
+		res.Expressions = append(res.Expressions, expr)
+	}
+	for _, e := range obj.Over.PartitionBy {
+		expr, err := toFlat(e)
+		if err != nil {
+			return nil, err
+		}
+		res.PartitionBy = append(res.PartitionBy, expr)
+	}
+	for _, e := range obj.Over.Ordering {
+		expr, err := toFlat(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		res.Ordering = append(res.Ordering,
+			analyticSortExpression{expr, e.Ascending != parser.No})
+	}
+	return res, nil
+}
+
+// analyticFunc is the call of an analytic function with an OVER clause.
+// Since the result for a row depends on the other rows in the window,
+// the results are computed by the execution plan for all rows before
+// the projections are evaluated. The result for each row is stored in
+// the row with the key so that the analyticResult evaluator can read it.
+//
+// The rows in the window are divided into partitions having the same
+// values of the PARTITION BY expressions. Rows in each partition are
+// sorted by the ORDER BY expressions, or are kept in the order of their
+// arrival when there is no ORDER BY clause. As in SQL, the frame of a
+// row consists of the rows from the beginning of the partition up to the
+// last row having the same ORDER BY values as the row (i.e., the whole
+// partition when there is no ORDER BY clause). first_value, last_value
+// and nth_value return values in the frame.
+type analyticFunc struct {
+	name        string
+	key         string
+	params      []Evaluator
+	partitionBy []Evaluator
+	ordering    []Evaluator
+	ascending   []bool
+}
+
+// newAnalyticResult creates the analyticFunc of the given call and
+// records it in reg, which must be an aggregateCollector. It returns
+// an Evaluator that reads the result of the function from a row.
+func newAnalyticResult(obj analyticFuncAppAST, reg udf.FunctionRegistry) (Evaluator, error) {
+	c, ok := reg.(*aggregateCollector)
+	if !ok {
+		return nil, fmt.Errorf("analytic function '%s' cannot be used here", obj.Function)
+	}
+	toEvals := func(exprs []FlatExpression) ([]Evaluator, error) {
+		evals := make([]Evaluator, len(exprs))
+		for i, ast := range exprs {
+			eval, err := ExpressionToEvaluator(ast, c.FunctionRegistry)
+			if err != nil {
+				return nil, err
+			}
+			evals[i] = eval
+		}
+		return evals, nil
+	}
+
+	// calls having the same representation are computed only once
+	h := sha1.New()
+	h.Write([]byte(obj.Repr()))
+	a := &analyticFunc{
+		name: string(obj.Function),
+		key:  ":analytic:" + hex.EncodeToString(h.Sum(nil))[:8],
+	}
+	var err error
+	if a.params, err = toEvals(obj.Expressions); err != nil {
+		return nil, err
+	}
+	if a.partitionBy, err = toEvals(obj.PartitionBy); err != nil {
+		return nil, err
+	}
+	orderExprs := make([]FlatExpression, len(obj.Ordering))
+	for i, e := range obj.Ordering {
+		orderExprs[i] = e.Expr
+		a.ascending = append(a.ascending, e.Ascending)
+	}
+	if a.ordering, err = toEvals(orderExprs); err != nil {
+		return nil, err
+	}
+	c.analytics[a.key] = a
+	return &analyticResult{a.key}, nil
+}
+
+// analyticResult reads the result of an analytic function computed
+// by the execution plan from the input row.
+type analyticResult struct {
+	key string
+}
+
+func (a *analyticResult) Eval(input data.Value) (data.Value, error) {
+	m, err := data.AsMap(input)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := m[a.key]
+	if !ok {
+		return nil, fmt.Errorf("the result of an analytic function has not been computed")
+	}
+	return v, nil
+}
+
+// collectAnalytics returns the analytic functions used in projections
+// in a deterministic order.
+func collectAnalytics(projections []aliasedEvaluator) []*analyticFunc {
+	m := map[string]*analyticFunc{}
+	for _, proj := range projections {
+		for key, a := range proj.analytics {
+			m[key] = a
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	analytics := make([]*analyticFunc, len(keys))
+	for i, key := range keys {
+		analytics[i] = m[key]
+	}
+	return analytics
+}
+
+// computeAnalytics computes the results of the analytic functions for
+// all rows in the window and stores them in the rows.
+func computeAnalytics(analytics []*analyticFunc, rows []data.Map) error {
+	for _, a := range analytics {
+		if err := a.compute(rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *analyticFunc) compute(rows []data.Map) error {
+	// divide the rows into partitions while keeping the order of rows
+	var partitions [][]int
+	var partitionKeys []data.Array
+	partitionIndex := map[data.HashValue][]int{}
+	for i, row := range rows {
+		key, err := evalAll(a.partitionBy, row)
+		if err != nil {
+			return err
+		}
+		h := data.Hash(key)
+		p := -1
+		for _, candidate := range partitionIndex[h] {
+			if data.Equal(partitionKeys[candidate], key) {
+				p = candidate
+				break
+			}
+		}
+		if p < 0 {
+			p = len(partitions)
+			partitions = append(partitions, nil)
+			partitionKeys = append(partitionKeys, key)
+			partitionIndex[h] = append(partitionIndex[h], p)
+		}
+		partitions[p] = append(partitions[p], i)
+	}
+
+	ordering := make([]sortArray, len(a.ordering))
+	for j, eval := range a.ordering {
+		values := make(data.Array, len(rows))
+		for i, row := range rows {
+			v, err := eval.Eval(row)
+			if err != nil {
+				return err
+			}
+			values[i] = v
+		}
+		ordering[j] = sortArray{values, a.ascending[j]}
+	}
+
+	// the first parameter is the value returned from another row
+	var values data.Array
+	if len(a.params) > 0 {
+		values = make(data.Array, len(rows))
+		for i, row := range rows {
+			v, err := a.params[0].Eval(row)
+			if err != nil {
+				return err
+			}
+			values[i] = v
+		}
+	}
+
+	for _, p := range partitions {
+		sort.Stable(&indexSlice{p, ordering})
+		if err := a.computePartition(rows, p, ordering, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computePartition computes the results for the rows of a partition.
+// p holds the indexes of the rows in the sorted order.
+func (a *analyticFunc) computePartition(rows []data.Map, p []int, ordering []sortArray, values data.Array) error {
+	peers := func(i, j int) bool {
+		for _, order := range ordering {
+			if !data.Equal(order.values[i], order.values[j]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	rank, denseRank := 0, 0
+	// frameEnd is the position after the last peer of the current row
+	frameEnd := 0
+	for pos, i := range p {
+		row := rows[i]
+		if pos == 0 || !peers(p[pos-1], i) {
+			rank = pos + 1
+			denseRank++
+		}
+		if pos >= frameEnd {
+			frameEnd = pos + 1
+			for frameEnd < len(p) && peers(i, p[frameEnd]) {
+				frameEnd++
+			}
+		}
+
+		var res data.Value = data.Null{}
+		switch a.name {
+		case "row_number":
+			res = data.Int(pos + 1)
+		case "rank":
+			res = data.Int(rank)
+		case "dense_rank":
+			res = data.Int(denseRank)
+		case "lag", "lead":
+			offset, err := a.intParam(row, 1, 1, 0)
+			if err != nil {
+				return err
+			}
+			target := pos - int(offset)
+			if a.name == "lead" {
+				target = pos + int(offset)
+			}
+			if target >= 0 && target < len(p) {
+				res = values[p[target]]
+			} else if len(a.params) > 2 {
+				if res, err = a.params[2].Eval(row); err != nil {
+					return err
+				}
+			}
+		case "first_value":
+			res = values[p[0]]
+		case "last_value":
+			res = values[p[frameEnd-1]]
+		case "nth_value":
+			n, err := a.intParam(row, 1, 1, 1)
+			if err != nil {
+				return err
+			}
+			if n <= int64(frameEnd) {
+				res = values[p[n-1]]
+			}
+		}
+		row[a.key] = res
+	}
+	return nil
+}
+
+// intParam evaluates the integer parameter at idx, which must not be
+// less than min. def is returned when the parameter isn't given.
+func (a *analyticFunc) intParam(row data.Map, idx int, def, min int64) (int64, error) {
+	if idx >= len(a.params) {
+		return def, nil
+	}
+	v, err := a.params[idx].Eval(row)
+	if err != nil {
+		return 0, err
+	}
+	i, err := data.AsInt(v)
+	if err != nil || i < min {
+		return 0, fmt.Errorf("parameter %d of analytic function '%s' must be "+
+			"an integer not less than %d: %v", idx+1, a.name, min, v)
+	}
+	return i, nil
+}
+
+// evalAll evaluates the evaluators on the row and returns the results
+// as an array.
+func evalAll(evals []Evaluator, row data.Map) (data.Array, error) {
+	res := make(data.Array, len(evals))
+	for i, eval := range evals {
+		v, err := eval.Eval(row)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
@@ -0,0 +1,200 @@
+package execution
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func getMeterTuples() []*core.Tuple {
+	readings := []struct {
+		meter string
+		value int64
+	}{
+		{"a", 10}, {"b", 100}, {"a", 15}, {"a", 15}, {"b", 130}, {"a", 22},
+	}
+	tuples := make([]*core.Tuple, len(readings))
+	for i, r := range readings {
+		tuples[i] = &core.Tuple{
+			Data: data.Map{
+				"meter": data.String(r.meter),
+				"value": data.Int(r.value),
+				"seq":   data.Int(i),
+			},
+			InputName: "src",
+			Timestamp: time.Date(2015, time.April, 10, 10, 23, i, 0, time.UTC),
+		}
+	}
+	return tuples
+}
+
+func TestAnalyticFunctions(t *testing.T) {
+	Convey("Given a SELECT clause computing deltas with lag", t, func() {
+		tuples := getMeterTuples()
+		s := `CREATE STREAM box AS SELECT RSTREAM meter, seq,
+			value - lag(value) OVER (PARTITION BY meter ORDER BY ts()) AS delta
+			FROM src [RANGE 4 TUPLES] ORDER BY seq`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the deltas in the last window should be computed per meter", func() {
+				So(out, ShouldResemble, []data.Map{
+					{"meter": data.String("a"), "seq": data.Int(2), "delta": data.Null{}},
+					{"meter": data.String("a"), "seq": data.Int(3), "delta": data.Int(0)},
+					{"meter": data.String("b"), "seq": data.Int(4), "delta": data.Null{}},
+					{"meter": data.String("a"), "seq": data.Int(5), "delta": data.Int(7)},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with ranking functions", t, func() {
+		tuples := getMeterTuples()
+		s := `CREATE STREAM box AS SELECT RSTREAM seq,
+			row_number() OVER (ORDER BY value DESC) AS n,
+			rank() OVER (ORDER BY value DESC) AS r,
+			dense_rank() OVER (ORDER BY value DESC) AS d,
+			row_number() OVER (PARTITION BY meter) AS pn
+			FROM src [RANGE 6 TUPLES] ORDER BY seq`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the rows should be ranked", func() {
+				// values in descending order: 130, 100, 22, 15, 15, 10
+				expected := [][]int64{
+					{0, 6, 6, 5, 1}, {1, 2, 2, 2, 1}, {2, 4, 4, 4, 2},
+					{3, 5, 4, 4, 3}, {4, 1, 1, 1, 2}, {5, 3, 3, 3, 4},
+				}
+				So(len(out), ShouldEqual, len(expected))
+				for i, e := range expected {
+					So(out[i], ShouldResemble, data.Map{"seq": data.Int(e[0]),
+						"n": data.Int(e[1]), "r": data.Int(e[2]), "d": data.Int(e[3]),
+						"pn": data.Int(e[4])})
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with value functions", t, func() {
+		tuples := getMeterTuples()
+		s := `CREATE STREAM box AS SELECT RSTREAM seq,
+			lead(value, 1, -1) OVER (PARTITION BY meter ORDER BY seq) AS next,
+			lag(value, 2) OVER (ORDER BY seq) AS prev2,
+			first_value(seq) OVER (PARTITION BY meter ORDER BY value) AS first,
+			last_value(seq) OVER (PARTITION BY meter ORDER BY value) AS last,
+			last_value(seq) OVER (PARTITION BY meter) AS last_all,
+			nth_value(seq, 2) OVER (PARTITION BY meter ORDER BY seq) AS second
+			FROM src [RANGE 6 TUPLES] ORDER BY seq`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the values should be taken from the other rows", func() {
+				null := data.Null{}
+				expected := [][]data.Value{
+					{data.Int(0), data.Int(15), null, data.Int(0), data.Int(0), data.Int(5), null},
+					{data.Int(1), data.Int(130), null, data.Int(1), data.Int(1), data.Int(4), null},
+					{data.Int(2), data.Int(15), data.Int(10), data.Int(0), data.Int(3), data.Int(5), data.Int(2)},
+					{data.Int(3), data.Int(22), data.Int(100), data.Int(0), data.Int(3), data.Int(5), data.Int(2)},
+					{data.Int(4), data.Int(-1), data.Int(15), data.Int(1), data.Int(4), data.Int(4), data.Int(4)},
+					{data.Int(5), data.Int(-1), data.Int(15), data.Int(0), data.Int(5), data.Int(5), data.Int(2)},
+				}
+				So(len(out), ShouldEqual, len(expected))
+				for i, e := range expected {
+					So(out[i], ShouldResemble, data.Map{"seq": e[0], "next": e[1],
+						"prev2": e[2], "first": e[3], "last": e[4], "last_all": e[5],
+						"second": e[6]})
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with an invalid offset of lag", t, func() {
+		tuples := getMeterTuples()
+		s := `CREATE STREAM box AS SELECT RSTREAM lag(value, -1) OVER () AS x
+			FROM src [RANGE 2 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with a tuple", func() {
+			_, err := plan.Process(tuples[0])
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a filter plan with an analytic function", t, func() {
+		tuples := getMeterTuples()
+		s := `CREATE STREAM box AS SELECT RSTREAM seq, row_number() OVER () AS n,
+			lag(value, 1, 0) OVER (PARTITION BY meter) AS prev
+			FROM src [RANGE 1 TUPLES]`
+		plan, refPlan, err := createFilterPlan(s, t)
+		So(err, ShouldBeNil)
+
+		compareWithRef(t, plan, refPlan, tuples)
+	})
+
+	for _, c := range []struct {
+		stmt string
+		err  string
+	}{
+		{`SELECT RSTREAM a FROM src [RANGE 2 TUPLES] WHERE row_number() OVER () = 1`,
+			"analytic functions not allowed in WHERE clause"},
+		{`SELECT RSTREAM count(a), row_number() OVER () FROM src [RANGE 2 TUPLES]`,
+			"analytic functions cannot be used with GROUP BY or aggregate functions"},
+		{`SELECT RSTREAM sum(lag(a) OVER ()) FROM src [RANGE 2 TUPLES]`,
+			"analytic functions cannot be used in aggregate functions"},
+		{`SELECT RSTREAM lag(row_number() OVER ()) OVER () FROM src [RANGE 2 TUPLES]`,
+			"analytic functions cannot be nested"},
+		{`SELECT RSTREAM lag(count(a)) OVER () FROM src [RANGE 2 TUPLES]`,
+			"aggregate functions cannot be used in analytic functions"},
+		{`SELECT RSTREAM sum(a) OVER () FROM src [RANGE 2 TUPLES]`,
+			"function 'sum' cannot be used with OVER"},
+		{`SELECT RSTREAM rank(a) OVER () FROM src [RANGE 2 TUPLES]`,
+			"analytic function 'rank' cannot take 1 parameter(s)"},
+	} {
+		c := c
+		Convey(fmt.Sprintf("Given a statement %s", c.stmt), t, func() {
+			reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+			stmt, _, err := parser.New().ParseStmt(c.stmt)
+			So(err, ShouldBeNil)
+			lp, err := Analyze(stmt.(parser.SelectStmt), reg)
+			if err == nil {
+				_, err = lp.MakePhysicalPlan(reg)
+			}
+
+			Convey("Then creating a plan should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, c.err)
+			})
+		})
+	}
+}
@@ -14,11 +14,23 @@ type aliasedEvaluator struct {
 	evaluator    Evaluator
 	hasAggregate bool
 	aggrEvals    map[string]Evaluator
+	// aggregates holds the calls of incremental aggregate functions
+	// keyed by the key of their results and recompute is true if
+	// there are other aggregate function calls.
+	aggregates map[string]incrementalAggregate
+	recompute  bool
+	// analytics holds the calls of analytic functions keyed by
+	// the key of their results.
+	analytics map[string]*analyticFunc
 }
 
 type commonExecutionPlan struct {
 	projections []aliasedEvaluator
 	groupList   []Evaluator
+	// analytics holds the analytic functions used in the projections.
+	// Their results must be computed before the projections are
+	// evaluated.
+	analytics []*analyticFunc
 	// filter stores the evaluator of the filter condition,
 	// or nil if there is no WHERE clause.
 	filter Evaluator
@@ -28,7 +40,8 @@ func prepareProjections(projections []aliasedExpression, reg udf.FunctionRegistr
 	output := make([]aliasedEvaluator, len(projections))
 	for i, proj := range projections {
 		// compute evaluators for each column
-		plan, err := ExpressionToEvaluator(proj.expr, reg)
+		collector := newAggregateCollector(reg)
+		plan, err := ExpressionToEvaluator(proj.expr, collector)
 		if err != nil {
 			return nil, err
 		}
@@ -46,13 +59,14 @@ func prepareProjections(projections []aliasedExpression, reg udf.FunctionRegistr
 			}
 		}
 		var path data.Path
-		if proj.alias != "*" && proj.alias != ":having:" {
+		if proj.alias != "*" && proj.alias != ":having:" && proj.alias != ":order:" {
 			path, err = data.CompilePath(proj.alias)
 			if err != nil {
 				return nil, err
 			}
 		}
-		output[i] = aliasedEvaluator{proj.alias, path, plan, containsAggregate, aggrEvals}
+		output[i] = aliasedEvaluator{proj.alias, path, plan, containsAggregate, aggrEvals,
+			collector.aggregates, collector.recompute, collector.analytics}
 	}
 	return output, nil
 }
@@ -14,7 +14,7 @@ type defaultSelectExecutionPlan struct {
 // CanBuildDefaultSelectExecutionPlan checks whether the given statement
 // allows to use an defaultSelectExecutionPlan.
 func CanBuildDefaultSelectExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) bool {
-	return !lp.GroupingStmt
+	return !lp.GroupingStmt && lp.MatchRecognize == nil
 }
 
 // NewDefaultSelectExecutionPlan creates a plan that follows the
@@ -84,17 +84,25 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 			if err != nil {
 				return fmt.Errorf("cached data was not a map: %v", io.cache)
 			}
-			output = append(output, resultRow{row: cachedResults, hash: io.hash})
+			output = append(output, resultRow{row: cachedResults, hash: io.hash, order: io.order})
 			return nil
 		}
 		// otherwise, compute all the expressions
 		d := *io.input
 		result := data.Map(make(map[string]data.Value, len(ep.projections)))
+		var order data.Array
 		for _, proj := range ep.projections {
 			value, err := proj.evaluator.Eval(d)
 			if err != nil {
 				return err
 			}
+			// the values of the ORDER BY clause are not emitted
+			if proj.alias == ":order:" {
+				if order, err = data.AsArray(value); err != nil {
+					return err
+				}
+				continue
+			}
 			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
 				return err
 			}
@@ -102,12 +110,29 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 		// update the fields of the input data for the next iteration
 		io.cache = result
 		io.hash = data.Hash(io.cache)
+		io.order = order
 		// since we have no grouping etc., "output data" = "cached data"
 		// and "hash of output data" = "hash of cached data"
-		output = append(output, resultRow{row: result, hash: io.hash})
+		output = append(output, resultRow{row: result, hash: io.hash, order: order})
 		return nil
 	}
 
+	// compute the results of analytic functions for all rows. since
+	// they depend on the other rows in the window, cached results
+	// cannot be used.
+	if len(ep.analytics) > 0 {
+		rows := make([]data.Map, 0, ep.filteredInputRows.Len())
+		for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
+			item := e.Value.(*inputRowWithCachedResult)
+			item.cache = nil
+			rows = append(rows, *item.input)
+		}
+		if err := computeAnalytics(ep.analytics, rows); err != nil {
+			rollback()
+			return err
+		}
+	}
+
 	// compute the output for each item in ep.filteredInputRows
 	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
 		item := e.Value.(*inputRowWithCachedResult)
@@ -3,6 +3,7 @@ package execution
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,8 +32,12 @@ func getTuples(num int) []*core.Tuple {
 }
 
 func createDefaultSelectPlan(s string, t *testing.T) (PhysicalPlan, error) {
+	return createDefaultSelectPlanWithContext(s, core.NewContext(nil), t)
+}
+
+func createDefaultSelectPlanWithContext(s string, ctx *core.Context, t *testing.T) (PhysicalPlan, error) {
 	p := parser.New()
-	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	reg := udf.CopyGlobalUDFRegistry(ctx)
 	_stmt, _, err := p.ParseStmt(s)
 	if err != nil {
 		return nil, err
@@ -2077,6 +2082,434 @@ func TestDefaultSelectExecutionPlanJoin(t *testing.T) {
 	})
 }
 
+func TestDefaultSelectExecutionPlanHashJoin(t *testing.T) {
+	Convey("Given a JOIN ... ON selecting from left and right", t, func() {
+		tuples := getTuples(8)
+		// rearrange the tuples
+		for i, t := range tuples {
+			if i%2 == 0 {
+				t.InputName = "src1"
+				t.Data["l"] = data.String(fmt.Sprintf("l%d", i))
+			} else {
+				t.InputName = "src2"
+				t.Data["r"] = data.String(fmt.Sprintf("r%d", i))
+			}
+		}
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:l, src2:r FROM src1 [RANGE 2 TUPLES] ` +
+			`JOIN src2 [RANGE 2 TUPLES] ON src2:int = src1:int + 1`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then joined values should appear in %v", idx), func() {
+					if idx%2 == 1 {
+						// a tuple from src2 (=right) was just added
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String(fmt.Sprintf("l%d", idx-1)), // int: x
+							"r": data.String(fmt.Sprintf("r%d", idx)),   // int: x+1
+						})
+					} else {
+						// a tuple from src1 (=left) was just added
+						So(len(out), ShouldEqual, 0)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a self-join with JOIN ... ON", t, func() {
+		tuples := getTuples(8)
+		// rearrange the tuples
+		for i, t := range tuples {
+			t.InputName = "src"
+			t.Data["x"] = data.String(fmt.Sprintf("x%d", i))
+		}
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:x AS l, src2:x AS r ` +
+			`FROM src [RANGE 2 TUPLES] AS src1 JOIN src [RANGE 2 TUPLES] AS src2 ` +
+			`ON src1:int + 1 = src2:int`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then joined values should appear in %v", idx), func() {
+					if idx == 0 {
+						// join condition fails
+						So(len(out), ShouldEqual, 0)
+					} else {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String(fmt.Sprintf("x%d", idx-1)), // int: x
+							"r": data.String(fmt.Sprintf("x%d", idx)),   // int: x+1
+						})
+					}
+				})
+			}
+		})
+	})
+
+	// tuples from src1 have the keys 1, 2 and 3 and tuples from
+	// src2 have the keys 1 and 3
+	outerJoinTuples := func() []*core.Tuple {
+		tuples := getTuples(5)
+		inputs := []string{"src1", "src1", "src2", "src2", "src1"}
+		keys := []int64{1, 2, 1, 3, 3}
+		for i, t := range tuples {
+			t.InputName = inputs[i]
+			t.Data["k"] = data.Int(keys[i])
+			if inputs[i] == "src1" {
+				t.Data["l"] = data.String(fmt.Sprintf("l%d", i))
+			} else {
+				t.Data["r"] = data.String(fmt.Sprintf("r%d", i))
+			}
+		}
+		return tuples
+	}
+	l := func(i int) data.Value {
+		return data.String(fmt.Sprintf("l%d", i))
+	}
+	r := func(i int) data.Value {
+		return data.String(fmt.Sprintf("r%d", i))
+	}
+	null := data.Null{}
+
+	outerJoinCases := []struct {
+		title    string
+		stmt     string
+		expected [][]data.Map
+	}{
+		{"a LEFT JOIN",
+			`CREATE STREAM box AS SELECT RSTREAM src1:l, src2:r FROM src1 [RANGE 2 TUPLES] ` +
+				`LEFT JOIN src2 [RANGE 1 TUPLES] ON src1:k = src2:k`,
+			[][]data.Map{
+				{{"l": l(0), "r": null}},
+				{{"l": l(0), "r": null}, {"l": l(1), "r": null}},
+				{{"l": l(0), "r": r(2)}, {"l": l(1), "r": null}},
+				// r2 left the window, so l0 doesn't have a match anymore
+				{{"l": l(0), "r": null}, {"l": l(1), "r": null}},
+				{{"l": l(1), "r": null}, {"l": l(4), "r": r(3)}},
+			},
+		},
+		{"a FULL OUTER JOIN",
+			`CREATE STREAM box AS SELECT RSTREAM src1:l, src2:r FROM src1 [RANGE 2 TUPLES] ` +
+				`FULL OUTER JOIN src2 [RANGE 1 TUPLES] ON src1:k = src2:k`,
+			[][]data.Map{
+				{{"l": l(0), "r": null}},
+				{{"l": l(0), "r": null}, {"l": l(1), "r": null}},
+				{{"l": l(0), "r": r(2)}, {"l": l(1), "r": null}},
+				{{"l": l(0), "r": null}, {"l": l(1), "r": null}, {"l": null, "r": r(3)}},
+				{{"l": l(1), "r": null}, {"l": l(4), "r": r(3)}},
+			},
+		},
+		{"a LEFT JOIN and a WHERE clause",
+			`CREATE STREAM box AS SELECT RSTREAM src1:l, src2:r FROM src1 [RANGE 2 TUPLES] ` +
+				`LEFT JOIN src2 [RANGE 1 TUPLES] ON src1:k = src2:k WHERE src1:k < 3`,
+			[][]data.Map{
+				{{"l": l(0), "r": null}},
+				{{"l": l(0), "r": null}, {"l": l(1), "r": null}},
+				{{"l": l(0), "r": r(2)}, {"l": l(1), "r": null}},
+				{{"l": l(0), "r": null}, {"l": l(1), "r": null}},
+				{{"l": l(1), "r": null}},
+			},
+		},
+	}
+
+	for _, c := range outerJoinCases {
+		c := c
+		Convey("Given "+c.title, t, func() {
+			tuples := outerJoinTuples()
+			plan, err := createDefaultSelectPlan(c.stmt, t)
+			So(err, ShouldBeNil)
+
+			Convey("When feeding it with tuples", func() {
+				for idx, inTup := range tuples {
+					out, err := plan.Process(inTup)
+					So(err, ShouldBeNil)
+					// sort the output before checking if it resembles
+					// the expected value
+					sort.Sort(tupleList(out))
+
+					Convey(fmt.Sprintf("Then unmatched tuples should be joined with NULL in %v", idx), func() {
+						So(out, ShouldResemble, c.expected[idx])
+					})
+				}
+			})
+		})
+	}
+
+	Convey("Given a JOIN ... ON with an aggregate in the ON clause", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:l FROM src1 [RANGE 2 TUPLES] ` +
+			`JOIN src2 [RANGE 2 TUPLES] ON src1:k = count(src2:k)`
+		_, err := createDefaultSelectPlan(s, t)
+
+		Convey("Then creating the plan should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a JOIN ... ON referring to an unknown relation", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:l FROM src1 [RANGE 2 TUPLES] ` +
+			`JOIN src2 [RANGE 2 TUPLES] ON src1:k = src3:k`
+		_, err := createDefaultSelectPlan(s, t)
+
+		Convey("Then creating the plan should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+// testTable is a keyed shared state used to test JOIN TABLE.
+type testTable struct {
+	rows map[string]data.Map
+}
+
+func (s *testTable) Lookup(ctx *core.Context, key data.Value) (data.Map, error) {
+	k, err := data.AsString(key)
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := s.rows[k]; ok {
+		return r, nil
+	}
+	return nil, core.NotExistError(fmt.Errorf("row '%v' was not found", k))
+}
+
+func (s *testTable) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+type testNonKeyedState struct{}
+
+func (s *testNonKeyedState) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func TestDefaultSelectExecutionPlanTableJoin(t *testing.T) {
+	ctx := core.NewContext(nil)
+	table := &testTable{rows: map[string]data.Map{
+		"a": {"id": data.String("a"), "name": data.String("sensor A")},
+		"b": {"id": data.String("b"), "name": data.String("sensor B")},
+	}}
+	if err := ctx.SharedStates.Add("devices", "test_table", table); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SharedStates.Add("not_a_table", "test_table", &testNonKeyedState{}); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []string{"a", "x", "b", "a"}
+	tableTuples := func() []*core.Tuple {
+		tuples := getTuples(4)
+		for i, t := range tuples {
+			t.Data["id"] = data.String(ids[i])
+		}
+		return tuples
+	}
+
+	Convey("Given a JOIN TABLE", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM src:int, devices:name FROM src [RANGE 1 TUPLES] ` +
+			`JOIN TABLE devices ON src:id = devices:id`
+		plan, err := createDefaultSelectPlanWithContext(s, ctx, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tableTuples() {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the matching row should be joined in %v", idx), func() {
+					if ids[idx] == "x" {
+						So(len(out), ShouldEqual, 0)
+					} else {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"int":  data.Int(idx + 1),
+							"name": data.String("sensor " + strings.ToUpper(ids[idx])),
+						})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a LEFT JOIN TABLE with an alias and a WHERE clause", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM s:int, d:name FROM src [RANGE 1 TUPLES] AS s ` +
+			`LEFT JOIN TABLE devices AS d ON s:id = d:id WHERE s:int != 3`
+		plan, err := createDefaultSelectPlanWithContext(s, ctx, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tableTuples() {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then tuples without a row should be joined with NULL in %v", idx), func() {
+					switch {
+					case idx == 2:
+						So(len(out), ShouldEqual, 0)
+					case ids[idx] == "x":
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"int":  data.Int(idx + 1),
+							"name": data.Null{},
+						})
+					default:
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"int":  data.Int(idx + 1),
+							"name": data.String("sensor " + strings.ToUpper(ids[idx])),
+						})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a JOIN TABLE without an equality in ON", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM src:int FROM src [RANGE 1 TUPLES] ` +
+			`JOIN TABLE devices ON src:id != devices:id`
+		_, err := createDefaultSelectPlanWithContext(s, ctx, t)
+
+		Convey("Then creating a plan should fail", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "ON clause of JOIN TABLE must contain an equality")
+		})
+	})
+
+	Convey("Given a FULL OUTER JOIN TABLE", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM src:int FROM src [RANGE 1 TUPLES] ` +
+			`FULL OUTER JOIN TABLE devices ON src:id = devices:id`
+		_, err := createDefaultSelectPlanWithContext(s, ctx, t)
+
+		Convey("Then creating a plan should fail", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "FULL OUTER JOIN cannot be used with a table")
+		})
+	})
+
+	Convey("Given a JOIN TABLE with a missing or non-keyed state", t, func() {
+		for _, name := range []string{"no_such_state", "not_a_table"} {
+			s := `CREATE STREAM box AS SELECT RSTREAM src:int FROM src [RANGE 1 TUPLES] ` +
+				`JOIN TABLE ` + name + ` ON src:id = ` + name + `:id`
+			_, err := createDefaultSelectPlanWithContext(s, ctx, t)
+
+			Convey("Then creating a plan should fail for "+name, func() {
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+
+	Convey("Given a JOIN TABLE with a table alias that is already used", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM src:int FROM src [RANGE 1 TUPLES] ` +
+			`JOIN TABLE devices AS src ON src:id = src:id`
+		_, err := createDefaultSelectPlanWithContext(s, ctx, t)
+
+		Convey("Then creating a plan should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanOrdering(t *testing.T) {
+	Convey("Given a SELECT clause with ORDER BY, LIMIT and OFFSET", t, func() {
+		tuples := getTuples(6)
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 4 TUPLES]
+			ORDER BY int DESC LIMIT 2 OFFSET 1`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the results should be sorted and limited", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldResemble, []data.Map{{"int": data.Int(1)}})
+				So(outs[2], ShouldResemble, []data.Map{{"int": data.Int(2)}, {"int": data.Int(1)}})
+				So(outs[3], ShouldResemble, []data.Map{{"int": data.Int(3)}, {"int": data.Int(2)}})
+				So(outs[5], ShouldResemble, []data.Map{{"int": data.Int(5)}, {"int": data.Int(4)}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause ordering by a column that is not emitted", t, func() {
+		tuples := getTuples(4)
+		tuples[0].Data["x"] = data.String("c")
+		tuples[1].Data["x"] = data.String("a")
+		tuples[2].Data["x"] = data.Null{}
+		tuples[3].Data["x"] = data.String("b")
+		s := `CREATE STREAM box AS SELECT RSTREAM int AS i FROM src [RANGE 4 TUPLES] ORDER BY x`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the results should be sorted by that column with NULL first", func() {
+				So(out, ShouldResemble, []data.Map{{"i": data.Int(3)}, {"i": data.Int(2)},
+					{"i": data.Int(4)}, {"i": data.Int(1)}})
+			})
+		})
+	})
+
+	Convey("Given an ISTREAM SELECT clause with ORDER BY and LIMIT", t, func() {
+		tuples := getTuples(6)
+		tuples[2].Data["int"] = data.Int(10)
+		s := `CREATE STREAM box AS SELECT ISTREAM int AS i FROM src [RANGE 3 TUPLES]
+			ORDER BY i DESC LIMIT 2`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then only rows entering the top N should be emitted", func() {
+				So(outs[0], ShouldResemble, []data.Map{{"i": data.Int(1)}})
+				So(outs[1], ShouldResemble, []data.Map{{"i": data.Int(2)}})
+				So(outs[2], ShouldResemble, []data.Map{{"i": data.Int(10)}})
+				So(outs[3], ShouldResemble, []data.Map{{"i": data.Int(4)}})
+				So(outs[4], ShouldResemble, []data.Map{{"i": data.Int(5)}})
+				So(outs[5], ShouldResemble, []data.Map{{"i": data.Int(6)}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with RANGE 1 TUPLES and LIMIT 0", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 1 TUPLES] LIMIT 0`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("Then it should not emit anything", func() {
+			for _, inTup := range getTuples(3) {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			}
+		})
+	})
+}
+
 func createDefaultSelectPlan2(s string) (PhysicalPlan, error) {
 	p := parser.New()
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
@@ -2243,3 +2676,93 @@ func BenchmarkNormalJoin(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkHashJoinExecution(b *testing.B) {
+	s := `CREATE STREAM box AS SELECT ISTREAM src1:int AS l, src2:int AS r
+			FROM src1 [RANGE 1000 TUPLES] JOIN src2 [RANGE 1000 TUPLES] ON src1:int = src2:int`
+	plan, err := createDefaultSelectPlan2(s)
+	if err != nil {
+		panic(err.Error())
+	}
+	tmplTup := core.Tuple{
+		Data:          data.Map{"int": data.Int(-1)},
+		InputName:     "src1",
+		Timestamp:     time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC),
+		ProcTimestamp: time.Date(2015, time.April, 10, 10, 24, 0, 0, time.UTC),
+		BatchID:       7,
+	}
+	for n := 0; n < b.N; n++ {
+		inTup := tmplTup.Copy()
+		inTup.Data["int"] = data.Int(n / 2)
+		if n%2 == 1 {
+			inTup.InputName = "src2"
+		}
+		_, err := plan.Process(inTup)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+}
+
+func TestDefaultSelectExecutionPlanDistinct(t *testing.T) {
+	Convey("Given a SELECT DISTINCT clause", t, func() {
+		tuples := getTuples(6)
+		for i, t := range tuples {
+			t.Data["foo"] = data.Int(i % 3)
+		}
+		s := `CREATE STREAM box AS SELECT ISTREAM DISTINCT foo FROM src [RANGE 4 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each value should only be emitted once per window", func() {
+				So(outs[0], ShouldResemble, []data.Map{{"foo": data.Int(0)}})
+				So(outs[1], ShouldResemble, []data.Map{{"foo": data.Int(1)}})
+				So(outs[2], ShouldResemble, []data.Map{{"foo": data.Int(2)}})
+				So(outs[3], ShouldBeEmpty)
+				So(outs[4], ShouldBeEmpty)
+				So(outs[5], ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given an RSTREAM SELECT DISTINCT clause with ORDER BY and LIMIT", t, func() {
+		tuples := getTuples(6)
+		for i, t := range tuples {
+			t.Data["foo"] = data.Int(i % 3)
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM DISTINCT foo AS f FROM src [RANGE 6 TUPLES]
+			ORDER BY f DESC LIMIT 2`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then duplicates should be removed before the LIMIT is applied", func() {
+				So(out, ShouldResemble, []data.Map{{"f": data.Int(2)}, {"f": data.Int(1)}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT DISTINCT clause ordering by a column that is not emitted", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM DISTINCT foo FROM src [RANGE 6 TUPLES] ORDER BY int`
+		_, err := createDefaultSelectPlan(s, t)
+
+		Convey("Then creating the plan should fail", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "must appear in the SELECT clause")
+		})
+	})
+}
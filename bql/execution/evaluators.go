@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
@@ -103,6 +104,11 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			} else {
 				path = obj.Relation + "." + path
 			}
+			pa, err := newPathAccess(path)
+			if err != nil {
+				return nil, err
+			}
+			return &rowValueAccess{*pa.(*pathAccess), obj.Relation}, nil
 		}
 		return newPathAccess(path)
 	case aggInputRef:
@@ -149,6 +155,11 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return newGreaterOrnewEqual(bo), nil
 		case parser.NotEqual:
 			return newNot(newEqual(bo)), nil
+		case parser.Like, parser.NotLike, parser.ILike, parser.NotILike,
+			parser.SimilarTo, parser.NotSimilarTo,
+			parser.RegexpMatch, parser.NotRegexpMatch,
+			parser.RegexpIMatch, parser.NotRegexpIMatch:
+			return newPatternMatch(bo, obj.Op, isConstantExpr(obj.Right))
 		case parser.Concat:
 			return &concat{bo}, nil
 		case parser.Is:
@@ -173,6 +184,8 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return newDivide(bo), nil
 		case parser.Modulo:
 			return newModulo(bo), nil
+		case parser.AtTimeZone:
+			return newAtTimeZone(bo, isConstantExpr(obj.Right))
 		}
 	case unaryOpAST:
 		// recurse
@@ -232,9 +245,18 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			}
 			evals[i] = eval
 		}
-		return FuncApp(fName, f, reg.Context(), evals), nil
+		fa := FuncApp(fName, f, reg.Context(), evals)
+		if isAggregateFunc(f, len(obj.Expressions)) {
+			collectAggregate(reg, fName, f, obj.Expressions, fa.(*funcApp), false)
+		}
+		return fa, nil
 	case aggregateInputSorter:
+		collectAggregate(reg, string(obj.Function), nil, nil, nil, false)
 		return newSortedInputAggFuncApp(obj.funcAppAST, obj.ID, obj.Ordering, reg)
+	case aggregateInputDeduplicator:
+		return newDistinctInputAggFuncApp(obj, reg)
+	case analyticFuncAppAST:
+		return newAnalyticResult(obj, reg)
 	case arrayAST:
 		// compute child Evaluators
 		evals := make([]Evaluator, len(obj.Expressions))
@@ -286,6 +308,30 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return nil, err
 		}
 		return newCaseBuilder(ref, whens, thens, def)
+	case inAST:
+		expr, err := ExpressionToEvaluator(obj.Expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		values, err := ExpressionToEvaluator(obj.Values, reg)
+		if err != nil {
+			return nil, err
+		}
+		return newIn(expr, values, isConstantExpr(obj.Values), obj.Not)
+	case betweenAST:
+		expr, err := ExpressionToEvaluator(obj.Expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		lower, err := ExpressionToEvaluator(obj.Lower, reg)
+		if err != nil {
+			return nil, err
+		}
+		upper, err := ExpressionToEvaluator(obj.Upper, reg)
+		if err != nil {
+			return nil, err
+		}
+		return &between{expr, lower, upper, obj.Not}, nil
 	case wildcardAST:
 		return &wildcard{obj.Relation}, nil
 	}
@@ -364,6 +410,28 @@ func newPathAccess(s string) (Evaluator, error) {
 	return &pathAccess{path}, nil
 }
 
+// rowValueAccess is a pathAccess for a column of a relation. It returns
+// NULL when the relation itself is NULL, which is the case for the side
+// of an outer join that has no matching tuple.
+type rowValueAccess struct {
+	pathAccess
+	relation string
+}
+
+func (r *rowValueAccess) Eval(input data.Value) (data.Value, error) {
+	v, err := r.pathAccess.Eval(input)
+	if err != nil {
+		// only look at the relation when the access failed so that
+		// the common case does not become slower
+		if m, ok := input.(data.Map); ok {
+			if rel, ok := m[r.relation]; ok && rel.Type() == data.TypeNull {
+				return data.Null{}, nil
+			}
+		}
+	}
+	return v, err
+}
+
 type missingPathCheck struct {
 	eval   pathAccess
 	negate bool
@@ -381,6 +449,9 @@ func (m *missingPathCheck) Eval(input data.Value) (data.Value, error) {
 }
 
 func newMissingPathCheck(eval Evaluator, negate bool) (Evaluator, error) {
+	if ra, ok := eval.(*rowValueAccess); ok {
+		eval = &ra.pathAccess
+	}
 	pa, ok := eval.(*pathAccess)
 	if !ok {
 		return nil, fmt.Errorf("expected pathAccess before IS [NOT] MISSING, not %v", eval)
@@ -475,7 +546,8 @@ func (t *timestampCast) Eval(input data.Value) (data.Value, error) {
 	if err != nil {
 		return nil, err
 	}
-	if val.Type() != data.TypeTimestamp {
+	// the timestamp of the missing side of an outer join is NULL
+	if val.Type() != data.TypeTimestamp && val.Type() != data.TypeNull {
 		return nil, fmt.Errorf("value %v was %T, not Time", val, val)
 	}
 	return val, nil
@@ -683,53 +755,56 @@ func newEqual(bo binOp) Evaluator {
 }
 
 func newLess(bo binOp) Evaluator {
-	cmpOp := func(leftVal data.Value, rightVal data.Value) (bool, error) {
-		leftType := leftVal.Type()
-		rightType := rightVal.Type()
-		stdErr := fmt.Errorf("cannot compare %T and %T", leftVal, rightVal)
-		if leftType == rightType {
-			retVal := false
-			switch leftType {
-			default:
-				return false, stdErr
-			case data.TypeInt:
-				l, _ := data.AsInt(leftVal)
-				r, _ := data.AsInt(rightVal)
-				retVal = l < r
-			case data.TypeFloat:
-				l, _ := data.AsFloat(leftVal)
-				r, _ := data.AsFloat(rightVal)
-				retVal = l < r
-			case data.TypeString:
-				l, _ := data.AsString(leftVal)
-				r, _ := data.AsString(rightVal)
-				retVal = l < r
-			case data.TypeBool:
-				l, _ := data.AsBool(leftVal)
-				r, _ := data.AsBool(rightVal)
-				retVal = (l == false) && (r == true)
-			case data.TypeTimestamp:
-				l, _ := data.AsTimestamp(leftVal)
-				r, _ := data.AsTimestamp(rightVal)
-				retVal = l.Before(r)
-			}
-			return retVal, nil
-		} else if leftType == data.TypeInt && rightType == data.TypeFloat {
-			// left is integer
+	return &compBinOp{bo, lessThan}
+}
+
+// lessThan returns true if leftVal is less than rightVal. It returns
+// an error if the values cannot be compared.
+func lessThan(leftVal data.Value, rightVal data.Value) (bool, error) {
+	leftType := leftVal.Type()
+	rightType := rightVal.Type()
+	stdErr := fmt.Errorf("cannot compare %T and %T", leftVal, rightVal)
+	if leftType == rightType {
+		retVal := false
+		switch leftType {
+		default:
+			return false, stdErr
+		case data.TypeInt:
 			l, _ := data.AsInt(leftVal)
-			// right is float; also convert left to float to avoid overflow
-			r, _ := data.AsFloat(rightVal)
-			return float64(l) < r, nil
-		} else if leftType == data.TypeFloat && rightType == data.TypeInt {
-			// left is float
-			l, _ := data.AsFloat(leftVal)
-			// right is int; convert right to float to avoid overflow
 			r, _ := data.AsInt(rightVal)
-			return l < float64(r), nil
-		}
-		return false, stdErr
+			retVal = l < r
+		case data.TypeFloat:
+			l, _ := data.AsFloat(leftVal)
+			r, _ := data.AsFloat(rightVal)
+			retVal = l < r
+		case data.TypeString:
+			l, _ := data.AsString(leftVal)
+			r, _ := data.AsString(rightVal)
+			retVal = l < r
+		case data.TypeBool:
+			l, _ := data.AsBool(leftVal)
+			r, _ := data.AsBool(rightVal)
+			retVal = (l == false) && (r == true)
+		case data.TypeTimestamp:
+			l, _ := data.AsTimestamp(leftVal)
+			r, _ := data.AsTimestamp(rightVal)
+			retVal = l.Before(r)
+		}
+		return retVal, nil
+	} else if leftType == data.TypeInt && rightType == data.TypeFloat {
+		// left is integer
+		l, _ := data.AsInt(leftVal)
+		// right is float; also convert left to float to avoid overflow
+		r, _ := data.AsFloat(rightVal)
+		return float64(l) < r, nil
+	} else if leftType == data.TypeFloat && rightType == data.TypeInt {
+		// left is float
+		l, _ := data.AsFloat(leftVal)
+		// right is int; convert right to float to avoid overflow
+		r, _ := data.AsInt(rightVal)
+		return l < float64(r), nil
 	}
-	return &compBinOp{bo, cmpOp}
+	return false, stdErr
 }
 
 func newLessOrEqual(bo binOp) Evaluator {
@@ -748,6 +823,143 @@ func newNotEqual(bo binOp) Evaluator {
 	return newNot(newEqual(bo))
 }
 
+/// Set Membership and Range Predicates
+
+// in checks whether a value is contained in an array. When the array
+// is constant, its elements are put in a hash set when the evaluator
+// is created.
+type in struct {
+	expr   Evaluator
+	values Evaluator
+	// set holds the elements of a constant array by their hash value.
+	// If set is nil, values is evaluated on every call.
+	set     map[data.HashValue][]data.Value
+	setNull bool
+	not     bool
+}
+
+func newIn(expr, values Evaluator, constant bool, not bool) (Evaluator, error) {
+	i := &in{
+		expr:   expr,
+		values: values,
+		not:    not,
+	}
+	if !constant {
+		return i, nil
+	}
+	v, err := values.Eval(nil)
+	if err != nil {
+		return nil, err
+	}
+	arr, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("right operand of IN must be an array: %v", v)
+	}
+	i.set = make(map[data.HashValue][]data.Value, len(arr))
+	for _, elem := range arr {
+		if elem.Type() == data.TypeNull {
+			i.setNull = true
+			continue
+		}
+		h := data.Hash(elem)
+		i.set[h] = append(i.set[h], elem)
+	}
+	return i, nil
+}
+
+func (i *in) Eval(input data.Value) (data.Value, error) {
+	v, err := i.expr.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	found, hasNull := false, false
+	if i.set != nil {
+		hasNull = i.setNull
+		for _, elem := range i.set[data.Hash(v)] {
+			if data.Equal(v, elem) {
+				found = true
+				break
+			}
+		}
+	} else {
+		values, err := i.values.Eval(input)
+		if err != nil {
+			return nil, err
+		}
+		if values.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+		arr, err := data.AsArray(values)
+		if err != nil {
+			return nil, fmt.Errorf("right operand of IN must be an array: %v", values)
+		}
+		for _, elem := range arr {
+			if elem.Type() == data.TypeNull {
+				hasNull = true
+			} else if data.Equal(v, elem) {
+				found = true
+				break
+			}
+		}
+	}
+	// like `v = a OR v = b OR ...`, the result is NULL if v is NULL
+	// or if v is not found but the array contains NULL
+	if v.Type() == data.TypeNull || (!found && hasNull) {
+		return data.Null{}, nil
+	}
+	return data.Bool(found != i.not), nil
+}
+
+// between checks whether a value is in a closed interval.
+type between struct {
+	expr  Evaluator
+	lower Evaluator
+	upper Evaluator
+	not   bool
+}
+
+func (b *between) Eval(input data.Value) (data.Value, error) {
+	v, err := b.expr.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	lower, err := b.lower.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := b.upper.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	// like `lower <= v AND v <= upper`, the result is only NULL if it
+	// cannot be decided by the non-NULL bound
+	if v.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	lowerOK, upperOK := true, true
+	if lower.Type() != data.TypeNull {
+		less, err := lessThan(v, lower)
+		if err != nil {
+			return nil, err
+		}
+		lowerOK = !less
+	}
+	if upper.Type() != data.TypeNull {
+		less, err := lessThan(upper, v)
+		if err != nil {
+			return nil, err
+		}
+		upperOK = !less
+	}
+	if !lowerOK || !upperOK {
+		return data.Bool(b.not), nil
+	}
+	if lower.Type() == data.TypeNull || upper.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	return data.Bool(!b.not), nil
+}
+
 /// A Unary Comparison Operation
 
 type isNull struct {
@@ -916,6 +1128,75 @@ func (nbo *concat) Eval(input data.Value) (v data.Value, err error) {
 	return data.String(leftString + rightString), nil
 }
 
+// atTimeZone converts the left operand to the time zone named by the
+// right operand. The result represents the same instant, but date/time
+// functions see the local time in that time zone.
+type atTimeZone struct {
+	binOp
+	// zone and loc hold the time zone used in the previous evaluation
+	// so that the time zone database isn't read for every tuple.
+	zone string
+	loc  *time.Location
+}
+
+// newAtTimeZone returns an Evaluator for AT TIME ZONE. When the time
+// zone is constant, it's loaded when the evaluator is created so that
+// an unknown time zone is reported before any data is processed.
+func newAtTimeZone(bo binOp, constant bool) (Evaluator, error) {
+	a := &atTimeZone{binOp: bo}
+	if constant {
+		v, err := bo.right.Eval(nil)
+		if err != nil {
+			return nil, err
+		}
+		// NULL and non-string time zones are handled by Eval
+		if v.Type() == data.TypeString {
+			zone, _ := data.AsString(v)
+			if _, err := a.location(zone); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return a, nil
+}
+
+func (a *atTimeZone) location(zone string) (*time.Location, error) {
+	if a.loc != nil && a.zone == zone {
+		return a.loc, nil
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone '%s'", zone)
+	}
+	a.zone = zone
+	a.loc = loc
+	return loc, nil
+}
+
+func (a *atTimeZone) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := a.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	t, err := data.AsTimestamp(leftVal)
+	if err != nil {
+		return nil, fmt.Errorf("left operand of AT TIME ZONE must be timestamp: %v", leftVal)
+	}
+	zone, err := data.AsString(rightVal)
+	if err != nil {
+		return nil, fmt.Errorf("right operand of AT TIME ZONE must be string: %v", rightVal)
+	}
+	loc, err := a.location(zone)
+	if err != nil {
+		return nil, err
+	}
+	return data.Timestamp(t.In(loc)), nil
+}
+
 /// Function Evaluation
 
 type funcApp struct {
@@ -924,9 +1205,49 @@ type funcApp struct {
 	params      []Evaluator
 	paramValues []reflect.Value
 	selector    data.Path
+	// resultKey is only set for an incremental aggregate function.
+	// If the input has a value with this key, it's used as the result
+	// of the function instead of calling the function.
+	resultKey string
 }
 
 func (f *funcApp) Eval(input data.Value) (v data.Value, err error) {
+	var result data.Value
+	if f.resultKey != "" {
+		if m, ok := input.(data.Map); ok {
+			result = m[f.resultKey]
+		}
+	}
+	if result == nil {
+		result, err = f.call(input)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if f.selector != nil {
+		switch result.Type() {
+		case data.TypeMap:
+			retmap, _ := data.AsMap(result)
+			selected, err := retmap.Get(f.selector)
+			if err != nil {
+				return nil, err
+			}
+			result = selected
+		case data.TypeArray:
+			retarr, _ := data.AsArray(result)
+			selected, err := retarr.Get(f.selector)
+			if err != nil {
+				return nil, err
+			}
+			result = selected
+		default:
+			return nil, fmt.Errorf("type '%v' is not supported with selector", result.Type())
+		}
+	}
+	return result, nil
+}
+
+func (f *funcApp) call(input data.Value) (v data.Value, err error) {
 	// catch panic (e.g., in called function)
 	defer func() {
 		if r := recover(); r != nil {
@@ -954,28 +1275,7 @@ func (f *funcApp) Eval(input data.Value) (v data.Value, err error) {
 		err := errVal.Interface().(error)
 		return nil, err
 	}
-	result := resultVal.Interface().(data.Value)
-	if f.selector != nil {
-		switch result.Type() {
-		case data.TypeMap:
-			retmap, _ := data.AsMap(result)
-			selected, err := retmap.Get(f.selector)
-			if err != nil {
-				return nil, err
-			}
-			result = selected
-		case data.TypeArray:
-			retarr, _ := data.AsArray(result)
-			selected, err := retarr.Get(f.selector)
-			if err != nil {
-				return nil, err
-			}
-			result = selected
-		default:
-			return nil, fmt.Errorf("type '%v' is not supported with selector", result.Type())
-		}
-	}
-	return result, nil
+	return resultVal.Interface().(data.Value), nil
 }
 
 // FuncApp represents evaluation of a function on a number
@@ -1157,6 +1457,124 @@ func newSortedInputAggFuncApp(obj funcAppAST, id string, ordering []sortExpressi
 	return &sortedInputAggFuncApp{backendFun, inOutKeys, sortEvals}, nil
 }
 
+/// Aggregate Function with Distinct Input
+
+type distinctInputAggFuncApp struct {
+	f Evaluator
+	// keys holds the keys of the aggregated parameters followed by
+	// the keys of the aggregated ORDER BY values. Rows are compared
+	// by the values of the first numParams keys only.
+	keys      []string
+	numParams int
+	// resultKey is the key of the result if the function is computed
+	// incrementally (see funcApp.resultKey)
+	resultKey string
+}
+
+func (d *distinctInputAggFuncApp) Eval(input data.Value) (data.Value, error) {
+	inputMap, err := data.AsMap(input)
+	if err != nil {
+		return nil, err
+	}
+	if d.resultKey != "" {
+		if _, ok := inputMap[d.resultKey]; ok {
+			return d.f.Eval(input)
+		}
+	}
+	if len(d.keys) == 0 {
+		return d.f.Eval(input)
+	}
+
+	// extract the arrays with the aggregated values
+	arrays := make([]data.Array, len(d.keys))
+	for i, key := range d.keys {
+		arr, err := data.AsArray(inputMap[key])
+		if err != nil {
+			return nil, fmt.Errorf("there was no aggregate data with key '%s'", key)
+		}
+		if i > 0 && len(arr) != len(arrays[0]) {
+			return nil, fmt.Errorf("aggregate data with key '%s' had bad length (%d, not %d)",
+				key, len(arr), len(arrays[0]))
+		}
+		arrays[i] = arr
+	}
+
+	// find the indexes of the first occurrences of all distinct
+	// combinations of parameter values
+	indexes := []int{}
+	seen := map[data.HashValue][]data.Array{}
+	for i := range arrays[0] {
+		values := make(data.Array, d.numParams)
+		for j := range values {
+			values[j] = arrays[j][i]
+		}
+		h := data.Hash(values)
+		duplicate := false
+		for _, v := range seen[h] {
+			if data.Equal(v, values) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		seen[h] = append(seen[h], values)
+		indexes = append(indexes, i)
+	}
+
+	// write the deduplicated values to a copy of the input so that
+	// other aggregate functions still see all values
+	distinctMap := make(data.Map, len(inputMap))
+	for k, v := range inputMap {
+		distinctMap[k] = v
+	}
+	for i, key := range d.keys {
+		arr := make(data.Array, len(indexes))
+		for j, idx := range indexes {
+			arr[j] = arrays[i][idx]
+		}
+		distinctMap[key] = arr
+	}
+	return d.f.Eval(distinctMap)
+}
+
+func newDistinctInputAggFuncApp(obj aggregateInputDeduplicator, reg udf.FunctionRegistry) (Evaluator, error) {
+	// the wrapped function must not be recorded by an aggregateCollector
+	// as it would be computed from all values, not the distinct ones
+	innerReg := reg
+	if c, ok := reg.(*aggregateCollector); ok {
+		innerReg = c.FunctionRegistry
+	}
+	f, err := ExpressionToEvaluator(obj.Expr, innerReg)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(obj.Refs)+len(obj.OrderRefs))
+	keys = append(keys, obj.Refs...)
+	keys = append(keys, obj.OrderRefs...)
+	d := &distinctInputAggFuncApp{
+		f:         f,
+		keys:      keys,
+		numParams: len(obj.Refs),
+	}
+
+	fa, ok := f.(*funcApp)
+	if !ok {
+		// an aggregate function with sorted input is always recomputed
+		collectAggregate(reg, "", nil, nil, nil, true)
+		return d, nil
+	}
+	funcAST := obj.Expr.(funcAppAST)
+	aggFunc, err := innerReg.Lookup(string(funcAST.Function), len(funcAST.Expressions))
+	if err != nil {
+		return nil, err
+	}
+	collectAggregate(reg, fa.name, aggFunc, funcAST.Expressions, fa, true)
+	d.resultKey = fa.resultKey
+	return d, nil
+}
+
 /// JSON-like data structures
 
 type arrayBuilder struct {
@@ -1264,6 +1682,10 @@ func (w *wildcard) Eval(input data.Value) (data.Value, error) {
 		if !exists {
 			return nil, fmt.Errorf("there is no entry with key '%s'", w.Relation)
 		}
+		if subElement.Type() == data.TypeNull {
+			// the missing side of an outer join has no columns
+			return output, nil
+		}
 		subMap, err := data.AsMap(subElement)
 		if err != nil {
 			return nil, err
@@ -1274,7 +1696,8 @@ func (w *wildcard) Eval(input data.Value) (data.Value, error) {
 	} else {
 		// if we have *, take items from all submaps
 		for alias, subElement := range aMap {
-			if strings.Contains(alias, ":meta:") {
+			if strings.Contains(alias, ":meta:") || strings.HasPrefix(alias, ":analytic:") ||
+				subElement.Type() == data.TypeNull {
 				continue
 			}
 			subMap, err := data.AsMap(subElement)
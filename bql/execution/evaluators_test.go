@@ -122,6 +122,18 @@ func TestFoldableExecution(t *testing.T) {
 			false, nil},
 		{parser.BinaryOpAST{parser.Concat, parser.StringLiteral{"7"}, parser.StringLiteral{"b"}},
 			true, data.String("7b")},
+		{parser.InAST{Expr: parser.NumericLiteral{7},
+			Values: parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}}},
+			false, nil},
+		{parser.InAST{Expr: parser.NumericLiteral{7},
+			Values: parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}}},
+			true, data.Bool(true)},
+		{parser.BetweenAST{Expr: parser.NumericLiteral{7},
+			Lower: parser.NumericLiteral{1}, Upper: parser.RowValue{"", "a"}},
+			false, nil},
+		{parser.BetweenAST{Expr: parser.NumericLiteral{7},
+			Lower: parser.NumericLiteral{1}, Upper: parser.NumericLiteral{5}},
+			true, data.Bool(false)},
 		// Other
 		{parser.AliasAST{parser.RowValue{"", "a"}, "hoge"},
 			false, nil},
@@ -132,27 +144,27 @@ func TestFoldableExecution(t *testing.T) {
 		{parser.TypeCastAST{parser.NumericLiteral{7}, parser.Float},
 			true, data.Float(7.0)},
 		{parser.FuncAppAST{parser.FuncName("now"),
-			parser.ExpressionsAST{[]parser.Expression{}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, false, nil},
 			false, nil},
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil, false, nil},
 			false, nil},
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}, nil, false, nil},
 			true, data.Int(8)},
 		{parser.FuncAppSelectorAST{
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.ArrayAST{parser.ExpressionsAST{
 						[]parser.Expression{parser.NumericLiteral{1}}}},
-				}}, nil},
+				}}, nil, false, nil},
 			parser.Raw{"[0]"}},
 			true, data.Int(1)},
 		{parser.FuncAppSelectorAST{
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.MapAST{[]parser.KeyValuePairAST{{"a", parser.StringLiteral{"value"}}}},
-				}}, nil},
+				}}, nil, false, nil},
 			parser.Raw{".a"}},
 			true, data.String("value")},
 		{parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}},
@@ -236,6 +248,108 @@ func TestFoldableExecution(t *testing.T) {
 	}
 }
 
+func TestInWithConstantOperand(t *testing.T) {
+	reg := &testFuncRegistry{ctx: core.NewContext(nil)}
+
+	Convey("Given an IN predicate whose constant right operand is not an array", t, func() {
+		ast := parser.InAST{Expr: parser.RowValue{"", "a"}, Values: parser.NumericLiteral{7}}
+		flatExpr, err := ParserExprToFlatExpr(ast, reg)
+		So(err, ShouldBeNil)
+
+		Convey("When an evaluator is created", func() {
+			_, err := ExpressionToEvaluator(flatExpr, reg)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "must be an array")
+			})
+		})
+	})
+}
+
+func TestAtTimeZone(t *testing.T) {
+	reg := &testFuncRegistry{ctx: core.NewContext(nil)}
+	ts := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+
+	Convey("Given an AT TIME ZONE operation", t, func() {
+		ast := parser.BinaryOpAST{Op: parser.AtTimeZone, Left: parser.RowValue{Column: "a"}, Right: parser.RowValue{Column: "z"}}
+		flatExpr, err := ParserExprToFlatExpr(ast, reg)
+		So(err, ShouldBeNil)
+		eval, err := ExpressionToEvaluator(flatExpr, reg)
+		So(err, ShouldBeNil)
+
+		Convey("When converting a timestamp to a time zone", func() {
+			res, err := eval.Eval(data.Map{"a": data.Timestamp(ts), "z": data.String("Asia/Tokyo")})
+			So(err, ShouldBeNil)
+			t, err := data.AsTimestamp(res)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should represent the same instant in the time zone", func() {
+				So(t.Equal(ts), ShouldBeTrue)
+				So(t.Location().String(), ShouldEqual, "Asia/Tokyo")
+				So(t.Hour(), ShouldEqual, 23)
+			})
+
+			Convey("And converting it to another time zone", func() {
+				res, err := eval.Eval(data.Map{"a": res, "z": data.String("UTC")})
+				So(err, ShouldBeNil)
+				t, _ := data.AsTimestamp(res)
+
+				Convey("Then the time zone should be changed", func() {
+					So(t.Equal(ts), ShouldBeTrue)
+					So(t.Hour(), ShouldEqual, 14)
+				})
+			})
+		})
+
+		Convey("When an operand is NULL", func() {
+			res1, err1 := eval.Eval(data.Map{"a": data.Null{}, "z": data.String("UTC")})
+			res2, err2 := eval.Eval(data.Map{"a": data.Timestamp(ts), "z": data.Null{}})
+
+			Convey("Then the result should be NULL", func() {
+				So(err1, ShouldBeNil)
+				So(res1, ShouldResemble, data.Null{})
+				So(err2, ShouldBeNil)
+				So(res2, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When an operand has a wrong type", func() {
+			_, err1 := eval.Eval(data.Map{"a": data.String("2015-05-01"), "z": data.String("UTC")})
+			_, err2 := eval.Eval(data.Map{"a": data.Timestamp(ts), "z": data.Int(9)})
+
+			Convey("Then evaluation should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the time zone is unknown", func() {
+			_, err := eval.Eval(data.Map{"a": data.Timestamp(ts), "z": data.String("Mars/Olympus")})
+
+			Convey("Then evaluation should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "unknown time zone")
+			})
+		})
+	})
+
+	Convey("Given an AT TIME ZONE operation with an unknown constant time zone", t, func() {
+		ast := parser.BinaryOpAST{Op: parser.AtTimeZone, Left: parser.RowValue{Column: "a"}, Right: parser.StringLiteral{Value: "Mars/Olympus"}}
+		flatExpr, err := ParserExprToFlatExpr(ast, reg)
+		So(err, ShouldBeNil)
+
+		Convey("When an evaluator is created", func() {
+			_, err := ExpressionToEvaluator(flatExpr, reg)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "unknown time zone")
+			})
+		})
+	})
+}
+
 func TestFuncAppConversion(t *testing.T) {
 	Convey("Given a function registry", t, func() {
 		reg := &testFuncRegistry{ctx: core.NewContext(nil)}
@@ -244,7 +358,7 @@ func TestFuncAppConversion(t *testing.T) {
 			ast := parser.FuncAppAST{parser.FuncName("plusone"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil}
+				}}, nil, false, nil}
 
 			Convey("Then we obtain an evaluatable funcApp", func() {
 				flatExpr, err := ParserExprToFlatExpr(ast, reg)
@@ -261,7 +375,7 @@ func TestFuncAppConversion(t *testing.T) {
 					parser.ExpressionsAST{[]parser.Expression{
 						parser.MapAST{[]parser.KeyValuePairAST{
 							{"a", parser.StringLiteral{"value"}}}},
-					}}, nil},
+					}}, nil, false, nil},
 				parser.Raw{".a"}}
 
 			Convey("Then we obtain an evaluatable funcApp", func() {
@@ -277,7 +391,7 @@ func TestFuncAppConversion(t *testing.T) {
 			ast := parser.FuncAppAST{parser.FuncName("fun"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil}
+				}}, nil, false, nil}
 
 			Convey("Then converting to an Evaluator fails", func() {
 				// we cannot even get the flat expression in that case
@@ -291,7 +405,7 @@ func TestFuncAppConversion(t *testing.T) {
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
 				}},
-				[]parser.SortedExpressionAST{{parser.RowValue{"", "a"}, parser.Yes}}}
+				[]parser.SortedExpressionAST{{parser.RowValue{"", "a"}, parser.Yes}}, false, nil}
 
 			Convey("Then converting to an Evaluator fails", func() {
 				// we cannot even get the flat expression in that case
@@ -308,7 +422,7 @@ func TestFuncAppConversion(t *testing.T) {
 					parser.ExpressionsAST{[]parser.Expression{
 						parser.MapAST{[]parser.KeyValuePairAST{
 							{"a", parser.StringLiteral{"value"}}}},
-					}}, nil},
+					}}, nil, false, nil},
 				parser.Raw{"[0"}}
 
 			Convey("Then converting to an Evaluator should fail", func() {
@@ -322,7 +436,7 @@ func TestFuncAppConversion(t *testing.T) {
 
 		Convey("When the now() function is used", func() {
 			ast := parser.FuncAppAST{parser.FuncName("now"),
-				parser.ExpressionsAST{[]parser.Expression{}}, nil}
+				parser.ExpressionsAST{[]parser.Expression{}}, nil, false, nil}
 
 			Convey("Then we obtain an evaluatable timestampCast", func() {
 				flatExpr, err := ParserExprToFlatExpr(ast, reg)
@@ -527,6 +641,27 @@ func TestAggFuncAppConversion(t *testing.T) {
 					data.Array{data.Int(2), data.Int(1)}},
 			},
 		},
+
+		// sort the aggregated values with WITHIN GROUP
+		{"percentile_cont(0.75) WITHIN GROUP (ORDER BY a DESC) FROM x [RANGE 1 TUPLES]", "",
+			aggregateInputSorter{
+				funcAppAST{"percentile_cont", []FlatExpression{floatLiteral{0.75}, aggInputRef{"g_f12cd6bc"}}},
+				[]sortExpression{sortExpression{aggInputRef{"g_f12cd6bc"}, false}},
+				"4ce387ed",
+			},
+			map[string]FlatExpression{
+				"g_f12cd6bc": rowValue{"x", "a"},
+			},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// correct input
+				{data.Map{"g_f12cd6bc": data.Array{data.Int(1), data.Int(5), data.Int(3)}},
+					data.Float(2)},
+				{data.Map{"g_f12cd6bc": data.Array{}},
+					data.Null{}},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1751,7 +1886,7 @@ func getTestCases() []struct {
 		},
 		/// Function Application
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil, false, nil},
 			// NB. This only tests the behavior of funcApp.Eval.
 			// It does *not* test the function registry, mismatch
 			// in parameter counts or any particular function.
@@ -1771,7 +1906,7 @@ func getTestCases() []struct {
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil},
+				}}, nil, false, nil},
 			parser.Raw{".key"}},
 			[]evalTest{
 				// function return selected value
@@ -1787,7 +1922,7 @@ func getTestCases() []struct {
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil},
+				}}, nil, false, nil},
 			parser.Raw{"[1]"}},
 			[]evalTest{
 				// function return selected value
@@ -1896,7 +2031,7 @@ func getTestCases() []struct {
 		// Using now() should find the timestamp at the
 		// correct position
 		{parser.FuncAppAST{parser.FuncName("now"),
-			parser.ExpressionsAST{[]parser.Expression{}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, false, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -1976,7 +2111,7 @@ func getTestCases() []struct {
 			},
 		},
 		{parser.FuncAppAST{parser.FuncName("maplen"),
-			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{}}}, nil, false, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -1993,7 +2128,7 @@ func getTestCases() []struct {
 			},
 		},
 		{parser.FuncAppAST{parser.FuncName("maplen"),
-			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{"a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{"a"}}}, nil, false, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -2010,6 +2145,93 @@ func getTestCases() []struct {
 					data.Int(1)},
 			},
 		},
+		// IN with a constant list
+		{parser.InAST{Expr: parser.RowValue{"", "a"},
+			Values: parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.StringLiteral{"x"}, parser.FloatLiteral{2.0}}}},
+			List: true},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// key not present
+				{data.Map{"x": data.Int(1)}, nil},
+				{data.Map{"a": data.Int(1)}, data.Bool(true)},
+				{data.Map{"a": data.Float(1.0)}, data.Bool(true)},
+				{data.Map{"a": data.Int(2)}, data.Bool(true)},
+				{data.Map{"a": data.String("x")}, data.Bool(true)},
+				{data.Map{"a": data.Int(3)}, data.Bool(false)},
+				{data.Map{"a": data.String("1")}, data.Bool(false)},
+				{data.Map{"a": data.Array{data.Int(1)}}, data.Bool(false)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
+		// NOT IN with a constant list containing NULL
+		{parser.InAST{Expr: parser.RowValue{"", "a"},
+			Values: parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.NullLiteral{}}}},
+			List: true, Not: true},
+			[]evalTest{
+				{data.Map{"a": data.Int(1)}, data.Bool(false)},
+				{data.Map{"a": data.Int(2)}, data.Null{}},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
+		// IN with a list that is not constant
+		{parser.InAST{Expr: parser.RowValue{"", "a"},
+			Values: parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.RowValue{"", "b"}}}},
+			List: true},
+			[]evalTest{
+				{data.Map{"a": data.Int(1), "b": data.Int(5)}, data.Bool(true)},
+				{data.Map{"a": data.Int(5), "b": data.Int(5)}, data.Bool(true)},
+				{data.Map{"a": data.Int(4), "b": data.Int(5)}, data.Bool(false)},
+				{data.Map{"a": data.Int(4), "b": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Int(1), "b": data.Null{}}, data.Bool(true)},
+				// key not present
+				{data.Map{"a": data.Int(1)}, nil},
+			},
+		},
+		// NOT IN with an array expression
+		{parser.InAST{Expr: parser.RowValue{"", "a"}, Values: parser.RowValue{"", "b"}, Not: true},
+			[]evalTest{
+				{data.Map{"a": data.Int(1), "b": data.Array{data.Int(1), data.Int(2)}}, data.Bool(false)},
+				{data.Map{"a": data.Int(3), "b": data.Array{data.Int(1), data.Int(2)}}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Array{}}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Map{"c": data.Int(1)},
+					"b": data.Array{data.Map{"c": data.Int(1)}}}, data.Bool(false)},
+				// not an array
+				{data.Map{"a": data.Int(3), "b": data.Int(3)}, nil},
+			},
+		},
+		// BETWEEN
+		{parser.BetweenAST{Expr: parser.RowValue{"", "a"},
+			Lower: parser.NumericLiteral{1}, Upper: parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.Int(1), "b": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Float(2.5), "b": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Int(0), "b": data.Int(3)}, data.Bool(false)},
+				{data.Map{"a": data.Int(4), "b": data.Int(3)}, data.Bool(false)},
+				// empty interval
+				{data.Map{"a": data.Int(2), "b": data.Int(0)}, data.Bool(false)},
+				// NULL handling
+				{data.Map{"a": data.Null{}, "b": data.Int(3)}, data.Null{}},
+				{data.Map{"a": data.Int(2), "b": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Int(0), "b": data.Null{}}, data.Bool(false)},
+				// cannot compare
+				{data.Map{"a": data.String("2"), "b": data.Int(3)}, nil},
+			},
+		},
+		// NOT BETWEEN
+		{parser.BetweenAST{Expr: parser.RowValue{"", "a"},
+			Lower: parser.StringLiteral{"b"}, Upper: parser.StringLiteral{"d"}, Not: true},
+			[]evalTest{
+				{data.Map{"a": data.String("c")}, data.Bool(false)},
+				{data.Map{"a": data.String("a")}, data.Bool(true)},
+				{data.Map{"a": data.String("e")}, data.Bool(true)},
+			},
+		},
 	}
 	return testCases
 }
@@ -158,6 +158,12 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			Selector: obj.Selector.Expr,
 		}, nil
 	case parser.FuncAppAST:
+		// analytic functions need all rows in the window
+		if obj.Over != nil {
+			err := fmt.Errorf("you cannot use analytic function '%s' "+
+				"in a flat expression", obj.Function)
+			return nil, err
+		}
 		// exception for now()
 		if string(obj.Function) == "now" && len(obj.Expressions) == 0 && len(obj.Ordering) == 0 {
 			return stmtMeta{parser.NowMeta}, nil
@@ -176,6 +182,10 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			err := fmt.Errorf("you cannot use ORDER BY in non-aggregate "+
 				"function '%s'", obj.Function)
 			return nil, err
+		} else if obj.Distinct {
+			err := fmt.Errorf("you cannot use DISTINCT in non-aggregate "+
+				"function '%s'", obj.Function)
+			return nil, err
 		}
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
@@ -245,6 +255,30 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		}
 		// return a new object
 		return caseAST{ref, c.Checks, c.Default}, nil
+	case parser.InAST:
+		expr, err := ParserExprToFlatExpr(obj.Expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		values, err := ParserExprToFlatExpr(obj.Values, reg)
+		if err != nil {
+			return nil, err
+		}
+		return inAST{expr, values, obj.Not}, nil
+	case parser.BetweenAST:
+		expr, err := ParserExprToFlatExpr(obj.Expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		lower, err := ParserExprToFlatExpr(obj.Lower, reg)
+		if err != nil {
+			return nil, err
+		}
+		upper, err := ParserExprToFlatExpr(obj.Upper, reg)
+		if err != nil {
+			return nil, err
+		}
+		return betweenAST{expr, lower, upper, obj.Not}, nil
 	case parser.Wildcard:
 		return wildcardAST{obj.Relation}, nil
 	}
@@ -328,6 +362,11 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			Selector: obj.Selector.Expr,
 		}, agg, nil
 	case parser.FuncAppAST:
+		// analytic functions are computed by the execution plan
+		if obj.Over != nil {
+			expr, err := parserAnalyticFuncAppToFlatExpr(obj, reg)
+			return expr, nil, err
+		}
 		// exception for now()
 		if string(obj.Function) == "now" && len(obj.Expressions) == 0 {
 			return stmtMeta{parser.NowMeta}, nil, nil
@@ -349,6 +388,11 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
 		returnAgg := map[string]FlatExpression{}
+		if obj.Distinct && !isAggregateFunc(function, len(obj.Expressions)) {
+			err := fmt.Errorf("you cannot use DISTINCT in non-aggregate "+
+				"function '%s'", obj.Function)
+			return nil, nil, err
+		}
 		if isAggregateFunc(function, len(obj.Expressions)) {
 			// we have a setting like
 			//  SELECT udaf(x+1, "state", c ORDER BY d + e, f DESC) ... GROUP BY c
@@ -360,6 +404,8 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 					// return a prettier error message
 					if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
 						err = fmt.Errorf("aggregate functions cannot be nested")
+					} else if strings.HasPrefix(err.Error(), "you cannot use analytic") {
+						err = fmt.Errorf("analytic functions cannot be used in aggregate functions")
 					}
 					return nil, nil, err
 				}
@@ -388,6 +434,8 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 				}
 			}
 
+			var aggExpr FlatExpression = funcAppAST{obj.Function, exprs}
+
 			// deal with ORDER BY specifications
 			if len(obj.Ordering) > 0 {
 				ordering := make([]sortExpression, len(obj.Ordering))
@@ -434,13 +482,46 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 					}
 					returnAgg[exprID] = expr
 				}
-				return aggregateInputSorter{
+				aggExpr = aggregateInputSorter{
 					funcAppAST{obj.Function, exprs},
 					ordering,
 					hex.EncodeToString(orderHash.Sum(nil))[:8],
-				}, returnAgg, nil
+				}
 			}
 
+			// deal with DISTINCT
+			if obj.Distinct {
+				// as the values of the ORDER BY clause of a row are not
+				// used anymore when the row is removed as a duplicate,
+				// they must be determined by the function parameters
+				for _, sortExpr := range obj.Ordering {
+					found := false
+					for _, ast := range obj.Expressions {
+						if ast.String() == sortExpr.Expr.String() {
+							found = true
+							break
+						}
+					}
+					if !found {
+						return nil, nil, fmt.Errorf("in an aggregate with DISTINCT, " +
+							"ORDER BY expressions must appear in the argument list")
+					}
+				}
+				var refs []string
+				for _, expr := range exprs {
+					if ref, ok := expr.(aggInputRef); ok {
+						refs = append(refs, ref.Ref)
+					}
+				}
+				var orderRefs []string
+				if sorter, ok := aggExpr.(aggregateInputSorter); ok {
+					for _, sortExpr := range sorter.Ordering {
+						orderRefs = append(orderRefs, sortExpr.Value.Ref)
+					}
+				}
+				aggExpr = aggregateInputDeduplicator{aggExpr, refs, orderRefs}
+			}
+			return aggExpr, returnAgg, nil
 		} else {
 			for i, ast := range obj.Expressions {
 				expr, agg, err := ParserExprToMaybeAggregate(ast, aggIdx, reg)
@@ -568,6 +649,53 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			returnAgg = nil
 		}
 		return caseAST{ref, c.Checks, c.Default}, returnAgg, nil
+
+	case parser.InAST:
+		// compute child expressions
+		returnAgg := map[string]FlatExpression{}
+		expr, agg, err := ParserExprToMaybeAggregate(obj.Expr, aggIdx, reg)
+		if err != nil {
+			return nil, nil, err
+		}
+		for key, val := range agg {
+			returnAgg[key] = val
+		}
+
+		newAggIdx := aggIdx + len(returnAgg)
+		values, agg, err := ParserExprToMaybeAggregate(obj.Values, newAggIdx, reg)
+		if err != nil {
+			return nil, nil, err
+		}
+		for key, val := range agg {
+			returnAgg[key] = val
+		}
+
+		if len(returnAgg) == 0 {
+			returnAgg = nil
+		}
+		return inAST{expr, values, obj.Not}, returnAgg, nil
+
+	case parser.BetweenAST:
+		// compute child expressions
+		exprs := make([]FlatExpression, 3)
+		returnAgg := map[string]FlatExpression{}
+		for i, ast := range []parser.Expression{obj.Expr, obj.Lower, obj.Upper} {
+			// compute the correct aggIdx
+			newAggIdx := aggIdx + len(returnAgg)
+			expr, agg, err := ParserExprToMaybeAggregate(ast, newAggIdx, reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			for key, val := range agg {
+				returnAgg[key] = val
+			}
+			exprs[i] = expr
+		}
+
+		if len(returnAgg) == 0 {
+			returnAgg = nil
+		}
+		return betweenAST{exprs[0], exprs[1], exprs[2], obj.Not}, returnAgg, nil
 	}
 	err := fmt.Errorf("don't know how to convert type %#v", e)
 	return nil, nil, err
@@ -628,6 +756,29 @@ func (v VolatilityType) String() string {
 	return s
 }
 
+// isConstantExpr returns true if the value of the expression only
+// depends on literals.
+func isConstantExpr(expr FlatExpression) bool {
+	switch obj := expr.(type) {
+	case nullLiteral, numericLiteral, floatLiteral, boolLiteral, stringLiteral:
+		return true
+	case binaryOpAST:
+		return isConstantExpr(obj.Left) && isConstantExpr(obj.Right)
+	case unaryOpAST:
+		return isConstantExpr(obj.Expr)
+	case typeCastAST:
+		return isConstantExpr(obj.Expr)
+	case arrayAST:
+		for _, e := range obj.Expressions {
+			if !isConstantExpr(e) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
 type binaryOpAST struct {
 	Op    parser.Operator
 	Left  FlatExpression
@@ -786,6 +937,99 @@ func (a aggregateInputSorter) Repr() string {
 		strings.Join(reprs, ","), strings.Join(ordering, ","))
 }
 
+// aggregateInputDeduplicator represents an aggregate function with
+// DISTINCT. Expr is either a funcAppAST or an aggregateInputSorter.
+// The aggregated values of Refs are deduplicated before Expr is
+// evaluated, and the values of OrderRefs are removed along with them.
+type aggregateInputDeduplicator struct {
+	Expr      FlatExpression
+	Refs      []string
+	OrderRefs []string
+}
+
+func (a aggregateInputDeduplicator) Repr() string {
+	repr := a.Expr.Repr()
+	i := strings.Index(repr, "(")
+	return repr[:i+1] + "DISTINCT " + repr[i+1:]
+}
+
+func (a aggregateInputDeduplicator) Columns() []rowValue {
+	return a.Expr.Columns()
+}
+
+func (a aggregateInputDeduplicator) Volatility() VolatilityType {
+	return a.Expr.Volatility()
+}
+
+func (a aggregateInputDeduplicator) ContainsWildcard() bool {
+	return a.Expr.ContainsWildcard()
+}
+
+// analyticFuncAppAST represents the call of an analytic function with
+// an OVER clause. It's computed by the execution plan from all rows in
+// the current window (see analyticFunc).
+type analyticFuncAppAST struct {
+	Function    parser.FuncName
+	Expressions []FlatExpression
+	PartitionBy []FlatExpression
+	Ordering    []analyticSortExpression
+}
+
+type analyticSortExpression struct {
+	Expr      FlatExpression
+	Ascending bool
+}
+
+func (a analyticFuncAppAST) Repr() string {
+	reprs := make([]string, len(a.Expressions))
+	for i, e := range a.Expressions {
+		reprs[i] = e.Repr()
+	}
+	partition := make([]string, len(a.PartitionBy))
+	for i, e := range a.PartitionBy {
+		partition[i] = e.Repr()
+	}
+	ordering := make([]string, len(a.Ordering))
+	for i, e := range a.Ordering {
+		ordering[i] = e.Expr.Repr()
+		if e.Ascending {
+			ordering[i] += " ASC"
+		} else {
+			ordering[i] += " DESC"
+		}
+	}
+	return fmt.Sprintf("%s(%s) OVER (PARTITION BY %s ORDER BY %s)", a.Function,
+		strings.Join(reprs, ","), strings.Join(partition, ","), strings.Join(ordering, ","))
+}
+
+func (a analyticFuncAppAST) Columns() []rowValue {
+	var allColumns []rowValue
+	for _, e := range a.Expressions {
+		allColumns = append(allColumns, e.Columns()...)
+	}
+	for _, e := range a.PartitionBy {
+		allColumns = append(allColumns, e.Columns()...)
+	}
+	for _, e := range a.Ordering {
+		allColumns = append(allColumns, e.Expr.Columns()...)
+	}
+	return allColumns
+}
+
+func (a analyticFuncAppAST) Volatility() VolatilityType {
+	// the result depends on the other rows in the window
+	return Volatile
+}
+
+func (a analyticFuncAppAST) ContainsWildcard() bool {
+	for _, e := range a.Expressions {
+		if e.ContainsWildcard() {
+			return true
+		}
+	}
+	return false
+}
+
 type arrayAST struct {
 	Expressions []FlatExpression
 }
@@ -933,6 +1177,74 @@ func (c caseAST) ContainsWildcard() bool {
 	return false
 }
 
+type inAST struct {
+	Expr FlatExpression
+	// Values evaluates to the array of values to look for
+	Values FlatExpression
+	Not    bool
+}
+
+func (i inAST) Repr() string {
+	op := "in"
+	if i.Not {
+		op = "notin"
+	}
+	return fmt.Sprintf("%s(%s,%s)", op, i.Expr.Repr(), i.Values.Repr())
+}
+
+func (i inAST) Columns() []rowValue {
+	return append(i.Expr.Columns(), i.Values.Columns()...)
+}
+
+func (i inAST) Volatility() VolatilityType {
+	l := i.Expr.Volatility()
+	r := i.Values.Volatility()
+	if l < r {
+		return l
+	}
+	return r
+}
+
+func (i inAST) ContainsWildcard() bool {
+	return i.Expr.ContainsWildcard() || i.Values.ContainsWildcard()
+}
+
+type betweenAST struct {
+	Expr  FlatExpression
+	Lower FlatExpression
+	Upper FlatExpression
+	Not   bool
+}
+
+func (b betweenAST) Repr() string {
+	op := "between"
+	if b.Not {
+		op = "notbetween"
+	}
+	return fmt.Sprintf("%s(%s,%s,%s)", op, b.Expr.Repr(), b.Lower.Repr(), b.Upper.Repr())
+}
+
+func (b betweenAST) Columns() []rowValue {
+	allColumns := b.Expr.Columns()
+	allColumns = append(allColumns, b.Lower.Columns()...)
+	return append(allColumns, b.Upper.Columns()...)
+}
+
+func (b betweenAST) Volatility() VolatilityType {
+	lv := b.Expr.Volatility()
+	for _, e := range []FlatExpression{b.Lower, b.Upper} {
+		if v := e.Volatility(); v < lv {
+			lv = v
+		}
+	}
+	return lv
+}
+
+func (b betweenAST) ContainsWildcard() bool {
+	return b.Expr.ContainsWildcard() || b.Lower.ContainsWildcard() ||
+		b.Upper.ContainsWildcard()
+}
+
 type whenThenPair struct {
 	When FlatExpression
 	Then FlatExpression
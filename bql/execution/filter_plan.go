@@ -16,11 +16,15 @@ type filterPlan struct {
 // CanBuildFilterPlan checks whether the given statement
 // allows to use a filterPlan.
 func CanBuildFilterPlan(lp *LogicalPlan, reg udf.FunctionRegistry) bool {
-	if len(lp.Relations) != 1 {
+	if len(lp.Relations) != 1 || lp.Join.Table.Name != "" || lp.MatchRecognize != nil {
+		return false
+	}
+	if len(lp.Ordering) > 0 || lp.HasLimit || lp.Offset > 0 {
 		return false
 	}
 	return !lp.GroupingStmt &&
 		lp.EmitterType == parser.Rstream &&
+		lp.Relations[0].Window == parser.SlidingWindow &&
 		lp.Relations[0].Unit == parser.Tuples &&
 		lp.Relations[0].Value == 1
 }
@@ -42,6 +46,7 @@ func NewFilterPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (PhysicalPlan, err
 	}
 	return &filterPlan{commonExecutionPlan{
 		projections: projs,
+		analytics:   collectAnalytics(projs),
 		filter:      filter,
 	}, lp.Relations[0].Alias}, nil
 }
@@ -78,6 +83,12 @@ func (ep *filterPlan) Process(input *core.Tuple) ([]data.Map, error) {
 			return nil, nil
 		}
 	}
+	// the window of analytic functions only contains this tuple
+	if len(ep.analytics) > 0 {
+		if err := computeAnalytics(ep.analytics, []data.Map{d}); err != nil {
+			return nil, err
+		}
+	}
 	// otherwise, compute all the expressions
 	result := data.Map(make(map[string]data.Value, len(ep.projections)))
 	for _, proj := range ep.projections {
@@ -390,6 +390,23 @@ func TestFilterPlanEmitters(t *testing.T) {
 
 }
 
+func TestFilterPlanOrdering(t *testing.T) {
+	for _, s := range []string{
+		`CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 1 TUPLES] ORDER BY int`,
+		`CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 1 TUPLES] LIMIT 0`,
+		`CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 1 TUPLES] OFFSET 1`,
+	} {
+		s := s
+		Convey(fmt.Sprintf("Given a statement with ORDER BY, LIMIT or OFFSET: %s", s), t, func() {
+			_, err := createFilterPlan2(s)
+
+			Convey("Then the filter plan should not be used", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	}
+}
+
 func createFilterPlan2(s string) (PhysicalPlan, error) {
 	p := parser.New()
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
@@ -9,6 +9,11 @@ import (
 
 type groupbyExecutionPlan struct {
 	streamRelationStreamExecutionPlan
+	// incremental holds the accumulators of the aggregate functions
+	// when they are maintained incrementally as rows enter and leave
+	// the window. It is nil if the aggregates are recomputed from all
+	// rows in the window every time the query is performed.
+	incremental *incrementalAggregation
 }
 
 // tmpGroupData is an intermediate data structure to represent
@@ -28,7 +33,7 @@ type tmpGroupData struct {
 // CanBuildGroupbyExecutionPlan checks whether the given statement
 // allows to use an groupbyExecutionPlan.
 func CanBuildGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) bool {
-	return lp.GroupingStmt
+	return lp.GroupingStmt && lp.MatchRecognize == nil
 }
 
 // NewGroupbyExecutionPlan builds a plan that follows the
@@ -46,8 +51,13 @@ func NewGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (Physica
 	if err != nil {
 		return nil, err
 	}
+	if len(underlying.analytics) > 0 {
+		return nil, fmt.Errorf("analytic functions cannot be used with " +
+			"GROUP BY or aggregate functions")
+	}
 	return &groupbyExecutionPlan{
 		*underlying,
+		newIncrementalAggregation(underlying.projections, underlying.window),
 	}, nil
 }
 
@@ -56,9 +66,21 @@ func NewGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (Physica
 // plan. Note that the order of items in the returned slice is undefined
 // and cannot be relied on.
 func (ep *groupbyExecutionPlan) Process(input *core.Tuple) ([]data.Map, error) {
+	if ep.incremental != nil {
+		return ep.process(input, ep.performQueryIncrementally)
+	}
 	return ep.process(input, ep.performQueryOnBuffer)
 }
 
+// Progress advances the time of the plan with a tuple processed by
+// another instance of the plan (see ProgressPlan).
+func (ep *groupbyExecutionPlan) Progress(input *core.Tuple) ([]data.Map, error) {
+	if ep.incremental != nil {
+		return ep.progress(input, ep.performQueryIncrementally)
+	}
+	return ep.progress(input, ep.performQueryOnBuffer)
+}
+
 // performQueryOnBuffer computes the projections of a SELECT query on the data
 // stored in `ep.filteredInputRows`. The query results (which is a set of
 // data.Value, not core.Tuple) is stored in ep.curResults. The data
@@ -163,28 +185,9 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	// function to compute the grouping expressions and store the
 	// input for aggregate functions in the correct group.
 	evalItem := func(io *inputRowWithCachedResult) error {
-		var itemGroupValues data.Array
-		// if we have a cached result, use this
-		if io.cache != nil {
-			cachedGroupValues, err := data.AsArray(io.cache)
-			if err != nil {
-				return fmt.Errorf("cached data was not an array: %v", io.cache)
-			}
-			itemGroupValues = cachedGroupValues
-		} else {
-			// otherwise, compute the expressions in the GROUP BY to find
-			// the correct group to append to
-			itemGroupValues = make([]data.Value, len(ep.groupList))
-			for i, eval := range ep.groupList {
-				// ordinary "flat" expression
-				value, err := eval.Eval(*io.input)
-				if err != nil {
-					return err
-				}
-				itemGroupValues[i] = value
-			}
-			io.cache = itemGroupValues
-			io.hash = data.Hash(io.cache)
+		itemGroupValues, err := ep.groupValues(io)
+		if err != nil {
+			return err
 		}
 
 		itemGroup, err := findOrCreateGroup(itemGroupValues, io.hash, *io.input)
@@ -206,87 +209,19 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	}
 
 	evalGroup := func(group *tmpGroupData) error {
-		result := data.Map(make(map[string]data.Value, len(ep.projections)))
 		// collect input for aggregate functions into an array
 		// within each group
 		for key := range allAggEvaluators {
 			group.nonAggData[key] = data.Array(group.aggData[key])
 			delete(group.aggData, key)
 		}
-		// evaluate HAVING condition, if there is one
-		for _, proj := range ep.projections {
-			if proj.alias == ":having:" {
-				havingResult, err := proj.evaluator.Eval(group.nonAggData)
-				if err != nil {
-					return err
-				}
-				// a NULL value is definitely not "true", so since we
-				// have only a binary decision, we should drop tuples
-				// where the condition evaluates to NULL
-				havingResultBool := false
-				if havingResult.Type() != data.TypeNull {
-					havingResultBool, err = data.AsBool(havingResult)
-					if err != nil {
-						return err
-					}
-				}
-				// if it evaluated to false, do not further process this group
-				if !havingResultBool {
-					return nil
-				}
-				break
-			}
-		}
-		// now evaluate all other projections
-		for _, proj := range ep.projections {
-			if proj.alias == ":having:" {
-				continue
-			}
-			// now evaluate this projection on the flattened data
-			value, err := proj.evaluator.Eval(group.nonAggData)
-			if err != nil {
-				return err
-			}
-			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
-				return err
-			}
-		}
-		output = append(output, resultRow{row: result, hash: data.Hash(result)})
-		return nil
-	}
-
-	evalNoGroup := func() error {
-		// if we have an empty group list *and* a GROUP BY clause,
-		// we have to return an empty result (because there are no
-		// rows with "the same values"). but if the list is empty and
-		// we *don't* have a GROUP BY clause, then we need to compute
-		// all foldables and aggregates with an empty input
-		if len(ep.groupList) > 0 {
-			return nil
+		result, err := ep.evalGroup(group.nonAggData)
+		if err != nil {
+			return err
 		}
-		input := data.Map{}
-		result := data.Map(make(map[string]data.Value, len(ep.projections)))
-		for _, proj := range ep.projections {
-			// collect input for aggregate functions
-			if proj.hasAggregate {
-				for key := range proj.aggrEvals {
-					input[key] = data.Array{}
-				}
-			}
-			// now evaluate this projection on the flattened data.
-			// note that input has *only* the keys of the empty
-			// arrays, no other columns, but we cannot have other
-			// columns involved in the projection (since we know
-			// that GROUP BY is empty).
-			value, err := proj.evaluator.Eval(input)
-			if err != nil {
-				return err
-			}
-			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
-				return err
-			}
+		if result != nil {
+			output = append(output, *result)
 		}
-		output = append(output, resultRow{row: result, hash: data.Hash(result)})
 		return nil
 	}
 
@@ -312,12 +247,130 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 		}
 	}
 	if len(groups) == 0 {
-		if err := evalNoGroup(); err != nil {
+		result, err := ep.evalNoGroup()
+		if err != nil {
 			rollback()
 			return err
 		}
+		if result != nil {
+			output = append(output, *result)
+		}
 	}
 
 	ep.curResults = output
 	return nil
 }
+
+// groupValues computes the values of the expressions in the GROUP BY
+// clause for the given row. The result is cached in the row.
+func (ep *groupbyExecutionPlan) groupValues(io *inputRowWithCachedResult) (data.Array, error) {
+	// if we have a cached result, use this
+	if io.cache != nil {
+		cachedGroupValues, err := data.AsArray(io.cache)
+		if err != nil {
+			return nil, fmt.Errorf("cached data was not an array: %v", io.cache)
+		}
+		return cachedGroupValues, nil
+	}
+	// otherwise, compute the expressions in the GROUP BY to find
+	// the correct group to append to
+	itemGroupValues := make(data.Array, len(ep.groupList))
+	for i, eval := range ep.groupList {
+		// ordinary "flat" expression
+		value, err := eval.Eval(*io.input)
+		if err != nil {
+			return nil, err
+		}
+		itemGroupValues[i] = value
+	}
+	io.cache = itemGroupValues
+	io.hash = data.Hash(io.cache)
+	return itemGroupValues, nil
+}
+
+// evalGroup evaluates the HAVING clause and the projections on the
+// values of a group, which include the input or the result of the
+// aggregate functions. It returns nil if the group doesn't satisfy
+// the HAVING clause.
+func (ep *groupbyExecutionPlan) evalGroup(nonAggData data.Map) (*resultRow, error) {
+	// evaluate HAVING condition, if there is one
+	for _, proj := range ep.projections {
+		if proj.alias == ":having:" {
+			havingResult, err := proj.evaluator.Eval(nonAggData)
+			if err != nil {
+				return nil, err
+			}
+			// a NULL value is definitely not "true", so since we
+			// have only a binary decision, we should drop tuples
+			// where the condition evaluates to NULL
+			havingResultBool := false
+			if havingResult.Type() != data.TypeNull {
+				havingResultBool, err = data.AsBool(havingResult)
+				if err != nil {
+					return nil, err
+				}
+			}
+			// if it evaluated to false, do not further process this group
+			if !havingResultBool {
+				return nil, nil
+			}
+			break
+		}
+	}
+	// now evaluate all other projections
+	return ep.evalProjections(nonAggData)
+}
+
+// evalNoGroup computes the result of a statement when there are no
+// input rows. It returns nil if there is no result.
+func (ep *groupbyExecutionPlan) evalNoGroup() (*resultRow, error) {
+	// if we have an empty group list *and* a GROUP BY clause,
+	// we have to return an empty result (because there are no
+	// rows with "the same values"). but if the list is empty and
+	// we *don't* have a GROUP BY clause, then we need to compute
+	// all foldables and aggregates with an empty input
+	if len(ep.groupList) > 0 {
+		return nil, nil
+	}
+	// collect input for aggregate functions.
+	// note that input has *only* the keys of the empty
+	// arrays, no other columns, but we cannot have other
+	// columns involved in the projection (since we know
+	// that GROUP BY is empty).
+	input := data.Map{}
+	for _, proj := range ep.projections {
+		if proj.hasAggregate {
+			for key := range proj.aggrEvals {
+				input[key] = data.Array{}
+			}
+		}
+	}
+	return ep.evalProjections(input)
+}
+
+// evalProjections evaluates all projections except for the HAVING
+// clause on the flattened data of a group.
+func (ep *groupbyExecutionPlan) evalProjections(input data.Map) (*resultRow, error) {
+	result := data.Map(make(map[string]data.Value, len(ep.projections)))
+	var order data.Array
+	for _, proj := range ep.projections {
+		if proj.alias == ":having:" {
+			continue
+		}
+		value, err := proj.evaluator.Eval(input)
+		if err != nil {
+			return nil, err
+		}
+		// the values of the ORDER BY clause are not emitted
+		if proj.alias == ":order:" {
+			if order, err = data.AsArray(value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
+			return nil, err
+		}
+	}
+	return &resultRow{row: result, hash: data.Hash(result), order: order}, nil
+}
@@ -1196,6 +1196,700 @@ func createGroupbyPlan2(s string) (PhysicalPlan, error) {
 	return NewGroupbyExecutionPlan(logicalPlan, reg)
 }
 
+func TestGroupbyExecutionPlanFixedWindows(t *testing.T) {
+	Convey("Given a SELECT clause with a tuple-based TUMBLING window", t, func() {
+		tuples := getTuples(7)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c, sum(int) AS s FROM src [TUMBLING 3 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then results should only be emitted when a window closes", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldResemble, []data.Map{{"c": data.Int(3), "s": data.Int(6)}})
+				So(outs[3], ShouldBeEmpty)
+				So(outs[4], ShouldBeEmpty)
+				So(outs[5], ShouldResemble, []data.Map{{"c": data.Int(3), "s": data.Int(15)}})
+				So(outs[6], ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a tuple-based TUMBLING window and ISTREAM", t, func() {
+		tuples := getTuples(7)
+
+		s := `CREATE STREAM box AS SELECT ISTREAM count(*) AS c FROM src [TUMBLING 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each window should be emitted even if its result is the same as the previous one", func() {
+				for i, out := range outs {
+					if i%2 == 0 {
+						So(out, ShouldBeEmpty)
+					} else {
+						So(out, ShouldResemble, []data.Map{{"c": data.Int(2)}})
+					}
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a tuple-based TUMBLING window and DSTREAM", t, func() {
+		tuples := getTuples(4)
+
+		s := `CREATE STREAM box AS SELECT DSTREAM count(*) AS c FROM src [TUMBLING 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each window should be emitted once when it closes", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldResemble, []data.Map{{"c": data.Int(2)}})
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{{"c": data.Int(2)}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a tuple-based HOPPING window", t, func() {
+		tuples := getTuples(6)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM sum(int) AS s FROM src [HOPPING 4 TUPLES EVERY 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then results should be emitted every two tuples", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldResemble, []data.Map{{"s": data.Int(3)}})
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{{"s": data.Int(10)}})
+				So(outs[4], ShouldBeEmpty)
+				So(outs[5], ShouldResemble, []data.Map{{"s": data.Int(18)}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a time-based TUMBLING window", t, func() {
+		// timestamps are at 10:23:00, 10:23:01, ..., 10:23:07
+		tuples := getOtherTuples()
+		tuples = append(tuples, getTuples(8)[4:]...)
+		for i, t := range tuples {
+			t.Data["foo"] = data.Int(i / 4)
+		}
+
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, count(*) AS c FROM src [TUMBLING 2 SECONDS] GROUP BY foo`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each window should be emitted once when it closes", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldResemble, []data.Map{{"foo": data.Int(0), "c": data.Int(2)}})
+				So(outs[3], ShouldBeEmpty)
+				So(outs[4], ShouldResemble, []data.Map{{"foo": data.Int(0), "c": data.Int(2)}})
+				So(outs[5], ShouldBeEmpty)
+				So(outs[6], ShouldResemble, []data.Map{{"foo": data.Int(1), "c": data.Int(2)}})
+				So(outs[7], ShouldBeEmpty)
+			})
+		})
+
+		Convey("When feeding it with tuples with a gap between them", func() {
+			tuples[3].Timestamp = tuples[3].Timestamp.Add(time.Minute)
+			outs := [][]data.Map{}
+			for _, inTup := range tuples[:4] {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then empty windows should not be emitted", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldResemble, []data.Map{{"foo": data.Int(0), "c": data.Int(2)}})
+				So(outs[3], ShouldResemble, []data.Map{{"foo": data.Int(0), "c": data.Int(1)}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a time-based TUMBLING window and ISTREAM", t, func() {
+		// timestamps are at 10:23:00, 10:23:01, ..., 10:23:06
+		tuples := getTuples(7)
+
+		s := `CREATE STREAM box AS SELECT ISTREAM count(*) AS c FROM src [TUMBLING 2 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each window should be emitted even if its result is the same as the previous one", func() {
+				for i, out := range outs {
+					if i > 0 && i%2 == 0 {
+						So(out, ShouldResemble, []data.Map{{"c": data.Int(2)}})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a time-based HOPPING window", t, func() {
+		// timestamps are at 10:23:00, 10:23:01, ..., 10:23:05
+		tuples := getTuples(6)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM sum(int) AS s FROM src [HOPPING 4 SECONDS EVERY 2 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each window should be emitted once when it closes", func() {
+				// the first window is [10:22:58, 10:23:02)
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldResemble, []data.Map{{"s": data.Int(3)}})
+				So(outs[3], ShouldBeEmpty)
+				// [10:23:00, 10:23:04)
+				So(outs[4], ShouldResemble, []data.Map{{"s": data.Int(10)}})
+				So(outs[5], ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a tuple closes multiple windows", func() {
+			tuples[3].Timestamp = tuples[3].Timestamp.Add(3 * time.Second)
+			outs := [][]data.Map{}
+			for _, inTup := range tuples[:4] {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then all closed windows should be emitted in order", func() {
+				So(outs[2], ShouldResemble, []data.Map{{"s": data.Int(3)}})
+				// [10:23:00, 10:23:04) and [10:23:02, 10:23:06)
+				So(outs[3], ShouldResemble, []data.Map{{"s": data.Int(6)}, {"s": data.Int(3)}})
+			})
+		})
+	})
+}
+
+func TestGroupbyExecutionPlanSessionWindow(t *testing.T) {
+	Convey("Given a SELECT clause with a SESSION window", t, func() {
+		tuples := getTuples(6)
+		secs := []int{0, 1, 2, 5, 6, 9}
+		keys := []string{"a", "b", "a", "b", "b", "a"}
+		for i, t := range tuples {
+			t.Timestamp = time.Date(2015, time.April, 10, 10, 23, secs[i], 0, time.UTC)
+			t.Data["foo"] = data.String(keys[i])
+		}
+
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, count(*) AS c FROM src [SESSION GAP 2 SECONDS BY foo] GROUP BY foo`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each session should be emitted once after the gap", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{
+					{"foo": data.String("b"), "c": data.Int(1)},
+					{"foo": data.String("a"), "c": data.Int(2)},
+				})
+				So(outs[4], ShouldBeEmpty)
+				So(outs[5], ShouldResemble, []data.Map{
+					{"foo": data.String("b"), "c": data.Int(2)},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a SESSION window and ISTREAM", t, func() {
+		// every tuple has its own session
+		tuples := getTuples(7)
+		for i, t := range tuples {
+			t.Timestamp = time.Date(2015, time.April, 10, 10, 23, i*3, 0, time.UTC)
+			t.Data["k"] = data.Int(1)
+		}
+
+		s := `CREATE STREAM box AS SELECT ISTREAM count(*) AS c FROM src [SESSION GAP 2 SECONDS BY k]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each session should be emitted even if its result is the same as the previous one", func() {
+				So(outs[0], ShouldBeEmpty)
+				for _, out := range outs[1:] {
+					So(out, ShouldResemble, []data.Map{{"c": data.Int(1)}})
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a SESSION window and a WHERE clause", t, func() {
+		tuples := getTuples(4)
+		for _, t := range tuples {
+			t.Data["foo"] = data.Int(1)
+		}
+		tuples[3].Timestamp = tuples[3].Timestamp.Add(time.Minute)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c, sum(int) AS s FROM src [SESSION GAP 1500 MILLISECONDS BY foo] WHERE int > 1`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the session should contain only the filtered tuples", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{{"c": data.Int(2), "s": data.Int(5)}})
+			})
+		})
+	})
+}
+
+func TestGroupbyExecutionPlanWatermark(t *testing.T) {
+	Convey("Given a SELECT clause with a TUMBLING window", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c, sum(int) AS s FROM src [TUMBLING 2 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		// tuples arrive out of order and have a watermark
+		// that is one second behind the latest timestamp
+		secs := []int{1, 0, 3, 2, 5, 4}
+		wms := []int{0, 0, 2, 2, 4, 4}
+		tuples := getTuples(len(secs))
+		for i, t := range tuples {
+			t.Data["int"] = data.Int(secs[i])
+			t.Timestamp = time.Date(2015, time.April, 10, 10, 23, secs[i], 0, time.UTC)
+			t.Watermark = time.Date(2015, time.April, 10, 10, 23, wms[i], 0, time.UTC)
+		}
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each window should be emitted once the watermark has passed it", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldResemble, []data.Map{{"c": data.Int(2), "s": data.Int(1)}})
+				So(outs[3], ShouldBeEmpty)
+				So(outs[4], ShouldResemble, []data.Map{{"c": data.Int(2), "s": data.Int(5)}})
+				So(outs[5], ShouldBeEmpty)
+			})
+
+			Convey("Then the plan should have the watermark of the input", func() {
+				wp, ok := plan.(WatermarkPlan)
+				So(ok, ShouldBeTrue)
+				So(wp.Watermark(), ShouldResemble, tuples[5].Watermark)
+			})
+
+			Convey("And feeding it with a tuple later than the watermark", func() {
+				late := getTuples(1)[0]
+				late.Timestamp = time.Date(2015, time.April, 10, 10, 23, 3, 0, time.UTC)
+				_, err := plan.Process(late)
+
+				Convey("Then it should fail with a late tuple error", func() {
+					So(err, ShouldNotBeNil)
+					So(core.IsLateTupleError(err), ShouldBeTrue)
+				})
+			})
+
+			Convey("And feeding it with a tuple advancing the watermark", func() {
+				next := getTuples(1)[0]
+				next.Data["int"] = data.Int(9)
+				next.Timestamp = time.Date(2015, time.April, 10, 10, 23, 9, 0, time.UTC)
+				next.Watermark = time.Date(2015, time.April, 10, 10, 23, 8, 0, time.UTC)
+				out, err := plan.Process(next)
+				So(err, ShouldBeNil)
+
+				Convey("Then the remaining window should be closed", func() {
+					So(out, ShouldResemble, []data.Map{{"c": data.Int(2), "s": data.Int(9)}})
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a SESSION window", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c FROM src [SESSION GAP 2 SECONDS BY 1]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		secs := []int{1, 0, 2, 8}
+		wms := []int{1, 1, 2, 5}
+		tuples := getTuples(len(secs))
+		for i, t := range tuples {
+			t.Timestamp = time.Date(2015, time.April, 10, 10, 23, secs[i], 0, time.UTC)
+			t.Watermark = time.Date(2015, time.April, 10, 10, 23, wms[i], 0, time.UTC)
+		}
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			errs := []error{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				outs = append(outs, out)
+				errs = append(errs, err)
+			}
+
+			Convey("Then a tuple later than the watermark should be rejected", func() {
+				So(errs[0], ShouldBeNil)
+				So(core.IsLateTupleError(errs[1]), ShouldBeTrue)
+				So(errs[2], ShouldBeNil)
+				So(errs[3], ShouldBeNil)
+			})
+
+			Convey("Then the session should be closed by the watermark", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{{"c": data.Int(2)}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause joining two inputs with a TUMBLING window", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c FROM src1 [TUMBLING 2 SECONDS], src2 [TUMBLING 2 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		tuples := getTuples(4)
+		for i, t := range tuples {
+			t.InputName = "src1"
+			t.Watermark = t.Timestamp
+			if i%2 == 1 {
+				t.InputName = "src2"
+				t.Timestamp = tuples[i-1].Timestamp
+				t.Watermark = time.Time{}
+			}
+		}
+
+		Convey("When only one input has sent a watermark", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the watermark should not advance", func() {
+				wp, ok := plan.(WatermarkPlan)
+				So(ok, ShouldBeTrue)
+				So(wp.Watermark(), ShouldBeZeroValue)
+				for _, out := range outs {
+					So(out, ShouldBeEmpty)
+				}
+			})
+
+			Convey("And the other input sends a watermark", func() {
+				next := getTuples(4)[3]
+				next.InputName = "src2"
+				next.Watermark = next.Timestamp
+				out, err := plan.Process(next)
+				So(err, ShouldBeNil)
+
+				Convey("Then the watermark should be the smaller one", func() {
+					wp := plan.(WatermarkPlan)
+					So(wp.Watermark(), ShouldResemble, tuples[2].Watermark)
+				})
+
+				Convey("Then the window closed by the watermark should be emitted", func() {
+					So(out, ShouldResemble, []data.Map{{"c": data.Int(1)}})
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause joining two inputs with a TUMBLING window and a small limit of pending tuples", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c FROM src1 [TUMBLING 2 SECONDS], src2 [TUMBLING 2 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+		plan.(*groupbyExecutionPlan).maxPending = 2
+
+		tuples := getTuples(8)
+		for i, t := range tuples {
+			t.InputName = "src1"
+			t.Timestamp = time.Date(2015, time.April, 10, 10, 23, i/2*2, 0, time.UTC)
+			t.Watermark = t.Timestamp
+			if i%2 == 1 {
+				t.InputName = "src2"
+				t.Watermark = time.Time{}
+			}
+		}
+
+		Convey("When one input never sends a watermark", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				So(plan.(*groupbyExecutionPlan).pending.Len(), ShouldBeLessThanOrEqualTo, 2)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the watermark should be advanced by the oldest pending tuple", func() {
+				wp := plan.(WatermarkPlan)
+				So(wp.Watermark(), ShouldResemble, tuples[6].Timestamp.Add(-2*time.Second))
+			})
+
+			Convey("Then windows should be emitted", func() {
+				So(outs[4], ShouldResemble, []data.Map{{"c": data.Int(1)}})
+				So(outs[6], ShouldResemble, []data.Map{{"c": data.Int(1)}})
+				for _, i := range []int{0, 1, 2, 3, 5, 7} {
+					So(outs[i], ShouldBeEmpty)
+				}
+			})
+
+			Convey("And feeding it with a tuple before the advanced watermark", func() {
+				late := getTuples(4)[3]
+				late.InputName = "src2"
+				_, err := plan.Process(late)
+
+				Convey("Then it should fail with a late tuple error", func() {
+					So(core.IsLateTupleError(err), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+
+func TestGroupbyExecutionPlanIncremental(t *testing.T) {
+	createPlans := func(s string) (*groupbyExecutionPlan, *groupbyExecutionPlan) {
+		incPlan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+		recomputePlan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+		recomputePlan.(*groupbyExecutionPlan).incremental = nil
+		return incPlan.(*groupbyExecutionPlan), recomputePlan.(*groupbyExecutionPlan)
+	}
+
+	getIncTuples := func() []*core.Tuple {
+		tuples := getTuples(12)
+		for i, t := range tuples {
+			t.Data["foo"] = data.Int(i % 3)
+			t.Data["b"] = data.Bool(i%4 != 3)
+			if i%5 == 4 {
+				t.Data["int"] = data.Null{}
+			}
+		}
+		return tuples
+	}
+
+	statements := []string{
+		`CREATE STREAM box AS SELECT ISTREAM foo, count(*) AS c, count(int) AS ci,
+			sum(int) AS s, avg(int) AS a, min(int) AS mi, max(int) AS ma,
+			bool_and(b) AS ba, bool_or(b) AS bo FROM src [RANGE 4 TUPLES] GROUP BY foo`,
+		`CREATE STREAM box AS SELECT RSTREAM count(*) AS c, max(int) - min(int) AS d
+			FROM src [RANGE 3 SECONDS] HAVING count(*) > 2`,
+		`CREATE STREAM box AS SELECT RSTREAM foo, sum(int) + 1 AS a, sum(int) AS b
+			FROM src [TUMBLING 5 TUPLES] GROUP BY foo`,
+		`CREATE STREAM box AS SELECT DSTREAM foo, bool_or(b) AS bo FROM src [RANGE 2 TUPLES] GROUP BY foo`,
+		`CREATE STREAM box AS SELECT RSTREAM b, count(DISTINCT foo) AS c, sum(DISTINCT foo) AS s,
+			count(foo) AS cf, count(DISTINCT int % 2) AS ci FROM src [RANGE 5 TUPLES] GROUP BY b`,
+	}
+
+	for _, s := range statements {
+		s := s
+
+		Convey(fmt.Sprintf("Given a statement computing aggregates incrementally: %s", s), t, func() {
+			incPlan, recomputePlan := createPlans(s)
+			So(incPlan.incremental, ShouldNotBeNil)
+
+			Convey("When feeding it with tuples", func() {
+				for i, inTup := range getIncTuples() {
+					expected, err := recomputePlan.Process(inTup.Copy())
+					So(err, ShouldBeNil)
+					out, err := incPlan.Process(inTup.Copy())
+					So(err, ShouldBeNil)
+
+					Convey(fmt.Sprintf("Then the result for tuple %d should be the same as recomputing it", i), func() {
+						So(len(out), ShouldEqual, len(expected))
+						for _, row := range expected {
+							So(out, ShouldContain, row)
+						}
+					})
+				}
+			})
+		})
+	}
+
+	Convey("Given a statement computing aggregates incrementally", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, count(*) AS c, sum(int) AS s
+			FROM src [RANGE 2 TUPLES] GROUP BY foo`
+		incPlan, recomputePlan := createPlans(s)
+		So(incPlan.incremental, ShouldNotBeNil)
+
+		Convey("When an invalid value enters and leaves the window", func() {
+			tuples := getOtherTuples()
+			tuples[1].Data["int"] = data.String("hoge")
+
+			Convey("Then the errors and results should be the same as recomputing it", func() {
+				for _, inTup := range tuples {
+					expected, expErr := recomputePlan.Process(inTup.Copy())
+					out, err := incPlan.Process(inTup.Copy())
+					So(err != nil, ShouldEqual, expErr != nil)
+					So(out, ShouldResemble, expected)
+				}
+			})
+
+			Convey("Then groups leaving the window should be removed", func() {
+				for _, inTup := range tuples {
+					incPlan.Process(inTup)
+				}
+				So(incPlan.incremental.groupOrder.Len(), ShouldEqual, 1)
+				So(len(incPlan.incremental.groups), ShouldEqual, 1)
+			})
+		})
+	})
+
+	Convey("Given a statement using an aggregate that cannot be computed incrementally", t, func() {
+		for _, s := range []string{
+			`CREATE STREAM box AS SELECT RSTREAM count(*) AS c, udaf(int) AS u FROM src [RANGE 2 TUPLES]`,
+			`CREATE STREAM box AS SELECT RSTREAM sum(int) AS s, array_agg(int) AS a FROM src [RANGE 2 TUPLES]`,
+			`CREATE STREAM box AS SELECT RSTREAM foo, count(*) AS c FROM src [SESSION GAP 2 SECONDS BY foo] GROUP BY foo`,
+			`CREATE STREAM box AS SELECT RSTREAM array_agg(DISTINCT int ORDER BY int) AS a FROM src [RANGE 2 TUPLES]`,
+		} {
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey(fmt.Sprintf("Then it should recompute the aggregates: %s", s), func() {
+				So(plan.(*groupbyExecutionPlan).incremental, ShouldBeNil)
+			})
+		}
+	})
+}
+
+func TestGroupbyExecutionPlanDistinct(t *testing.T) {
+	Convey("Given a statement with aggregates of distinct values", t, func() {
+		tuples := getTuples(6)
+		for i, t := range tuples {
+			t.Data["foo"] = data.Int(i % 2)
+			t.Data["x"] = data.Int(i / 3)
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM x, count(DISTINCT foo) AS c,
+			array_agg(DISTINCT int % 3 ORDER BY int % 3 DESC) AS a
+			FROM src [RANGE 6 TUPLES] GROUP BY x`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the aggregates should only see distinct values", func() {
+				So(out, ShouldResemble, []data.Map{
+					{"x": data.Int(0), "c": data.Int(2),
+						"a": data.Array{data.Int(2), data.Int(1), data.Int(0)}},
+					{"x": data.Int(1), "c": data.Int(2),
+						"a": data.Array{data.Int(2), data.Int(1), data.Int(0)}},
+				})
+			})
+		})
+	})
+
+	Convey("Given a statement with DISTINCT and ORDER BY in an aggregate", t, func() {
+		Convey("When the ORDER BY expression is not a parameter", func() {
+			s := `CREATE STREAM box AS SELECT RSTREAM array_agg(DISTINCT foo ORDER BY int)
+				FROM src [RANGE 2 TUPLES]`
+			_, err := createGroupbyPlan(s, t)
+
+			Convey("Then creating the plan should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "must appear in the argument list")
+			})
+		})
+	})
+
+	Convey("Given a statement with DISTINCT in a non-aggregate function", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*), abs(DISTINCT foo)
+			FROM src [RANGE 2 TUPLES] GROUP BY foo`
+		_, err := createGroupbyPlan(s, t)
+
+		Convey("Then creating the plan should fail", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "cannot use DISTINCT in non-aggregate")
+		})
+	})
+}
+
 func BenchmarkGroupingExecution(b *testing.B) {
 	s := `CREATE STREAM box AS SELECT RSTREAM foo, count(int) FROM src [RANGE 5 TUPLES] GROUP BY foo`
 	plan, err := createGroupbyPlan2(s)
@@ -0,0 +1,334 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// hashJoin holds the state of a FROM clause using the JOIN ... ON syntax.
+// Equality conditions between the two relations in the ON clause are used
+// as the key of a hash index over each input buffer, so that a new tuple
+// is only combined with those tuples of the other relation that have the
+// same key instead of with the whole buffer. The index is maintained
+// incrementally when tuples enter and leave the buffers.
+type hashJoin struct {
+	joinType parser.JoinType
+	// left and right are the aliases of the joined relations.
+	left  string
+	right string
+	// keys holds, for each alias, the evaluators computing the join
+	// key of a tuple of that relation. The i-th evaluators of both
+	// relations are compared for equality. When ON doesn't contain
+	// any such equality, all tuples share the same key.
+	keys map[string][]Evaluator
+	// condition evaluates the complete ON clause on a pair of tuples.
+	condition Evaluator
+	// index holds, for each alias, the tuples in the buffer keyed by
+	// the hash of their join key.
+	index map[string]map[data.HashValue][]*tupleWithDerivedInputRows
+}
+
+func newHashJoin(join parser.JoinAST, left, right string, reg udf.FunctionRegistry) (*hashJoin, error) {
+	flatOn, err := ParserExprToFlatExpr(join.On, reg)
+	if err != nil {
+		// return a prettier error message
+		if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+			err = fmt.Errorf("aggregates not allowed in ON clause")
+		}
+		return nil, err
+	}
+	condition, err := ExpressionToEvaluator(flatOn, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &hashJoin{
+		joinType:  join.Type,
+		left:      left,
+		right:     right,
+		keys:      map[string][]Evaluator{},
+		condition: condition,
+		index: map[string]map[data.HashValue][]*tupleWithDerivedInputRows{
+			left:  {},
+			right: {},
+		},
+	}
+
+	// find all conditions of the form `left:x = right:y` that are
+	// combined with AND on the top level of the ON clause
+	for _, cond := range splitConjunction(join.On) {
+		op, ok := cond.(parser.BinaryOpAST)
+		if !ok || op.Op != parser.Equal {
+			continue
+		}
+		l, r := op.Left, op.Right
+		if onlyReferences(l, right) && onlyReferences(r, left) {
+			l, r = r, l
+		} else if !onlyReferences(l, left) || !onlyReferences(r, right) {
+			continue
+		}
+		leftKey, err := newJoinKeyEvaluator(l, reg)
+		if err != nil {
+			return nil, err
+		}
+		rightKey, err := newJoinKeyEvaluator(r, reg)
+		if err != nil {
+			return nil, err
+		}
+		if leftKey == nil || rightKey == nil {
+			continue
+		}
+		j.keys[left] = append(j.keys[left], leftKey)
+		j.keys[right] = append(j.keys[right], rightKey)
+	}
+	return j, nil
+}
+
+// splitConjunction returns the operands of the top level AND operators
+// of the given expression.
+func splitConjunction(expr parser.Expression) []parser.Expression {
+	op, ok := expr.(parser.BinaryOpAST)
+	if !ok || op.Op != parser.And {
+		return []parser.Expression{expr}
+	}
+	return append(splitConjunction(op.Left), splitConjunction(op.Right)...)
+}
+
+// onlyReferences returns true if the given expression refers to the
+// given relation and to no other relation.
+func onlyReferences(expr parser.Expression, alias string) bool {
+	rels := expr.ReferencedRelations()
+	return len(rels) == 1 && rels[alias]
+}
+
+// newJoinKeyEvaluator creates an Evaluator for one side of an equality
+// in the ON clause. It returns nil if the expression cannot be used as
+// a key of the hash index because it may return different values for
+// the same tuple over time.
+func newJoinKeyEvaluator(expr parser.Expression, reg udf.FunctionRegistry) (Evaluator, error) {
+	flatExpr, err := ParserExprToFlatExpr(expr, reg)
+	if err != nil {
+		return nil, err
+	}
+	if flatExpr.Volatility() != Immutable {
+		return nil, nil
+	}
+	return ExpressionToEvaluator(flatExpr, reg)
+}
+
+// other returns the alias of the relation joined with the given one.
+func (j *hashJoin) other(alias string) string {
+	if alias == j.left {
+		return j.right
+	}
+	return j.left
+}
+
+// preserves returns true if tuples of the given relation appear in the
+// result even if they don't match any tuple of the other relation.
+func (j *hashJoin) preserves(alias string) bool {
+	switch j.joinType {
+	case parser.LeftOuterJoin:
+		return alias == j.left
+	case parser.FullOuterJoin:
+		return true
+	}
+	return false
+}
+
+// computeKey computes the hash of the join key of a tuple. The second
+// return value is false if the tuple cannot match any tuple because a
+// part of its key is NULL.
+func (j *hashJoin) computeKey(alias string, t *tupleWithDerivedInputRows) (data.HashValue, bool, error) {
+	keys := j.keys[alias]
+	if len(keys) == 0 {
+		return 0, true, nil
+	}
+	dataHolder := data.Map{alias: t.tuple.Data[alias]}
+	setMetadata(dataHolder, alias, t.tuple)
+	values := make(data.Array, len(keys))
+	for i, k := range keys {
+		v, err := k.Eval(dataHolder)
+		if err != nil {
+			return 0, false, err
+		}
+		if v.Type() == data.TypeNull {
+			return 0, false, nil
+		}
+		values[i] = v
+	}
+	return data.Hash(values), true, nil
+}
+
+// joinInputTuples is the equivalent of filterInputTuples for a FROM
+// clause using the JOIN ... ON syntax. It looks up the tuples matching
+// the newly added tuple in the hash index of the other relation, adds
+// the joined rows that satisfy the WHERE clause to `ep.filteredInputRows`
+// and maintains the rows that join tuples of an outer join with NULL.
+func (ep *streamRelationStreamExecutionPlan) joinInputTuples() error {
+	j := ep.join
+	ep.filteredInputRowsBuffer = list.New()
+	removedRows := map[*inputRowWithCachedResult]bool{}
+
+	// on a self-join, the new tuple was appended to both buffers and
+	// the copies are joined one after another, so that the pair of
+	// the two copies is found exactly once
+	for _, alias := range []string{j.left, j.right} {
+		if !ep.lastTupleBuffers[alias] {
+			continue
+		}
+		t := ep.buffers[alias].tuples.Back().Value.(*tupleWithDerivedInputRows)
+		hash, ok, err := j.computeKey(alias, t)
+		if err != nil {
+			return err
+		}
+		if ok {
+			other := j.other(alias)
+			for _, p := range j.index[other][hash] {
+				dataHolder := data.Map{}
+				dataHolder[alias] = t.tuple.Data[alias]
+				setMetadata(dataHolder, alias, t.tuple)
+				dataHolder[other] = p.tuple.Data[other]
+				setMetadata(dataHolder, other, p.tuple)
+				matched, err := ep.evalCondition(j.condition, dataHolder)
+				if err != nil {
+					return err
+				}
+				if !matched {
+					continue
+				}
+
+				t.partners = append(t.partners, p)
+				p.partners = append(p.partners, t)
+				if p.nullRow != nil {
+					// p isn't joined with NULL anymore
+					removedRows[p.nullRow] = true
+					p.nullRow = nil
+				}
+				if _, err := ep.addJoinedRow(dataHolder, t, p); err != nil {
+					return err
+				}
+			}
+			j.index[alias][hash] = append(j.index[alias][hash], t)
+			t.keyHash = hash
+			t.indexed = true
+		}
+		if len(t.partners) == 0 && j.preserves(alias) {
+			if err := ep.addNullRow(alias, t); err != nil {
+				return err
+			}
+		}
+	}
+
+	ep.filteredInputRows.PushBackList(ep.filteredInputRowsBuffer)
+	ep.removeExpiredInputRows(removedRows)
+	return nil
+}
+
+// removeFromJoin removes a tuple that left the window from the hash index.
+// Tuples of an outer join that lose their last matching tuple are joined
+// with NULL again.
+func (ep *streamRelationStreamExecutionPlan) removeFromJoin(alias string, t *tupleWithDerivedInputRows) error {
+	j := ep.join
+	if t.indexed {
+		candidates := j.index[alias][t.keyHash]
+		for i, c := range candidates {
+			if c == t {
+				candidates = append(candidates[:i], candidates[i+1:]...)
+				break
+			}
+		}
+		if len(candidates) == 0 {
+			delete(j.index[alias], t.keyHash)
+		} else {
+			j.index[alias][t.keyHash] = candidates
+		}
+		t.indexed = false
+	}
+
+	other := j.other(alias)
+	ep.filteredInputRowsBuffer = list.New()
+	for _, p := range t.partners {
+		for i, c := range p.partners {
+			if c == t {
+				p.partners = append(p.partners[:i], p.partners[i+1:]...)
+				break
+			}
+		}
+		if len(p.partners) == 0 && j.preserves(other) {
+			if err := ep.addNullRow(other, p); err != nil {
+				return err
+			}
+		}
+	}
+	t.partners = nil
+	ep.filteredInputRows.PushBackList(ep.filteredInputRowsBuffer)
+	return nil
+}
+
+// addNullRow joins a tuple of an outer join that doesn't have any
+// matching tuple with NULL and adds the result to
+// `ep.filteredInputRowsBuffer` if it satisfies the WHERE clause.
+func (ep *streamRelationStreamExecutionPlan) addNullRow(alias string, t *tupleWithDerivedInputRows) error {
+	other := ep.join.other(alias)
+	dataHolder := data.Map{}
+	dataHolder[alias] = t.tuple.Data[alias]
+	setMetadata(dataHolder, alias, t.tuple)
+	dataHolder[other] = data.Null{}
+	dataHolder[fmt.Sprintf("%s:meta:%s", other, parser.TimestampMeta)] = data.Null{}
+	row, err := ep.addJoinedRow(dataHolder, t)
+	if err != nil {
+		return err
+	}
+	t.nullRow = row
+	return nil
+}
+
+// addJoinedRow adds a copy of the given row to `ep.filteredInputRowsBuffer`
+// and to the rows derived from the given tuples if it satisfies the WHERE
+// clause. It returns the added row or nil if the row doesn't satisfy the
+// WHERE clause.
+func (ep *streamRelationStreamExecutionPlan) addJoinedRow(dataHolder data.Map, origin ...*tupleWithDerivedInputRows) (*inputRowWithCachedResult, error) {
+	if ep.filter != nil {
+		matched, err := ep.evalCondition(ep.filter, dataHolder)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			return nil, nil
+		}
+	} else {
+		dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
+	}
+	item := make(data.Map, len(dataHolder))
+	for key, val := range dataHolder {
+		item[key] = val
+	}
+	row := &inputRowWithCachedResult{
+		input: &item,
+	}
+	for _, t := range origin {
+		t.rows = append(t.rows, row)
+	}
+	ep.filteredInputRowsBuffer.PushBack(row)
+	return row, nil
+}
+
+// evalCondition evaluates a condition such as a WHERE or an ON clause.
+// NULL is treated as false.
+func (ep *streamRelationStreamExecutionPlan) evalCondition(cond Evaluator, dataHolder data.Map) (bool, error) {
+	dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
+	res, err := cond.Eval(dataHolder)
+	if err != nil {
+		return false, err
+	}
+	if res.Type() == data.TypeNull {
+		return false, nil
+	}
+	return data.AsBool(res)
+}
@@ -0,0 +1,418 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+	"sort"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// incrementalAggregate describes a call of an incremental aggregate
+// function such as `count(a)` in a statement.
+type incrementalAggregate struct {
+	f udf.IncrementalAggregate
+	// inputKey is the key of the aggregated input (see aggInputRef)
+	inputKey string
+	// resultKey is the key used to pass the result of the function
+	// to the funcApp evaluator of the call
+	resultKey string
+	// distinct is true if the function is called with DISTINCT
+	distinct bool
+}
+
+func (a incrementalAggregate) newAccumulator() udf.Accumulator {
+	acc := a.f.NewAccumulator()
+	if a.distinct {
+		return newDistinctAccumulator(acc)
+	}
+	return acc
+}
+
+// aggregateCollector is a FunctionRegistry that is used while the
+// evaluators of a projection are created. It records the calls of
+// aggregate functions so that the plan can decide whether they can
+// be computed incrementally.
+type aggregateCollector struct {
+	udf.FunctionRegistry
+	aggregates map[string]incrementalAggregate
+	// recompute is true if there is a call of an aggregate function
+	// that cannot be computed incrementally
+	recompute bool
+	// analytics holds the calls of analytic functions keyed by the
+	// key of their results
+	analytics map[string]*analyticFunc
+}
+
+func newAggregateCollector(reg udf.FunctionRegistry) *aggregateCollector {
+	return &aggregateCollector{
+		FunctionRegistry: reg,
+		aggregates:       map[string]incrementalAggregate{},
+		analytics:        map[string]*analyticFunc{},
+	}
+}
+
+// collectAggregate records a call of an aggregate function if reg is
+// an aggregateCollector. When the function can be computed incrementally,
+// the key of its result is assigned to the given funcApp. distinct is
+// true if the function is called with DISTINCT.
+func collectAggregate(reg udf.FunctionRegistry, name string, f udf.UDF, params []FlatExpression, fa *funcApp, distinct bool) {
+	c, ok := reg.(*aggregateCollector)
+	if !ok {
+		return
+	}
+	inc, ok := f.(udf.IncrementalAggregate)
+	if !ok || len(params) != 1 {
+		c.recompute = true
+		return
+	}
+	ref, ok := params[0].(aggInputRef)
+	if !ok {
+		c.recompute = true
+		return
+	}
+	key := fmt.Sprintf(":agg:%s:%s", name, ref.Ref)
+	if distinct {
+		key = fmt.Sprintf(":agg:%s:distinct:%s", name, ref.Ref)
+	}
+	fa.resultKey = key
+	c.aggregates[key] = incrementalAggregate{inc, ref.Ref, key, distinct}
+}
+
+// valueCount is the number of occurrences of a value.
+type valueCount struct {
+	value data.Value
+	count int
+}
+
+// distinctAccumulator wraps the accumulator of an aggregate function
+// called with DISTINCT. It only adds the first occurrence of a value to
+// the wrapped accumulator and only retracts a value from it when its
+// last occurrence is retracted.
+type distinctAccumulator struct {
+	acc    udf.Accumulator
+	counts map[data.HashValue][]valueCount
+}
+
+func newDistinctAccumulator(acc udf.Accumulator) *distinctAccumulator {
+	return &distinctAccumulator{
+		acc:    acc,
+		counts: map[data.HashValue][]valueCount{},
+	}
+}
+
+func (d *distinctAccumulator) Add(v data.Value) error {
+	// values that are not equal to themselves (i.e., NaN or containers
+	// holding NaN) are always distinct and cannot be found again
+	if !data.Equal(v, v) {
+		return d.acc.Add(v)
+	}
+	h := data.Hash(v)
+	counts := d.counts[h]
+	for i := range counts {
+		if data.Equal(counts[i].value, v) {
+			counts[i].count++
+			return nil
+		}
+	}
+	if err := d.acc.Add(v); err != nil {
+		return err
+	}
+	d.counts[h] = append(counts, valueCount{v, 1})
+	return nil
+}
+
+func (d *distinctAccumulator) Retract(v data.Value) error {
+	if !data.Equal(v, v) {
+		return d.acc.Retract(v)
+	}
+	h := data.Hash(v)
+	counts := d.counts[h]
+	for i := range counts {
+		if !data.Equal(counts[i].value, v) {
+			continue
+		}
+		if counts[i].count--; counts[i].count > 0 {
+			return nil
+		}
+		// retract the value that was actually added, which may have
+		// a different type, e.g. Int(2) instead of Float(2.0)
+		added := counts[i].value
+		if len(counts) == 1 {
+			delete(d.counts, h)
+		} else {
+			d.counts[h] = append(counts[:i], counts[i+1:]...)
+		}
+		return d.acc.Retract(added)
+	}
+	return fmt.Errorf("value %v was not added to the accumulator", v)
+}
+
+func (d *distinctAccumulator) Result() (data.Value, error) {
+	return d.acc.Result()
+}
+
+// accumulatorState wraps an accumulator and counts the values that
+// could not be added to it.
+type accumulatorState struct {
+	acc     udf.Accumulator
+	invalid int
+	err     error
+}
+
+func (s *accumulatorState) result() (data.Value, error) {
+	if s.invalid > 0 {
+		return nil, s.err
+	}
+	return s.acc.Result()
+}
+
+// aggregateGroup holds the accumulators of the rows in the window
+// that have the same values for GROUP BY columns.
+type aggregateGroup struct {
+	group data.Array
+	// nonAggData is a representative set of values for this group
+	nonAggData data.Map
+	numRows    int
+	// accs holds one accumulator per aggregate call
+	accs []accumulatorState
+	// elem is the position of the group in the list of groups
+	elem *list.Element
+}
+
+// incrementalAggregation maintains the results of the aggregate
+// functions of a grouping statement while rows enter and leave the
+// window, so that the aggregates don't have to be recomputed from
+// all rows in the window every time the query is performed.
+type incrementalAggregation struct {
+	aggregates []incrementalAggregate
+	// inputs holds the evaluators of the aggregated inputs
+	inputs map[string]Evaluator
+	groups map[data.HashValue][]*aggregateGroup
+	// groupOrder holds the groups in the order of their creation
+	groupOrder *list.List
+}
+
+// newIncrementalAggregation returns nil if the aggregates cannot be
+// computed incrementally because an aggregate function doesn't support
+// it or because rows are not maintained incrementally by the window.
+func newIncrementalAggregation(projs []aliasedEvaluator, window parser.WindowSpecAST) *incrementalAggregation {
+	// SESSION windows are evaluated only once per session anyway
+	if window.Window == parser.SessionWindow {
+		return nil
+	}
+	a := &incrementalAggregation{
+		inputs:     map[string]Evaluator{},
+		groups:     map[data.HashValue][]*aggregateGroup{},
+		groupOrder: list.New(),
+	}
+	// the same call can appear in multiple projections, but it only
+	// needs one accumulator
+	seen := map[string]bool{}
+	for _, proj := range projs {
+		if proj.recompute {
+			return nil
+		}
+		for key, agg := range proj.aggregates {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			a.aggregates = append(a.aggregates, agg)
+			a.inputs[agg.inputKey] = proj.aggrEvals[agg.inputKey]
+		}
+	}
+	sort.Sort(incrementalAggregates(a.aggregates))
+	return a
+}
+
+type incrementalAggregates []incrementalAggregate
+
+func (a incrementalAggregates) Len() int           { return len(a) }
+func (a incrementalAggregates) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a incrementalAggregates) Less(i, j int) bool { return a[i].resultKey < a[j].resultKey }
+
+// findGroup returns the group having the given values or nil if there
+// is no such group.
+func (a *incrementalAggregation) findGroup(groupValues data.Array, groupHash data.HashValue) *aggregateGroup {
+	for _, g := range a.groups[groupHash] {
+		if data.Equal(groupValues, g.group) {
+			return g
+		}
+	}
+	return nil
+}
+
+// add adds a row entering the window to the accumulators of its group.
+func (a *incrementalAggregation) add(io *inputRowWithCachedResult, groupValues data.Array) error {
+	// compute all the input data for the aggregate functions before
+	// changing any state
+	inputs := make(map[string]data.Value, len(a.inputs))
+	for key, eval := range a.inputs {
+		value, err := eval.Eval(*io.input)
+		if err != nil {
+			return err
+		}
+		inputs[key] = value
+	}
+
+	g := a.findGroup(groupValues, io.hash)
+	if g == nil {
+		g = &aggregateGroup{
+			group:      groupValues,
+			nonAggData: io.input.Copy(),
+			accs:       make([]accumulatorState, len(a.aggregates)),
+		}
+		for i, agg := range a.aggregates {
+			g.accs[i].acc = agg.newAccumulator()
+		}
+		g.elem = a.groupOrder.PushBack(g)
+		a.groups[io.hash] = append(a.groups[io.hash], g)
+	}
+
+	values := make([]data.Value, len(a.aggregates))
+	for i, agg := range a.aggregates {
+		v := inputs[agg.inputKey]
+		if err := g.accs[i].acc.Add(v); err != nil {
+			// the error is reported as the result as long as
+			// this row is in the window
+			g.accs[i].invalid++
+			g.accs[i].err = err
+			continue
+		}
+		values[i] = v
+	}
+	g.numRows++
+	io.aggregated = true
+	io.aggValues = values
+	return nil
+}
+
+// retract removes a row leaving the window from the accumulators of
+// its group. The group is removed when it doesn't have any rows.
+func (a *incrementalAggregation) retract(io *inputRowWithCachedResult) error {
+	groupValues, err := data.AsArray(io.cache)
+	if err != nil {
+		return fmt.Errorf("cached data was not an array: %v", io.cache)
+	}
+	g := a.findGroup(groupValues, io.hash)
+	if g == nil {
+		return fmt.Errorf("group %v was not found", groupValues)
+	}
+	io.aggregated = false
+	for i, v := range io.aggValues {
+		if v == nil {
+			g.accs[i].invalid--
+			continue
+		}
+		if err := g.accs[i].acc.Retract(v); err != nil {
+			return err
+		}
+	}
+	io.aggValues = nil
+
+	if g.numRows--; g.numRows > 0 {
+		return nil
+	}
+	a.groupOrder.Remove(g.elem)
+	candidates := a.groups[io.hash]
+	for i, c := range candidates {
+		if c == g {
+			candidates = append(candidates[:i], candidates[i+1:]...)
+			break
+		}
+	}
+	if len(candidates) == 0 {
+		delete(a.groups, io.hash)
+	} else {
+		a.groups[io.hash] = candidates
+	}
+	return nil
+}
+
+// performQueryIncrementally is the equivalent of performQueryOnBuffer
+// when the aggregates are computed incrementally. Instead of grouping
+// all rows in `ep.filteredInputRows`, it only adds the rows that
+// entered the window and retracts the rows that left it since the
+// previous run.
+func (ep *groupbyExecutionPlan) performQueryIncrementally() error {
+	inc := ep.incremental
+	// reuse the allocated memory
+	output := ep.prevResults[0:0]
+	// remember the previous results
+	ep.prevResults = ep.curResults
+
+	rollback := func() {
+		// NB. see performQueryOnBuffer for why this is required
+		ep.prevResults = output
+	}
+
+	// retract the rows that left the window
+	retracted := ep.retractedRows
+	ep.retractedRows = nil
+	for _, io := range retracted {
+		if err := inc.retract(io); err != nil {
+			rollback()
+			return err
+		}
+	}
+
+	// rows are always appended to the end of ep.filteredInputRows, so
+	// the rows that have not been added to the accumulators yet are at
+	// the end of the list. if adding a row fails, the following rows
+	// will be added in the next run.
+	var first *list.Element
+	for e := ep.filteredInputRows.Back(); e != nil; e = e.Prev() {
+		if e.Value.(*inputRowWithCachedResult).aggregated {
+			break
+		}
+		first = e
+	}
+	for e := first; e != nil; e = e.Next() {
+		io := e.Value.(*inputRowWithCachedResult)
+		groupValues, err := ep.groupValues(io)
+		if err != nil {
+			rollback()
+			return err
+		}
+		if err := inc.add(io, groupValues); err != nil {
+			rollback()
+			return err
+		}
+	}
+
+	for e := inc.groupOrder.Front(); e != nil; e = e.Next() {
+		g := e.Value.(*aggregateGroup)
+		for i, agg := range inc.aggregates {
+			v, err := g.accs[i].result()
+			if err != nil {
+				rollback()
+				return err
+			}
+			g.nonAggData[agg.resultKey] = v
+		}
+		result, err := ep.evalGroup(g.nonAggData)
+		if err != nil {
+			rollback()
+			return err
+		}
+		if result != nil {
+			output = append(output, *result)
+		}
+	}
+	if inc.groupOrder.Len() == 0 {
+		result, err := ep.evalNoGroup()
+		if err != nil {
+			rollback()
+			return err
+		}
+		if result != nil {
+			output = append(output, *result)
+		}
+	}
+
+	ep.curResults = output
+	return nil
+}
@@ -0,0 +1,709 @@
+package execution
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+const (
+	// maxPatternRepetitions is the maximum number used in a quantifier
+	// of a pattern such as `A{2,5}`.
+	maxPatternRepetitions = 100
+
+	// maxPatternInstructions limits the size of a compiled pattern,
+	// which grows with nested quantifiers.
+	maxPatternInstructions = 10000
+)
+
+// matchRecognizePlan is a physical plan for statements having a
+// MATCH_RECOGNIZE clause. Input rows are divided into partitions by the
+// PARTITION BY expressions and the pattern, compiled into an NFA, runs
+// on the rows of each partition in the order of their arrival.
+//
+// The NFA is simulated by a Pike VM. Each thread of the VM remembers the
+// last row mapped to each pattern variable so that DEFINE conditions can
+// refer to earlier rows of the match. Quantifiers are greedy and the
+// match starting at the earliest row is preferred. When a match has been
+// found, matching resumes at the row after the last row of the match.
+// Since a greedy quantifier may still extend a match, a match is emitted
+// when all threads preferred to it have failed or have left the window.
+//
+// The window of the input relation limits the length of a match: the
+// first and the last row of a match must be in the window at the same
+// time. For each match, one row consisting of the PARTITION BY columns
+// and the MEASURES is passed to the WHERE and SELECT clauses.
+type matchRecognizePlan struct {
+	commonExecutionPlan
+	relAlias string
+
+	// rangeTuples or rangeDuration is the size of the window.
+	rangeTuples   int64
+	rangeDuration time.Duration
+
+	partitionBy   []Evaluator
+	partitionCols []data.Path
+	measures      []Evaluator
+	measurePaths  []data.Path
+	// variables holds the names of all pattern variables in sorted order.
+	variables []string
+	// defines holds the conditions of pattern variables. A variable
+	// without a condition matches any row.
+	defines map[string]Evaluator
+	program []patternInst
+
+	// partitions holds partitions having running threads in the order
+	// of their creation. partitionIndex is used to look them up by key.
+	partitions     []*matchPartition
+	partitionIndex map[data.HashValue][]*matchPartition
+	seq            int64
+}
+
+// CanBuildMatchRecognizePlan checks whether the given statement
+// allows to use a matchRecognizePlan.
+func CanBuildMatchRecognizePlan(lp *LogicalPlan, reg udf.FunctionRegistry) bool {
+	return lp.MatchRecognize != nil
+}
+
+// NewMatchRecognizePlan creates a plan detecting sequences of rows
+// matching the pattern given in the MATCH_RECOGNIZE clause.
+func NewMatchRecognizePlan(lp *LogicalPlan, reg udf.FunctionRegistry) (PhysicalPlan, error) {
+	if lp.GroupingStmt {
+		return nil, fmt.Errorf("aggregate functions cannot be used with MATCH_RECOGNIZE")
+	}
+	m := lp.MatchRecognize
+	rel := lp.Relations[0]
+	ep := &matchRecognizePlan{
+		relAlias:       rel.Alias,
+		defines:        map[string]Evaluator{},
+		partitionIndex: map[data.HashValue][]*matchPartition{},
+	}
+	switch rel.Unit {
+	case parser.Tuples:
+		ep.rangeTuples = int64(rel.Value)
+	case parser.Seconds:
+		ep.rangeDuration = time.Duration(rel.Value * float64(time.Second))
+	case parser.Milliseconds:
+		ep.rangeDuration = time.Duration(rel.Value * float64(time.Millisecond))
+	default:
+		return nil, fmt.Errorf("unknown window unit: %v", rel.Unit)
+	}
+
+	toEval := func(e parser.Expression) (Evaluator, error) {
+		flatExpr, err := ParserExprToFlatExpr(e, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in MATCH_RECOGNIZE clause")
+			} else if strings.HasPrefix(err.Error(), "you cannot use analytic") {
+				err = fmt.Errorf("analytic functions not allowed in MATCH_RECOGNIZE clause")
+			}
+			return nil, err
+		}
+		return ExpressionToEvaluator(flatExpr, reg)
+	}
+	columns := map[string]bool{}
+	addColumn := func(name string) (data.Path, error) {
+		if columns[name] {
+			return nil, fmt.Errorf("column '%s' appears more than once in MATCH_RECOGNIZE", name)
+		}
+		columns[name] = true
+		return data.CompilePath(name)
+	}
+
+	for _, e := range m.PartitionBy {
+		col, ok := e.(parser.RowValue)
+		if !ok || !simpleColumnNameRe.MatchString(col.Column) {
+			return nil, fmt.Errorf("PARTITION BY of MATCH_RECOGNIZE only supports "+
+				"column names: %s", e)
+		}
+		eval, err := toEval(e)
+		if err != nil {
+			return nil, err
+		}
+		path, err := addColumn(col.Column)
+		if err != nil {
+			return nil, err
+		}
+		ep.partitionBy = append(ep.partitionBy, eval)
+		ep.partitionCols = append(ep.partitionCols, path)
+	}
+	for _, e := range m.Measures {
+		// the parser makes sure that all measures have an alias
+		alias := e.(parser.AliasAST)
+		eval, err := toEval(alias.Expr)
+		if err != nil {
+			return nil, err
+		}
+		path, err := addColumn(alias.Alias)
+		if err != nil {
+			return nil, err
+		}
+		ep.measures = append(ep.measures, eval)
+		ep.measurePaths = append(ep.measurePaths, path)
+	}
+	for _, def := range m.Definitions {
+		eval, err := toEval(def.Cond)
+		if err != nil {
+			return nil, err
+		}
+		ep.defines[def.Variable] = eval
+	}
+
+	vars := map[string]bool{}
+	collectPatternVariables(m.Pattern, vars)
+	for v := range vars {
+		ep.variables = append(ep.variables, v)
+	}
+	sort.Strings(ep.variables)
+	program, err := compilePattern(m.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	ep.program = program
+
+	projs, err := prepareProjections(lp.Projections, reg)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := prepareFilter(lp.Filter, reg)
+	if err != nil {
+		return nil, err
+	}
+	ep.commonExecutionPlan = commonExecutionPlan{
+		projections: projs,
+		analytics:   collectAnalytics(projs),
+		filter:      filter,
+	}
+	return ep, nil
+}
+
+// matchedRow is a row fed to the NFA.
+type matchedRow struct {
+	seq   int64
+	tuple *core.Tuple
+}
+
+// matchThread is a thread of the Pike VM.
+type matchThread struct {
+	pc int
+	// first and last are the first and the last row of the match. They
+	// are nil before the thread consumes a row.
+	first *matchedRow
+	last  *matchedRow
+	// vars holds the last row mapped to each pattern variable. It can
+	// be shared with other threads and must not be modified.
+	vars map[string]*matchedRow
+}
+
+func (th *matchThread) at(pc int) *matchThread {
+	return &matchThread{pc, th.first, th.last, th.vars}
+}
+
+// consume returns a thread which has mapped the row to the variable.
+func (th *matchThread) consume(variable string, row *matchedRow) *matchThread {
+	vars := make(map[string]*matchedRow, len(th.vars)+1)
+	for v, r := range th.vars {
+		vars[v] = r
+	}
+	vars[variable] = row
+	first := th.first
+	if first == nil {
+		first = row
+	}
+	return &matchThread{th.pc + 1, first, row, vars}
+}
+
+// matchPartition holds the threads running on the rows of a partition
+// in the order of their priority.
+type matchPartition struct {
+	key     data.Array
+	threads []*matchThread
+}
+
+func (ep *matchRecognizePlan) Process(input *core.Tuple) ([]data.Map, error) {
+	// because the tuple is kept by threads, ShallowCopy is required here.
+	// It also sets core.TFSharedData.
+	t := input.ShallowCopy()
+	now := data.Timestamp(time.Now().In(time.UTC))
+	row := &matchedRow{ep.seq, t}
+	ep.seq++
+
+	d := data.Map{ep.relAlias: t.Data}
+	setMetadata(d, ep.relAlias, t)
+	d[":meta:NOW"] = now
+	key, err := evalAll(ep.partitionBy, d)
+	if err != nil {
+		return nil, err
+	}
+
+	type partitionMatch struct {
+		key   data.Array
+		match *matchThread
+	}
+	var matches []partitionMatch
+	// the rows which can't be a part of a match anymore are dropped
+	// first, which may finish a pending match
+	for _, part := range ep.partitions {
+		if m := ep.expire(part, row); m != nil {
+			matches = append(matches, partitionMatch{part.key, m})
+		}
+	}
+	part := ep.lookupPartition(key)
+	found, err := ep.advance(part, row, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range found {
+		matches = append(matches, partitionMatch{part.key, m})
+	}
+	ep.removeIdlePartitions()
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	rows := make([]data.Map, 0, len(matches))
+	for _, m := range matches {
+		r, err := ep.measure(m.key, m.match, now)
+		if err != nil {
+			return nil, err
+		}
+		if ep.filter != nil {
+			filterResult, err := ep.filter.Eval(r)
+			if err != nil {
+				return nil, err
+			}
+			// rows where the filter condition evaluates to NULL are dropped
+			if filterResult.Type() == data.TypeNull {
+				continue
+			}
+			b, err := data.AsBool(filterResult)
+			if err != nil {
+				return nil, err
+			}
+			if !b {
+				continue
+			}
+		}
+		rows = append(rows, r)
+	}
+	// the window of analytic functions consists of the matches found
+	// by this tuple
+	if len(ep.analytics) > 0 {
+		if err := computeAnalytics(ep.analytics, rows); err != nil {
+			return nil, err
+		}
+	}
+
+	output := make([]data.Map, 0, len(rows))
+	for _, r := range rows {
+		result := data.Map(make(map[string]data.Value, len(ep.projections)))
+		for _, proj := range ep.projections {
+			value, err := proj.evaluator.Eval(r)
+			if err != nil {
+				return nil, err
+			}
+			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
+				return nil, err
+			}
+		}
+		output = append(output, result)
+	}
+	return output, nil
+}
+
+func (ep *matchRecognizePlan) lookupPartition(key data.Array) *matchPartition {
+	h := data.Hash(key)
+	for _, part := range ep.partitionIndex[h] {
+		if data.Equal(part.key, key) {
+			return part
+		}
+	}
+	part := &matchPartition{key: key}
+	ep.partitions = append(ep.partitions, part)
+	ep.partitionIndex[h] = append(ep.partitionIndex[h], part)
+	return part
+}
+
+func (ep *matchRecognizePlan) removeIdlePartitions() {
+	alive := ep.partitions[:0]
+	for _, part := range ep.partitions {
+		if len(part.threads) > 0 {
+			alive = append(alive, part)
+			continue
+		}
+		h := data.Hash(part.key)
+		candidates := ep.partitionIndex[h]
+		for i, c := range candidates {
+			if c == part {
+				candidates = append(candidates[:i], candidates[i+1:]...)
+				break
+			}
+		}
+		if len(candidates) == 0 {
+			delete(ep.partitionIndex, h)
+		} else {
+			ep.partitionIndex[h] = candidates
+		}
+	}
+	for i := len(alive); i < len(ep.partitions); i++ {
+		ep.partitions[i] = nil
+	}
+	ep.partitions = alive
+}
+
+// inWindow returns true if the first row of a match and the given row
+// can be in the window at the same time.
+func (ep *matchRecognizePlan) inWindow(first, row *matchedRow) bool {
+	if ep.rangeTuples > 0 {
+		return row.seq-first.seq < ep.rangeTuples
+	}
+	return row.tuple.Timestamp.Sub(first.tuple.Timestamp) <= ep.rangeDuration
+}
+
+// expire removes the threads of the partition which cannot consume the
+// given row because their first row would leave the window. When a
+// pending match has no threads preferred to it anymore, it's removed
+// from the partition and returned.
+func (ep *matchRecognizePlan) expire(part *matchPartition, row *matchedRow) *matchThread {
+	alive := make([]*matchThread, 0, len(part.threads))
+	for _, th := range part.threads {
+		if ep.program[th.pc].op != patternOpMatch && !ep.inWindow(th.first, row) {
+			continue
+		}
+		alive = append(alive, th)
+	}
+	part.threads = alive
+	if len(alive) > 0 && ep.program[alive[0].pc].op == patternOpMatch {
+		part.threads = nil
+		return alive[0]
+	}
+	return nil
+}
+
+// advance feeds the row to the threads of the partition and returns the
+// matches which have been found.
+func (ep *matchRecognizePlan) advance(part *matchPartition, row *matchedRow, now data.Value) ([]*matchThread, error) {
+	var matches []*matchThread
+	for {
+		clist := part.threads
+		pending := false
+		for _, th := range clist {
+			if ep.program[th.pc].op == patternOpMatch {
+				pending = true
+				break
+			}
+		}
+		if !pending {
+			// a new match can start at this row with the lowest priority
+			clist, _ = ep.addThread(clist, &matchThread{}, map[string]bool{})
+		}
+
+		var nlist []*matchThread
+		visited := map[string]bool{}
+		restart := false
+		for _, th := range clist {
+			inst := ep.program[th.pc]
+			if inst.op == patternOpMatch {
+				if len(nlist) == 0 {
+					// all threads preferred to the match have failed
+					matches = append(matches, th)
+					restart = true
+				} else {
+					nlist = append(nlist, th)
+				}
+				// threads having a lower priority than the match are cut
+				break
+			}
+			ok, err := ep.test(th, inst.variable, row, now)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			var matched bool
+			nlist, matched = ep.addThread(nlist, th.consume(inst.variable, row), visited)
+			if matched {
+				break
+			}
+		}
+		if restart {
+			// the row isn't a part of the match and can start a new one
+			part.threads = nil
+			continue
+		}
+
+		part.threads = nlist
+		if len(nlist) > 0 && ep.program[nlist[0].pc].op == patternOpMatch {
+			matches = append(matches, nlist[0])
+			part.threads = nil
+		}
+		return matches, nil
+	}
+}
+
+// addThread appends the thread to the list after following jumps and
+// splits. It returns true when the thread reaches the end of the
+// pattern, in which case threads having a lower priority must not be
+// added to the list anymore. visited prevents duplicate threads.
+func (ep *matchRecognizePlan) addThread(list []*matchThread, th *matchThread, visited map[string]bool) ([]*matchThread, bool) {
+	key := ep.threadKey(th)
+	if visited[key] {
+		return list, false
+	}
+	visited[key] = true
+
+	inst := ep.program[th.pc]
+	switch inst.op {
+	case patternOpJump:
+		return ep.addThread(list, th.at(inst.x), visited)
+	case patternOpSplit:
+		list, matched := ep.addThread(list, th.at(inst.x), visited)
+		if matched {
+			return list, true
+		}
+		return ep.addThread(list, th.at(inst.y), visited)
+	case patternOpMatch:
+		return append(list, th), true
+	}
+	return append(list, th), false
+}
+
+// threadKey returns a string identifying the state of the thread.
+// Threads having the same state behave in the same way.
+func (ep *matchRecognizePlan) threadKey(th *matchThread) string {
+	key := make([]string, 0, len(ep.variables)+2)
+	key = append(key, strconv.Itoa(th.pc))
+	if th.first == nil {
+		key = append(key, "-")
+	} else {
+		key = append(key, strconv.FormatInt(th.first.seq, 10))
+	}
+	for _, v := range ep.variables {
+		if r := th.vars[v]; r == nil {
+			key = append(key, "-")
+		} else {
+			key = append(key, strconv.FormatInt(r.seq, 10))
+		}
+	}
+	return strings.Join(key, ",")
+}
+
+// test returns true if the row can be mapped to the pattern variable.
+func (ep *matchRecognizePlan) test(th *matchThread, variable string, row *matchedRow, now data.Value) (bool, error) {
+	cond, ok := ep.defines[variable]
+	if !ok {
+		return true, nil
+	}
+	res, err := cond.Eval(ep.rowContext(th.vars, row, now))
+	if err != nil {
+		return false, err
+	}
+	// a NULL value is regarded as false as in WHERE
+	if res.Type() == data.TypeNull {
+		return false, nil
+	}
+	return data.AsBool(res)
+}
+
+// rowContext creates the input of the expressions in the
+// MATCH_RECOGNIZE clause. The input relation refers to the given row
+// and a pattern variable refers to the last row mapped to the variable,
+// or NULL if there's no such row.
+func (ep *matchRecognizePlan) rowContext(vars map[string]*matchedRow, row *matchedRow, now data.Value) data.Map {
+	d := make(data.Map, 2*len(ep.variables)+3)
+	d[ep.relAlias] = row.tuple.Data
+	setMetadata(d, ep.relAlias, row.tuple)
+	d[":meta:NOW"] = now
+	for _, v := range ep.variables {
+		if r := vars[v]; r != nil {
+			d[v] = r.tuple.Data
+			setMetadata(d, v, r.tuple)
+		} else {
+			d[v] = data.Null{}
+			d[fmt.Sprintf("%s:meta:%s", v, parser.TimestampMeta)] = data.Null{}
+		}
+	}
+	return d
+}
+
+// measure computes the row of the match passed to the SELECT clause.
+// The input relation refers to the last row of the match in MEASURES.
+func (ep *matchRecognizePlan) measure(key data.Array, th *matchThread, now data.Value) (data.Map, error) {
+	res := data.Map{}
+	for i, path := range ep.partitionCols {
+		if err := res.Set(path, key[i]); err != nil {
+			return nil, err
+		}
+	}
+	d := ep.rowContext(th.vars, th.last, now)
+	for i, eval := range ep.measures {
+		v, err := eval.Eval(d)
+		if err != nil {
+			return nil, err
+		}
+		if err := res.Set(ep.measurePaths[i], v); err != nil {
+			return nil, err
+		}
+	}
+	r := data.Map{ep.relAlias: res}
+	setMetadata(r, ep.relAlias, th.last.tuple)
+	r[":meta:NOW"] = now
+	return r, nil
+}
+
+type patternOp int
+
+const (
+	// patternOpConsume maps a row to the variable.
+	patternOpConsume patternOp = iota
+	// patternOpSplit continues at x and, with a lower priority, at y.
+	patternOpSplit
+	// patternOpJump continues at x.
+	patternOpJump
+	// patternOpMatch reports a match.
+	patternOpMatch
+)
+
+// patternInst is an instruction of a compiled pattern.
+type patternInst struct {
+	op       patternOp
+	variable string
+	x        int
+	y        int
+}
+
+// compilePattern compiles the pattern into instructions of a Pike VM.
+// Quantifiers are greedy, i.e., a split prefers another repetition.
+func compilePattern(p parser.PatternAST) ([]patternInst, error) {
+	if err := validatePattern(p); err != nil {
+		return nil, err
+	}
+	if patternNullable(p) {
+		return nil, fmt.Errorf("the pattern must not match an empty sequence of rows: %s", p)
+	}
+	c := &patternCompiler{}
+	if err := c.emit(p); err != nil {
+		return nil, err
+	}
+	c.prog = append(c.prog, patternInst{op: patternOpMatch})
+	return c.prog, nil
+}
+
+func validatePattern(p parser.PatternAST) error {
+	if p.Min > maxPatternRepetitions || p.Max > maxPatternRepetitions {
+		return fmt.Errorf("the number of repetitions in a pattern must be at most %d: %s",
+			maxPatternRepetitions, p)
+	}
+	if p.Max == 0 || (p.Max > 0 && p.Max < p.Min) {
+		return fmt.Errorf("invalid quantifier in a pattern: %s", p)
+	}
+	for _, e := range p.Elements {
+		if err := validatePattern(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// patternNullable returns true if the pattern matches an empty sequence.
+func patternNullable(p parser.PatternAST) bool {
+	if p.Min == 0 {
+		return true
+	}
+	if p.Variable != "" {
+		return false
+	}
+	for _, e := range p.Elements {
+		nullable := patternNullable(e)
+		if p.Alternation && nullable {
+			return true
+		} else if !p.Alternation && !nullable {
+			return false
+		}
+	}
+	return !p.Alternation
+}
+
+type patternCompiler struct {
+	prog []patternInst
+}
+
+func (c *patternCompiler) add(inst patternInst) int {
+	c.prog = append(c.prog, inst)
+	return len(c.prog) - 1
+}
+
+// emit compiles the pattern including its quantifier.
+func (c *patternCompiler) emit(p parser.PatternAST) error {
+	if len(c.prog) > maxPatternInstructions {
+		return fmt.Errorf("the pattern is too complex")
+	}
+	for i := 0; i < p.Min; i++ {
+		if err := c.emitOnce(p); err != nil {
+			return err
+		}
+	}
+	if p.Max < 0 {
+		loop := c.add(patternInst{op: patternOpSplit, x: len(c.prog) + 1})
+		if err := c.emitOnce(p); err != nil {
+			return err
+		}
+		c.add(patternInst{op: patternOpJump, x: loop})
+		c.prog[loop].y = len(c.prog)
+		return nil
+	}
+	var splits []int
+	for i := p.Min; i < p.Max; i++ {
+		splits = append(splits, c.add(patternInst{op: patternOpSplit, x: len(c.prog) + 1}))
+		if err := c.emitOnce(p); err != nil {
+			return err
+		}
+	}
+	for _, s := range splits {
+		c.prog[s].y = len(c.prog)
+	}
+	return nil
+}
+
+// emitOnce compiles the pattern without its quantifier.
+func (c *patternCompiler) emitOnce(p parser.PatternAST) error {
+	switch {
+	case p.Variable != "":
+		c.add(patternInst{op: patternOpConsume, variable: p.Variable})
+	case p.Alternation:
+		var jumps []int
+		for i, e := range p.Elements {
+			if i == len(p.Elements)-1 {
+				if err := c.emit(e); err != nil {
+					return err
+				}
+				break
+			}
+			split := c.add(patternInst{op: patternOpSplit, x: len(c.prog) + 1})
+			if err := c.emit(e); err != nil {
+				return err
+			}
+			jumps = append(jumps, c.add(patternInst{op: patternOpJump}))
+			c.prog[split].y = len(c.prog)
+		}
+		for _, j := range jumps {
+			c.prog[j].x = len(c.prog)
+		}
+	default:
+		for _, e := range p.Elements {
+			if err := c.emit(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,331 @@
+package execution
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func createMatchRecognizePlan(s string) (PhysicalPlan, error) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	stmt, _, err := parser.New().ParseStmt(s)
+	if err != nil {
+		return nil, err
+	}
+	lp, err := Analyze(stmt.(parser.CreateStreamAsSelectStmt).Select, reg)
+	if err != nil {
+		return nil, err
+	}
+	if !CanBuildMatchRecognizePlan(lp, reg) {
+		return nil, fmt.Errorf("matchRecognizePlan cannot be used for statement: %s", s)
+	}
+	return NewMatchRecognizePlan(lp, reg)
+}
+
+func makeMatchTuples(rows ...data.Map) []*core.Tuple {
+	tuples := make([]*core.Tuple, len(rows))
+	for i, r := range rows {
+		r["seq"] = data.Int(i)
+		tuples[i] = &core.Tuple{
+			Data:      r,
+			InputName: "src",
+			Timestamp: time.Date(2015, time.April, 10, 10, 23, i, 0, time.UTC),
+		}
+	}
+	return tuples
+}
+
+// processAll feeds the tuples to the plan and returns the output
+// of each tuple.
+func processAll(plan PhysicalPlan, tuples []*core.Tuple) [][]data.Map {
+	res := make([][]data.Map, len(tuples))
+	for i, t := range tuples {
+		out, err := plan.Process(t)
+		So(err, ShouldBeNil)
+		res[i] = out
+	}
+	return res
+}
+
+func getSensorTuples() []*core.Tuple {
+	sensor := func(name string, temp int64, door string) data.Map {
+		return data.Map{"sensor": data.String(name), "temp": data.Int(temp),
+			"door": data.String(door)}
+	}
+	return makeMatchTuples(
+		sensor("s1", 20, "closed"),
+		sensor("s2", 30, "closed"),
+		sensor("s1", 22, "closed"),
+		sensor("s1", 25, "closed"),
+		sensor("s2", 29, "closed"),
+		sensor("s1", 21, "open"),
+		sensor("s2", 35, "closed"),
+		sensor("s2", 36, "open"),
+		sensor("s1", 19, "open"),
+	)
+}
+
+func TestMatchRecognizePlan(t *testing.T) {
+	Convey("Given a statement detecting a rising temperature followed by an open door", t, func() {
+		stmtFmt := `CREATE STREAM box AS SELECT RSTREAM sensor, start_temp, last_temp,
+			start_seq, end_seq FROM src [RANGE %d TUPLES]
+			MATCH_RECOGNIZE (PARTITION BY sensor
+				MEASURES A:temp AS start_temp, B:temp AS last_temp, A:seq AS start_seq,
+					seq AS end_seq
+				PATTERN (A B+ C)
+				DEFINE B AS temp > A:temp AND door = "closed", C AS door = "open")`
+
+		Convey("When the window is large enough", func() {
+			plan, err := createMatchRecognizePlan(fmt.Sprintf(stmtFmt, 10))
+			So(err, ShouldBeNil)
+			out := processAll(plan, getSensorTuples())
+
+			Convey("Then matches should be found in each partition", func() {
+				for i, o := range out {
+					switch i {
+					case 5:
+						So(o, ShouldResemble, []data.Map{{"sensor": data.String("s1"),
+							"start_temp": data.Int(20), "last_temp": data.Int(25),
+							"start_seq": data.Int(0), "end_seq": data.Int(5)}})
+					case 7:
+						So(o, ShouldResemble, []data.Map{{"sensor": data.String("s2"),
+							"start_temp": data.Int(29), "last_temp": data.Int(35),
+							"start_seq": data.Int(4), "end_seq": data.Int(7)}})
+					default:
+						So(o, ShouldBeEmpty)
+					}
+				}
+			})
+		})
+
+		Convey("When the window limits the length of a match", func() {
+			plan, err := createMatchRecognizePlan(fmt.Sprintf(stmtFmt, 5))
+			So(err, ShouldBeNil)
+			out := processAll(plan, getSensorTuples())
+
+			Convey("Then a match starting later should be found", func() {
+				So(out[5], ShouldResemble, []data.Map{{"sensor": data.String("s1"),
+					"start_temp": data.Int(22), "last_temp": data.Int(25),
+					"start_seq": data.Int(2), "end_seq": data.Int(5)}})
+				So(out[7], ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When filtering the matches", func() {
+			plan, err := createMatchRecognizePlan(`CREATE STREAM box AS SELECT RSTREAM
+				sensor, ts() AS ts FROM src [RANGE 10 SECONDS]
+				MATCH_RECOGNIZE (PARTITION BY sensor MEASURES A:temp AS start_temp
+					PATTERN (A B+ C)
+					DEFINE B AS temp > A:temp AND door = "closed", C AS door = "open")
+				WHERE start_temp > 20`)
+			So(err, ShouldBeNil)
+			out := processAll(plan, getSensorTuples())
+
+			Convey("Then only the matches satisfying the condition should be emitted", func() {
+				for i, o := range out {
+					if i == 7 {
+						So(o, ShouldResemble, []data.Map{{"sensor": data.String("s2"),
+							"ts": data.Timestamp(time.Date(2015, time.April, 10, 10, 23, 7, 0, time.UTC))}})
+					} else {
+						So(o, ShouldBeEmpty)
+					}
+				}
+			})
+		})
+	})
+
+	Convey("Given a statement with a greedy quantifier", t, func() {
+		plan, err := createMatchRecognizePlan(`CREATE STREAM box AS SELECT RSTREAM *
+			FROM src [RANGE 10 TUPLES]
+			MATCH_RECOGNIZE (MEASURES A:value AS low, B:value AS high
+				PATTERN (A B+)
+				DEFINE B AS B:value IS NULL AND value > A:value OR value > B:value)`)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var rows []data.Map
+			for _, v := range []int64{1, 2, 3, 2, 5, 6} {
+				rows = append(rows, data.Map{"value": data.Int(v)})
+			}
+			out := processAll(plan, makeMatchTuples(rows...))
+
+			Convey("Then the longest match should be emitted after it ends", func() {
+				for i, o := range out {
+					if i == 3 {
+						So(o, ShouldResemble, []data.Map{{"low": data.Int(1), "high": data.Int(3)}})
+					} else {
+						So(o, ShouldBeEmpty)
+					}
+				}
+			})
+		})
+	})
+
+	Convey("Given a statement with a pending match", t, func() {
+		plan, err := createMatchRecognizePlan(`CREATE STREAM box AS SELECT RSTREAM
+			sensor, n FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (PARTITION BY sensor MEASURES B:seq AS n
+				PATTERN (A B+) DEFINE B AS true)`)
+		So(err, ShouldBeNil)
+
+		Convey("When a tuple of another partition moves the window", func() {
+			out := processAll(plan, makeMatchTuples(
+				data.Map{"sensor": data.String("a")},
+				data.Map{"sensor": data.String("a")},
+				data.Map{"sensor": data.String("b")},
+			))
+
+			Convey("Then the pending match should be emitted", func() {
+				So(out[0], ShouldBeEmpty)
+				So(out[1], ShouldBeEmpty)
+				So(out[2], ShouldResemble, []data.Map{{"sensor": data.String("a"), "n": data.Int(1)}})
+			})
+		})
+	})
+
+	Convey("Given a statement with an alternation and a bounded quantifier", t, func() {
+		plan, err := createMatchRecognizePlan(`CREATE STREAM box AS SELECT RSTREAM s, e
+			FROM src [RANGE 20 TUPLES]
+			MATCH_RECOGNIZE (MEASURES X:seq AS s, Z:seq AS e
+				PATTERN (X Y{2,3} Z | X Z)
+				DEFINE X AS c = "x", Y AS c = "y", Z AS c = "z")`)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var rows []data.Map
+			for _, c := range "xyzxyyzxyyyyzxz" {
+				rows = append(rows, data.Map{"c": data.String(string(c))})
+			}
+			out := processAll(plan, makeMatchTuples(rows...))
+
+			Convey("Then the sequences matching the pattern should be found", func() {
+				for i, o := range out {
+					switch i {
+					case 6:
+						So(o, ShouldResemble, []data.Map{{"s": data.Int(3), "e": data.Int(6)}})
+					case 14:
+						So(o, ShouldResemble, []data.Map{{"s": data.Int(13), "e": data.Int(14)}})
+					default:
+						So(o, ShouldBeEmpty)
+					}
+				}
+			})
+		})
+	})
+
+	Convey("Given a statement with a condition not returning a bool", t, func() {
+		plan, err := createMatchRecognizePlan(`CREATE STREAM box AS SELECT RSTREAM *
+			FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (PATTERN (A) DEFINE A AS seq)`)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with a tuple", func() {
+			_, err := plan.Process(makeMatchTuples(data.Map{})[0])
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	for _, c := range []struct {
+		stmt string
+		err  string
+	}{
+		{`SELECT ISTREAM * FROM src [RANGE 2 TUPLES] MATCH_RECOGNIZE (PATTERN (A) DEFINE A AS a)`,
+			"MATCH_RECOGNIZE can only be used with RSTREAM"},
+		{`SELECT RSTREAM * FROM a [RANGE 2 TUPLES], b [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (PATTERN (A) DEFINE A AS a:x)`,
+			"MATCH_RECOGNIZE can only be used with a single input relation"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES] MATCH_RECOGNIZE (PATTERN (A) DEFINE A AS a)
+			ORDER BY a`,
+			"MATCH_RECOGNIZE cannot be used with DISTINCT, GROUP BY, HAVING, ORDER BY, LIMIT or OFFSET"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES] MATCH_RECOGNIZE (PATTERN (A) DEFINE B AS a)`,
+			"pattern variable 'B' in DEFINE doesn't appear in PATTERN"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (PATTERN (A) DEFINE A AS a, A AS b)`,
+			"pattern variable 'A' is defined more than once"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES] MATCH_RECOGNIZE (PATTERN (src) DEFINE src AS a)`,
+			"pattern variable 'src' conflicts with the input relation"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (MEASURES X:a AS a PATTERN (A) DEFINE A AS a)`,
+			"cannot refer to 'X' in MEASURES of MATCH_RECOGNIZE"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (PARTITION BY A:a PATTERN (A) DEFINE A AS a)`,
+			"cannot refer to 'A' in PARTITION BY of MATCH_RECOGNIZE"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (PARTITION BY a + 1 PATTERN (A) DEFINE A AS a)`,
+			"PARTITION BY of MATCH_RECOGNIZE only supports column names: src:a + 1"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (PARTITION BY a MEASURES A:b AS a PATTERN (A) DEFINE A AS a)`,
+			"column 'a' appears more than once in MATCH_RECOGNIZE"},
+		{`SELECT RSTREAM count(a) FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (MEASURES A:a AS a PATTERN (A) DEFINE A AS a)`,
+			"aggregate functions cannot be used with MATCH_RECOGNIZE"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES]
+			MATCH_RECOGNIZE (MEASURES count(A:a) AS a PATTERN (A) DEFINE A AS a)`,
+			"aggregates not allowed in MATCH_RECOGNIZE clause"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES] MATCH_RECOGNIZE (PATTERN (A* B?) DEFINE A AS a)`,
+			"the pattern must not match an empty sequence of rows: A* B?"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES] MATCH_RECOGNIZE (PATTERN (A{3,2}) DEFINE A AS a)`,
+			"invalid quantifier in a pattern: A{3,2}"},
+		{`SELECT RSTREAM * FROM src [RANGE 2 TUPLES] MATCH_RECOGNIZE (PATTERN (A{101}) DEFINE A AS a)`,
+			"the number of repetitions in a pattern must be at most 100: A{101}"},
+	} {
+		c := c
+		Convey(fmt.Sprintf("Given a statement %s", c.stmt), t, func() {
+			reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+			stmt, _, err := parser.New().ParseStmt(c.stmt)
+			So(err, ShouldBeNil)
+			lp, err := Analyze(stmt.(parser.SelectStmt), reg)
+			if err == nil {
+				_, err = lp.MakePhysicalPlan(reg)
+			}
+
+			Convey("Then creating a plan should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, c.err)
+			})
+		})
+	}
+}
+
+func TestCompilePattern(t *testing.T) {
+	Convey("Given a pattern with quantifiers and an alternation", t, func() {
+		p := parser.PatternAST{Elements: []parser.PatternAST{
+			{Variable: "A", Min: 1, Max: -1},
+			{Alternation: true, Elements: []parser.PatternAST{
+				{Variable: "B", Min: 1, Max: 1},
+				{Variable: "C", Min: 0, Max: 2},
+			}, Min: 1, Max: 1},
+		}, Min: 1, Max: 1}
+
+		Convey("When compiling it", func() {
+			prog, err := compilePattern(p)
+
+			Convey("Then it should be compiled to greedy instructions", func() {
+				So(err, ShouldBeNil)
+				So(prog, ShouldResemble, []patternInst{
+					{op: patternOpConsume, variable: "A"},
+					{op: patternOpSplit, x: 2, y: 4},
+					{op: patternOpConsume, variable: "A"},
+					{op: patternOpJump, x: 1},
+					{op: patternOpSplit, x: 5, y: 7},
+					{op: patternOpConsume, variable: "B"},
+					{op: patternOpJump, x: 11},
+					{op: patternOpSplit, x: 8, y: 11},
+					{op: patternOpConsume, variable: "C"},
+					{op: patternOpSplit, x: 10, y: 11},
+					{op: patternOpConsume, variable: "C"},
+					{op: patternOpMatch},
+				})
+			})
+		})
+	})
+}
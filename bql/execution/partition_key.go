@@ -0,0 +1,88 @@
+package execution
+
+import (
+	"fmt"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// PartitionKeyFunc returns a function computing the partition key of an
+// input tuple from the expressions in the GROUP BY clause of the plan.
+// Tuples having different keys never belong to the same group, so that
+// they can be processed by independent instances of the plan, e.g. in
+// parallel.
+//
+// Each instance has to be notified of tuples routed to other instances
+// through ProgressPlan.Progress so that it expires tuples and closes
+// windows at the same time as a single plan does. Then, the instances
+// emit the same rows as a single plan processing all tuples, although
+// rows of different groups can be emitted in a different order.
+//
+// It returns an error when the plan cannot be partitioned, i.e. when the
+// results of a group depend on tuples of other groups. This is the case
+// when the statement has more than one input relation, a window which isn't
+// based on time, a SESSION window, DISTINCT, ORDER BY, LIMIT, OFFSET, or
+// emitter options. RSTREAM is rejected as well because each instance would
+// only emit the groups it has instead of the whole relation. With a sliding
+// window, all expressions in the GROUP BY clause have to be selected.
+// Otherwise, ISTREAM and DSTREAM could regard a row of a group as the same
+// as a row of another group.
+func (lp *LogicalPlan) PartitionKeyFunc(reg udf.FunctionRegistry) (func(t *core.Tuple) (data.Value, error), error) {
+	if len(lp.GroupList) == 0 {
+		return nil, fmt.Errorf("partitioning requires a GROUP BY clause")
+	}
+	if len(lp.Relations) != 1 {
+		return nil, fmt.Errorf("partitioning can only be used with a single input relation")
+	}
+	rel := lp.Relations[0]
+	if rel.Unit == parser.Tuples || rel.Window == parser.SessionWindow {
+		return nil, fmt.Errorf("partitioning requires a window based on time other than SESSION")
+	}
+	if lp.EmitterType == parser.Rstream {
+		return nil, fmt.Errorf("partitioning cannot be used with RSTREAM")
+	}
+	if lp.Distinct || len(lp.Ordering) > 0 || lp.HasLimit || lp.Offset > 0 {
+		return nil, fmt.Errorf("partitioning cannot be used with DISTINCT, ORDER BY, LIMIT or OFFSET")
+	}
+	if lp.EmitterLimit >= 0 || lp.EmitterSamplingType != parser.UnspecifiedSamplingType {
+		return nil, fmt.Errorf("partitioning cannot be used with emitter options")
+	}
+	if rel.Window == parser.SlidingWindow {
+		for _, g := range lp.GroupList {
+			selected := false
+			for _, p := range lp.Projections {
+				if rv, ok := p.expr.(rowValue); ok && rv == g {
+					selected = true
+					break
+				}
+			}
+			if !selected {
+				return nil, fmt.Errorf("partitioning with a sliding window requires "+
+					"all GROUP BY columns to be selected: %v", g.Repr())
+			}
+		}
+	}
+
+	evals, err := prepareGroupList(lp.GroupList, reg)
+	if err != nil {
+		return nil, err
+	}
+	alias := rel.Alias
+	return func(t *core.Tuple) (data.Value, error) {
+		// the input has the same shape as the one in the plan
+		row := data.Map{alias: t.Data}
+		setMetadata(row, alias, t)
+		key := make(data.Array, len(evals))
+		for i, e := range evals {
+			v, err := e.Eval(row)
+			if err != nil {
+				return nil, err
+			}
+			key[i] = v
+		}
+		return key, nil
+	}, nil
+}
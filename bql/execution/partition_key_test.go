@@ -0,0 +1,95 @@
+package execution
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func analyzeSelect(s string) (*LogicalPlan, udf.FunctionRegistry, error) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	stmt, _, err := parser.New().ParseStmt(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	lp, err := Analyze(stmt.(parser.SelectStmt), reg)
+	return lp, reg, err
+}
+
+func TestPartitionKeyFunc(t *testing.T) {
+	Convey("Given a statement grouping by columns", t, func() {
+		lp, reg, err := analyzeSelect(`SELECT ISTREAM s:d, s:v, count(*) AS c
+			FROM src [RANGE 10 SECONDS] AS s GROUP BY s:d, s:v`)
+		So(err, ShouldBeNil)
+
+		Convey("When creating a partition key function", func() {
+			key, err := lp.PartitionKeyFunc(reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should compute the key from the GROUP BY clause", func() {
+				k, err := key(core.NewTuple(data.Map{"d": data.String("a"), "v": data.Int(3)}))
+				So(err, ShouldBeNil)
+				So(k, ShouldResemble, data.Array{data.String("a"), data.Int(3)})
+			})
+
+			Convey("Then it should fail when the key cannot be computed", func() {
+				_, err := key(core.NewTuple(data.Map{"d": data.String("a")}))
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a statement with a TUMBLING window", t, func() {
+		lp, reg, err := analyzeSelect(`SELECT ISTREAM d, count(*) AS c
+			FROM src [TUMBLING 10 SECONDS] GROUP BY d`)
+		So(err, ShouldBeNil)
+
+		Convey("Then creating a partition key function should succeed", func() {
+			_, err := lp.PartitionKeyFunc(reg)
+			So(err, ShouldBeNil)
+		})
+	})
+
+	for _, c := range []struct {
+		stmt string
+		err  string
+	}{
+		{`SELECT ISTREAM count(*) FROM src [RANGE 10 SECONDS]`,
+			"partitioning requires a GROUP BY clause"},
+		{`SELECT ISTREAM a:d, count(*) FROM a [RANGE 10 SECONDS], b [RANGE 10 SECONDS] GROUP BY a:d`,
+			"partitioning can only be used with a single input relation"},
+		{`SELECT ISTREAM d, count(*) FROM src [RANGE 10 TUPLES] GROUP BY d`,
+			"partitioning requires a window based on time other than SESSION"},
+		{`SELECT ISTREAM d, count(*) FROM src [SESSION GAP 10 SECONDS BY d] GROUP BY d`,
+			"partitioning requires a window based on time other than SESSION"},
+		{`SELECT RSTREAM d, count(*) FROM src [RANGE 10 SECONDS] GROUP BY d`,
+			"partitioning cannot be used with RSTREAM"},
+		{`SELECT RSTREAM d, count(*) FROM src [TUMBLING 10 SECONDS] GROUP BY d`,
+			"partitioning cannot be used with RSTREAM"},
+		{`SELECT ISTREAM d, count(*) AS c FROM src [RANGE 10 SECONDS] GROUP BY d ORDER BY c`,
+			"partitioning cannot be used with DISTINCT, ORDER BY, LIMIT or OFFSET"},
+		{`SELECT ISTREAM d, count(*) FROM src [RANGE 10 SECONDS] GROUP BY d LIMIT 3`,
+			"partitioning cannot be used with DISTINCT, ORDER BY, LIMIT or OFFSET"},
+		{`SELECT ISTREAM [LIMIT 3] d, count(*) FROM src [RANGE 10 SECONDS] GROUP BY d`,
+			"partitioning cannot be used with emitter options"},
+		{`SELECT ISTREAM d, count(*) FROM src [RANGE 10 SECONDS] GROUP BY d, v`,
+			"partitioning with a sliding window requires all GROUP BY columns to be selected: src:v"},
+	} {
+		c := c
+		Convey(fmt.Sprintf("Given a statement %s", c.stmt), t, func() {
+			lp, reg, err := analyzeSelect(c.stmt)
+			So(err, ShouldBeNil)
+
+			Convey("Then creating a partition key function should fail", func() {
+				_, err := lp.PartitionKeyFunc(reg)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, c.err)
+			})
+		})
+	}
+}
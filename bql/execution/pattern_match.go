@@ -0,0 +1,196 @@
+package execution
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// patternMatch evaluates the pattern matching operators LIKE, ILIKE,
+// SIMILAR TO, ~ and ~* as well as their negations. The left operand is
+// the string to match and the right operand is the pattern.
+type patternMatch struct {
+	binOp
+	op      parser.Operator
+	compile func(pattern string) (*regexp.Regexp, error)
+	// constant is true if the pattern is compiled when the evaluator
+	// is created. Otherwise, re holds the regular expression compiled
+	// from the pattern used in the previous evaluation.
+	constant bool
+	pattern  string
+	re       *regexp.Regexp
+}
+
+// newPatternMatch returns an Evaluator for the given pattern matching
+// operator. When the pattern is constant, it's compiled only once so
+// that an invalid pattern is reported before any data is processed.
+func newPatternMatch(bo binOp, op parser.Operator, constant bool) (Evaluator, error) {
+	p := &patternMatch{
+		binOp: bo,
+		op:    op,
+	}
+	switch op {
+	case parser.Like, parser.NotLike:
+		p.compile = func(pattern string) (*regexp.Regexp, error) {
+			return compileLikePattern(pattern, false)
+		}
+	case parser.ILike, parser.NotILike:
+		p.compile = func(pattern string) (*regexp.Regexp, error) {
+			return compileLikePattern(pattern, true)
+		}
+	case parser.SimilarTo, parser.NotSimilarTo:
+		p.compile = compileSimilarToPattern
+	case parser.RegexpMatch, parser.NotRegexpMatch:
+		p.compile = regexp.Compile
+	case parser.RegexpIMatch, parser.NotRegexpIMatch:
+		p.compile = func(pattern string) (*regexp.Regexp, error) {
+			return regexp.Compile("(?i)" + pattern)
+		}
+	default:
+		return nil, fmt.Errorf("%v is not a pattern matching operator", op)
+	}
+
+	if constant {
+		v, err := bo.right.Eval(nil)
+		if err != nil {
+			return nil, err
+		}
+		// NULL and non-string patterns are handled by Eval
+		if v.Type() == data.TypeString {
+			pattern, _ := data.AsString(v)
+			if _, err := p.regexp(pattern); err != nil {
+				return nil, err
+			}
+			p.constant = true
+		}
+	}
+	return p, nil
+}
+
+// regexp returns the regular expression compiled from the given pattern.
+func (p *patternMatch) regexp(pattern string) (*regexp.Regexp, error) {
+	if p.re != nil && (p.constant || p.pattern == pattern) {
+		return p.re, nil
+	}
+	re, err := p.compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern for %v: %v", p.op, err)
+	}
+	p.pattern = pattern
+	p.re = re
+	return re, nil
+}
+
+func (p *patternMatch) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := p.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	str, err := data.AsString(leftVal)
+	if err != nil {
+		return nil, fmt.Errorf("left operand of %v must be string: %v", p.op, leftVal)
+	}
+	pattern, err := data.AsString(rightVal)
+	if err != nil {
+		return nil, fmt.Errorf("right operand of %v must be string: %v", p.op, rightVal)
+	}
+	re, err := p.regexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+	matched := re.MatchString(str)
+	switch p.op {
+	case parser.NotLike, parser.NotILike, parser.NotSimilarTo,
+		parser.NotRegexpMatch, parser.NotRegexpIMatch:
+		return data.Bool(!matched), nil
+	}
+	return data.Bool(matched), nil
+}
+
+// compileLikePattern converts a LIKE pattern into a regular expression
+// matching the whole string. In the pattern, `%` matches any sequence of
+// characters, `_` matches any single character and a backslash escapes
+// the following character.
+func compileLikePattern(pattern string, ignoreCase bool) (*regexp.Regexp, error) {
+	b := bytes.NewBufferString("^(?s)")
+	if ignoreCase {
+		b.WriteString("(?i)")
+	}
+	escaped := false
+	for _, r := range pattern {
+		if escaped {
+			b.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+			continue
+		}
+		switch r {
+		case '\\':
+			escaped = true
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("LIKE pattern must not end with an escape character")
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
+
+// compileSimilarToPattern converts a SIMILAR TO pattern into a regular
+// expression matching the whole string. As in SQL, `%` and `_` are used
+// instead of `.*` and `.`, while `|`, `*`, `+`, `?`, `{m,n}`, parentheses
+// and bracket expressions have the same meaning as in regular
+// expressions. All other characters, including `.`, `^` and `$`, match
+// themselves, and a backslash escapes the following character.
+func compileSimilarToPattern(pattern string) (*regexp.Regexp, error) {
+	b := bytes.NewBufferString("^(?s:")
+	escaped := false
+	inBracket := false
+	for _, r := range pattern {
+		if escaped {
+			b.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+			continue
+		}
+		if inBracket {
+			// bracket expressions are passed through as they are
+			if r == ']' {
+				inBracket = false
+			}
+			b.WriteRune(r)
+			continue
+		}
+		switch r {
+		case '\\':
+			escaped = true
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		case '[':
+			inBracket = true
+			b.WriteRune(r)
+		case '|', '*', '+', '?', '{', '}', '(', ')':
+			b.WriteRune(r)
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("SIMILAR TO pattern must not end with an escape character")
+	}
+	b.WriteString(")$")
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,152 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestPatternMatchOperators(t *testing.T) {
+	reg := &testFuncRegistry{ctx: core.NewContext(nil)}
+
+	testCases := []struct {
+		op       parser.Operator
+		str      string
+		pattern  string
+		expected bool
+	}{
+		// LIKE
+		{parser.Like, "sensor error: overheat", "%error%", true},
+		{parser.Like, "sensor error: overheat", "error%", false},
+		{parser.Like, "abc", "a_c", true},
+		{parser.Like, "abbc", "a_c", false},
+		{parser.Like, "ABC", "abc", false},
+		{parser.Like, "a\nb", "a%b", true},
+		{parser.Like, "100%", `100\%`, true},
+		{parser.Like, "1000", `100\%`, false},
+		{parser.Like, "a.c", "a.c", true},
+		{parser.Like, "abc", "a.c", false},
+		{parser.Like, "(x)", "(x)", true},
+		{parser.Like, "日本語", "_本_", true},
+		{parser.NotLike, "abc", "a%", false},
+		{parser.NotLike, "abc", "b%", true},
+		// ILIKE
+		{parser.ILike, "ABC", "abc", true},
+		{parser.ILike, "Error: x", "error%", true},
+		{parser.NotILike, "ABC", "a%", false},
+		// SIMILAR TO
+		{parser.SimilarTo, "abc", "abc", true},
+		{parser.SimilarTo, "abc", "a", false},
+		{parser.SimilarTo, "abc", "%(b|d)%", true},
+		{parser.SimilarTo, "abc", "(b|c)%", false},
+		{parser.SimilarTo, "aaab", "a+b", true},
+		{parser.SimilarTo, "aab", "a{3}b", false},
+		{parser.SimilarTo, "a.c", "a.c", true},
+		{parser.SimilarTo, "abc", "a.c", false},
+		{parser.SimilarTo, "abc", "a[a-c]c", true},
+		{parser.SimilarTo, "a|c", `a\|c`, true},
+		{parser.NotSimilarTo, "abc", "%(b|d)%", false},
+		// regular expressions
+		{parser.RegexpMatch, "sensor error: overheat", "err(or)?", true},
+		{parser.RegexpMatch, "sensor error: overheat", "^err", false},
+		{parser.RegexpMatch, "ERROR", "error", false},
+		{parser.NotRegexpMatch, "ERROR", "error", true},
+		{parser.RegexpIMatch, "ERROR", "error", true},
+		{parser.NotRegexpIMatch, "ERROR", "error", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		Convey(fmt.Sprintf("Given %q %v %q", tc.str, tc.op, tc.pattern), t, func() {
+			Convey("When the pattern is constant", func() {
+				ast := parser.BinaryOpAST{Op: tc.op, Left: parser.RowValue{Column: "a"}, Right: parser.StringLiteral{Value: tc.pattern}}
+				res, err := EvaluateOnInput(ast, data.Map{"a": data.String(tc.str)}, reg)
+
+				Convey(fmt.Sprintf("Then the result should be %v", tc.expected), func() {
+					So(err, ShouldBeNil)
+					So(res, ShouldEqual, data.Bool(tc.expected))
+				})
+			})
+
+			Convey("When the pattern is taken from the input", func() {
+				ast := parser.BinaryOpAST{Op: tc.op, Left: parser.RowValue{Column: "a"}, Right: parser.RowValue{Column: "p"}}
+				res, err := EvaluateOnInput(ast, data.Map{"a": data.String(tc.str), "p": data.String(tc.pattern)}, reg)
+
+				Convey(fmt.Sprintf("Then the result should be %v", tc.expected), func() {
+					So(err, ShouldBeNil)
+					So(res, ShouldEqual, data.Bool(tc.expected))
+				})
+			})
+		})
+	}
+
+	Convey("Given a LIKE operation with a pattern taken from the input", t, func() {
+		ast := parser.BinaryOpAST{Op: parser.Like, Left: parser.RowValue{Column: "a"}, Right: parser.RowValue{Column: "p"}}
+		flatExpr, err := ParserExprToFlatExpr(ast, reg)
+		So(err, ShouldBeNil)
+		eval, err := ExpressionToEvaluator(flatExpr, reg)
+		So(err, ShouldBeNil)
+
+		Convey("When the pattern changes between evaluations", func() {
+			res1, err1 := eval.Eval(data.Map{"a": data.String("abc"), "p": data.String("a%")})
+			res2, err2 := eval.Eval(data.Map{"a": data.String("abc"), "p": data.String("b%")})
+
+			Convey("Then each evaluation should use the current pattern", func() {
+				So(err1, ShouldBeNil)
+				So(res1, ShouldEqual, data.Bool(true))
+				So(err2, ShouldBeNil)
+				So(res2, ShouldEqual, data.Bool(false))
+			})
+		})
+
+		Convey("When an operand is NULL", func() {
+			res1, err1 := eval.Eval(data.Map{"a": data.Null{}, "p": data.String("a%")})
+			res2, err2 := eval.Eval(data.Map{"a": data.String("abc"), "p": data.Null{}})
+
+			Convey("Then the result should be NULL", func() {
+				So(err1, ShouldBeNil)
+				So(res1, ShouldResemble, data.Null{})
+				So(err2, ShouldBeNil)
+				So(res2, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When an operand is not a string", func() {
+			_, err1 := eval.Eval(data.Map{"a": data.Int(1), "p": data.String("a%")})
+			_, err2 := eval.Eval(data.Map{"a": data.String("abc"), "p": data.Int(1)})
+
+			Convey("Then evaluation should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the pattern is invalid", func() {
+			_, err := eval.Eval(data.Map{"a": data.String("abc"), "p": data.String(`a\`)})
+
+			Convey("Then evaluation should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "escape character")
+			})
+		})
+	})
+
+	Convey("Given a regular expression match with an invalid constant pattern", t, func() {
+		ast := parser.BinaryOpAST{Op: parser.RegexpMatch, Left: parser.RowValue{Column: "a"},
+			Right: parser.BinaryOpAST{Op: parser.Concat, Left: parser.StringLiteral{Value: "("}, Right: parser.StringLiteral{Value: "a"}}}
+		flatExpr, err := ParserExprToFlatExpr(ast, reg)
+		So(err, ShouldBeNil)
+
+		Convey("When an evaluator is created", func() {
+			_, err := ExpressionToEvaluator(flatExpr, reg)
+
+			Convey("Then it should fail before any input is evaluated", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "invalid pattern for ~")
+			})
+		})
+	})
+}
@@ -7,9 +7,16 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"reflect"
+	"sort"
 	"time"
 )
 
+// maxPendingTuples is the maximum number of tuples held back while waiting
+// for the watermark. It prevents them from piling up when one of the inputs
+// doesn't send watermarks or stops sending tuples.
+const maxPendingTuples = 10000
+
 type inputBuffer struct {
 	tuples     *list.List
 	windowSize float64
@@ -19,6 +26,17 @@ type inputBuffer struct {
 type tupleWithDerivedInputRows struct {
 	tuple *core.Tuple
 	rows  []*inputRowWithCachedResult
+	// the following fields are only used with the JOIN ... ON syntax.
+	// keyHash is the hash of the join key and indexed is true if the
+	// tuple was added to the hash index with that key.
+	keyHash data.HashValue
+	indexed bool
+	// partners holds the tuples of the other relation that satisfy
+	// the ON clause together with this tuple.
+	partners []*tupleWithDerivedInputRows
+	// nullRow is the row joining this tuple with NULL in an outer
+	// join when there is no matching tuple.
+	nullRow *inputRowWithCachedResult
 }
 
 func (i *inputBuffer) isTimeBased() bool {
@@ -32,6 +50,14 @@ type inputRowWithCachedResult struct {
 	input *data.Map
 	cache data.Value
 	hash  data.HashValue
+	// aggregated is true if the row has been added to the accumulators
+	// of incrementally computed aggregates and aggValues holds the values
+	// that were added (or nil for values that could not be added).
+	aggregated bool
+	aggValues  []data.Value
+	// order holds the cached values of the ORDER BY clause
+	// when cache holds the projected row.
+	order data.Array
 }
 
 // resultRow holds data for a tuple to be emitted (sooner or later)
@@ -41,6 +67,9 @@ type inputRowWithCachedResult struct {
 type resultRow struct {
 	row  data.Map
 	hash data.HashValue
+	// order holds the values of the expressions in the ORDER BY
+	// clause, or nil if there is no ORDER BY clause.
+	order data.Array
 }
 
 // resultRowCount stores a count for a particular data item. This is
@@ -51,6 +80,22 @@ type resultRowCount struct {
 	count int
 }
 
+// session holds the input rows of an open SESSION window for
+// a particular session key.
+type session struct {
+	key  data.Value
+	rows *list.List
+	// lastTimestamp is the timestamp of the last tuple that
+	// was added to this session
+	lastTimestamp time.Time
+	// elem is the position of the session in the list of
+	// sessions ordered by lastTimestamp
+	elem *list.Element
+	// tuples holds the input tuples of the session including
+	// the ones that were filtered out
+	tuples []*core.Tuple
+}
+
 // partialList is a data structure representing a continuous sublist
 // of a linked list. An iteration must start at the `start` item
 // and must go on until `end` is reached (not including `end`!)
@@ -70,6 +115,13 @@ type partialList struct {
 // - perform a SELECT query on that data,
 // - compute the data that need to be emitted by comparison with
 //   the previous run's results.
+//
+// For TUMBLING and HOPPING windows, the SELECT query is only performed
+// when a window is closed, i.e., when enough tuples have arrived for
+// a tuple-based window or when the first tuple with a timestamp after
+// the end of a time-based window arrives. Results of a closed window
+// aren't compared with the previous window's results, so all of them
+// are emitted regardless of the emitter type.
 type streamRelationStreamExecutionPlan struct {
 	commonExecutionPlan
 	// store name->alias mapping
@@ -100,10 +152,65 @@ type streamRelationStreamExecutionPlan struct {
 	// filteredInputRows holds data that serves as the input for
 	// the relation-to-relation operation
 	filteredInputRowsBuffer *list.List
+	// retractedRows holds the rows that have been removed from
+	// `filteredInputRows` since the last query although they were
+	// added to the accumulators of incrementally computed aggregates.
+	retractedRows []*inputRowWithCachedResult
 	// lastTupleBuffers stores the names of the input buffers that
 	// the last tuple was appended to. this is valid after
 	// `addTupleToBuffer` has returned.
 	lastTupleBuffers map[string]bool
+	// window holds the window specification that is shared by all
+	// input relations. It is only used for TUMBLING and HOPPING windows.
+	window parser.WindowSpecAST
+	// numTuples counts the tuples that arrived so far. It is used to
+	// decide when a tuple-based TUMBLING or HOPPING window is closed.
+	numTuples int64
+	// nextWindowEnd holds the end of the oldest time-based TUMBLING or
+	// HOPPING window that has not been closed yet. It is the zero
+	// time until the first tuple has arrived.
+	nextWindowEnd time.Time
+	// sessionKey evaluates the BY clause of a SESSION window.
+	sessionKey Evaluator
+	// sessions holds the open sessions of a SESSION window, keyed
+	// by the hash of the session key.
+	sessions map[data.HashValue][]*session
+	// sessionsByTime holds the open sessions ordered by the timestamp
+	// of their last tuple, oldest first.
+	sessionsByTime *list.List
+	// watermarks holds the largest watermark received so far for
+	// each input name.
+	watermarks map[string]time.Time
+	// watermark is the smallest of the watermarks of all inputs, or zero
+	// if any input has not sent a watermark yet. Tuples
+	// having a timestamp before it are processed (in the order of their
+	// timestamps) and tuples arriving later than that are rejected.
+	watermark time.Time
+	// pending holds tuples that arrived with a watermark but whose
+	// timestamp has not been passed by the watermark yet, ordered
+	// by their timestamps. Its elements are *pendingTuple.
+	pending *list.List
+	// maxPending is the maximum number of tuples in pending. When
+	// it's exceeded, the watermark is advanced to the timestamp of
+	// the oldest pending tuple so that the tuple can be processed.
+	maxPending int
+	// join holds the hash index of a FROM clause using the JOIN ... ON
+	// syntax. It is nil for a comma-separated list of relations.
+	join *hashJoin
+	// table holds the shared state joined with the input relation
+	// by JOIN TABLE. It is nil if there is no JOIN TABLE clause.
+	table *tableJoin
+	// distinct is true if duplicate results of a query are removed
+	// by SELECT DISTINCT.
+	distinct bool
+	// ascending holds the sort direction of each expression in the
+	// ORDER BY clause.
+	ascending []bool
+	// limit is the maximum number of results of a query, or -1 if
+	// there is no LIMIT clause, and offset is the number of results
+	// skipped before that.
+	limit  int64
+	offset int64
 }
 
 func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (*streamRelationStreamExecutionPlan, error) {
@@ -125,6 +232,53 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 	// for compatibility with the old syntax, take the last RANGE
 	// specification as valid for all buffers
 
+	// TUMBLING and HOPPING windows are the same for all relations
+	// (this is checked in validateReferences)
+	var window parser.WindowSpecAST
+	if len(lp.Relations) > 0 {
+		window = lp.Relations[0].WindowSpecAST
+	}
+	// SESSION windows can only be used with a single relation
+	// (this is also checked in validateReferences)
+	var sessionKey Evaluator
+	if window.Window == parser.SessionWindow {
+		rel := lp.Relations[0]
+		key, err := ParserExprToFlatExpr(window.Key.RenameReferencedRelation("", rel.Alias), reg)
+		if err != nil {
+			return nil, err
+		}
+		sessionKey, err = ExpressionToEvaluator(key, reg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// JOIN TABLE is always used with one relation and JOIN ... ON is
+	// always used with two relations (this is ensured by the parser)
+	var join *hashJoin
+	var table *tableJoin
+	if lp.Join.Table.Name != "" {
+		table, err = newTableJoin(lp.Join, lp.Relations[0].Alias, reg)
+		if err != nil {
+			return nil, err
+		}
+	} else if lp.Join.Type != parser.CrossJoin {
+		join, err = newHashJoin(lp.Join, lp.Relations[0].Alias, lp.Relations[1].Alias, reg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// ASC is the default sort direction
+	var ascending []bool
+	for _, order := range lp.Ordering {
+		ascending = append(ascending, order.Ascending != parser.No)
+	}
+	limit := int64(-1)
+	if lp.HasLimit {
+		limit = lp.Limit
+	}
+
 	// initialize buffers (one per declared input relation)
 	buffers := make(map[string]*inputBuffer, len(lp.Relations))
 	for _, rel := range lp.Relations {
@@ -141,6 +295,7 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 		commonExecutionPlan: commonExecutionPlan{
 			projections: projs,
 			groupList:   groupList,
+			analytics:   collectAnalytics(projs),
 			filter:      filter,
 		},
 		relations:            lp.Relations,
@@ -150,6 +305,19 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 		prevResults:          []resultRow{},
 		prevHashesForIstream: map[data.HashValue][]resultRowCount{},
 		filteredInputRows:    list.New(),
+		window:               window,
+		sessionKey:           sessionKey,
+		sessions:             map[data.HashValue][]*session{},
+		sessionsByTime:       list.New(),
+		watermarks:           map[string]time.Time{},
+		pending:              list.New(),
+		maxPending:           maxPendingTuples,
+		join:                 join,
+		table:                table,
+		distinct:             lp.Distinct,
+		ascending:            ascending,
+		limit:                limit,
+		offset:               lp.Offset,
 	}, nil
 }
 
@@ -218,7 +386,7 @@ func (ep *streamRelationStreamExecutionPlan) addTupleToBuffer(t *core.Tuple) err
 // specification.
 func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curTupTime time.Time) error {
 	expiredInputRows := map[*inputRowWithCachedResult]bool{}
-	for _, buffer := range ep.buffers {
+	for alias, buffer := range ep.buffers {
 		curBufSize := int64(buffer.tuples.Len())
 		if buffer.windowType == parser.Tuples { // tuple-based window
 			windowSizeInt := int64(buffer.windowSize)
@@ -238,6 +406,11 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 						expiredInputRows[inputRow] = true
 					}
 					buffer.tuples.Remove(e)
+					if ep.join != nil {
+						if err := ep.removeFromJoin(alias, tupCont); err != nil {
+							return err
+						}
+					}
 				}
 			}
 
@@ -260,23 +433,134 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 						expiredInputRows[inputRow] = true
 					}
 					buffer.tuples.Remove(e)
+					if ep.join != nil {
+						if err := ep.removeFromJoin(alias, tupCont); err != nil {
+							return err
+						}
+					}
 				}
 			}
 		} else {
 			return fmt.Errorf("unknown window type: %+v", *buffer)
 		}
 	}
-	// now delete all rows marked for deletion
+	ep.removeExpiredInputRows(expiredInputRows)
+	return nil
+}
+
+// removeTuplesBefore removes tuples from the buffer that have a timestamp
+// before the given time. Other than removeOutdatedTuplesFromBuffer, this
+// is used for time-based TUMBLING and HOPPING windows, where the window
+// boundaries do not depend on the timestamp of the current tuple.
+func (ep *streamRelationStreamExecutionPlan) removeTuplesBefore(start time.Time) error {
+	expiredInputRows := map[*inputRowWithCachedResult]bool{}
+	for alias, buffer := range ep.buffers {
+		var next *list.Element
+		for e := buffer.tuples.Front(); e != nil; e = next {
+			next = e.Next()
+			tupCont := e.Value.(*tupleWithDerivedInputRows)
+			if tupCont.tuple.Timestamp.Before(start) {
+				// mark input rows that are derived from outdated
+				// tuples for deletion
+				for _, inputRow := range tupCont.rows {
+					expiredInputRows[inputRow] = true
+				}
+				buffer.tuples.Remove(e)
+				if ep.join != nil {
+					if err := ep.removeFromJoin(alias, tupCont); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	ep.removeExpiredInputRows(expiredInputRows)
+	return nil
+}
+
+// removeExpiredInputRows deletes all rows that are marked for deletion
+// from `ep.filteredInputRows`.
+func (ep *streamRelationStreamExecutionPlan) removeExpiredInputRows(expiredInputRows map[*inputRowWithCachedResult]bool) {
+	if len(expiredInputRows) == 0 {
+		return
+	}
 	var next *list.Element
 	for e := ep.filteredInputRows.Front(); e != nil; e = next {
 		next = e.Next()
 		itemPtr := e.Value.(*inputRowWithCachedResult)
 		if toDelete := expiredInputRows[itemPtr]; toDelete {
 			ep.filteredInputRows.Remove(e)
+			if itemPtr.aggregated {
+				ep.retractedRows = append(ep.retractedRows, itemPtr)
+			}
 		}
 	}
+}
 
-	return nil
+// hasTuplesBefore returns true if any buffer contains a tuple with
+// a timestamp before the given time.
+func (ep *streamRelationStreamExecutionPlan) hasTuplesBefore(end time.Time) bool {
+	for _, buffer := range ep.buffers {
+		for e := buffer.tuples.Front(); e != nil; e = e.Next() {
+			tupCont := e.Value.(*tupleWithDerivedInputRows)
+			if tupCont.tuple.Timestamp.Before(end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// oldestTimestamp returns the smallest timestamp of all tuples in the
+// buffers. The second return value is false if all buffers are empty.
+func (ep *streamRelationStreamExecutionPlan) oldestTimestamp() (time.Time, bool) {
+	var oldest time.Time
+	found := false
+	for _, buffer := range ep.buffers {
+		if e := buffer.tuples.Front(); e != nil {
+			ts := e.Value.(*tupleWithDerivedInputRows).tuple.Timestamp
+			if !found || ts.Before(oldest) {
+				oldest = ts
+				found = true
+			}
+		}
+	}
+	return oldest, found
+}
+
+// windowSizeAndSlide returns the size of a TUMBLING or HOPPING window
+// and the distance between the starts of two consecutive windows. The
+// values are nanoseconds for a time-based window and numbers of tuples
+// for a tuple-based window.
+func (ep *streamRelationStreamExecutionPlan) windowSizeAndSlide() (int64, int64) {
+	toInt := func(i parser.IntervalAST) int64 {
+		switch i.Unit {
+		case parser.Seconds:
+			return int64(i.Value * float64(time.Second))
+		case parser.Milliseconds:
+			return int64(i.Value * float64(time.Millisecond))
+		}
+		return int64(i.Value)
+	}
+	size := toInt(ep.window.IntervalAST)
+	if ep.window.Window == parser.HoppingWindow {
+		return size, toInt(ep.window.Slide)
+	}
+	return size, size
+}
+
+// firstWindowEnd returns the end of the oldest time-based window that
+// contains the given time. Windows are aligned so that all windows
+// start at a multiple of the slide width (counted from the Unix epoch).
+func (ep *streamRelationStreamExecutionPlan) firstWindowEnd(t time.Time) time.Time {
+	size, slide := ep.windowSizeAndSlide()
+	// find the smallest k so that k*slide + size > t
+	d := t.UnixNano() - size
+	k := d / slide
+	if d < 0 && d%slide != 0 {
+		k-- // round towards negative infinity
+	}
+	return time.Unix(0, (k+1)*slide+size).In(time.UTC)
 }
 
 // previousMultiplicity returns how often the given map was emitted
@@ -403,6 +687,99 @@ func (ep *streamRelationStreamExecutionPlan) computeResultTuples() ([]data.Map,
 	return nil, fmt.Errorf("emitter type '%s' not implemented", ep.emitterType)
 }
 
+// computeClosedWindowResultTuples is the equivalent of computeResultTuples
+// for a closed TUMBLING or HOPPING window or a closed session. Because each
+// of them is evaluated exactly once, its results are not compared with the
+// ones of another window or session, and all of them are emitted once
+// regardless of the emitter type.
+func (ep *streamRelationStreamExecutionPlan) computeClosedWindowResultTuples() []data.Map {
+	// results of the next window must not be compared with this window
+	if len(ep.prevHashesForIstream) > 0 {
+		ep.prevHashesForIstream = map[data.HashValue][]resultRowCount{}
+	}
+	output := make([]data.Map, 0, len(ep.curResults))
+	for _, res := range ep.curResults {
+		output = append(output, res.row)
+	}
+	return output
+}
+
+// orderResults returns a function that calls performQueryOnBuffer and
+// then applies the ORDER BY, OFFSET and LIMIT clauses to ep.curResults.
+// The relation-to-stream operation is performed on the remaining results,
+// so that, e.g., ISTREAM emits the rows that newly entered the top N.
+func (ep *streamRelationStreamExecutionPlan) orderResults(performQueryOnBuffer func() error) func() error {
+	return func() error {
+		if err := performQueryOnBuffer(); err != nil {
+			return err
+		}
+		results := ep.curResults
+		if len(ep.ascending) > 0 {
+			// sort the results in the same way as the input of an
+			// aggregate function with ORDER BY
+			sortData := make([]sortArray, len(ep.ascending))
+			for i, asc := range ep.ascending {
+				values := make(data.Array, len(results))
+				for j, res := range results {
+					values[j] = res.order[i]
+				}
+				sortData[i] = sortArray{values, asc}
+			}
+			indexes := make([]int, len(results))
+			for i := range indexes {
+				indexes[i] = i
+			}
+			sort.Stable(&indexSlice{indexes, sortData})
+			sorted := make([]resultRow, len(results))
+			for i, idx := range indexes {
+				sorted[i] = results[idx]
+			}
+			copy(results, sorted)
+		}
+		if ep.offset >= int64(len(results)) {
+			results = results[:0]
+		} else {
+			results = results[ep.offset:]
+		}
+		if ep.limit >= 0 && ep.limit < int64(len(results)) {
+			results = results[:ep.limit]
+		}
+		ep.curResults = results
+		return nil
+	}
+}
+
+// removeDuplicateResults wraps performQueryOnBuffer so that only the
+// first one of equal results remains in ep.curResults, as required by
+// SELECT DISTINCT.
+func (ep *streamRelationStreamExecutionPlan) removeDuplicateResults(performQueryOnBuffer func() error) func() error {
+	return func() error {
+		if err := performQueryOnBuffer(); err != nil {
+			return err
+		}
+		// ep.curResults is not shared with ep.prevResults, so
+		// it can be filtered in place
+		results := ep.curResults[:0]
+		seen := map[data.HashValue][]data.Map{}
+		for _, res := range ep.curResults {
+			duplicate := false
+			for _, row := range seen[res.hash] {
+				if data.Equal(row, res.row) {
+					duplicate = true
+					break
+				}
+			}
+			if duplicate {
+				continue
+			}
+			seen[res.hash] = append(seen[res.hash], res.row)
+			results = append(results, res)
+		}
+		ep.curResults = results
+		return nil
+	}
+}
+
 // Process takes an input tuple, a function that represents the "subclassing"
 // plan's core functionality and returns a slice of Map values that correspond
 // to the results of the query represented by this execution plan. Note that the
@@ -410,6 +787,217 @@ func (ep *streamRelationStreamExecutionPlan) computeResultTuples() ([]data.Map,
 func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
 	ep.now = time.Now().In(time.UTC)
 
+	if ep.distinct {
+		performQueryOnBuffer = ep.removeDuplicateResults(performQueryOnBuffer)
+	}
+	if len(ep.ascending) > 0 || ep.limit >= 0 || ep.offset > 0 {
+		performQueryOnBuffer = ep.orderResults(performQueryOnBuffer)
+	}
+
+	if input.Watermark.IsZero() && len(ep.watermarks) == 0 {
+		return ep.processTuple(input, performQueryOnBuffer)
+	}
+	return ep.processWithWatermark(input, false, performQueryOnBuffer)
+}
+
+// progress is the equivalent of process for a tuple processed by another
+// instance of the same plan (see ProgressPlan). The tuple isn't added to
+// the plan, but it advances the time of the plan in the same way as the
+// tuple would do in process.
+func (ep *streamRelationStreamExecutionPlan) progress(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	ep.now = time.Now().In(time.UTC)
+
+	// DISTINCT, ORDER BY, LIMIT, and OFFSET aren't supported because
+	// LogicalPlan.PartitionKeyFunc rejects them.
+	if input.Watermark.IsZero() && len(ep.watermarks) == 0 {
+		return ep.progressTuple(input, performQueryOnBuffer)
+	}
+	return ep.processWithWatermark(input, true, performQueryOnBuffer)
+}
+
+// Watermark returns the smallest of the watermarks of all inputs. It
+// returns the zero time until all inputs have sent a watermark unless
+// too many tuples are held back (see processWithWatermark).
+func (ep *streamRelationStreamExecutionPlan) Watermark() time.Time {
+	return ep.watermark
+}
+
+// RetainedTuples returns the tuples in the windows and in open sessions
+// ordered by their timestamps, followed by the tuples waiting for the
+// watermark. Watermarks of the tuples that have already been processed
+// are cleared because the watermark could have passed them in a different
+// order. Therefore, the plan processing the tuples again doesn't reject
+// tuples that arrive after its previous watermark.
+func (ep *streamRelationStreamExecutionPlan) RetainedTuples() []*core.Tuple {
+	var ts []*core.Tuple
+	add := func(t *core.Tuple, d data.Map) {
+		c := t.ShallowCopy()
+		c.Data = d
+		c.Watermark = time.Time{}
+		ts = append(ts, c)
+	}
+
+	// a tuple is in more than one buffer on self-join, but all of
+	// them share the same Data
+	seen := map[uintptr]bool{}
+	for _, rel := range ep.relations {
+		for e := ep.buffers[rel.Alias].tuples.Front(); e != nil; e = e.Next() {
+			t := e.Value.(*tupleWithDerivedInputRows).tuple
+			d, ok := t.Data[rel.Alias].(data.Map)
+			if !ok {
+				continue
+			}
+			p := reflect.ValueOf(d).Pointer()
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+			add(t, d)
+		}
+	}
+	for e := ep.sessionsByTime.Front(); e != nil; e = e.Next() {
+		for _, t := range e.Value.(*session).tuples {
+			add(t, t.Data)
+		}
+	}
+	sort.Stable(tuplesByTimestamp(ts))
+
+	for e := ep.pending.Front(); e != nil; e = e.Next() {
+		if p := e.Value.(*pendingTuple); !p.progress {
+			ts = append(ts, p.t.ShallowCopy())
+		}
+	}
+	return ts
+}
+
+// pendingTuple is a tuple waiting for the watermark. When progress is
+// true, the tuple was passed to Progress and only advances the time of
+// the plan when the watermark has passed it.
+type pendingTuple struct {
+	t        *core.Tuple
+	progress bool
+}
+
+type tuplesByTimestamp []*core.Tuple
+
+func (t tuplesByTimestamp) Len() int           { return len(t) }
+func (t tuplesByTimestamp) Less(i, j int) bool { return t[i].Timestamp.Before(t[j].Timestamp) }
+func (t tuplesByTimestamp) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
+// processWithWatermark buffers the input tuple until the watermark has
+// passed its timestamp. Then, all buffered tuples are processed in the
+// order of their timestamps, and windows that end before the watermark
+// are closed without waiting for a tuple from the next window. When
+// progress is true, the tuple only advances the time of the plan (see
+// progressTuple) and a late tuple is ignored.
+//
+// The watermark is held until all inputs have sent a watermark. When an
+// input doesn't send watermarks at all or doesn't send tuples for a long
+// time, the number of tuples held back is bounded by maxPending. Once it's
+// exceeded, the watermark is forcibly advanced to the timestamp of the
+// oldest tuple held back. Tuples having a timestamp before the advanced
+// watermark are rejected with core.LateTupleError and reported as late
+// tuples.
+func (ep *streamRelationStreamExecutionPlan) processWithWatermark(input *core.Tuple, progress bool, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if input.Timestamp.Before(ep.watermark) {
+		if progress {
+			// the instance processing the tuple rejects it
+			return nil, nil
+		}
+		return nil, core.LateTupleError(fmt.Errorf("tuple with timestamp %v "+
+			"arrived after the watermark %v", input.Timestamp, ep.watermark))
+	}
+
+	// update the watermark. it is held until all inputs have sent a
+	// watermark because tuples from an input that has not sent any
+	// watermark yet can still arrive with any timestamp.
+	if input.Watermark.After(ep.watermarks[input.InputName]) {
+		ep.watermarks[input.InputName] = input.Watermark
+	}
+	var watermark time.Time
+	for i := range ep.relations {
+		wm, ok := ep.watermarks[ep.relationKey(&ep.relations[i])]
+		if !ok {
+			watermark = time.Time{}
+			break
+		}
+		if watermark.IsZero() || wm.Before(watermark) {
+			watermark = wm
+		}
+	}
+	if watermark.After(ep.watermark) {
+		ep.watermark = watermark
+	}
+
+	// insert the tuple into the list of pending tuples, which
+	// is ordered by timestamp. because the tuple is kept after
+	// this method returns, ShallowCopy is required here.
+	t := &pendingTuple{
+		t:        input.ShallowCopy(),
+		progress: progress,
+	}
+	e := ep.pending.Back()
+	for ; e != nil; e = e.Prev() {
+		if !t.t.Timestamp.Before(e.Value.(*pendingTuple).t.Timestamp) {
+			break
+		}
+	}
+	if e == nil {
+		ep.pending.PushFront(t)
+	} else {
+		ep.pending.InsertAfter(t, e)
+	}
+
+	// process all tuples that the watermark has passed
+	var output []data.Map
+	for e := ep.pending.Front(); e != nil; e = ep.pending.Front() {
+		t := e.Value.(*pendingTuple)
+		if !t.t.Timestamp.Before(ep.watermark) {
+			if ep.pending.Len() <= ep.maxPending {
+				break
+			}
+			ep.watermark = t.t.Timestamp
+		}
+		ep.pending.Remove(e)
+		var res []data.Map
+		var err error
+		if t.progress {
+			res, err = ep.progressTuple(t.t, performQueryOnBuffer)
+		} else {
+			res, err = ep.processTuple(t.t, performQueryOnBuffer)
+		}
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+	}
+
+	// close all windows that cannot receive any more tuples
+	var res []data.Map
+	var err error
+	if ep.window.Window == parser.SessionWindow {
+		res, err = ep.closeSessions(ep.watermark, performQueryOnBuffer)
+	} else if ep.window.Window != parser.SlidingWindow && ep.window.Unit != parser.Tuples &&
+		!ep.nextWindowEnd.IsZero() {
+		res, err = ep.closeTimeWindows(ep.watermark, performQueryOnBuffer)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(output, res...), nil
+}
+
+// processTuple processes a single tuple in the order of arrival.
+func (ep *streamRelationStreamExecutionPlan) processTuple(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.window.Window == parser.SessionWindow {
+		return ep.processSessionWindow(input, performQueryOnBuffer)
+	} else if ep.window.Window != parser.SlidingWindow {
+		if ep.window.Unit == parser.Tuples {
+			return ep.processTupleWindow(input, performQueryOnBuffer)
+		}
+		return ep.processTimeWindow(input, performQueryOnBuffer)
+	}
+
 	// stream-to-relation:
 	// updates the internal buffer with correct window data
 	if err := ep.addTupleToBuffer(input); err != nil {
@@ -434,7 +1022,253 @@ func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQ
 	return ep.computeResultTuples()
 }
 
+// progressTuple is the equivalent of processTuple for a tuple passed to
+// progress. Tuples which have left a sliding window by the timestamp of
+// the tuple are removed and the results are emitted according to the
+// emitter. Time-based TUMBLING and HOPPING windows that end at or before
+// the timestamp are closed.
+func (ep *streamRelationStreamExecutionPlan) progressTuple(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.window.Window == parser.SessionWindow || ep.window.Unit == parser.Tuples {
+		return nil, fmt.Errorf("progress cannot be used with a SESSION window or a window based on the number of tuples")
+	} else if ep.window.Window != parser.SlidingWindow {
+		if ep.nextWindowEnd.IsZero() {
+			return nil, nil
+		}
+		return ep.closeTimeWindows(input.Timestamp, performQueryOnBuffer)
+	}
+
+	if err := ep.removeOutdatedTuplesFromBuffer(input.Timestamp); err != nil {
+		return nil, err
+	}
+	if err := performQueryOnBuffer(); err != nil {
+		return nil, err
+	}
+	return ep.computeResultTuples()
+}
+
+// processTupleWindow is the equivalent of process for tuple-based
+// TUMBLING and HOPPING windows. The buffer is updated with every tuple,
+// but the query is only performed and results are only emitted when
+// a window is closed.
+func (ep *streamRelationStreamExecutionPlan) processTupleWindow(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	// stream-to-relation:
+	// updates the internal buffer with correct window data
+	if err := ep.addTupleToBuffer(input); err != nil {
+		return nil, err
+	}
+	if err := ep.removeOutdatedTuplesFromBuffer(input.Timestamp); err != nil {
+		return nil, err
+	}
+	if err := ep.filterInputTuples(); err != nil {
+		return nil, err
+	}
+
+	// the window is closed after every `slide` tuples
+	ep.numTuples++
+	_, slide := ep.windowSizeAndSlide()
+	if ep.numTuples%slide != 0 {
+		return nil, nil
+	}
+
+	// relation-to-relation and relation-to-stream:
+	// perform the query on the closed window and emit the results
+	if err := performQueryOnBuffer(); err != nil {
+		return nil, err
+	}
+	return ep.computeClosedWindowResultTuples(), nil
+}
+
+// processTimeWindow is the equivalent of process for time-based
+// TUMBLING and HOPPING windows. When a tuple arrives, all windows
+// that end at or before the tuple's timestamp are closed (in order)
+// and their results are emitted. Each window is therefore evaluated
+// exactly once. Windows that do not contain any tuple are skipped.
+//
+// Note that this method assumes that tuples arrive in the order of
+// their timestamps, which is guaranteed when the input has watermarks.
+func (ep *streamRelationStreamExecutionPlan) processTimeWindow(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	// the new tuple is appended to the buffer first, but it will
+	// only appear in the input of the query after filterInputTuples
+	// has been called, so it will not be visible when closing
+	// the windows that end before its timestamp
+	if err := ep.addTupleToBuffer(input); err != nil {
+		return nil, err
+	}
+	if ep.nextWindowEnd.IsZero() {
+		ep.nextWindowEnd = ep.firstWindowEnd(input.Timestamp)
+	}
+	output, err := ep.closeTimeWindows(input.Timestamp, performQueryOnBuffer)
+	if err != nil {
+		return nil, err
+	}
+	if err := ep.filterInputTuples(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// closeTimeWindows closes all time-based windows that end at or before
+// the given time (in order) and returns their results. Windows that do
+// not contain any tuple are skipped.
+func (ep *streamRelationStreamExecutionPlan) closeTimeWindows(until time.Time, performQueryOnBuffer func() error) ([]data.Map, error) {
+	size, slide := ep.windowSizeAndSlide()
+
+	var output []data.Map
+	for !until.Before(ep.nextWindowEnd) {
+		if err := ep.removeTuplesBefore(ep.nextWindowEnd.Add(-time.Duration(size))); err != nil {
+			return nil, err
+		}
+		if !ep.hasTuplesBefore(ep.nextWindowEnd) {
+			// all remaining windows until the one containing the
+			// oldest tuple in the buffer are empty
+			oldest, ok := ep.oldestTimestamp()
+			if !ok {
+				// the buffer is empty, so the next window will be
+				// determined by the next tuple
+				ep.nextWindowEnd = time.Time{}
+				return output, nil
+			}
+			ep.nextWindowEnd = ep.firstWindowEnd(oldest)
+			continue
+		}
+
+		// relation-to-relation and relation-to-stream:
+		// perform the query on the closed window and emit the results
+		if err := performQueryOnBuffer(); err != nil {
+			return nil, err
+		}
+		output = append(output, ep.computeClosedWindowResultTuples()...)
+		ep.nextWindowEnd = ep.nextWindowEnd.Add(time.Duration(slide))
+	}
+
+	// tuples that are older than the oldest open window are not
+	// required anymore
+	if err := ep.removeTuplesBefore(ep.nextWindowEnd.Add(-time.Duration(size))); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// processSessionWindow is the equivalent of process for SESSION windows.
+// Tuples are assigned to a session by the value of the BY expression.
+// When a tuple arrives, all sessions that did not receive a tuple within
+// the gap before the new tuple's timestamp are closed (oldest first) and
+// their results are emitted. Each session is therefore evaluated exactly
+// once.
+//
+// Note that this method assumes that tuples arrive in the order of
+// their timestamps, which is guaranteed when the input has watermarks.
+func (ep *streamRelationStreamExecutionPlan) processSessionWindow(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	rel := ep.relations[0]
+	if input.InputName != ep.relationKey(&rel) {
+		return nil, fmt.Errorf("tuple has input name '%s' set, but we "+
+			"can only deal with %v", input.InputName, []string{rel.Name})
+	}
+
+	// compute the key of the session the tuple belongs to
+	keyInput := data.Map{rel.Alias: input.Data}
+	setMetadata(keyInput, rel.Alias, input)
+	key, err := ep.sessionKey.Eval(keyInput)
+	if err != nil {
+		return nil, err
+	}
+	keyHash := data.Hash(key)
+
+	// close all sessions that are older than the gap
+	output, err := ep.closeSessions(input.Timestamp, performQueryOnBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	// stream-to-relation:
+	// filter the new tuple and add the result to its session
+	ep.filteredInputRows = list.New()
+	if err := ep.addTupleToBuffer(input); err != nil {
+		return nil, err
+	}
+	err = ep.filterInputTuples()
+	// the input rows are stored in the session, so the buffer
+	// is not required anymore
+	ep.buffers[rel.Alias].tuples.Init()
+	if err != nil {
+		return nil, err
+	}
+	s := ep.findOrCreateSession(key, keyHash)
+	s.rows.PushBackList(ep.filteredInputRows)
+	s.tuples = append(s.tuples, input.ShallowCopy())
+	s.lastTimestamp = input.Timestamp
+	ep.sessionsByTime.MoveToBack(s.elem)
+	return output, nil
+}
+
+// closeSessions closes all sessions whose last tuple is older than the
+// given time by more than the gap (oldest first) and returns their results.
+func (ep *streamRelationStreamExecutionPlan) closeSessions(until time.Time, performQueryOnBuffer func() error) ([]data.Map, error) {
+	var output []data.Map
+	gap := time.Duration(ep.window.Value * float64(time.Second))
+	if ep.window.Unit == parser.Milliseconds {
+		gap = time.Duration(ep.window.Value * float64(time.Millisecond))
+	}
+	for e := ep.sessionsByTime.Front(); e != nil; e = ep.sessionsByTime.Front() {
+		s := e.Value.(*session)
+		if until.Sub(s.lastTimestamp) <= gap {
+			break
+		}
+
+		// relation-to-relation and relation-to-stream:
+		// perform the query on the closed session and emit the results
+		ep.filteredInputRows = s.rows
+		if err := performQueryOnBuffer(); err != nil {
+			return nil, err
+		}
+		output = append(output, ep.computeClosedWindowResultTuples()...)
+		ep.removeSession(s, data.Hash(s.key))
+	}
+	return output, nil
+}
+
+// findOrCreateSession returns the open session for the given key. If
+// there is no such session, a new one is created.
+func (ep *streamRelationStreamExecutionPlan) findOrCreateSession(key data.Value, keyHash data.HashValue) *session {
+	for _, s := range ep.sessions[keyHash] {
+		if data.Equal(key, s.key) {
+			return s
+		}
+	}
+	s := &session{
+		key:  key,
+		rows: list.New(),
+	}
+	s.elem = ep.sessionsByTime.PushBack(s)
+	ep.sessions[keyHash] = append(ep.sessions[keyHash], s)
+	return s
+}
+
+// removeSession removes a closed session.
+func (ep *streamRelationStreamExecutionPlan) removeSession(s *session, keyHash data.HashValue) {
+	ep.sessionsByTime.Remove(s.elem)
+	candidates := ep.sessions[keyHash]
+	for i, c := range candidates {
+		if c == s {
+			candidates = append(candidates[:i], candidates[i+1:]...)
+			break
+		}
+	}
+	if len(candidates) == 0 {
+		delete(ep.sessions, keyHash)
+	} else {
+		ep.sessions[keyHash] = candidates
+	}
+}
+
 func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
+	if ep.join != nil {
+		return ep.joinInputTuples()
+	}
+	if ep.table != nil {
+		return ep.lookupInputTuples()
+	}
+
 	// we need to make a cross product of the data in all buffers,
 	// combine it to get an input like
 	//  {"streamA": {data}, "streamB": {data}, "streamC": {data}}
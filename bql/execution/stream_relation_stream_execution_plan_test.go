@@ -15,14 +15,17 @@ func TestMultiplicityHandling(t *testing.T) {
 			a := resultRow{
 				data.Map{"a": data.Int(5)},
 				data.HashValue(17),
+				nil,
 			}
 			b := resultRow{
 				data.Map{"a": data.Int(6)},
 				data.HashValue(17),
+				nil,
 			}
 			c := resultRow{
 				data.Map{"a": data.Int(7)},
 				data.HashValue(18),
+				nil,
 			}
 
 			Convey("Then adding and counting should work correctly", func() {
@@ -0,0 +1,170 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// tableJoin holds the state of a FROM clause using the JOIN TABLE syntax.
+// Each tuple of the input relation is joined with the row of a keyed
+// shared state which is looked up when the tuple arrives. Updates of the
+// shared state don't affect tuples that are already in the window.
+type tableJoin struct {
+	joinType parser.JoinType
+	// stream is the alias of the input relation.
+	stream string
+	// table is the name of the shared state and alias is the name
+	// used to refer to its rows in the statement.
+	table string
+	alias string
+	// key computes the lookup key from a tuple of the input relation.
+	key Evaluator
+	// condition evaluates the complete ON clause on a joined row.
+	condition Evaluator
+	ctx       *core.Context
+}
+
+func newTableJoin(join parser.JoinAST, stream string, reg udf.FunctionRegistry) (*tableJoin, error) {
+	flatOn, err := ParserExprToFlatExpr(join.On, reg)
+	if err != nil {
+		// return a prettier error message
+		if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+			err = fmt.Errorf("aggregates not allowed in ON clause")
+		}
+		return nil, err
+	}
+	condition, err := ExpressionToEvaluator(flatOn, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &tableJoin{
+		joinType:  join.Type,
+		stream:    stream,
+		table:     join.Table.Name,
+		alias:     join.Table.Alias,
+		condition: condition,
+		ctx:       reg.Context(),
+	}
+
+	// the first condition of the form `stream:x = table:y` that is
+	// combined with AND on the top level of the ON clause determines
+	// the key used to look up the table
+	for _, cond := range splitConjunction(join.On) {
+		op, ok := cond.(parser.BinaryOpAST)
+		if !ok || op.Op != parser.Equal {
+			continue
+		}
+		l, r := op.Left, op.Right
+		if onlyReferences(l, j.alias) && onlyReferences(r, stream) {
+			l = r
+		} else if !onlyReferences(l, stream) || !onlyReferences(r, j.alias) {
+			continue
+		}
+		flatKey, err := ParserExprToFlatExpr(l, reg)
+		if err != nil {
+			return nil, err
+		}
+		j.key, err = ExpressionToEvaluator(flatKey, reg)
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+	if j.key == nil {
+		return nil, fmt.Errorf("ON clause of JOIN TABLE must contain an equality "+
+			"between '%s' and '%s'", stream, j.alias)
+	}
+
+	if j.ctx != nil {
+		if _, err := j.keyedState(); err != nil {
+			return nil, err
+		}
+	}
+	return j, nil
+}
+
+// keyedState returns the shared state joined with the input relation.
+// The state is obtained from the registry every time because it can
+// be replaced while the statement is running.
+func (j *tableJoin) keyedState() (core.KeyedSharedState, error) {
+	s, err := j.ctx.SharedStates.Get(j.table)
+	if err != nil {
+		return nil, err
+	}
+	ks, ok := s.(core.KeyedSharedState)
+	if !ok {
+		return nil, fmt.Errorf("state '%v' cannot be used as a table", j.table)
+	}
+	return ks, nil
+}
+
+// lookup returns the row of the table whose key matches the given
+// input row or nil if there is no such row.
+func (j *tableJoin) lookup(dataHolder data.Map) (data.Map, error) {
+	k, err := j.key.Eval(dataHolder)
+	if err != nil {
+		return nil, err
+	}
+	if k.Type() == data.TypeNull {
+		return nil, nil
+	}
+	s, err := j.keyedState()
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.Lookup(j.ctx, k)
+	if err != nil {
+		if core.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// the state owns the row, so it must not be shared with the result
+	return row.Copy(), nil
+}
+
+// lookupInputTuples is the equivalent of filterInputTuples for a FROM
+// clause using the JOIN TABLE syntax. It joins the newly added tuple with
+// the matching row of the table and adds the result to
+// `ep.filteredInputRows` if it satisfies the WHERE clause. On a LEFT JOIN,
+// a tuple without a matching row is joined with NULL.
+func (ep *streamRelationStreamExecutionPlan) lookupInputTuples() error {
+	j := ep.table
+	ep.filteredInputRowsBuffer = list.New()
+
+	t := ep.buffers[j.stream].tuples.Back().Value.(*tupleWithDerivedInputRows)
+	dataHolder := data.Map{}
+	dataHolder[j.stream] = t.tuple.Data[j.stream]
+	setMetadata(dataHolder, j.stream, t.tuple)
+	dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
+
+	row, err := j.lookup(dataHolder)
+	if err != nil {
+		return err
+	}
+	matched := false
+	if row != nil {
+		dataHolder[j.alias] = row
+		if matched, err = ep.evalCondition(j.condition, dataHolder); err != nil {
+			return err
+		}
+	}
+	if !matched {
+		if j.joinType != parser.LeftOuterJoin {
+			return nil
+		}
+		dataHolder[j.alias] = data.Null{}
+	}
+	if _, err := ep.addJoinedRow(dataHolder, t); err != nil {
+		return err
+	}
+	ep.filteredInputRows.PushBackList(ep.filteredInputRowsBuffer)
+	return nil
+}
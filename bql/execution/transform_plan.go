@@ -5,6 +5,7 @@ import (
 	"math"
 	"regexp"
 	"strings"
+	"time"
 
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
@@ -44,10 +45,14 @@ type LogicalPlan struct {
 	EmitterSampling     float64
 	EmitterSamplingType parser.EmitterSamplingType
 	Projections         []aliasedExpression
+	parser.DistinctAST
 	parser.WindowedFromAST
+	parser.MatchRecognizeAST
 	Filter    FlatExpression
 	GroupList []FlatExpression
 	parser.HavingAST
+	parser.OrderingAST
+	parser.LimitAST
 }
 
 // PhysicalPlan is a physical interface that is capable of
@@ -71,6 +76,52 @@ type PhysicalPlan interface {
 	Process(input *core.Tuple) ([]data.Map, error)
 }
 
+// WatermarkPlan is a PhysicalPlan which supports watermarks of input
+// tuples (see core.Tuple.Watermark). Such a plan holds back tuples until
+// the watermark has passed their timestamps so that results don't depend
+// on the order in which tuples arrive. Tuples arriving after the watermark
+// had passed their timestamps are rejected with an error for which
+// core.IsLateTupleError returns true.
+type WatermarkPlan interface {
+	PhysicalPlan
+
+	// Watermark returns the current watermark of the plan. All results
+	// for tuples having a timestamp before the watermark have already
+	// been returned from Process. It returns the zero time when the plan
+	// hasn't received any watermark yet.
+	Watermark() time.Time
+}
+
+// ProgressPlan is a PhysicalPlan which can be notified of tuples processed
+// by other instances of the same plan, e.g. shards of a statement
+// partitioned by LogicalPlan.PartitionKeyFunc. Each instance processes
+// tuples of its own groups with Process and all other tuples with
+// Progress so that it expires tuples and closes windows at the same time
+// as a single plan processing all tuples would do.
+type ProgressPlan interface {
+	PhysicalPlan
+
+	// Progress advances the time of the plan to the timestamp and the
+	// watermark of the input tuple without adding the tuple to the plan.
+	// Like Process, it returns data.Map items to be emitted as tuples,
+	// e.g. results of closed windows or groups changed by expired tuples.
+	//
+	// NB. Progress must not be called concurrently with Process.
+	Progress(input *core.Tuple) ([]data.Map, error)
+}
+
+// RetainingPlan is a PhysicalPlan which retains input tuples, e.g. in
+// windows, to compute results for future tuples. The state of the plan can
+// be rebuilt by processing the retained tuples with a new instance of the
+// same plan.
+type RetainingPlan interface {
+	PhysicalPlan
+
+	// RetainedTuples returns copies of the input tuples currently retained
+	// by the plan in the order in which they have to be processed again.
+	RetainedTuples() []*core.Tuple
+}
+
 // Analyze checks the given SELECT statement for logical errors
 // (references to unknown tables etc.) and creates a LogicalPlan
 // that is internally consistent.
@@ -90,6 +141,12 @@ func Analyze(s parser.SelectStmt, reg udf.FunctionRegistry) (*LogicalPlan, error
 	   >   compatible types.
 	*/
 
+	resolveOrderingAliases(&s)
+
+	if err := validateDistinctOrdering(&s); err != nil {
+		return nil, err
+	}
+
 	if err := makeRelationAliases(&s); err != nil {
 		return nil, err
 	}
@@ -98,6 +155,10 @@ func Analyze(s parser.SelectStmt, reg udf.FunctionRegistry) (*LogicalPlan, error
 		return nil, err
 	}
 
+	if err := validateMatchRecognize(&s); err != nil {
+		return nil, err
+	}
+
 	return flattenExpressions(&s, reg)
 }
 
@@ -191,6 +252,28 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		groupingMode = true
 	}
 
+	if len(s.Ordering) > 0 {
+		// all expressions in the ORDER BY clause are computed as one
+		// array so that the values can be sorted like the input of
+		// an aggregate function with ORDER BY
+		exprs := make([]parser.Expression, len(s.Ordering))
+		for i, order := range s.Ordering {
+			exprs[i] = order.Expr
+		}
+		orderArray := parser.ArrayAST{ExpressionsAST: parser.ExpressionsAST{Expressions: exprs}}
+		flatExpr, aggrs, err := ParserExprToMaybeAggregate(orderArray, numAggParams, reg)
+		if err != nil {
+			return nil, err
+		}
+		// use a special column name
+		colHeader := ":order:"
+		flatProjExprs = append(flatProjExprs,
+			aliasedExpression{colHeader, flatExpr, aggrs})
+		if len(aggrs) > 0 {
+			groupingMode = true
+		}
+	}
+
 	var filterExpr FlatExpression
 	if s.Filter != nil {
 		filterFlatExpr, err := ParserExprToFlatExpr(s.Filter, reg)
@@ -198,6 +281,8 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 			// return a prettier error message
 			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
 				err = fmt.Errorf("aggregates not allowed in WHERE clause")
+			} else if strings.HasPrefix(err.Error(), "you cannot use analytic") {
+				err = fmt.Errorf("analytic functions not allowed in WHERE clause")
 			}
 			return nil, err
 		}
@@ -213,6 +298,8 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 			// return a prettier error message
 			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
 				err = fmt.Errorf("aggregates not allowed in GROUP BY clause")
+			} else if strings.HasPrefix(err.Error(), "you cannot use analytic") {
+				err = fmt.Errorf("analytic functions not allowed in GROUP BY clause")
 			}
 			return nil, err
 		}
@@ -311,6 +398,16 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		}
 	}
 
+	// validate the LIMIT and OFFSET clauses
+	if s.HasLimit && s.Limit < 0 {
+		return nil, fmt.Errorf("LIMIT clause must have a "+
+			"non-negative value, not %d", s.Limit)
+	}
+	if s.Offset < 0 {
+		return nil, fmt.Errorf("OFFSET clause must have a "+
+			"non-negative value, not %d", s.Offset)
+	}
+
 	return &LogicalPlan{
 		groupingMode,
 		s.EmitterAST.EmitterType,
@@ -318,13 +415,71 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		emitSampling,
 		emitSamplingType,
 		flatProjExprs,
+		s.DistinctAST,
 		s.WindowedFromAST,
+		s.MatchRecognizeAST,
 		filterExpr,
 		flatGroupExprs,
 		s.HavingAST,
+		s.OrderingAST,
+		s.LimitAST,
 	}, nil
 }
 
+// resolveOrderingAliases replaces columns in the ORDER BY clause that
+// refer to an alias of the SELECT clause (as in `SELECT a + b AS c ...
+// ORDER BY c`) by the aliased expression.
+func resolveOrderingAliases(s *parser.SelectStmt) {
+	if len(s.Ordering) == 0 {
+		return
+	}
+	aliases := map[string]parser.Expression{}
+	for _, proj := range s.Projections {
+		if alias, ok := proj.(parser.AliasAST); ok {
+			aliases[alias.Alias] = alias.Expr
+		}
+	}
+	newOrdering := make([]parser.SortedExpressionAST, len(s.Ordering))
+	for i, order := range s.Ordering {
+		if col, ok := order.Expr.(parser.RowValue); ok && col.Relation == "" {
+			if expr, exists := aliases[col.Column]; exists {
+				order.Expr = expr
+			}
+		}
+		newOrdering[i] = order
+	}
+	// the slice is copied because it is shared with the caller's statement
+	s.Ordering = newOrdering
+}
+
+// validateDistinctOrdering checks that all expressions in the ORDER BY
+// clause of a SELECT DISTINCT statement appear in the SELECT clause.
+// Otherwise, the order of rows that were removed as duplicates of each
+// other would be undefined.
+func validateDistinctOrdering(s *parser.SelectStmt) error {
+	if !s.Distinct || len(s.Ordering) == 0 {
+		return nil
+	}
+	selected := map[string]bool{}
+	for _, proj := range s.Projections {
+		if alias, ok := proj.(parser.AliasAST); ok {
+			proj = alias.Expr
+		}
+		if _, ok := proj.(parser.Wildcard); ok {
+			// any column may appear in the output
+			return nil
+		}
+		selected[proj.String()] = true
+	}
+	for _, order := range s.Ordering {
+		if !selected[order.Expr.String()] {
+			return fmt.Errorf("for SELECT DISTINCT, ORDER BY expressions "+
+				"must appear in the SELECT clause: %s", order.Expr)
+		}
+	}
+	return nil
+}
+
 // makeRelationAliases will assign an internal alias to every relation
 // does not yet have one (given by the user). It will also detect if
 // there is a conflict between aliases.
@@ -335,6 +490,9 @@ func makeRelationAliases(s *parser.SelectStmt) error {
 		// if the relation does not yet have an internal alias, use
 		// the relation name itself
 		if aliasedRel.Alias == "" {
+			if aliasedRel.Type == parser.SubqueryStream {
+				return fmt.Errorf("subquery in FROM must have an alias")
+			}
 			aliasedRel.Alias = aliasedRel.Name
 		}
 		otherRel, exists := relNames[aliasedRel.Alias]
@@ -346,11 +504,21 @@ func makeRelationAliases(s *parser.SelectStmt) error {
 		newRels[i] = aliasedRel
 	}
 	s.Relations = newRels
+	if s.Join.Table.Name != "" {
+		// a table joined with JOIN TABLE is referred to like a relation
+		if s.Join.Table.Alias == "" {
+			s.Join.Table.Alias = s.Join.Table.Name
+		}
+		if otherRel, exists := relNames[s.Join.Table.Alias]; exists {
+			return fmt.Errorf("cannot use relation '%s' and table '%s' with the "+
+				"same alias '%s'", otherRel.Name, s.Join.Table.Name, s.Join.Table.Alias)
+		}
+	}
 	return nil
 }
 
 // validateReferences checks if the references to input relations
-// in SELECT, WHERE, GROUP BY and HAVING clauses of the given
+// in SELECT, WHERE, GROUP BY, HAVING and ORDER BY clauses of the given
 // statement are matching the relations mentioned in the FROM
 // clause.
 func validateReferences(s *parser.SelectStmt) error {
@@ -364,7 +532,8 @@ func validateReferences(s *parser.SelectStmt) error {
 	   the input relations (as in `SELECT a.col, b.col FROM a, b`).
 	*/
 
-	// collect the referenced relations in SELECT, WHERE, GROUP BY clauses
+	// collect the referenced relations in SELECT, WHERE, GROUP BY, HAVING,
+	// ORDER BY and ON clauses
 	// and store them in the given map
 	refRels := map[string]bool{}
 	for _, proj := range s.Projections {
@@ -387,6 +556,16 @@ func validateReferences(s *parser.SelectStmt) error {
 			refRels[rel] = true
 		}
 	}
+	for _, order := range s.Ordering {
+		for rel := range order.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
+	if s.Join.On != nil {
+		for rel := range s.Join.On.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
 
 	// do the correctness check for SELECT, WHERE, GROUP BY clauses
 	if len(s.Relations) == 0 {
@@ -394,7 +573,7 @@ func validateReferences(s *parser.SelectStmt) error {
 		// this case should never happen due to parser setup
 		return fmt.Errorf("need at least one relation to select from")
 
-	} else if len(s.Relations) == 1 {
+	} else if len(s.Relations) == 1 && s.Join.Table.Name == "" {
 		inputRel := s.Relations[0].Alias
 		if len(refRels) == 1 {
 			// Sample: SELECT a FROM b // SELECT b.a FROM b
@@ -426,6 +605,9 @@ func validateReferences(s *parser.SelectStmt) error {
 			if s.Having != nil {
 				s.Having = s.Having.RenameReferencedRelation("", inputRel)
 			}
+			for i, order := range s.Ordering {
+				s.Ordering[i] = order.RenameReferencedRelation("", inputRel).(parser.SortedExpressionAST)
+			}
 
 		} else if len(refRels) > 1 {
 			// Sample: SELECT a, b.a FROM b // SELECT b.a, x.a FROM b
@@ -442,21 +624,29 @@ func validateReferences(s *parser.SelectStmt) error {
 		// if we arrive here, the only referenced relation is valid or
 		// we do not actually reference anything
 
-	} else if len(s.Relations) > 1 {
+	} else {
 		// Sample: SELECT b.a, c.d FROM b, c
 		// check if all referenced relations are actually listed in FROM
+		// (a table joined with JOIN TABLE counts as an input relation)
+		inputAliases := make([]string, 0, len(s.Relations)+1)
+		for _, inputRel := range s.Relations {
+			inputAliases = append(inputAliases, inputRel.Alias)
+		}
+		if s.Join.Table.Name != "" {
+			inputAliases = append(inputAliases, s.Join.Table.Alias)
+		}
 		for rel := range refRels {
 			found := false
-			for _, inputRel := range s.Relations {
-				if rel == inputRel.Alias {
+			for _, alias := range inputAliases {
+				if rel == alias {
 					found = true
 					break
 				}
 			}
 			if !found {
-				prettyRels := make([]string, 0, len(s.Relations))
-				for _, inRel := range s.Relations {
-					prettyRels = append(prettyRels, fmt.Sprintf("'%s'", inRel.Alias))
+				prettyRels := make([]string, 0, len(inputAliases))
+				for _, alias := range inputAliases {
+					prettyRels = append(prettyRels, fmt.Sprintf("'%s'", alias))
 				}
 				prettyRelsStr := strings.Join(prettyRels, ", ")
 				err := fmt.Errorf("cannot reference relation '%s' "+
@@ -468,6 +658,10 @@ func validateReferences(s *parser.SelectStmt) error {
 		// FROM clause -> OK
 	}
 
+	if s.Join.Table.Name != "" && s.Join.Type == parser.FullOuterJoin {
+		return fmt.Errorf("FULL OUTER JOIN cannot be used with a table")
+	}
+
 	for _, rel := range s.Relations {
 		if rel.Value <= 0 {
 			err := fmt.Errorf("number in RANGE clause must be positive, not %v", rel.Value)
@@ -502,6 +696,158 @@ func validateReferences(s *parser.SelectStmt) error {
 		}
 	}
 
+	// TUMBLING, HOPPING and SESSION windows are evaluated only when a
+	// window is closed, so all input relations need to be closed at the
+	// same time
+	for _, rel := range s.Relations {
+		if rel.Window == parser.SlidingWindow {
+			continue
+		}
+		if rel.Window == parser.HoppingWindow {
+			if err := validateHoppingWindow(rel.WindowSpecAST); err != nil {
+				return err
+			}
+		}
+		if rel.Window == parser.SessionWindow {
+			if len(s.Relations) > 1 {
+				return fmt.Errorf("a SESSION window can only be used " +
+					"with a single input relation")
+			}
+			for ref := range rel.Key.ReferencedRelations() {
+				if ref != "" && ref != rel.Alias {
+					return fmt.Errorf("cannot refer to relation '%s' in the BY "+
+						"clause of a SESSION window over '%s'", ref, rel.Alias)
+				}
+			}
+		}
+		for _, other := range s.Relations {
+			if !other.WindowSpecAST.Equal(rel.WindowSpecAST) {
+				return fmt.Errorf("all relations must use the same window " +
+					"when a TUMBLING or HOPPING window is used")
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateMatchRecognize checks the MATCH_RECOGNIZE clause of the given
+// statement and makes references to the input relation in the clause
+// explicit. Expressions in the clause can refer to the input relation
+// and to pattern variables, but PARTITION BY can only refer to the input
+// relation. The clause needs a single input relation with a sliding
+// window, which limits the length of a match.
+func validateMatchRecognize(s *parser.SelectStmt) error {
+	m := s.MatchRecognize
+	if m == nil {
+		return nil
+	}
+	if len(s.Relations) != 1 || s.Join.Table.Name != "" {
+		return fmt.Errorf("MATCH_RECOGNIZE can only be used with a single input relation")
+	}
+	rel := s.Relations[0]
+	if rel.Window != parser.SlidingWindow {
+		return fmt.Errorf("MATCH_RECOGNIZE can only be used with a RANGE window")
+	}
+	if s.EmitterType != parser.Rstream {
+		return fmt.Errorf("MATCH_RECOGNIZE can only be used with RSTREAM")
+	}
+	if s.Distinct || len(s.GroupList) > 0 || s.Having != nil || len(s.Ordering) > 0 ||
+		s.HasLimit || s.Offset > 0 {
+		return fmt.Errorf("MATCH_RECOGNIZE cannot be used with DISTINCT, GROUP BY, " +
+			"HAVING, ORDER BY, LIMIT or OFFSET")
+	}
+
+	vars := map[string]bool{}
+	collectPatternVariables(m.Pattern, vars)
+	if vars[rel.Alias] {
+		return fmt.Errorf("pattern variable '%s' conflicts with the input relation", rel.Alias)
+	}
+	defined := map[string]bool{}
+	for _, def := range m.Definitions {
+		if !vars[def.Variable] {
+			return fmt.Errorf("pattern variable '%s' in DEFINE doesn't appear in PATTERN",
+				def.Variable)
+		}
+		if defined[def.Variable] {
+			return fmt.Errorf("pattern variable '%s' is defined more than once", def.Variable)
+		}
+		defined[def.Variable] = true
+	}
+
+	rename := func(e parser.Expression, clause string, allowVars bool) (parser.Expression, error) {
+		for ref := range e.ReferencedRelations() {
+			if ref != "" && ref != rel.Alias && !(allowVars && vars[ref]) {
+				return nil, fmt.Errorf("cannot refer to '%s' in %s of MATCH_RECOGNIZE", ref, clause)
+			}
+		}
+		return e.RenameReferencedRelation("", rel.Alias), nil
+	}
+	// the clause is copied because it is shared with the caller's statement
+	newMatch := *m
+	newMatch.PartitionBy = make([]parser.Expression, len(m.PartitionBy))
+	for i, e := range m.PartitionBy {
+		renamed, err := rename(e, "PARTITION BY", false)
+		if err != nil {
+			return err
+		}
+		newMatch.PartitionBy[i] = renamed
+	}
+	newMatch.Measures = make([]parser.Expression, len(m.Measures))
+	for i, e := range m.Measures {
+		renamed, err := rename(e, "MEASURES", true)
+		if err != nil {
+			return err
+		}
+		newMatch.Measures[i] = renamed
+	}
+	newMatch.Definitions = make([]parser.PatternDefinitionAST, len(m.Definitions))
+	for i, def := range m.Definitions {
+		renamed, err := rename(def.Cond, "DEFINE", true)
+		if err != nil {
+			return err
+		}
+		newMatch.Definitions[i] = parser.PatternDefinitionAST{Variable: def.Variable, Cond: renamed}
+	}
+	s.MatchRecognize = &newMatch
+	return nil
+}
+
+// collectPatternVariables adds the pattern variables used in the given
+// pattern to vars.
+func collectPatternVariables(p parser.PatternAST, vars map[string]bool) {
+	if p.Variable != "" {
+		vars[p.Variable] = true
+	}
+	for _, e := range p.Elements {
+		collectPatternVariables(e, vars)
+	}
+}
+
+// validateHoppingWindow checks if the EVERY clause of a HOPPING window
+// is compatible with the window size.
+func validateHoppingWindow(w parser.WindowSpecAST) error {
+	if w.Slide.Value <= 0 {
+		return fmt.Errorf("number in EVERY clause must be positive, not %v", w.Slide.Value)
+	}
+	if (w.Unit == parser.Tuples) != (w.Slide.Unit == parser.Tuples) {
+		return fmt.Errorf("window size and EVERY clause of a HOPPING window " +
+			"must both be specified in TUPLES or both as a time")
+	}
+	if w.Slide.Unit == parser.Tuples && math.Trunc(w.Slide.Value) != w.Slide.Value {
+		return fmt.Errorf("number in EVERY clause must be integral "+
+			"for TUPLES, not %v", w.Slide.Value)
+	}
+	toSeconds := func(i parser.IntervalAST) float64 {
+		if i.Unit == parser.Milliseconds {
+			return i.Value / 1000
+		}
+		return i.Value
+	}
+	if toSeconds(w.Slide) > toSeconds(w.IntervalAST) {
+		return fmt.Errorf("EVERY clause of a HOPPING window must not be " +
+			"larger than the window size")
+	}
 	return nil
 }
 
@@ -528,7 +874,9 @@ func (lp *LogicalPlan) MakePhysicalPlan(reg udf.FunctionRegistry) (PhysicalPlan,
 	   > and generates one or more physical plans, using physical operators
 	   > that match the Spark execution engine.
 	*/
-	if CanBuildFilterPlan(lp, reg) {
+	if CanBuildMatchRecognizePlan(lp, reg) {
+		return NewMatchRecognizePlan(lp, reg)
+	} else if CanBuildFilterPlan(lp, reg) {
 		return NewFilterPlan(lp, reg)
 	} else if CanBuildDefaultSelectExecutionPlan(lp, reg) {
 		return NewDefaultSelectExecutionPlan(lp, reg)
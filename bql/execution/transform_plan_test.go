@@ -45,16 +45,18 @@ type analyzeTest struct {
 }
 
 func TestRelationChecker(t *testing.T) {
-	r := parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}
+	r := parser.WindowSpecAST{parser.SlidingWindow, parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}, parser.IntervalAST{}, nil}
 	singleFrom := parser.WindowedFromAST{
 		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil}, r, 0, parser.Wait}, ""},
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil, nil}, r, 0, parser.Wait}, ""},
 		},
+		parser.JoinAST{},
 	}
 	singleFromAlias := parser.WindowedFromAST{
 		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil}, r, 0, parser.Wait}, "t"},
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil, nil}, r, 0, parser.Wait}, "t"},
 		},
+		parser.JoinAST{},
 	}
 	two := parser.NumericLiteral{2}
 	a := parser.RowValue{"", "a"}
@@ -121,7 +123,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{a}},
-					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}, false, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, ""},
@@ -179,7 +181,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{a}},
-					[]parser.SortedExpressionAST{{tB, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{tB, parser.UnspecifiedKeyword}}, false, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, "cannot refer to relations"},
@@ -187,7 +189,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{tA}},
-					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}, false, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, "cannot refer to relations"},
@@ -524,9 +526,17 @@ func TestRelationChecker(t *testing.T) {
 }
 
 func TestRelationAliasing(t *testing.T) {
-	r := parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}
+	r := parser.WindowSpecAST{parser.SlidingWindow, parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}, parser.IntervalAST{}, nil}
 	two := parser.NumericLiteral{2}
 	proj := parser.ProjectionsAST{[]parser.Expression{two}}
+	sub := parser.SelectStmt{
+		EmitterAST:     parser.EmitterAST{parser.Istream, nil},
+		ProjectionsAST: proj,
+		WindowedFromAST: parser.WindowedFromAST{
+			[]parser.AliasedStreamWindowAST{
+				{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, ""},
+			}, parser.JoinAST{}},
+	}
 
 	testCases := []analyzeTest{
 		// SELECT 2 FROM a              -> OK
@@ -534,53 +544,69 @@ func TestRelationAliasing(t *testing.T) {
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, ""},
+				}, parser.JoinAST{}},
 		}, ""},
 		// SELECT 2 FROM a AS b         -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, "b"},
+				}, parser.JoinAST{}},
 		}, ""},
 		// SELECT 2 FROM a AS b, a      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, ""},
+				}, parser.JoinAST{}},
 		}, ""},
 		// SELECT 2 FROM a AS b, c AS a -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil}, r, 0, parser.Wait}, "a"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil, nil}, r, 0, parser.Wait}, "a"},
+				}, parser.JoinAST{}},
 		}, ""},
 		// SELECT 2 FROM a, a           -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, ""},
+				}, parser.JoinAST{}},
 		}, "cannot use relations"},
 		// SELECT 2 FROM a, b AS a      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil}, r, 0, parser.Wait}, "a"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, 0, parser.Wait}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil, nil}, r, 0, parser.Wait}, "a"},
+				}, parser.JoinAST{}},
 		}, "cannot use relations"},
+		// SELECT 2 FROM (SELECT ISTREAM 2 FROM a) AS b -> OK
+		{&parser.SelectStmt{
+			ProjectionsAST: proj,
+			WindowedFromAST: parser.WindowedFromAST{
+				[]parser.AliasedStreamWindowAST{
+					{parser.StreamWindowAST{parser.Stream{parser.SubqueryStream, "", nil, &sub}, r, 0, parser.Wait}, "b"},
+				}, parser.JoinAST{}},
+		}, ""},
+		// SELECT 2 FROM (SELECT ISTREAM 2 FROM a) -> NG
+		{&parser.SelectStmt{
+			ProjectionsAST: proj,
+			WindowedFromAST: parser.WindowedFromAST{
+				[]parser.AliasedStreamWindowAST{
+					{parser.StreamWindowAST{parser.Stream{parser.SubqueryStream, "", nil, &sub}, r, 0, parser.Wait}, ""},
+				}, parser.JoinAST{}},
+		}, "subquery in FROM must have an alias"},
 	}
 
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
@@ -971,6 +997,32 @@ func TestRangeChecker(t *testing.T) {
 		{"a FROM x [RANGE 86400000 MILLISECONDS]", ""},
 		{"a FROM x [RANGE 86400000.01 MILLISECONDS]",
 			"RANGE value 8.640000001e+07 is too large for MILLISECONDS (must be at most 86400000)"},
+		// TUMBLING and HOPPING
+		{"a FROM x [TUMBLING 10 TUPLES]", ""},
+		{"a FROM x [TUMBLING 1048576 TUPLES]",
+			"RANGE value 1048576 is too large for TUPLES (must be at most 1048575)"},
+		{"a FROM x [HOPPING 60 SECONDS EVERY 10 SECONDS]", ""},
+		{"a FROM x [HOPPING 1 SECONDS EVERY 500 MILLISECONDS]", ""},
+		{"a FROM x [HOPPING 10 SECONDS EVERY 0 SECONDS]",
+			"number in EVERY clause must be positive"},
+		{"a FROM x [HOPPING 10 SECONDS EVERY 2 TUPLES]",
+			"window size and EVERY clause of a HOPPING window must both be specified"},
+		{"a FROM x [HOPPING 10 SECONDS EVERY 20 SECONDS]",
+			"EVERY clause of a HOPPING window must not be larger than the window size"},
+		{"x:a FROM x [TUMBLING 10 SECONDS], y [TUMBLING 10 SECONDS]", ""},
+		{"x:a FROM x [TUMBLING 10 SECONDS], y [RANGE 10 SECONDS]",
+			"all relations must use the same window"},
+		{"x:a FROM x [TUMBLING 10 SECONDS], y [TUMBLING 5 SECONDS]",
+			"all relations must use the same window"},
+		// SESSION
+		{"a FROM x [SESSION GAP 10 SECONDS BY b]", ""},
+		{"a FROM x [SESSION GAP 10 SECONDS BY x:b]", ""},
+		{"a FROM x [SESSION GAP 0 SECONDS BY b]",
+			"number in RANGE clause must be positive"},
+		{"a FROM x [SESSION GAP 10 SECONDS BY y:b]",
+			"cannot refer to relation 'y' in the BY clause of a SESSION window"},
+		{"x:a FROM x [SESSION GAP 10 SECONDS BY b], y [SESSION GAP 10 SECONDS BY b]",
+			"a SESSION window can only be used with a single input relation"},
 	}
 
 	for _, testCase := range testCases {
@@ -1236,3 +1288,58 @@ func TestVolatileAggregateChecker(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalyzeOrdering(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+		grouping      bool
+	}{
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY a", "", false},
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY b DESC, c LIMIT 3 OFFSET 2", "", false},
+		{"a FROM x [RANGE 1 TUPLES] LIMIT 0", "", false},
+		{"a + 1 AS b FROM x [RANGE 1 TUPLES] ORDER BY b", "", false},
+		{"x:a FROM x [RANGE 1 TUPLES] ORDER BY x:a", "", false},
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY y:a", "cannot refer to relations", false},
+		{"a FROM x [RANGE 1 TUPLES] LIMIT -1", "LIMIT clause must have a non-negative value", false},
+		{"a FROM x [RANGE 1 TUPLES] OFFSET -1", "OFFSET clause must have a non-negative value", false},
+		// ORDER BY follows the rules of GROUP BY
+		{"a FROM x [RANGE 1 TUPLES] GROUP BY a ORDER BY a", "", true},
+		{"a FROM x [RANGE 1 TUPLES] GROUP BY a ORDER BY count(*) DESC", "", true},
+		{"a, avg(b) AS c FROM x [RANGE 1 TUPLES] GROUP BY a ORDER BY c", "", true},
+		{"a FROM x [RANGE 1 TUPLES] GROUP BY a ORDER BY b", "column \"x:b\" must appear in the GROUP BY clause", true},
+		// aggregates in ORDER BY turn the statement into a grouping statement
+		{"count(*) FROM x [RANGE 1 TUPLES] ORDER BY max(a)", "", true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				logPlan, err := Analyze(ast, reg)
+				if testCase.expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+						So(logPlan.GroupingStmt, ShouldEqual, testCase.grouping)
+						So(len(logPlan.Ordering), ShouldEqual, len(ast.Ordering))
+						So(logPlan.LimitAST, ShouldResemble, ast.LimitAST)
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, testCase.expectedError)
+					})
+				}
+			})
+		})
+	}
+}
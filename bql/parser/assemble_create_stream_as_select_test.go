@@ -2,6 +2,7 @@ package parser
 
 import (
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"testing"
 )
 
@@ -10,26 +11,30 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 		ps := parseStack{}
 		Convey("When the stack contains the correct CREATE STREAM items", func() {
 			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleWith(4, 4)
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
+			ps.AssembleDistinct(6, 6)
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.PushComponent(8, 9, Identifier("y"))
 			ps.AssembleAlias()
 			ps.AssembleProjections(6, 9)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
-			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
+			ps.PushComponent(11, 12, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, nil})
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
+			ps.PushComponent(15, 16, SlidingWindow)
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.AssembleWindowSpec()
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -37,6 +42,7 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.AssembleAliasedStreamWindow()
 			ps.EnsureAliasedStreamWindow()
 			ps.AssembleWindowedFrom(10, 20)
+			ps.AssembleMatchRecognize(20, 20)
 			ps.PushComponent(20, 21, RowValue{"", "e"})
 			ps.AssembleFilter(20, 21)
 			ps.PushComponent(21, 22, RowValue{"", "f"})
@@ -44,7 +50,11 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.AssembleGrouping(21, 23)
 			ps.PushComponent(23, 24, RowValue{"", "h"})
 			ps.AssembleHaving(23, 24)
+			ps.AssembleOrdering(24, 24)
+			ps.AssembleLimit(24, 24)
+			ps.AssembleOffset(24, 24)
 			ps.AssembleSelect()
+			ps.AssembleSourceSinkSpecs(24, 24)
 			ps.AssembleCreateStreamAsSelect()
 
 			Convey("Then AssembleCreateStreamAsSelect transforms them into one item", func() {
@@ -155,5 +165,33 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When doing a full SELECT with parameters", func() {
+			p.Buffer = `CREATE STREAM x AS SELECT ISTREAM a FROM c [RANGE 1 TUPLES] WHERE b WITH parallelism=4, foo="bar"`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				cssComp := top.(CreateStreamAsSelectStmt)
+
+				So(cssComp.Name, ShouldEqual, "x")
+				So(cssComp.Select.Filter, ShouldResemble, RowValue{"", "b"})
+				So(cssComp.Params, ShouldResemble, []SourceSinkParamAST{
+					{"parallelism", data.Int(4)},
+					{"foo", data.String("bar")},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(cssComp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
 	})
 }
@@ -10,26 +10,30 @@ func TestAssembleCreateStreamAsSelectUnion(t *testing.T) {
 		ps := parseStack{}
 		Convey("When the stack contains the correct CREATE STREAM items", func() {
 			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleWith(4, 4)
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
+			ps.AssembleDistinct(6, 6)
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.PushComponent(8, 9, Identifier("y"))
 			ps.AssembleAlias()
 			ps.AssembleProjections(6, 9)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
-			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
+			ps.PushComponent(11, 12, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, nil})
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
+			ps.PushComponent(15, 16, SlidingWindow)
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.AssembleWindowSpec()
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -37,6 +41,7 @@ func TestAssembleCreateStreamAsSelectUnion(t *testing.T) {
 			ps.AssembleAliasedStreamWindow()
 			ps.EnsureAliasedStreamWindow()
 			ps.AssembleWindowedFrom(10, 20)
+			ps.AssembleMatchRecognize(20, 20)
 			ps.PushComponent(20, 21, RowValue{"", "e"})
 			ps.AssembleFilter(20, 21)
 			ps.PushComponent(21, 22, RowValue{"", "f"})
@@ -44,6 +49,9 @@ func TestAssembleCreateStreamAsSelectUnion(t *testing.T) {
 			ps.AssembleGrouping(21, 23)
 			ps.PushComponent(23, 24, RowValue{"", "h"})
 			ps.AssembleHaving(23, 24)
+			ps.AssembleOrdering(24, 24)
+			ps.AssembleLimit(24, 24)
+			ps.AssembleOffset(24, 24)
 			ps.AssembleSelect()
 			ps.AssembleSelectUnion(4, 24)
 			ps.AssembleCreateStreamAsSelectUnion()
@@ -0,0 +1,93 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleDistinct(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a DISTINCT keyword in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(7, 15, Yes)
+			ps.AssembleDistinct(6, 15)
+
+			Convey("Then AssembleDistinct replaces it with a DistinctAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 6)
+				So(top.end, ShouldEqual, 15)
+				So(top.comp, ShouldResemble, DistinctAST{true})
+			})
+		})
+
+		Convey("When the given range is empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleDistinct(6, 6)
+
+			Convey("Then AssembleDistinct pushes an empty DistinctAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				So(ps.Peek().comp, ShouldResemble, DistinctAST{})
+			})
+		})
+
+		Convey("When the stack contains a keyword not in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 14, Yes)
+			f := func() {
+				ps.AssembleDistinct(7, 14)
+			}
+
+			Convey("Then AssembleDistinct panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting DISTINCT rows", func() {
+			p.Buffer = "SELECT RSTREAM DISTINCT a, count(DISTINCT b) FROM s [RANGE 2 TUPLES] GROUP BY a"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(s.Distinct, ShouldBeTrue)
+				So(s.Projections, ShouldResemble, []Expression{
+					RowValue{"", "a"},
+					FuncAppAST{FuncName("count"), ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil, true, nil},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting a column whose name starts with distinct", func() {
+			p.Buffer = "SELECT RSTREAM distinct_a FROM s [RANGE 2 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.Distinct, ShouldBeFalse)
+				So(s.Projections, ShouldResemble, []Expression{RowValue{"", "distinct_a"}})
+			})
+		})
+	})
+}
@@ -12,6 +12,7 @@ func TestAssembleFuncApp(t *testing.T) {
 		Convey("When the stack contains three correct items", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 7, FuncName("add"))
+			ps.PushComponent(7, 7, UnspecifiedKeyword)
 			ps.PushComponent(7, 8, ExpressionsAST{[]Expression{
 				NumericLiteral{2},
 				RowValue{"", "a"}}})
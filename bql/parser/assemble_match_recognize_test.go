@@ -0,0 +1,145 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleMatchRecognize(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the given range is empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleMatchRecognize(6, 6)
+
+			Convey("Then AssembleMatchRecognize pushes one item onto the stack", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an empty MatchRecognizeAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.comp, ShouldResemble, MatchRecognizeAST{})
+				})
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting with a MATCH_RECOGNIZE clause", func() {
+			p.Buffer = `SELECT RSTREAM sensor, start_temp FROM s [RANGE 10 SECONDS] ` +
+				`MATCH_RECOGNIZE (PARTITION BY sensor MEASURES A:temp AS start_temp, ` +
+				`C:ts() AS opened PATTERN (A B+ C) DEFINE B AS temp > A:temp, ` +
+				`C AS door = "open") WHERE start_temp > 20`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(s.MatchRecognize, ShouldNotBeNil)
+				m := s.MatchRecognize
+				So(m.PartitionBy, ShouldResemble, []Expression{RowValue{"", "sensor"}})
+				So(m.Measures, ShouldResemble, []Expression{
+					AliasAST{RowValue{"A", "temp"}, "start_temp"},
+					AliasAST{RowMeta{"C", TimestampMeta}, "opened"},
+				})
+				So(m.Pattern, ShouldResemble, PatternAST{
+					Elements: []PatternAST{
+						{Variable: "A", Min: 1, Max: 1},
+						{Variable: "B", Min: 1, Max: -1},
+						{Variable: "C", Min: 1, Max: 1},
+					},
+					Min: 1, Max: 1,
+				})
+				So(m.Definitions, ShouldResemble, []PatternDefinitionAST{
+					{"B", BinaryOpAST{Greater, RowValue{"", "temp"}, RowValue{"A", "temp"}}},
+					{"C", BinaryOpAST{Equal, RowValue{"", "door"}, StringLiteral{"open"}}},
+				})
+				So(s.Filter, ShouldNotBeNil)
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a MATCH_RECOGNIZE clause without optional parts", func() {
+			p.Buffer = `SELECT RSTREAM * FROM s [RANGE 5 TUPLES] ` +
+				`MATCH_RECOGNIZE(PATTERN(A B) DEFINE A AS x)`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.MatchRecognize, ShouldNotBeNil)
+				So(s.MatchRecognize.PartitionBy, ShouldBeEmpty)
+				So(s.MatchRecognize.Measures, ShouldBeEmpty)
+				So(s.MatchRecognize.Definitions, ShouldHaveLength, 1)
+
+				Convey("And String() should return the normalized statement", func() {
+					So(s.String(), ShouldEqual, `SELECT RSTREAM * FROM s [RANGE 5 TUPLES] `+
+						`MATCH_RECOGNIZE (PATTERN (A B) DEFINE A AS x)`)
+				})
+			})
+		})
+
+		Convey("When selecting with complex patterns", func() {
+			for _, pattern := range []string{
+				"A | B C",
+				"(A | B C)+ D{2,} E? F{0,3} (G H){2}",
+				"(A+)* B",
+				"A ((B | C) D)? E{3,5}",
+			} {
+				p.Buffer = "SELECT RSTREAM * FROM s [RANGE 5 TUPLES] MATCH_RECOGNIZE (PATTERN (" +
+					pattern + ") DEFINE A AS x)"
+				p.Init()
+
+				Convey("Then the statement should be parsed correctly: "+pattern, func() {
+					err := p.Parse()
+					So(err, ShouldBeNil)
+					p.Execute()
+
+					s := p.parseStack.Peek().comp.(SelectStmt)
+					So(s.MatchRecognize.Pattern.String(), ShouldEqual, pattern)
+				})
+			}
+		})
+
+		Convey("When selecting with quantifiers written differently", func() {
+			p.Buffer = "SELECT RSTREAM * FROM s [RANGE 5 TUPLES] MATCH_RECOGNIZE " +
+				"(PATTERN (A{ 1 , } B{0,} C{,1} D{1,1} (E)) DEFINE A AS x)"
+			p.Init()
+
+			Convey("Then they should be normalized", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.MatchRecognize.Pattern.String(), ShouldEqual, "A+ B* C? D E")
+			})
+		})
+
+		Convey("When selecting with an empty pattern", func() {
+			p.Buffer = "SELECT RSTREAM * FROM s [RANGE 5 TUPLES] MATCH_RECOGNIZE " +
+				"(PATTERN () DEFINE A AS x)"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
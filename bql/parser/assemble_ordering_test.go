@@ -0,0 +1,183 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleOrdering(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains sorted expressions in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, RowValue{"", "a"})
+			ps.PushComponent(7, 8, UnspecifiedKeyword)
+			ps.AssembleSortedExpression()
+			ps.PushComponent(8, 9, RowValue{"", "b"})
+			ps.PushComponent(9, 10, No)
+			ps.AssembleSortedExpression()
+			ps.AssembleOrdering(6, 10)
+
+			Convey("Then AssembleOrdering replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an OrderingAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 10)
+					So(top.comp, ShouldHaveSameTypeAs, OrderingAST{})
+
+					Convey("And it contains the previous data", func() {
+						comp := top.comp.(OrderingAST)
+						So(comp.Ordering, ShouldResemble, []SortedExpressionAST{
+							{RowValue{"", "a"}, UnspecifiedKeyword},
+							{RowValue{"", "b"}, No},
+						})
+					})
+				})
+			})
+		})
+
+		Convey("When the given range is empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleOrdering(6, 6)
+
+			Convey("Then AssembleOrdering pushes an empty OrderingAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top.comp, ShouldResemble, OrderingAST{})
+			})
+		})
+	})
+
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a LIMIT and an OFFSET", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, NumericLiteral{10})
+			ps.AssembleLimit(6, 8)
+			ps.PushComponent(8, 10, NumericLiteral{3})
+			ps.AssembleOffset(8, 10)
+
+			Convey("Then they are replaced by one LimitAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 6)
+				So(top.end, ShouldEqual, 10)
+				So(top.comp, ShouldResemble, LimitAST{true, 10, 3})
+			})
+		})
+
+		Convey("When the stack contains only an OFFSET", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleLimit(6, 6)
+			ps.PushComponent(6, 8, NumericLiteral{3})
+			ps.AssembleOffset(6, 8)
+
+			Convey("Then they are replaced by one LimitAST without a limit", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 6)
+				So(top.end, ShouldEqual, 8)
+				So(top.comp, ShouldResemble, LimitAST{false, 0, 3})
+			})
+		})
+
+		Convey("When the given ranges are empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleLimit(6, 6)
+			ps.AssembleOffset(6, 6)
+
+			Convey("Then an empty LimitAST is pushed", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top.comp, ShouldResemble, LimitAST{})
+			})
+		})
+
+		Convey("When the stack contains a LIMIT not in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, NumericLiteral{10})
+			f := func() {
+				ps.AssembleLimit(7, 8)
+			}
+			Convey("Then AssembleLimit panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting with an ORDER BY, a LIMIT and an OFFSET", func() {
+			p.Buffer = "SELECT RSTREAM k, avg(v) AS a FROM s [RANGE 60 SECONDS] GROUP BY k ORDER BY avg(v) DESC, k LIMIT 10 OFFSET 5"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(len(s.GroupList), ShouldEqual, 1)
+				So(s.Ordering, ShouldResemble, []SortedExpressionAST{
+					{FuncAppAST{FuncName("avg"), ExpressionsAST{[]Expression{RowValue{"", "v"}}}, nil, false, nil}, No},
+					{RowValue{"", "k"}, UnspecifiedKeyword},
+				})
+				So(s.LimitAST, ShouldResemble, LimitAST{true, 10, 5})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with an ORDER BY only", func() {
+			p.Buffer = "SELECT ISTREAM a FROM s [RANGE 2 TUPLES] ORDER BY a ASC"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.Ordering, ShouldResemble, []SortedExpressionAST{
+					{RowValue{"", "a"}, Yes},
+				})
+				So(s.LimitAST, ShouldResemble, LimitAST{})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with an OFFSET only", func() {
+			p.Buffer = "SELECT ISTREAM a FROM s [RANGE 2 TUPLES] WHERE b OFFSET 1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.Ordering, ShouldBeNil)
+				So(s.LimitAST, ShouldResemble, LimitAST{false, 0, 1})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
+}
@@ -49,3 +49,101 @@ func TestAssembleInterval(t *testing.T) {
 		})
 	})
 }
+
+func TestAssembleWindowSpec(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a window type and an interval", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, TumblingWindow)
+			ps.PushComponent(7, 8, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.AssembleWindowSpec()
+
+			Convey("Then AssembleWindowSpec replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a WindowSpecAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 8)
+					So(top.comp, ShouldHaveSameTypeAs, WindowSpecAST{})
+
+					Convey("And it contains the previous data", func() {
+						comp := top.comp.(WindowSpecAST)
+						So(comp.Window, ShouldEqual, TumblingWindow)
+						So(comp.Value, ShouldEqual, 2)
+						So(comp.Unit, ShouldEqual, Seconds)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a window type and two intervals", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, HoppingWindow)
+			ps.PushComponent(7, 8, IntervalAST{FloatLiteral{6}, Tuples})
+			ps.PushComponent(8, 9, IntervalAST{FloatLiteral{2}, Tuples})
+			ps.AssembleHoppingWindowSpec()
+
+			Convey("Then AssembleHoppingWindowSpec replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a WindowSpecAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 9)
+					So(top.comp, ShouldHaveSameTypeAs, WindowSpecAST{})
+
+					Convey("And it contains the previous data", func() {
+						comp := top.comp.(WindowSpecAST)
+						So(comp.Window, ShouldEqual, HoppingWindow)
+						So(comp.Value, ShouldEqual, 6)
+						So(comp.Unit, ShouldEqual, Tuples)
+						So(comp.Slide.Value, ShouldEqual, 2)
+						So(comp.Slide.Unit, ShouldEqual, Tuples)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a window type, an interval and a key", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, SessionWindow)
+			ps.PushComponent(7, 8, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.PushComponent(8, 9, RowValue{"", "a"})
+			ps.AssembleSessionWindowSpec()
+
+			Convey("Then AssembleSessionWindowSpec replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a WindowSpecAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 9)
+					So(top.comp, ShouldHaveSameTypeAs, WindowSpecAST{})
+
+					Convey("And it contains the previous data", func() {
+						comp := top.comp.(WindowSpecAST)
+						So(comp.Window, ShouldEqual, SessionWindow)
+						So(comp.Value, ShouldEqual, 2)
+						So(comp.Unit, ShouldEqual, Seconds)
+						So(comp.Key, ShouldResemble, RowValue{"", "a"})
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, IntervalAST{FloatLiteral{2}, Seconds})
+
+			Convey("Then AssembleWindowSpec panics", func() {
+				So(ps.AssembleWindowSpec, ShouldPanic)
+			})
+		})
+	})
+}
@@ -10,24 +10,28 @@ func TestAssembleSelect(t *testing.T) {
 	Convey("Given a parseStack", t, func() {
 		ps := parseStack{}
 		Convey("When the stack contains the correct SELECT items", func() {
+			ps.AssembleWith(4, 4)
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
+			ps.AssembleDistinct(6, 6)
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.AssembleProjections(6, 8)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
-			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
+			ps.PushComponent(11, 12, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, nil})
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
+			ps.PushComponent(15, 16, SlidingWindow)
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.AssembleWindowSpec()
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -35,6 +39,7 @@ func TestAssembleSelect(t *testing.T) {
 			ps.AssembleAliasedStreamWindow()
 			ps.EnsureAliasedStreamWindow()
 			ps.AssembleWindowedFrom(10, 20)
+			ps.AssembleMatchRecognize(20, 20)
 			ps.PushComponent(22, 24, RowValue{"", "e"})
 			ps.AssembleFilter(22, 24)
 			ps.PushComponent(24, 26, RowValue{"", "f"})
@@ -42,6 +47,14 @@ func TestAssembleSelect(t *testing.T) {
 			ps.AssembleGrouping(24, 28)
 			ps.PushComponent(28, 30, RowValue{"", "h"})
 			ps.AssembleHaving(28, 30)
+			ps.PushComponent(30, 31, RowValue{"", "i"})
+			ps.PushComponent(31, 32, No)
+			ps.AssembleSortedExpression()
+			ps.AssembleOrdering(30, 32)
+			ps.PushComponent(32, 33, NumericLiteral{10})
+			ps.AssembleLimit(32, 33)
+			ps.PushComponent(33, 34, NumericLiteral{5})
+			ps.AssembleOffset(33, 34)
 			ps.AssembleSelect()
 
 			Convey("Then AssembleSelect transforms them into one item", func() {
@@ -51,7 +64,7 @@ func TestAssembleSelect(t *testing.T) {
 					top := ps.Peek()
 					So(top, ShouldNotBeNil)
 					So(top.begin, ShouldEqual, 4)
-					So(top.end, ShouldEqual, 30)
+					So(top.end, ShouldEqual, 34)
 					So(top.comp, ShouldHaveSameTypeAs, SelectStmt{})
 
 					Convey("And it contains the previously pushed data", func() {
@@ -79,6 +92,9 @@ func TestAssembleSelect(t *testing.T) {
 						So(comp.GroupList[0], ShouldResemble, RowValue{"", "f"})
 						So(comp.GroupList[1], ShouldResemble, RowValue{"", "g"})
 						So(comp.Having, ShouldResemble, RowValue{"", "h"})
+						So(comp.Ordering, ShouldResemble, []SortedExpressionAST{
+							{RowValue{"", "i"}, No}})
+						So(comp.LimitAST, ShouldResemble, LimitAST{true, 10, 5})
 					})
 				})
 			})
@@ -96,21 +112,24 @@ func TestAssembleSelect(t *testing.T) {
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
+			ps.AssembleDistinct(6, 6)
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.AssembleProjections(6, 8)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
-			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
+			ps.PushComponent(11, 12, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, nil})
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
+			ps.PushComponent(15, 16, SlidingWindow)
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.AssembleWindowSpec()
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -118,6 +137,7 @@ func TestAssembleSelect(t *testing.T) {
 			ps.AssembleAliasedStreamWindow()
 			ps.EnsureAliasedStreamWindow()
 			ps.AssembleWindowedFrom(10, 20)
+			ps.AssembleMatchRecognize(20, 20)
 			ps.PushComponent(22, 24, RowValue{"", "e"})
 			ps.AssembleFilter(22, 24)
 			ps.PushComponent(24, 26, RowValue{"", "f"})
@@ -125,6 +145,9 @@ func TestAssembleSelect(t *testing.T) {
 			ps.AssembleGrouping(24, 28)
 			ps.PushComponent(28, 30, RowValue{"", "h"})
 			ps.AssembleFilter(28, 30) // must be HAVING in correct stmt
+			ps.AssembleOrdering(30, 30)
+			ps.AssembleLimit(30, 30)
+			ps.AssembleOffset(30, 30)
 			Convey("Then AssembleSelect panics", func() {
 				So(ps.AssembleSelect, ShouldPanic)
 			})
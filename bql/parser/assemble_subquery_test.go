@@ -0,0 +1,99 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleSubquery(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a SelectStmt", func() {
+			sel := SelectStmt{
+				EmitterAST: EmitterAST{Istream, nil},
+				ProjectionsAST: ProjectionsAST{[]Expression{
+					RowValue{"", "a"},
+				}},
+			}
+			ps.PushComponent(1, 30, sel)
+			ps.AssembleSubquery(0, 31)
+
+			Convey("Then AssembleSubquery replaces it with a new item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a Stream", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 0)
+					So(top.end, ShouldEqual, 31)
+					So(top.comp, ShouldHaveSameTypeAs, Stream{})
+
+					Convey("And it contains the previous data", func() {
+						comp := top.comp.(Stream)
+						So(comp.Type, ShouldEqual, SubqueryStream)
+						So(comp.Name, ShouldEqual, "")
+						So(comp.Params, ShouldBeNil)
+						So(*comp.Select, ShouldResemble, sel)
+					})
+				})
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting from a subquery", func() {
+			p.Buffer = "SELECT RSTREAM x:a FROM (SELECT ISTREAM a FROM s [RANGE 1 TUPLES] WHERE b) [RANGE 10 SECONDS] AS x"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(len(s.Relations), ShouldEqual, 1)
+				rel := s.Relations[0]
+				So(rel.Alias, ShouldEqual, "x")
+				So(rel.Type, ShouldEqual, SubqueryStream)
+				So(rel.Name, ShouldEqual, "")
+				So(rel.IntervalAST, ShouldResemble, IntervalAST{FloatLiteral{10}, Seconds})
+				So(rel.Select, ShouldNotBeNil)
+				So(rel.Select.EmitterType, ShouldEqual, Istream)
+				So(rel.Select.Relations[0].Name, ShouldEqual, "s")
+				So(rel.Select.Filter, ShouldResemble, RowValue{"", "b"})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting from nested subqueries", func() {
+			p.Buffer = "SELECT ISTREAM y:a FROM (SELECT ISTREAM x:a FROM (SELECT ISTREAM a FROM s [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS x) [RANGE 2 TUPLES] AS y"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				inner := s.Relations[0].Select
+				So(inner, ShouldNotBeNil)
+				So(inner.Relations[0].Type, ShouldEqual, SubqueryStream)
+				So(inner.Relations[0].Select.Relations[0].Name, ShouldEqual, "s")
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
+}
@@ -13,11 +13,11 @@ func TestAssembleWindowedFrom(t *testing.T) {
 		Convey("When the stack contains only AliasedStreamWindows in the given range", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 8, AliasedStreamWindowAST{
-				StreamWindowAST{Stream{ActualStream, "a", nil}, IntervalAST{FloatLiteral{3}, Tuples},
+				StreamWindowAST{Stream{ActualStream, "a", nil, nil}, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, nil},
 					2, UnspecifiedSheddingOption}, "",
 			})
 			ps.PushComponent(8, 10, AliasedStreamWindowAST{
-				StreamWindowAST{Stream{ActualStream, "b", nil}, IntervalAST{FloatLiteral{2}, Seconds},
+				StreamWindowAST{Stream{ActualStream, "b", nil, nil}, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{2}, Seconds}, IntervalAST{}, nil},
 					UnspecifiedCapacity, Wait}, "",
 			})
 			ps.AssembleWindowedFrom(6, 10)
@@ -94,6 +94,42 @@ func TestAssembleWindowedFrom(t *testing.T) {
 			})
 		})
 
+		Convey("When the stack contains AliasedStreamWindows and a JoinAST in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, AliasedStreamWindowAST{
+				StreamWindowAST{Stream{ActualStream, "a", nil, nil}, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, nil},
+					UnspecifiedCapacity, UnspecifiedSheddingOption}, "",
+			})
+			ps.PushComponent(8, 9, LeftOuterJoin)
+			ps.PushComponent(9, 11, AliasedStreamWindowAST{
+				StreamWindowAST{Stream{ActualStream, "b", nil, nil}, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{2}, Seconds}, IntervalAST{}, nil},
+					UnspecifiedCapacity, UnspecifiedSheddingOption}, "",
+			})
+			ps.PushComponent(11, 12, RowValue{"a", "k"})
+			ps.AssembleJoin()
+			ps.AssembleWindowedFrom(6, 12)
+
+			Convey("Then AssembleWindowedFrom transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a WindowedFromAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 12)
+					So(top.comp, ShouldHaveSameTypeAs, WindowedFromAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(WindowedFromAST)
+						So(len(comp.Relations), ShouldEqual, 2)
+						So(comp.Relations[0].Name, ShouldEqual, "a")
+						So(comp.Relations[1].Name, ShouldEqual, "b")
+						So(comp.Join, ShouldResemble, JoinAST{LeftOuterJoin, RowValue{"a", "k"}, TableAST{}})
+					})
+				})
+			})
+		})
+
 		Convey("When the stack contains non-AliasedStreamWindows in the given range", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			f := func() {
@@ -160,5 +196,114 @@ func TestAssembleWindowedFrom(t *testing.T) {
 				})
 			})
 		})
+
+		joinTypes := map[string]JoinType{
+			"JOIN":            InnerJoin,
+			"INNER JOIN":      InnerJoin,
+			"LEFT JOIN":       LeftOuterJoin,
+			"LEFT OUTER JOIN": LeftOuterJoin,
+			"FULL JOIN":       FullOuterJoin,
+			"FULL OUTER JOIN": FullOuterJoin,
+		}
+		for join, joinType := range joinTypes {
+			join, joinType := join, joinType
+			Convey("When selecting with a "+join, func() {
+				p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a:v, b:w FROM c [RANGE 3 TUPLES] AS a " +
+					join + " d [RANGE 2 SECONDS] AS b ON a:k = b:k AND a:v > 1"
+				p.Init()
+
+				Convey("Then the statement should be parsed correctly", func() {
+					err := p.Parse()
+					So(err, ShouldBeNil)
+					p.Execute()
+
+					ps := p.parseStack
+					So(ps.Len(), ShouldEqual, 1)
+					top := ps.Peek().comp
+					So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+					comp := top.(CreateStreamAsSelectStmt).Select
+					So(len(comp.Relations), ShouldEqual, 2)
+					So(comp.Relations[0].Name, ShouldEqual, "c")
+					So(comp.Relations[0].Alias, ShouldEqual, "a")
+					So(comp.Relations[1].Name, ShouldEqual, "d")
+					So(comp.Relations[1].Alias, ShouldEqual, "b")
+					So(comp.Join.Type, ShouldEqual, joinType)
+					So(comp.Join.On, ShouldResemble, BinaryOpAST{And,
+						BinaryOpAST{Equal, RowValue{"a", "k"}, RowValue{"b", "k"}},
+						BinaryOpAST{Greater, RowValue{"a", "v"}, NumericLiteral{1}},
+					})
+
+					Convey("And String() should return the normalized statement", func() {
+						stmt := top.(CreateStreamAsSelectStmt)
+						So(stmt.String(), ShouldEqual, "CREATE STREAM x AS SELECT ISTREAM a:v, b:w FROM "+
+							"c [RANGE 3 TUPLES] AS a "+joinType.String()+" d [RANGE 2 SECONDS] AS b "+
+							"ON a:k = b:k AND a:v > 1")
+					})
+				})
+			})
+		}
+
+		Convey("When selecting with a JOIN TABLE", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM s:v, devices:name FROM s [RANGE 1 TUPLES] " +
+				"JOIN TABLE devices ON s:id = devices:id"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(len(comp.Relations), ShouldEqual, 1)
+				So(comp.Relations[0].Name, ShouldEqual, "s")
+				So(comp.Join, ShouldResemble, JoinAST{InnerJoin,
+					BinaryOpAST{Equal, RowValue{"s", "id"}, RowValue{"devices", "id"}},
+					TableAST{"devices", ""}})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a LEFT JOIN TABLE with an alias", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM s:v, d:name FROM s [RANGE 1 TUPLES] " +
+				"LEFT JOIN TABLE devices AS d ON s:id = d:id"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(len(comp.Relations), ShouldEqual, 1)
+				So(comp.Join.Type, ShouldEqual, LeftOuterJoin)
+				So(comp.Join.Table, ShouldResemble, TableAST{"devices", "d"})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a JOIN without ON", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a:v FROM c [RANGE 3 TUPLES] AS a JOIN d [RANGE 2 SECONDS] AS b"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
 	})
 }
@@ -12,8 +12,8 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains two correct items", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
-			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 10, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{2}, Seconds}, IntervalAST{}, nil})
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropOldest)
@@ -44,8 +44,8 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains two correct items (float)", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
-			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{0.2}, Seconds})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 10, WindowSpecAST{SlidingWindow, IntervalAST{FloatLiteral{0.2}, Seconds}, IntervalAST{}, nil})
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropNewest)
@@ -76,7 +76,7 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains a wrong item", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
 
 			Convey("Then AssembleStreamWindow panics", func() {
 				So(ps.AssembleStreamWindow, ShouldPanic)
@@ -186,5 +186,137 @@ func TestAssembleStreamWindow(t *testing.T) {
 				})
 			})
 		})
+		Convey("When selecting with a TUMBLING window", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a, b FROM c [TUMBLING 10 SECONDS]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Window, ShouldEqual, TumblingWindow)
+				So(comp.Relations[0].Value, ShouldEqual, 10)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Capacity, ShouldEqual, UnspecifiedCapacity)
+				So(comp.Relations[0].Shedding, ShouldEqual, UnspecifiedSheddingOption)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a HOPPING window", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a, b FROM c [HOPPING 60 SECONDS EVERY 500 MILLISECONDS, BUFFER SIZE 3]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Window, ShouldEqual, HoppingWindow)
+				So(comp.Relations[0].Value, ShouldEqual, 60)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Slide.Value, ShouldEqual, 500)
+				So(comp.Relations[0].Slide.Unit, ShouldEqual, Milliseconds)
+				So(comp.Relations[0].Capacity, ShouldEqual, 3)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a HOPPING window (TUPLES)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a, b FROM c [HOPPING 6 TUPLES EVERY 2 TUPLES] AS d"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Window, ShouldEqual, HoppingWindow)
+				So(comp.Relations[0].Value, ShouldEqual, 6)
+				So(comp.Relations[0].Unit, ShouldEqual, Tuples)
+				So(comp.Relations[0].Slide.Value, ShouldEqual, 2)
+				So(comp.Relations[0].Slide.Unit, ShouldEqual, Tuples)
+				So(comp.Relations[0].Alias, ShouldEqual, "d")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a SESSION window", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a, count(b) FROM c [SESSION GAP 30 SECONDS BY device_id, DROP OLDEST IF FULL]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Window, ShouldEqual, SessionWindow)
+				So(comp.Relations[0].Value, ShouldEqual, 30)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Key, ShouldResemble, RowValue{"", "device_id"})
+				So(comp.Relations[0].Shedding, ShouldEqual, DropOldest)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a SESSION window with a TUPLES gap", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a, b FROM c [SESSION GAP 3 TUPLES BY a]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotEqual, nil)
+			})
+		})
+
+		Convey("When selecting with a HOPPING window without EVERY", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT RSTREAM a, b FROM c [HOPPING 6 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotEqual, nil)
+			})
+		})
 	})
 }
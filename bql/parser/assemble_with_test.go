@@ -0,0 +1,116 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleWith(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the given range is empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleWith(6, 6)
+
+			Convey("Then AssembleWith pushes one item onto the stack", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an empty WithAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.comp, ShouldResemble, WithAST{})
+				})
+			})
+		})
+
+		Convey("When the stack contains common table expressions", func() {
+			sel := SelectStmt{ProjectionsAST: ProjectionsAST{[]Expression{RowValue{"", "a"}}}}
+			ps.PushComponent(5, 6, StreamIdentifier("x"))
+			ps.PushComponent(10, 20, sel)
+			ps.AssembleCommonTableExpression()
+			ps.PushComponent(22, 23, StreamIdentifier("y"))
+			ps.PushComponent(27, 37, sel)
+			ps.AssembleCommonTableExpression()
+			ps.AssembleWith(0, 38)
+
+			Convey("Then AssembleWith replaces them with a WithAST", func() {
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 0)
+				So(top.end, ShouldEqual, 38)
+				So(top.comp, ShouldResemble, WithAST{[]CommonTableExpressionAST{
+					{"x", sel},
+					{"y", sel},
+				}})
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting with a WITH clause", func() {
+			p.Buffer = `WITH smoothed AS (SELECT ISTREAM avg(v) AS v FROM s [RANGE 5 TUPLES]), ` +
+				`flagged AS (SELECT ISTREAM v, v > 10 AS high FROM smoothed [RANGE 1 TUPLES]) ` +
+				`SELECT ISTREAM v FROM flagged [RANGE 1 TUPLES] WHERE high`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				ctes := s.CommonTableExpressions
+				So(len(ctes), ShouldEqual, 2)
+				So(ctes[0].Name, ShouldEqual, "smoothed")
+				So(ctes[0].Select.Relations[0].Name, ShouldEqual, "s")
+				So(ctes[1].Name, ShouldEqual, "flagged")
+				So(ctes[1].Select.Relations[0].Name, ShouldEqual, "smoothed")
+				So(s.Relations[0].Name, ShouldEqual, "flagged")
+				So(s.Filter, ShouldResemble, RowValue{"", "high"})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When creating a stream with a WITH clause", func() {
+			p.Buffer = `CREATE STREAM x AS WITH a AS (SELECT ISTREAM * FROM s [RANGE 1 TUPLES]) ` +
+				`SELECT RSTREAM * FROM a [RANGE 2 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				top := p.parseStack.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				s := top.(CreateStreamAsSelectStmt)
+				So(s.Name, ShouldEqual, "x")
+				So(len(s.Select.CommonTableExpressions), ShouldEqual, 1)
+				So(s.Select.CommonTableExpressions[0].Name, ShouldEqual, "a")
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with an empty WITH clause", func() {
+			p.Buffer = `WITH SELECT ISTREAM * FROM s [RANGE 1 TUPLES]`
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
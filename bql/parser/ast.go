@@ -23,21 +23,30 @@ type Expression interface {
 // Combined Structures (all with *AST)
 
 type SelectStmt struct {
+	WithAST
 	EmitterAST
+	DistinctAST
 	ProjectionsAST
 	WindowedFromAST
+	MatchRecognizeAST
 	FilterAST
 	GroupingAST
 	HavingAST
+	OrderingAST
+	LimitAST
 }
 
 func (s SelectStmt) String() string {
-	str := []string{"SELECT", s.EmitterAST.string()}
+	str := []string{s.WithAST.string(), "SELECT", s.EmitterAST.string()}
+	str = append(str, s.DistinctAST.string())
 	str = append(str, s.ProjectionsAST.string())
 	str = append(str, s.WindowedFromAST.string())
+	str = append(str, s.MatchRecognizeAST.string())
 	str = append(str, s.FilterAST.string())
 	str = append(str, s.GroupingAST.string())
 	str = append(str, s.HavingAST.string())
+	str = append(str, s.OrderingAST.string())
+	str = append(str, s.LimitAST.string())
 
 	st := []string{}
 	for _, s := range str {
@@ -63,10 +72,15 @@ func (s SelectUnionStmt) String() string {
 type CreateStreamAsSelectStmt struct {
 	Name   StreamIdentifier
 	Select SelectStmt
+	SourceSinkSpecsAST
 }
 
 func (s CreateStreamAsSelectStmt) String() string {
 	str := []string{"CREATE", "STREAM", string(s.Name), "AS", s.Select.String()}
+	specs := s.SourceSinkSpecsAST.string("WITH")
+	if specs != "" {
+		str = append(str, specs)
+	}
 	return strings.Join(str, " ")
 }
 
@@ -371,6 +385,17 @@ func (e EmitterSampling) string() string {
 	return ""
 }
 
+type DistinctAST struct {
+	Distinct bool
+}
+
+func (a DistinctAST) string() string {
+	if a.Distinct {
+		return "DISTINCT"
+	}
+	return ""
+}
+
 type ProjectionsAST struct {
 	Projections []Expression
 }
@@ -406,6 +431,7 @@ func (a AliasAST) String() string {
 
 type WindowedFromAST struct {
 	Relations []AliasedStreamWindowAST
+	Join      JoinAST
 }
 
 func (a WindowedFromAST) string() string {
@@ -417,9 +443,42 @@ func (a WindowedFromAST) string() string {
 	for _, r := range a.Relations {
 		str = append(str, r.string())
 	}
+	if a.Join.Table.Name != "" {
+		return fmt.Sprintf("FROM %s %s TABLE %s ON %s", str[0], a.Join.Type,
+			a.Join.Table.string(), a.Join.On.String())
+	}
+	if a.Join.Type != CrossJoin {
+		return fmt.Sprintf("FROM %s %s %s ON %s", str[0], a.Join.Type, str[1],
+			a.Join.On.String())
+	}
 	return "FROM " + strings.Join(str, ", ")
 }
 
+// JoinAST describes how the two relations of a FROM clause using
+// the JOIN syntax are combined. For the comma-separated syntax,
+// Type is CrossJoin and On is nil. When a stream is joined with
+// a shared state using JOIN TABLE, Table holds the state and the
+// FROM clause has only one relation.
+type JoinAST struct {
+	Type  JoinType
+	On    Expression
+	Table TableAST
+}
+
+// TableAST refers to a shared state that is joined with a stream
+// like a table. Name is empty if there is no JOIN TABLE clause.
+type TableAST struct {
+	Name  string
+	Alias string
+}
+
+func (a TableAST) string() string {
+	if a.Alias != "" {
+		return a.Name + " AS " + a.Alias
+	}
+	return a.Name
+}
+
 type AliasedStreamWindowAST struct {
 	StreamWindowAST
 	Alias string
@@ -437,13 +496,13 @@ const UnspecifiedCapacity int64 = -1
 
 type StreamWindowAST struct {
 	Stream
-	IntervalAST
+	WindowSpecAST
 	Capacity int64
 	Shedding SheddingOption
 }
 
 func (a StreamWindowAST) string() string {
-	interval := a.IntervalAST.string()
+	interval := a.WindowSpecAST.string()
 	capacity := ""
 	if a.Capacity != UnspecifiedCapacity {
 		capacity = fmt.Sprintf(", BUFFER SIZE %d", a.Capacity)
@@ -464,6 +523,9 @@ func (a StreamWindowAST) string() string {
 			ps = append(ps, p.String())
 		}
 		return a.Stream.Name + "(" + strings.Join(ps, ", ") + ") " + suffix
+
+	case SubqueryStream:
+		return "(" + a.Stream.Select.String() + ") " + suffix
 	}
 
 	return "UnknownStreamType"
@@ -475,7 +537,180 @@ type IntervalAST struct {
 }
 
 func (a IntervalAST) string() string {
-	return "RANGE " + a.FloatLiteral.String() + " " + a.Unit.String()
+	return a.FloatLiteral.String() + " " + a.Unit.String()
+}
+
+// WindowSpecAST describes the window that is computed over a stream.
+// The embedded IntervalAST holds the size of the window (or the gap
+// for a SESSION window), Slide holds the distance between two
+// consecutive windows for a HOPPING window and Key holds the expression
+// in the BY clause of a SESSION window. Both are unused otherwise.
+type WindowSpecAST struct {
+	Window WindowType
+	IntervalAST
+	Slide IntervalAST
+	Key   Expression
+}
+
+func (a WindowSpecAST) string() string {
+	if a.Window == SessionWindow {
+		return a.Window.String() + " GAP " + a.IntervalAST.string() +
+			" BY " + a.Key.String()
+	}
+	str := a.Window.String() + " " + a.IntervalAST.string()
+	if a.Window == HoppingWindow {
+		str += " EVERY " + a.Slide.string()
+	}
+	return str
+}
+
+// Equal returns true if both window specifications describe
+// the same window.
+func (a WindowSpecAST) Equal(b WindowSpecAST) bool {
+	if a.Window != b.Window || a.IntervalAST != b.IntervalAST || a.Slide != b.Slide {
+		return false
+	}
+	if a.Key == nil || b.Key == nil {
+		return a.Key == nil && b.Key == nil
+	}
+	return a.Key.String() == b.Key.String()
+}
+
+// WithAST holds the common table expressions defined in the WITH
+// clause of a SELECT statement. CommonTableExpressions is empty when
+// the clause isn't given.
+type WithAST struct {
+	CommonTableExpressions []CommonTableExpressionAST
+}
+
+func (a WithAST) string() string {
+	if len(a.CommonTableExpressions) == 0 {
+		return ""
+	}
+	ctes := make([]string, len(a.CommonTableExpressions))
+	for i, cte := range a.CommonTableExpressions {
+		ctes[i] = cte.String()
+	}
+	return "WITH " + strings.Join(ctes, ", ")
+}
+
+// CommonTableExpressionAST is a named SELECT statement in the WITH
+// clause. The rest of the statement refers to its result like a stream
+// having the name.
+type CommonTableExpressionAST struct {
+	Name   StreamIdentifier
+	Select SelectStmt
+}
+
+func (c CommonTableExpressionAST) String() string {
+	return string(c.Name) + " AS (" + c.Select.String() + ")"
+}
+
+// MatchRecognizeAST holds the MATCH_RECOGNIZE clause of a SELECT
+// statement. MatchRecognize is nil when the clause isn't given.
+type MatchRecognizeAST struct {
+	MatchRecognize *MatchRecognizeClauseAST
+}
+
+func (a MatchRecognizeAST) string() string {
+	if a.MatchRecognize == nil {
+		return ""
+	}
+	return a.MatchRecognize.String()
+}
+
+// MatchRecognizeClauseAST detects sequences of rows matching a row
+// pattern, e.g., `MATCH_RECOGNIZE (PARTITION BY sensor MEASURES A:temp AS
+// start_temp PATTERN (A B+ C) DEFINE B AS temp > A:temp, C AS door = "open")`.
+// Each Measures element is an AliasAST.
+type MatchRecognizeClauseAST struct {
+	PartitionBy []Expression
+	Measures    []Expression
+	Pattern     PatternAST
+	Definitions []PatternDefinitionAST
+}
+
+func (a MatchRecognizeClauseAST) String() string {
+	str := []string{}
+	if len(a.PartitionBy) > 0 {
+		str = append(str, "PARTITION BY "+ExpressionsAST{a.PartitionBy}.string())
+	}
+	if len(a.Measures) > 0 {
+		str = append(str, "MEASURES "+ExpressionsAST{a.Measures}.string())
+	}
+	str = append(str, "PATTERN ("+a.Pattern.String()+")")
+	defs := make([]string, len(a.Definitions))
+	for i, d := range a.Definitions {
+		defs[i] = d.String()
+	}
+	str = append(str, "DEFINE "+strings.Join(defs, ", "))
+	return "MATCH_RECOGNIZE (" + strings.Join(str, " ") + ")"
+}
+
+// PatternAST is a row pattern in MATCH_RECOGNIZE. It's either a pattern
+// variable, a sequence of patterns or an alternation of patterns, which
+// is repeated at least Min times and at most Max times. Max is negative
+// when the number of repetitions is unbounded.
+type PatternAST struct {
+	Variable    string
+	Alternation bool
+	Elements    []PatternAST
+	Min         int
+	Max         int
+}
+
+func (p PatternAST) String() string {
+	var body string
+	if p.Variable != "" {
+		body = p.Variable
+	} else {
+		elems := make([]string, len(p.Elements))
+		for i, e := range p.Elements {
+			elems[i] = e.String()
+			if !p.Alternation && e.Alternation && e.quantifier() == "" {
+				elems[i] = "(" + elems[i] + ")"
+			}
+		}
+		if p.Alternation {
+			body = strings.Join(elems, " | ")
+		} else {
+			body = strings.Join(elems, " ")
+		}
+	}
+	q := p.quantifier()
+	if q != "" && p.Variable == "" {
+		body = "(" + body + ")"
+	}
+	return body + q
+}
+
+func (p PatternAST) quantifier() string {
+	switch {
+	case p.Min == 1 && p.Max == 1:
+		return ""
+	case p.Min == 0 && p.Max < 0:
+		return "*"
+	case p.Min == 1 && p.Max < 0:
+		return "+"
+	case p.Min == 0 && p.Max == 1:
+		return "?"
+	case p.Max < 0:
+		return fmt.Sprintf("{%d,}", p.Min)
+	case p.Min == p.Max:
+		return fmt.Sprintf("{%d}", p.Min)
+	}
+	return fmt.Sprintf("{%d,%d}", p.Min, p.Max)
+}
+
+// PatternDefinitionAST is the condition which a row must satisfy to
+// be mapped to a pattern variable.
+type PatternDefinitionAST struct {
+	Variable string
+	Cond     Expression
+}
+
+func (a PatternDefinitionAST) String() string {
+	return a.Variable + " AS " + a.Cond.String()
 }
 
 type FilterAST struct {
@@ -516,6 +751,40 @@ func (a HavingAST) string() string {
 	return "HAVING " + a.Having.String()
 }
 
+type OrderingAST struct {
+	Ordering []SortedExpressionAST
+}
+
+func (a OrderingAST) string() string {
+	if len(a.Ordering) == 0 {
+		return ""
+	}
+	exprs := make([]string, len(a.Ordering))
+	for i, e := range a.Ordering {
+		exprs[i] = e.String()
+	}
+	return "ORDER BY " + strings.Join(exprs, ", ")
+}
+
+// LimitAST holds the LIMIT and OFFSET clauses of a SELECT statement.
+// Limit is only valid when HasLimit is true.
+type LimitAST struct {
+	HasLimit bool
+	Limit    int64
+	Offset   int64
+}
+
+func (a LimitAST) string() string {
+	str := []string{}
+	if a.HasLimit {
+		str = append(str, fmt.Sprintf("LIMIT %d", a.Limit))
+	}
+	if a.Offset != 0 {
+		str = append(str, fmt.Sprintf("OFFSET %d", a.Offset))
+	}
+	return strings.Join(str, " ")
+}
+
 type SourceSinkSpecsAST struct {
 	Params []SourceSinkParamAST
 }
@@ -707,6 +976,13 @@ type FuncAppAST struct {
 	Function FuncName
 	ExpressionsAST
 	Ordering []SortedExpressionAST
+	// Distinct is true if the aggregated values are deduplicated
+	// before the function is called, as in `count(DISTINCT a)`.
+	Distinct bool
+	// Over is the OVER clause of an analytic function call such as
+	// `lag(a) OVER (PARTITION BY b ORDER BY c)`, or nil if the
+	// function is not called as an analytic function.
+	Over *OverClauseAST
 }
 
 func (f FuncAppAST) ReferencedRelations() map[string]bool {
@@ -721,6 +997,11 @@ func (f FuncAppAST) ReferencedRelations() map[string]bool {
 			rels[rel] = true
 		}
 	}
+	if f.Over != nil {
+		for rel := range f.Over.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
 	return rels
 }
 
@@ -733,7 +1014,12 @@ func (f FuncAppAST) RenameReferencedRelation(from, to string) Expression {
 	for i, expr := range f.Ordering {
 		newOrderExprs[i] = expr.RenameReferencedRelation(from, to).(SortedExpressionAST)
 	}
-	return FuncAppAST{f.Function, ExpressionsAST{newExprs}, newOrderExprs}
+	var newOver *OverClauseAST
+	if f.Over != nil {
+		o := f.Over.RenameReferencedRelation(from, to)
+		newOver = &o
+	}
+	return FuncAppAST{f.Function, ExpressionsAST{newExprs}, newOrderExprs, f.Distinct, newOver}
 }
 
 func (f FuncAppAST) Foldable() bool {
@@ -742,9 +1028,13 @@ func (f FuncAppAST) Foldable() bool {
 	if string(f.Function) == "now" && len(f.Expressions) == 0 {
 		return false
 	}
-	// if there is a ORDER BY clause, then this is definitely an
-	// aggregate function and therefore not foldable
-	if len(f.Ordering) > 0 {
+	// if there is a ORDER BY clause or DISTINCT, then this is
+	// definitely an aggregate function and therefore not foldable
+	if len(f.Ordering) > 0 || f.Distinct {
+		return false
+	}
+	// an analytic function depends on other rows in the window
+	if f.Over != nil {
 		return false
 	}
 	for _, expr := range f.Expressions {
@@ -757,7 +1047,11 @@ func (f FuncAppAST) Foldable() bool {
 }
 
 func (f FuncAppAST) String() string {
-	s := string(f.Function) + "(" + f.ExpressionsAST.string()
+	s := string(f.Function) + "("
+	if f.Distinct {
+		s += "DISTINCT "
+	}
+	s += f.ExpressionsAST.string()
 	if len(f.Ordering) > 0 {
 		orderStrings := make([]string, len(f.Ordering))
 		for i, expr := range f.Ordering {
@@ -765,7 +1059,67 @@ func (f FuncAppAST) String() string {
 		}
 		s += " ORDER BY " + strings.Join(orderStrings, ", ")
 	}
-	return s + ")"
+	s += ")"
+	if f.Over != nil {
+		s += " " + f.Over.String()
+	}
+	return s
+}
+
+// OverClauseAST is the OVER clause of an analytic function call. The
+// rows in the window are divided into partitions having the same values
+// of the PartitionBy expressions, and the rows in each partition are
+// sorted by Ordering.
+type OverClauseAST struct {
+	PartitionBy []Expression
+	Ordering    []SortedExpressionAST
+}
+
+func (o OverClauseAST) ReferencedRelations() map[string]bool {
+	rels := map[string]bool{}
+	for _, expr := range o.PartitionBy {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	for _, expr := range o.Ordering {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (o OverClauseAST) RenameReferencedRelation(from, to string) OverClauseAST {
+	var newPartition []Expression
+	for _, expr := range o.PartitionBy {
+		newPartition = append(newPartition, expr.RenameReferencedRelation(from, to))
+	}
+	var newOrderExprs []SortedExpressionAST
+	for _, expr := range o.Ordering {
+		newOrderExprs = append(newOrderExprs,
+			expr.RenameReferencedRelation(from, to).(SortedExpressionAST))
+	}
+	return OverClauseAST{newPartition, newOrderExprs}
+}
+
+func (o OverClauseAST) String() string {
+	var clauses []string
+	if len(o.PartitionBy) > 0 {
+		partStrings := make([]string, len(o.PartitionBy))
+		for i, expr := range o.PartitionBy {
+			partStrings[i] = expr.String()
+		}
+		clauses = append(clauses, "PARTITION BY "+strings.Join(partStrings, ", "))
+	}
+	if len(o.Ordering) > 0 {
+		orderStrings := make([]string, len(o.Ordering))
+		for i, expr := range o.Ordering {
+			orderStrings[i] = expr.String()
+		}
+		clauses = append(clauses, "ORDER BY "+strings.Join(orderStrings, ", "))
+	}
+	return "OVER (" + strings.Join(clauses, " ") + ")"
 }
 
 type FuncAppSelectorAST struct {
@@ -937,10 +1291,13 @@ type Stream struct {
 	Type   StreamType
 	Name   string
 	Params []Expression
+	// Select is the statement of a subquery (as in
+	// `FROM (SELECT ...) [RANGE ...] AS x`) and nil otherwise.
+	Select *SelectStmt
 }
 
 func NewStream(s string) Stream {
-	return Stream{ActualStream, s, nil}
+	return Stream{ActualStream, s, nil, nil}
 }
 
 type Wildcard struct {
@@ -1023,6 +1380,105 @@ func NewRowValue(s string) RowValue {
 	return RowValue{components[0], components[1]}
 }
 
+// InAST represents `Expr IN (a, b, c)` or `Expr IN Values`, where Values
+// evaluates to an array, and the negated forms using NOT IN.
+type InAST struct {
+	Expr Expression
+	// Values is an ArrayAST holding the elements of the list if List
+	// is true, otherwise it is an expression evaluating to an array.
+	Values Expression
+	List   bool
+	Not    bool
+}
+
+func (i InAST) ReferencedRelations() map[string]bool {
+	rels := i.Expr.ReferencedRelations()
+	if rels == nil {
+		return i.Values.ReferencedRelations()
+	}
+	for rel := range i.Values.ReferencedRelations() {
+		rels[rel] = true
+	}
+	return rels
+}
+
+func (i InAST) RenameReferencedRelation(from, to string) Expression {
+	return InAST{i.Expr.RenameReferencedRelation(from, to),
+		i.Values.RenameReferencedRelation(from, to), i.List, i.Not}
+}
+
+func (i InAST) Foldable() bool {
+	return i.Expr.Foldable() && i.Values.Foldable()
+}
+
+func (i InAST) String() string {
+	op := " IN "
+	if i.Not {
+		op = " NOT IN "
+	}
+	if arr, ok := i.Values.(ArrayAST); ok && i.List {
+		return encloseComparisonOperand(i.Expr) + op + "(" + arr.ExpressionsAST.string() + ")"
+	}
+	return encloseComparisonOperand(i.Expr) + op + encloseComparisonOperand(i.Values)
+}
+
+// BetweenAST represents `Expr BETWEEN Lower AND Upper` and its negated
+// form using NOT BETWEEN.
+type BetweenAST struct {
+	Expr  Expression
+	Lower Expression
+	Upper Expression
+	Not   bool
+}
+
+func (b BetweenAST) ReferencedRelations() map[string]bool {
+	rels := map[string]bool{}
+	for _, expr := range []Expression{b.Expr, b.Lower, b.Upper} {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (b BetweenAST) RenameReferencedRelation(from, to string) Expression {
+	return BetweenAST{b.Expr.RenameReferencedRelation(from, to),
+		b.Lower.RenameReferencedRelation(from, to),
+		b.Upper.RenameReferencedRelation(from, to), b.Not}
+}
+
+func (b BetweenAST) Foldable() bool {
+	return b.Expr.Foldable() && b.Lower.Foldable() && b.Upper.Foldable()
+}
+
+func (b BetweenAST) String() string {
+	op := " BETWEEN "
+	if b.Not {
+		op = " NOT BETWEEN "
+	}
+	return encloseComparisonOperand(b.Expr) + op + encloseComparisonOperand(b.Lower) +
+		" AND " + encloseComparisonOperand(b.Upper)
+}
+
+// encloseComparisonOperand returns the string representation of an
+// operand of IN or BETWEEN, enclosed in parentheses if the operand
+// is a comparison or a logical operation.
+func encloseComparisonOperand(e Expression) string {
+	enclose := false
+	switch e := e.(type) {
+	case BinaryOpAST:
+		enclose = !e.Op.hasHigherPrecedenceThan(NotRegexpIMatch)
+	case UnaryOpAST:
+		enclose = e.Op == Not
+	case InAST, BetweenAST:
+		enclose = true
+	}
+	if enclose {
+		return "(" + e.String() + ")"
+	}
+	return e.String()
+}
+
 type WhenThenPairAST struct {
 	When Expression
 	Then Expression
@@ -1396,6 +1852,7 @@ const (
 	UnknownStreamType StreamType = iota
 	ActualStream
 	UDSFStream
+	SubqueryStream
 )
 
 func (st StreamType) String() string {
@@ -1405,6 +1862,8 @@ func (st StreamType) String() string {
 		s = "ActualStream"
 	case UDSFStream:
 		s = "UDSFStream"
+	case SubqueryStream:
+		s = "SubqueryStream"
 	}
 	return s
 }
@@ -1431,6 +1890,60 @@ func (i IntervalUnit) String() string {
 	return s
 }
 
+// WindowType specifies when a window over a stream is evaluated. A
+// SlidingWindow (the default, written as RANGE) is evaluated every
+// time a tuple arrives, a TumblingWindow, a HoppingWindow and a
+// SessionWindow are evaluated only once when they are closed.
+type WindowType int
+
+const (
+	SlidingWindow WindowType = iota
+	TumblingWindow
+	HoppingWindow
+	SessionWindow
+)
+
+func (w WindowType) String() string {
+	s := "UNKNOWN"
+	switch w {
+	case SlidingWindow:
+		s = "RANGE"
+	case TumblingWindow:
+		s = "TUMBLING"
+	case HoppingWindow:
+		s = "HOPPING"
+	case SessionWindow:
+		s = "SESSION"
+	}
+	return s
+}
+
+// JoinType is the type of a JOIN in the FROM clause. A CrossJoin
+// (written as a comma-separated list of relations) is the default.
+type JoinType int
+
+const (
+	CrossJoin JoinType = iota
+	InnerJoin
+	LeftOuterJoin
+	FullOuterJoin
+)
+
+func (j JoinType) String() string {
+	s := "UNKNOWN"
+	switch j {
+	case CrossJoin:
+		s = ","
+	case InnerJoin:
+		s = "JOIN"
+	case LeftOuterJoin:
+		s = "LEFT JOIN"
+	case FullOuterJoin:
+		s = "FULL OUTER JOIN"
+	}
+	return s
+}
+
 type MetaInformation int
 
 const (
@@ -1564,6 +2077,16 @@ const (
 	Greater
 	GreaterOrEqual
 	NotEqual
+	Like
+	NotLike
+	ILike
+	NotILike
+	SimilarTo
+	NotSimilarTo
+	RegexpMatch
+	NotRegexpMatch
+	RegexpIMatch
+	NotRegexpIMatch
 	Concat
 	Is
 	IsNot
@@ -1572,6 +2095,7 @@ const (
 	Multiply
 	Divide
 	Modulo
+	AtTimeZone
 	UnaryMinus
 )
 
@@ -1583,6 +2107,9 @@ func (op Operator) hasSamePrecedenceAs(rhs Operator) bool {
 	if Less <= op && op <= GreaterOrEqual && Less <= rhs && rhs <= GreaterOrEqual {
 		return true
 	}
+	if Like <= op && op <= NotRegexpIMatch && Like <= rhs && rhs <= NotRegexpIMatch {
+		return true
+	}
 	if Is <= op && op <= IsNot && Is <= rhs && rhs <= IsNot {
 		return true
 	}
@@ -1625,6 +2152,26 @@ func (o Operator) String() string {
 		s = ">="
 	case NotEqual:
 		s = "!="
+	case Like:
+		s = "LIKE"
+	case NotLike:
+		s = "NOT LIKE"
+	case ILike:
+		s = "ILIKE"
+	case NotILike:
+		s = "NOT ILIKE"
+	case SimilarTo:
+		s = "SIMILAR TO"
+	case NotSimilarTo:
+		s = "NOT SIMILAR TO"
+	case RegexpMatch:
+		s = "~"
+	case NotRegexpMatch:
+		s = "!~"
+	case RegexpIMatch:
+		s = "~*"
+	case NotRegexpIMatch:
+		s = "!~*"
 	case Concat:
 		s = "||"
 	case Is:
@@ -1641,6 +2188,8 @@ func (o Operator) String() string {
 		s = "/"
 	case Modulo:
 		s = "%"
+	case AtTimeZone:
+		s = "AT TIME ZONE"
 	case UnaryMinus:
 		s = "-"
 	}
@@ -44,6 +44,8 @@ const (
 	ruleLoadStateOrCreateStmt
 	ruleSaveStateStmt
 	ruleEvalStmt
+	ruleWithOpt
+	ruleCommonTableExpression
 	ruleEmitter
 	ruleEmitterOptions
 	ruleEmitterOptionCombinations
@@ -54,6 +56,7 @@ const (
 	ruleTimeBasedSampling
 	ruleTimeBasedSamplingSeconds
 	ruleTimeBasedSamplingMilliseconds
+	ruleDistinctOpt
 	ruleProjections
 	ruleProjection
 	ruleAliasExpression
@@ -62,14 +65,38 @@ const (
 	ruleTimeInterval
 	ruleTuplesInterval
 	ruleRelations
+	ruleJoinedRelations
+	ruleJoinType
+	ruleJoinedTable
+	ruleTableLike
+	ruleAliasedTable
+	ruleTable
+	ruleMatchRecognize
+	ruleMatchPartitionOpt
+	ruleMatchMeasuresOpt
+	rulePatternDefinition
+	rulePatternAlternation
+	rulePatternSequence
+	rulePatternTerm
+	rulePatternPrimary
+	rulePatternVariable
+	rulePatternQuantifier
 	ruleFilter
 	ruleGrouping
 	ruleGroupList
 	ruleHaving
+	ruleOrdering
+	ruleLimit
+	ruleOffset
 	ruleRelationLike
 	ruleAliasedStreamWindow
 	ruleStreamWindow
+	ruleWindowSpec
+	ruleSimpleWindowSpec
+	ruleHoppingWindowSpec
+	ruleSessionWindowSpec
 	ruleStreamLike
+	ruleSubquery
 	ruleUDSFFuncApp
 	ruleCapacitySpecOpt
 	ruleSheddingSpecOpt
@@ -91,10 +118,16 @@ const (
 	ruleandExpr
 	rulenotExpr
 	rulecomparisonExpr
+	ruleInPredicate
+	ruleInList
+	ruleBetweenPredicate
+	ruleNegationOpt
+	ruleNegation
 	ruleotherOpExpr
 	ruleisExpr
 	ruletermExpr
 	ruleproductExpr
+	ruleatTimeZoneExpr
 	ruleminusExpr
 	rulecastExpr
 	rulebaseExpr
@@ -103,7 +136,12 @@ const (
 	ruleFuncAppSelector
 	ruleFuncElemAccessor
 	ruleFuncAppWithOrderBy
+	ruleFuncAppWithinGroup
+	ruleFuncAppWithOver
+	ruleOverPartitionOpt
+	ruleOverOrderOpt
 	ruleFuncAppWithoutOrderBy
+	ruleParamsDistinct
 	ruleFuncParams
 	ruleParamsOrder
 	ruleSortedExpression
@@ -117,6 +155,7 @@ const (
 	ruleWhenThenPair
 	ruleLiteral
 	ruleComparisonOp
+	rulePatternMatchOp
 	ruleOtherOp
 	ruleIsOp
 	rulePlusMinusOp
@@ -142,6 +181,13 @@ const (
 	ruleTUPLES
 	ruleSECONDS
 	ruleMILLISECONDS
+	ruleSlidingWindow
+	ruleTumblingWindow
+	ruleHoppingWindow
+	ruleSessionWindow
+	ruleInnerJoin
+	ruleLeftOuterJoin
+	ruleFullOuterJoin
 	ruleWait
 	ruleDropOldest
 	ruleDropNewest
@@ -150,6 +196,7 @@ const (
 	ruleSourceSinkParamKey
 	rulePaused
 	ruleUnpaused
+	ruleDistinct
 	ruleAscending
 	ruleDescending
 	ruleType
@@ -170,6 +217,16 @@ const (
 	ruleGreater
 	ruleGreaterOrEqual
 	ruleNotEqual
+	ruleLike
+	ruleNotLike
+	ruleILike
+	ruleNotILike
+	ruleSimilarTo
+	ruleNotSimilarTo
+	ruleRegexpMatch
+	ruleNotRegexpMatch
+	ruleRegexpIMatch
+	ruleNotRegexpIMatch
 	ruleConcat
 	ruleIs
 	ruleIsNot
@@ -178,6 +235,7 @@ const (
 	ruleMultiply
 	ruleDivide
 	ruleModulo
+	ruleAtTimeZone
 	ruleUnaryMinus
 	ruleIdentifier
 	ruleTargetIdentifier
@@ -339,6 +397,59 @@ const (
 	ruleAction133
 	ruleAction134
 	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
+	ruleAction166
+	ruleAction167
+	ruleAction168
+	ruleAction169
+	ruleAction170
+	ruleAction171
+	ruleAction172
+	ruleAction173
+	ruleAction174
+	ruleAction175
+	ruleAction176
+	ruleAction177
+	ruleAction178
+	ruleAction179
+	ruleAction180
+	ruleAction181
+	ruleAction182
+	ruleAction183
+	ruleAction184
+	ruleAction185
+	ruleAction186
+	ruleAction187
+	ruleAction188
 )
 
 var rul3s = [...]string{
@@ -373,6 +484,8 @@ var rul3s = [...]string{
 	"LoadStateOrCreateStmt",
 	"SaveStateStmt",
 	"EvalStmt",
+	"WithOpt",
+	"CommonTableExpression",
 	"Emitter",
 	"EmitterOptions",
 	"EmitterOptionCombinations",
@@ -383,6 +496,7 @@ var rul3s = [...]string{
 	"TimeBasedSampling",
 	"TimeBasedSamplingSeconds",
 	"TimeBasedSamplingMilliseconds",
+	"DistinctOpt",
 	"Projections",
 	"Projection",
 	"AliasExpression",
@@ -391,14 +505,38 @@ var rul3s = [...]string{
 	"TimeInterval",
 	"TuplesInterval",
 	"Relations",
+	"JoinedRelations",
+	"JoinType",
+	"JoinedTable",
+	"TableLike",
+	"AliasedTable",
+	"Table",
+	"MatchRecognize",
+	"MatchPartitionOpt",
+	"MatchMeasuresOpt",
+	"PatternDefinition",
+	"PatternAlternation",
+	"PatternSequence",
+	"PatternTerm",
+	"PatternPrimary",
+	"PatternVariable",
+	"PatternQuantifier",
 	"Filter",
 	"Grouping",
 	"GroupList",
 	"Having",
+	"Ordering",
+	"Limit",
+	"Offset",
 	"RelationLike",
 	"AliasedStreamWindow",
 	"StreamWindow",
+	"WindowSpec",
+	"SimpleWindowSpec",
+	"HoppingWindowSpec",
+	"SessionWindowSpec",
 	"StreamLike",
+	"Subquery",
 	"UDSFFuncApp",
 	"CapacitySpecOpt",
 	"SheddingSpecOpt",
@@ -420,10 +558,16 @@ var rul3s = [...]string{
 	"andExpr",
 	"notExpr",
 	"comparisonExpr",
+	"InPredicate",
+	"InList",
+	"BetweenPredicate",
+	"NegationOpt",
+	"Negation",
 	"otherOpExpr",
 	"isExpr",
 	"termExpr",
 	"productExpr",
+	"atTimeZoneExpr",
 	"minusExpr",
 	"castExpr",
 	"baseExpr",
@@ -432,7 +576,12 @@ var rul3s = [...]string{
 	"FuncAppSelector",
 	"FuncElemAccessor",
 	"FuncAppWithOrderBy",
+	"FuncAppWithinGroup",
+	"FuncAppWithOver",
+	"OverPartitionOpt",
+	"OverOrderOpt",
 	"FuncAppWithoutOrderBy",
+	"ParamsDistinct",
 	"FuncParams",
 	"ParamsOrder",
 	"SortedExpression",
@@ -446,6 +595,7 @@ var rul3s = [...]string{
 	"WhenThenPair",
 	"Literal",
 	"ComparisonOp",
+	"PatternMatchOp",
 	"OtherOp",
 	"IsOp",
 	"PlusMinusOp",
@@ -471,6 +621,13 @@ var rul3s = [...]string{
 	"TUPLES",
 	"SECONDS",
 	"MILLISECONDS",
+	"SlidingWindow",
+	"TumblingWindow",
+	"HoppingWindow",
+	"SessionWindow",
+	"InnerJoin",
+	"LeftOuterJoin",
+	"FullOuterJoin",
 	"Wait",
 	"DropOldest",
 	"DropNewest",
@@ -479,6 +636,7 @@ var rul3s = [...]string{
 	"SourceSinkParamKey",
 	"Paused",
 	"Unpaused",
+	"Distinct",
 	"Ascending",
 	"Descending",
 	"Type",
@@ -499,6 +657,16 @@ var rul3s = [...]string{
 	"Greater",
 	"GreaterOrEqual",
 	"NotEqual",
+	"Like",
+	"NotLike",
+	"ILike",
+	"NotILike",
+	"SimilarTo",
+	"NotSimilarTo",
+	"RegexpMatch",
+	"NotRegexpMatch",
+	"RegexpIMatch",
+	"NotRegexpIMatch",
 	"Concat",
 	"Is",
 	"IsNot",
@@ -507,6 +675,7 @@ var rul3s = [...]string{
 	"Multiply",
 	"Divide",
 	"Modulo",
+	"AtTimeZone",
 	"UnaryMinus",
 	"Identifier",
 	"TargetIdentifier",
@@ -668,6 +837,59 @@ var rul3s = [...]string{
 	"Action133",
 	"Action134",
 	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
+	"Action166",
+	"Action167",
+	"Action168",
+	"Action169",
+	"Action170",
+	"Action171",
+	"Action172",
+	"Action173",
+	"Action174",
+	"Action175",
+	"Action176",
+	"Action177",
+	"Action178",
+	"Action179",
+	"Action180",
+	"Action181",
+	"Action182",
+	"Action183",
+	"Action184",
+	"Action185",
+	"Action186",
+	"Action187",
+	"Action188",
 }
 
 type token32 struct {
@@ -784,7 +1006,7 @@ type bqlPegBackend struct {
 
 	Buffer string
 	buffer []rune
-	rules  [326]func() bool
+	rules  [437]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -974,210 +1196,218 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction24:
 
-			p.AssembleEmitter()
+			// This is *always* executed, even if there is no
+			// WITH clause present in the statement.
+			p.AssembleWith(begin, end)
 
 		case ruleAction25:
 
-			p.AssembleEmitterOptions(begin, end)
+			p.AssembleCommonTableExpression()
 
 		case ruleAction26:
 
-			p.AssembleEmitterLimit()
+			p.AssembleEmitter()
 
 		case ruleAction27:
 
-			p.AssembleEmitterSampling(CountBasedSampling, 1)
+			p.AssembleEmitterOptions(begin, end)
 
 		case ruleAction28:
 
-			p.AssembleEmitterSampling(RandomizedSampling, 1)
+			p.AssembleEmitterLimit()
 
 		case ruleAction29:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 1)
+			p.AssembleEmitterSampling(CountBasedSampling, 1)
 
 		case ruleAction30:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+			p.AssembleEmitterSampling(RandomizedSampling, 1)
 
 		case ruleAction31:
 
-			p.AssembleProjections(begin, end)
+			p.AssembleEmitterSampling(TimeBasedSampling, 1)
 
 		case ruleAction32:
 
-			p.AssembleAlias()
+			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 
 		case ruleAction33:
 
-			// This is *always* executed, even if there is no
-			// FROM clause present in the statement.
-			p.AssembleWindowedFrom(begin, end)
+			p.AssembleDistinct(begin, end)
 
 		case ruleAction34:
 
-			p.AssembleInterval()
+			p.AssembleProjections(begin, end)
 
 		case ruleAction35:
 
-			p.AssembleInterval()
+			p.AssembleAlias()
 
 		case ruleAction36:
 
 			// This is *always* executed, even if there is no
-			// WHERE clause present in the statement.
-			p.AssembleFilter(begin, end)
+			// FROM clause present in the statement.
+			p.AssembleWindowedFrom(begin, end)
 
 		case ruleAction37:
 
-			// This is *always* executed, even if there is no
-			// GROUP BY clause present in the statement.
-			p.AssembleGrouping(begin, end)
+			p.AssembleInterval()
 
 		case ruleAction38:
 
-			// This is *always* executed, even if there is no
-			// HAVING clause present in the statement.
-			p.AssembleHaving(begin, end)
+			p.AssembleInterval()
 
 		case ruleAction39:
 
-			p.EnsureAliasedStreamWindow()
+			p.AssembleJoin()
 
 		case ruleAction40:
 
-			p.AssembleAliasedStreamWindow()
+			p.AssembleTableJoin()
 
 		case ruleAction41:
 
-			p.AssembleStreamWindow()
+			p.AssembleAliasedTable()
 
 		case ruleAction42:
 
-			p.AssembleUDSFFuncApp()
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, TableAST{Name: substr})
 
 		case ruleAction43:
 
-			p.EnsureCapacitySpec(begin, end)
+			// This is *always* executed, even if there is no
+			// MATCH_RECOGNIZE clause present in the statement.
+			p.AssembleMatchRecognize(begin, end)
 
 		case ruleAction44:
 
-			p.EnsureSheddingSpec(begin, end)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction45:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction46:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.AssemblePatternDefinition()
 
 		case ruleAction47:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.AssemblePatternAlternation(begin, end)
 
 		case ruleAction48:
 
-			p.EnsureIdentifier(begin, end)
+			p.AssemblePatternSequence(begin, end)
 
 		case ruleAction49:
 
-			p.AssembleSourceSinkParam()
+			p.AssemblePatternTerm()
 
 		case ruleAction50:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, PatternAST{Variable: substr, Min: 1, Max: 1})
 
 		case ruleAction51:
 
-			p.AssembleMap(begin, end)
+			substr := string([]rune(buffer)[begin:end])
+			p.AssemblePatternQuantifier(begin, end, substr)
 
 		case ruleAction52:
 
-			p.AssembleKeyValuePair()
+			// This is *always* executed, even if there is no
+			// WHERE clause present in the statement.
+			p.AssembleFilter(begin, end)
 
 		case ruleAction53:
 
-			p.EnsureKeywordPresent(begin, end)
+			// This is *always* executed, even if there is no
+			// GROUP BY clause present in the statement.
+			p.AssembleGrouping(begin, end)
 
 		case ruleAction54:
 
-			p.AssembleBinaryOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// HAVING clause present in the statement.
+			p.AssembleHaving(begin, end)
 
 		case ruleAction55:
 
-			p.AssembleBinaryOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// ORDER BY clause present in the statement.
+			p.AssembleOrdering(begin, end)
 
 		case ruleAction56:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// LIMIT clause present in the statement.
+			p.AssembleLimit(begin, end)
 
 		case ruleAction57:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleOffset(begin, end)
 
 		case ruleAction58:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureAliasedStreamWindow()
 
 		case ruleAction59:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleAliasedStreamWindow()
 
 		case ruleAction60:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleStreamWindow()
 
 		case ruleAction61:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleWindowSpec()
 
 		case ruleAction62:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.AssembleHoppingWindowSpec()
 
 		case ruleAction63:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleSessionWindowSpec()
 
 		case ruleAction64:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleSubquery(begin, end)
 
 		case ruleAction65:
 
-			p.AssembleFuncAppSelector()
+			p.AssembleUDSFFuncApp()
 
 		case ruleAction66:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRaw(substr))
+			p.EnsureCapacitySpec(begin, end)
 
 		case ruleAction67:
 
-			p.AssembleFuncApp()
+			p.EnsureSheddingSpec(begin, end)
 
 		case ruleAction68:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleFuncApp()
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction69:
 
-			p.AssembleExpressions(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction70:
 
-			p.AssembleExpressions(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction71:
 
-			p.AssembleSortedExpression()
+			p.EnsureIdentifier(begin, end)
 
 		case ruleAction72:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleSourceSinkParam()
 
 		case ruleAction73:
 
@@ -1194,277 +1424,492 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction76:
 
-			p.AssembleConditionCase(begin, end)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction77:
 
-			p.AssembleExpressionCase(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction78:
 
-			p.AssembleWhenThenPair()
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction79:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStream(substr))
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction80:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction81:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowValue(substr))
+			p.AssembleIn(begin, end)
 
 		case ruleAction82:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction83:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleBetween(begin, end)
 
 		case ruleAction84:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewFloatLiteral(substr))
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction85:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, FuncName(substr))
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction86:
 
-			p.PushComponent(begin, end, NewNullLiteral())
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction87:
 
-			p.PushComponent(begin, end, NewMissing())
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction88:
 
-			p.PushComponent(begin, end, NewBoolLiteral(true))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction89:
 
-			p.PushComponent(begin, end, NewBoolLiteral(false))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction90:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewWildcard(substr))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction91:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStringLiteral(substr))
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction92:
 
-			p.PushComponent(begin, end, Istream)
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction93:
 
-			p.PushComponent(begin, end, Dstream)
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction94:
 
-			p.PushComponent(begin, end, Rstream)
+			p.AssembleFuncAppSelector()
 
 		case ruleAction95:
 
-			p.PushComponent(begin, end, Tuples)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRaw(substr))
 
 		case ruleAction96:
 
-			p.PushComponent(begin, end, Seconds)
+			p.AssembleFuncApp()
 
 		case ruleAction97:
 
-			p.PushComponent(begin, end, Milliseconds)
+			p.AssembleFuncAppWithinGroup()
 
 		case ruleAction98:
 
-			p.PushComponent(begin, end, Wait)
+			p.AssembleFuncAppOver()
 
 		case ruleAction99:
 
-			p.PushComponent(begin, end, DropOldest)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction100:
 
-			p.PushComponent(begin, end, DropNewest)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction101:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, StreamIdentifier(substr))
+			p.AssembleExpressions(begin, end)
+			p.AssembleFuncApp()
 
 		case ruleAction102:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkType(substr))
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction103:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction104:
 
-			p.PushComponent(begin, end, Yes)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction105:
 
-			p.PushComponent(begin, end, No)
+			p.AssembleSortedExpression()
 
 		case ruleAction106:
 
-			p.PushComponent(begin, end, Yes)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction107:
 
-			p.PushComponent(begin, end, No)
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction108:
 
-			p.PushComponent(begin, end, Bool)
+			p.AssembleMap(begin, end)
 
 		case ruleAction109:
 
-			p.PushComponent(begin, end, Int)
+			p.AssembleKeyValuePair()
 
 		case ruleAction110:
 
-			p.PushComponent(begin, end, Float)
+			p.AssembleConditionCase(begin, end)
 
 		case ruleAction111:
 
-			p.PushComponent(begin, end, String)
+			p.AssembleExpressionCase(begin, end)
 
 		case ruleAction112:
 
-			p.PushComponent(begin, end, Blob)
+			p.AssembleWhenThenPair()
 
 		case ruleAction113:
 
-			p.PushComponent(begin, end, Timestamp)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStream(substr))
 
 		case ruleAction114:
 
-			p.PushComponent(begin, end, Array)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 
 		case ruleAction115:
 
-			p.PushComponent(begin, end, Map)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRowValue(substr))
 
 		case ruleAction116:
 
-			p.PushComponent(begin, end, Or)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction117:
 
-			p.PushComponent(begin, end, And)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction118:
 
-			p.PushComponent(begin, end, Not)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewFloatLiteral(substr))
 
 		case ruleAction119:
 
-			p.PushComponent(begin, end, Equal)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, FuncName(substr))
 
 		case ruleAction120:
 
-			p.PushComponent(begin, end, Less)
+			p.PushComponent(begin, end, NewNullLiteral())
 
 		case ruleAction121:
 
-			p.PushComponent(begin, end, LessOrEqual)
+			p.PushComponent(begin, end, NewMissing())
 
 		case ruleAction122:
 
-			p.PushComponent(begin, end, Greater)
+			p.PushComponent(begin, end, NewBoolLiteral(true))
 
 		case ruleAction123:
 
-			p.PushComponent(begin, end, GreaterOrEqual)
+			p.PushComponent(begin, end, NewBoolLiteral(false))
 
 		case ruleAction124:
 
-			p.PushComponent(begin, end, NotEqual)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewWildcard(substr))
 
 		case ruleAction125:
 
-			p.PushComponent(begin, end, Concat)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStringLiteral(substr))
 
 		case ruleAction126:
 
-			p.PushComponent(begin, end, Is)
+			p.PushComponent(begin, end, Istream)
 
 		case ruleAction127:
 
-			p.PushComponent(begin, end, IsNot)
+			p.PushComponent(begin, end, Dstream)
 
 		case ruleAction128:
 
-			p.PushComponent(begin, end, Plus)
+			p.PushComponent(begin, end, Rstream)
 
 		case ruleAction129:
 
-			p.PushComponent(begin, end, Minus)
+			p.PushComponent(begin, end, Tuples)
 
 		case ruleAction130:
 
-			p.PushComponent(begin, end, Multiply)
+			p.PushComponent(begin, end, Seconds)
 
 		case ruleAction131:
 
-			p.PushComponent(begin, end, Divide)
+			p.PushComponent(begin, end, Milliseconds)
 
 		case ruleAction132:
 
-			p.PushComponent(begin, end, Modulo)
+			p.PushComponent(begin, end, SlidingWindow)
 
 		case ruleAction133:
 
-			p.PushComponent(begin, end, UnaryMinus)
+			p.PushComponent(begin, end, TumblingWindow)
 
 		case ruleAction134:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, Identifier(substr))
+			p.PushComponent(begin, end, HoppingWindow)
 
 		case ruleAction135:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, Identifier(substr))
+			p.PushComponent(begin, end, SessionWindow)
 
-		}
-	}
-	_, _, _, _, _ = buffer, _buffer, text, begin, end
-}
+		case ruleAction136:
 
-func (p *bqlPegBackend) Init() {
-	var (
-		max                  token32
-		position, tokenIndex uint32
-		buffer               []rune
-	)
-	p.reset = func() {
-		max = token32{}
-		position, tokenIndex = 0, 0
+			p.PushComponent(begin, end, InnerJoin)
 
-		p.buffer = []rune(p.Buffer)
-		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
-			p.buffer = append(p.buffer, endSymbol)
-		}
+		case ruleAction137:
+
+			p.PushComponent(begin, end, LeftOuterJoin)
+
+		case ruleAction138:
+
+			p.PushComponent(begin, end, FullOuterJoin)
+
+		case ruleAction139:
+
+			p.PushComponent(begin, end, Wait)
+
+		case ruleAction140:
+
+			p.PushComponent(begin, end, DropOldest)
+
+		case ruleAction141:
+
+			p.PushComponent(begin, end, DropNewest)
+
+		case ruleAction142:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, StreamIdentifier(substr))
+
+		case ruleAction143:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkType(substr))
+
+		case ruleAction144:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+
+		case ruleAction145:
+
+			p.PushComponent(begin, end, Yes)
+
+		case ruleAction146:
+
+			p.PushComponent(begin, end, No)
+
+		case ruleAction147:
+
+			p.PushComponent(begin, end, Yes)
+
+		case ruleAction148:
+
+			p.PushComponent(begin, end, Yes)
+
+		case ruleAction149:
+
+			p.PushComponent(begin, end, No)
+
+		case ruleAction150:
+
+			p.PushComponent(begin, end, Bool)
+
+		case ruleAction151:
+
+			p.PushComponent(begin, end, Int)
+
+		case ruleAction152:
+
+			p.PushComponent(begin, end, Float)
+
+		case ruleAction153:
+
+			p.PushComponent(begin, end, String)
+
+		case ruleAction154:
+
+			p.PushComponent(begin, end, Blob)
+
+		case ruleAction155:
+
+			p.PushComponent(begin, end, Timestamp)
+
+		case ruleAction156:
+
+			p.PushComponent(begin, end, Array)
+
+		case ruleAction157:
+
+			p.PushComponent(begin, end, Map)
+
+		case ruleAction158:
+
+			p.PushComponent(begin, end, Or)
+
+		case ruleAction159:
+
+			p.PushComponent(begin, end, And)
+
+		case ruleAction160:
+
+			p.PushComponent(begin, end, Not)
+
+		case ruleAction161:
+
+			p.PushComponent(begin, end, Equal)
+
+		case ruleAction162:
+
+			p.PushComponent(begin, end, Less)
+
+		case ruleAction163:
+
+			p.PushComponent(begin, end, LessOrEqual)
+
+		case ruleAction164:
+
+			p.PushComponent(begin, end, Greater)
+
+		case ruleAction165:
+
+			p.PushComponent(begin, end, GreaterOrEqual)
+
+		case ruleAction166:
+
+			p.PushComponent(begin, end, NotEqual)
+
+		case ruleAction167:
+
+			p.PushComponent(begin, end, Like)
+
+		case ruleAction168:
+
+			p.PushComponent(begin, end, NotLike)
+
+		case ruleAction169:
+
+			p.PushComponent(begin, end, ILike)
+
+		case ruleAction170:
+
+			p.PushComponent(begin, end, NotILike)
+
+		case ruleAction171:
+
+			p.PushComponent(begin, end, SimilarTo)
+
+		case ruleAction172:
+
+			p.PushComponent(begin, end, NotSimilarTo)
+
+		case ruleAction173:
+
+			p.PushComponent(begin, end, RegexpMatch)
+
+		case ruleAction174:
+
+			p.PushComponent(begin, end, NotRegexpMatch)
+
+		case ruleAction175:
+
+			p.PushComponent(begin, end, RegexpIMatch)
+
+		case ruleAction176:
+
+			p.PushComponent(begin, end, NotRegexpIMatch)
+
+		case ruleAction177:
+
+			p.PushComponent(begin, end, Concat)
+
+		case ruleAction178:
+
+			p.PushComponent(begin, end, Is)
+
+		case ruleAction179:
+
+			p.PushComponent(begin, end, IsNot)
+
+		case ruleAction180:
+
+			p.PushComponent(begin, end, Plus)
+
+		case ruleAction181:
+
+			p.PushComponent(begin, end, Minus)
+
+		case ruleAction182:
+
+			p.PushComponent(begin, end, Multiply)
+
+		case ruleAction183:
+
+			p.PushComponent(begin, end, Divide)
+
+		case ruleAction184:
+
+			p.PushComponent(begin, end, Modulo)
+
+		case ruleAction185:
+
+			p.PushComponent(begin, end, AtTimeZone)
+
+		case ruleAction186:
+
+			p.PushComponent(begin, end, UnaryMinus)
+
+		case ruleAction187:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, Identifier(substr))
+
+		case ruleAction188:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, Identifier(substr))
+
+		}
+	}
+	_, _, _, _, _ = buffer, _buffer, text, begin, end
+}
+
+func (p *bqlPegBackend) Init() {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		buffer               []rune
+	)
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
 		buffer = p.buffer
 	}
 	p.reset()
@@ -1844,11 +2289,14 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex = position43, tokenIndex43
 			return false
 		},
-		/* 8 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having Action2)> */
+		/* 8 SelectStmt <- <(WithOpt (('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T')) Emitter DistinctOpt Projections WindowedFrom MatchRecognize Filter Grouping Having Ordering Limit Offset Action2)> */
 		func() bool {
 			position49, tokenIndex49 := position, tokenIndex
 			{
 				position50 := position
+				if !_rules[ruleWithOpt]() {
+					goto l49
+				}
 				{
 					position51, tokenIndex51 := position, tokenIndex
 					if buffer[position] != rune('s') {
@@ -1942,12 +2390,18 @@ func (p *bqlPegBackend) Init() {
 				if !_rules[ruleEmitter]() {
 					goto l49
 				}
+				if !_rules[ruleDistinctOpt]() {
+					goto l49
+				}
 				if !_rules[ruleProjections]() {
 					goto l49
 				}
 				if !_rules[ruleWindowedFrom]() {
 					goto l49
 				}
+				if !_rules[ruleMatchRecognize]() {
+					goto l49
+				}
 				if !_rules[ruleFilter]() {
 					goto l49
 				}
@@ -1957,6 +2411,15 @@ func (p *bqlPegBackend) Init() {
 				if !_rules[ruleHaving]() {
 					goto l49
 				}
+				if !_rules[ruleOrdering]() {
+					goto l49
+				}
+				if !_rules[ruleLimit]() {
+					goto l49
+				}
+				if !_rules[ruleOffset]() {
+					goto l49
+				}
 				if !_rules[ruleAction2]() {
 					goto l49
 				}
@@ -2260,7 +2723,7 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex = position63, tokenIndex63
 			return false
 		},
-		/* 10 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
+		/* 10 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt SourceSinkSpecs Action4)> */
 		func() bool {
 			position100, tokenIndex100 := position, tokenIndex
 			{
@@ -2493,6 +2956,9 @@ func (p *bqlPegBackend) Init() {
 				if !_rules[ruleSelectStmt]() {
 					goto l100
 				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l100
+				}
 				if !_rules[ruleAction4]() {
 					goto l100
 				}
@@ -6529,11619 +6995,18258 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex = position616, tokenIndex616
 			return false
 		},
-		/* 30 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action24)> */
+		/* 30 WithOpt <- <(<(('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H') sp CommonTableExpression (spOpt ',' spOpt CommonTableExpression)* sp)?> Action24)> */
 		func() bool {
 			position633, tokenIndex633 := position, tokenIndex
 			{
 				position634 := position
-				if !_rules[rulesp]() {
-					goto l633
-				}
 				{
-					position635, tokenIndex635 := position, tokenIndex
-					if !_rules[ruleISTREAM]() {
-						goto l636
-					}
-					goto l635
-				l636:
-					position, tokenIndex = position635, tokenIndex635
-					if !_rules[ruleDSTREAM]() {
+					position635 := position
+					{
+						position636, tokenIndex636 := position, tokenIndex
+						{
+							position638, tokenIndex638 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l639
+							}
+							position++
+							goto l638
+						l639:
+							position, tokenIndex = position638, tokenIndex638
+							if buffer[position] != rune('W') {
+								goto l636
+							}
+							position++
+						}
+					l638:
+						{
+							position640, tokenIndex640 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l641
+							}
+							position++
+							goto l640
+						l641:
+							position, tokenIndex = position640, tokenIndex640
+							if buffer[position] != rune('I') {
+								goto l636
+							}
+							position++
+						}
+					l640:
+						{
+							position642, tokenIndex642 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l643
+							}
+							position++
+							goto l642
+						l643:
+							position, tokenIndex = position642, tokenIndex642
+							if buffer[position] != rune('T') {
+								goto l636
+							}
+							position++
+						}
+					l642:
+						{
+							position644, tokenIndex644 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l645
+							}
+							position++
+							goto l644
+						l645:
+							position, tokenIndex = position644, tokenIndex644
+							if buffer[position] != rune('H') {
+								goto l636
+							}
+							position++
+						}
+					l644:
+						if !_rules[rulesp]() {
+							goto l636
+						}
+						if !_rules[ruleCommonTableExpression]() {
+							goto l636
+						}
+					l646:
+						{
+							position647, tokenIndex647 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l647
+							}
+							if buffer[position] != rune(',') {
+								goto l647
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l647
+							}
+							if !_rules[ruleCommonTableExpression]() {
+								goto l647
+							}
+							goto l646
+						l647:
+							position, tokenIndex = position647, tokenIndex647
+						}
+						if !_rules[rulesp]() {
+							goto l636
+						}
 						goto l637
+					l636:
+						position, tokenIndex = position636, tokenIndex636
 					}
-					goto l635
 				l637:
-					position, tokenIndex = position635, tokenIndex635
-					if !_rules[ruleRSTREAM]() {
-						goto l633
-					}
-				}
-			l635:
-				if !_rules[ruleEmitterOptions]() {
-					goto l633
+					add(rulePegText, position635)
 				}
 				if !_rules[ruleAction24]() {
 					goto l633
 				}
-				add(ruleEmitter, position634)
+				add(ruleWithOpt, position634)
 			}
 			return true
 		l633:
 			position, tokenIndex = position633, tokenIndex633
 			return false
 		},
-		/* 31 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action25)> */
+		/* 31 CommonTableExpression <- <(StreamIdentifier sp (('a' / 'A') ('s' / 'S')) spOpt '(' spOpt SelectStmt spOpt ')' Action25)> */
 		func() bool {
-			position638, tokenIndex638 := position, tokenIndex
+			position648, tokenIndex648 := position, tokenIndex
 			{
-				position639 := position
+				position649 := position
+				if !_rules[ruleStreamIdentifier]() {
+					goto l648
+				}
+				if !_rules[rulesp]() {
+					goto l648
+				}
 				{
-					position640 := position
-					{
-						position641, tokenIndex641 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l641
-						}
-						if buffer[position] != rune('[') {
-							goto l641
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l641
+					position650, tokenIndex650 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l651
+					}
+					position++
+					goto l650
+				l651:
+					position, tokenIndex = position650, tokenIndex650
+					if buffer[position] != rune('A') {
+						goto l648
+					}
+					position++
+				}
+			l650:
+				{
+					position652, tokenIndex652 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l653
+					}
+					position++
+					goto l652
+				l653:
+					position, tokenIndex = position652, tokenIndex652
+					if buffer[position] != rune('S') {
+						goto l648
+					}
+					position++
+				}
+			l652:
+				if !_rules[rulespOpt]() {
+					goto l648
+				}
+				if buffer[position] != rune('(') {
+					goto l648
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l648
+				}
+				if !_rules[ruleSelectStmt]() {
+					goto l648
+				}
+				if !_rules[rulespOpt]() {
+					goto l648
+				}
+				if buffer[position] != rune(')') {
+					goto l648
+				}
+				position++
+				if !_rules[ruleAction25]() {
+					goto l648
+				}
+				add(ruleCommonTableExpression, position649)
+			}
+			return true
+		l648:
+			position, tokenIndex = position648, tokenIndex648
+			return false
+		},
+		/* 32 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action26)> */
+		func() bool {
+			position654, tokenIndex654 := position, tokenIndex
+			{
+				position655 := position
+				if !_rules[rulesp]() {
+					goto l654
+				}
+				{
+					position656, tokenIndex656 := position, tokenIndex
+					if !_rules[ruleISTREAM]() {
+						goto l657
+					}
+					goto l656
+				l657:
+					position, tokenIndex = position656, tokenIndex656
+					if !_rules[ruleDSTREAM]() {
+						goto l658
+					}
+					goto l656
+				l658:
+					position, tokenIndex = position656, tokenIndex656
+					if !_rules[ruleRSTREAM]() {
+						goto l654
+					}
+				}
+			l656:
+				if !_rules[ruleEmitterOptions]() {
+					goto l654
+				}
+				if !_rules[ruleAction26]() {
+					goto l654
+				}
+				add(ruleEmitter, position655)
+			}
+			return true
+		l654:
+			position, tokenIndex = position654, tokenIndex654
+			return false
+		},
+		/* 33 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action27)> */
+		func() bool {
+			position659, tokenIndex659 := position, tokenIndex
+			{
+				position660 := position
+				{
+					position661 := position
+					{
+						position662, tokenIndex662 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l662
+						}
+						if buffer[position] != rune('[') {
+							goto l662
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l662
 						}
 						if !_rules[ruleEmitterOptionCombinations]() {
-							goto l641
+							goto l662
 						}
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l662
 						}
 						if buffer[position] != rune(']') {
-							goto l641
+							goto l662
 						}
 						position++
-						goto l642
-					l641:
-						position, tokenIndex = position641, tokenIndex641
+						goto l663
+					l662:
+						position, tokenIndex = position662, tokenIndex662
 					}
-				l642:
-					add(rulePegText, position640)
+				l663:
+					add(rulePegText, position661)
 				}
-				if !_rules[ruleAction25]() {
-					goto l638
+				if !_rules[ruleAction27]() {
+					goto l659
 				}
-				add(ruleEmitterOptions, position639)
+				add(ruleEmitterOptions, position660)
 			}
 			return true
-		l638:
-			position, tokenIndex = position638, tokenIndex638
+		l659:
+			position, tokenIndex = position659, tokenIndex659
 			return false
 		},
-		/* 32 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
+		/* 34 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
 		func() bool {
-			position643, tokenIndex643 := position, tokenIndex
+			position664, tokenIndex664 := position, tokenIndex
 			{
-				position644 := position
+				position665 := position
 				{
-					position645, tokenIndex645 := position, tokenIndex
+					position666, tokenIndex666 := position, tokenIndex
 					if !_rules[ruleEmitterLimit]() {
-						goto l646
+						goto l667
 					}
-					goto l645
-				l646:
-					position, tokenIndex = position645, tokenIndex645
+					goto l666
+				l667:
+					position, tokenIndex = position666, tokenIndex666
 					if !_rules[ruleEmitterSample]() {
-						goto l647
+						goto l668
 					}
 					if !_rules[rulesp]() {
-						goto l647
+						goto l668
 					}
 					if !_rules[ruleEmitterLimit]() {
-						goto l647
+						goto l668
 					}
-					goto l645
-				l647:
-					position, tokenIndex = position645, tokenIndex645
+					goto l666
+				l668:
+					position, tokenIndex = position666, tokenIndex666
 					if !_rules[ruleEmitterSample]() {
-						goto l643
+						goto l664
 					}
 				}
-			l645:
-				add(ruleEmitterOptionCombinations, position644)
+			l666:
+				add(ruleEmitterOptionCombinations, position665)
 			}
 			return true
-		l643:
-			position, tokenIndex = position643, tokenIndex643
+		l664:
+			position, tokenIndex = position664, tokenIndex664
 			return false
 		},
-		/* 33 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action26)> */
+		/* 35 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action28)> */
 		func() bool {
-			position648, tokenIndex648 := position, tokenIndex
+			position669, tokenIndex669 := position, tokenIndex
 			{
-				position649 := position
+				position670 := position
 				{
-					position650, tokenIndex650 := position, tokenIndex
+					position671, tokenIndex671 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l651
+						goto l672
 					}
 					position++
-					goto l650
-				l651:
-					position, tokenIndex = position650, tokenIndex650
+					goto l671
+				l672:
+					position, tokenIndex = position671, tokenIndex671
 					if buffer[position] != rune('L') {
-						goto l648
+						goto l669
 					}
 					position++
 				}
-			l650:
+			l671:
 				{
-					position652, tokenIndex652 := position, tokenIndex
+					position673, tokenIndex673 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l653
+						goto l674
 					}
 					position++
-					goto l652
-				l653:
-					position, tokenIndex = position652, tokenIndex652
+					goto l673
+				l674:
+					position, tokenIndex = position673, tokenIndex673
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l669
 					}
 					position++
 				}
-			l652:
+			l673:
 				{
-					position654, tokenIndex654 := position, tokenIndex
+					position675, tokenIndex675 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l655
+						goto l676
 					}
 					position++
-					goto l654
-				l655:
-					position, tokenIndex = position654, tokenIndex654
+					goto l675
+				l676:
+					position, tokenIndex = position675, tokenIndex675
 					if buffer[position] != rune('M') {
-						goto l648
+						goto l669
 					}
 					position++
 				}
-			l654:
+			l675:
 				{
-					position656, tokenIndex656 := position, tokenIndex
+					position677, tokenIndex677 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l657
+						goto l678
 					}
 					position++
-					goto l656
-				l657:
-					position, tokenIndex = position656, tokenIndex656
+					goto l677
+				l678:
+					position, tokenIndex = position677, tokenIndex677
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l669
 					}
 					position++
 				}
-			l656:
+			l677:
 				{
-					position658, tokenIndex658 := position, tokenIndex
+					position679, tokenIndex679 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l659
+						goto l680
 					}
 					position++
-					goto l658
-				l659:
-					position, tokenIndex = position658, tokenIndex658
+					goto l679
+				l680:
+					position, tokenIndex = position679, tokenIndex679
 					if buffer[position] != rune('T') {
-						goto l648
+						goto l669
 					}
 					position++
 				}
-			l658:
+			l679:
 				if !_rules[rulesp]() {
-					goto l648
+					goto l669
 				}
 				if !_rules[ruleNumericLiteral]() {
-					goto l648
+					goto l669
 				}
-				if !_rules[ruleAction26]() {
-					goto l648
+				if !_rules[ruleAction28]() {
+					goto l669
 				}
-				add(ruleEmitterLimit, position649)
+				add(ruleEmitterLimit, position670)
 			}
 			return true
-		l648:
-			position, tokenIndex = position648, tokenIndex648
+		l669:
+			position, tokenIndex = position669, tokenIndex669
 			return false
 		},
-		/* 34 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
+		/* 36 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
 		func() bool {
-			position660, tokenIndex660 := position, tokenIndex
+			position681, tokenIndex681 := position, tokenIndex
 			{
-				position661 := position
+				position682 := position
 				{
-					position662, tokenIndex662 := position, tokenIndex
+					position683, tokenIndex683 := position, tokenIndex
 					if !_rules[ruleCountBasedSampling]() {
-						goto l663
+						goto l684
 					}
-					goto l662
-				l663:
-					position, tokenIndex = position662, tokenIndex662
+					goto l683
+				l684:
+					position, tokenIndex = position683, tokenIndex683
 					if !_rules[ruleRandomizedSampling]() {
-						goto l664
+						goto l685
 					}
-					goto l662
-				l664:
-					position, tokenIndex = position662, tokenIndex662
+					goto l683
+				l685:
+					position, tokenIndex = position683, tokenIndex683
 					if !_rules[ruleTimeBasedSampling]() {
-						goto l660
+						goto l681
 					}
 				}
-			l662:
-				add(ruleEmitterSample, position661)
+			l683:
+				add(ruleEmitterSample, position682)
 			}
 			return true
-		l660:
-			position, tokenIndex = position660, tokenIndex660
+		l681:
+			position, tokenIndex = position681, tokenIndex681
 			return false
 		},
-		/* 35 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action27)> */
+		/* 37 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action29)> */
 		func() bool {
-			position665, tokenIndex665 := position, tokenIndex
+			position686, tokenIndex686 := position, tokenIndex
 			{
-				position666 := position
+				position687 := position
 				{
-					position667, tokenIndex667 := position, tokenIndex
+					position688, tokenIndex688 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l668
+						goto l689
 					}
 					position++
-					goto l667
-				l668:
-					position, tokenIndex = position667, tokenIndex667
+					goto l688
+				l689:
+					position, tokenIndex = position688, tokenIndex688
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l667:
+			l688:
 				{
-					position669, tokenIndex669 := position, tokenIndex
+					position690, tokenIndex690 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l670
+						goto l691
 					}
 					position++
-					goto l669
-				l670:
-					position, tokenIndex = position669, tokenIndex669
+					goto l690
+				l691:
+					position, tokenIndex = position690, tokenIndex690
 					if buffer[position] != rune('V') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l669:
+			l690:
 				{
-					position671, tokenIndex671 := position, tokenIndex
+					position692, tokenIndex692 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l672
+						goto l693
 					}
 					position++
-					goto l671
-				l672:
-					position, tokenIndex = position671, tokenIndex671
+					goto l692
+				l693:
+					position, tokenIndex = position692, tokenIndex692
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l671:
+			l692:
 				{
-					position673, tokenIndex673 := position, tokenIndex
+					position694, tokenIndex694 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l674
+						goto l695
 					}
 					position++
-					goto l673
-				l674:
-					position, tokenIndex = position673, tokenIndex673
+					goto l694
+				l695:
+					position, tokenIndex = position694, tokenIndex694
 					if buffer[position] != rune('R') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l673:
+			l694:
 				{
-					position675, tokenIndex675 := position, tokenIndex
+					position696, tokenIndex696 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l676
+						goto l697
 					}
 					position++
-					goto l675
-				l676:
-					position, tokenIndex = position675, tokenIndex675
+					goto l696
+				l697:
+					position, tokenIndex = position696, tokenIndex696
 					if buffer[position] != rune('Y') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l675:
+			l696:
 				if !_rules[rulesp]() {
-					goto l665
+					goto l686
 				}
 				if !_rules[ruleNumericLiteral]() {
-					goto l665
+					goto l686
 				}
 				if !_rules[rulespOpt]() {
-					goto l665
+					goto l686
 				}
 				{
-					position677, tokenIndex677 := position, tokenIndex
+					position698, tokenIndex698 := position, tokenIndex
 					if buffer[position] != rune('-') {
-						goto l677
+						goto l698
 					}
 					position++
-					goto l678
-				l677:
-					position, tokenIndex = position677, tokenIndex677
+					goto l699
+				l698:
+					position, tokenIndex = position698, tokenIndex698
 				}
-			l678:
+			l699:
 				if !_rules[rulespOpt]() {
-					goto l665
+					goto l686
 				}
 				{
-					position679, tokenIndex679 := position, tokenIndex
+					position700, tokenIndex700 := position, tokenIndex
 					{
-						position681, tokenIndex681 := position, tokenIndex
+						position702, tokenIndex702 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l682
+							goto l703
 						}
 						position++
-						goto l681
-					l682:
-						position, tokenIndex = position681, tokenIndex681
+						goto l702
+					l703:
+						position, tokenIndex = position702, tokenIndex702
 						if buffer[position] != rune('S') {
-							goto l680
+							goto l701
 						}
 						position++
 					}
-				l681:
+				l702:
 					{
-						position683, tokenIndex683 := position, tokenIndex
+						position704, tokenIndex704 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l684
+							goto l705
 						}
 						position++
-						goto l683
-					l684:
-						position, tokenIndex = position683, tokenIndex683
+						goto l704
+					l705:
+						position, tokenIndex = position704, tokenIndex704
 						if buffer[position] != rune('T') {
-							goto l680
+							goto l701
 						}
 						position++
 					}
-				l683:
-					goto l679
-				l680:
-					position, tokenIndex = position679, tokenIndex679
+				l704:
+					goto l700
+				l701:
+					position, tokenIndex = position700, tokenIndex700
 					{
-						position686, tokenIndex686 := position, tokenIndex
+						position707, tokenIndex707 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l687
+							goto l708
 						}
 						position++
-						goto l686
-					l687:
-						position, tokenIndex = position686, tokenIndex686
+						goto l707
+					l708:
+						position, tokenIndex = position707, tokenIndex707
 						if buffer[position] != rune('N') {
-							goto l685
+							goto l706
 						}
 						position++
 					}
-				l686:
+				l707:
 					{
-						position688, tokenIndex688 := position, tokenIndex
+						position709, tokenIndex709 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l689
+							goto l710
 						}
 						position++
-						goto l688
-					l689:
-						position, tokenIndex = position688, tokenIndex688
+						goto l709
+					l710:
+						position, tokenIndex = position709, tokenIndex709
 						if buffer[position] != rune('D') {
-							goto l685
+							goto l706
 						}
 						position++
 					}
-				l688:
-					goto l679
-				l685:
-					position, tokenIndex = position679, tokenIndex679
+				l709:
+					goto l700
+				l706:
+					position, tokenIndex = position700, tokenIndex700
 					{
-						position691, tokenIndex691 := position, tokenIndex
+						position712, tokenIndex712 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l692
+							goto l713
 						}
 						position++
-						goto l691
-					l692:
-						position, tokenIndex = position691, tokenIndex691
+						goto l712
+					l713:
+						position, tokenIndex = position712, tokenIndex712
 						if buffer[position] != rune('R') {
-							goto l690
+							goto l711
 						}
 						position++
 					}
-				l691:
+				l712:
 					{
-						position693, tokenIndex693 := position, tokenIndex
+						position714, tokenIndex714 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l694
+							goto l715
 						}
 						position++
-						goto l693
-					l694:
-						position, tokenIndex = position693, tokenIndex693
+						goto l714
+					l715:
+						position, tokenIndex = position714, tokenIndex714
 						if buffer[position] != rune('D') {
-							goto l690
+							goto l711
 						}
 						position++
 					}
-				l693:
-					goto l679
-				l690:
-					position, tokenIndex = position679, tokenIndex679
+				l714:
+					goto l700
+				l711:
+					position, tokenIndex = position700, tokenIndex700
 					{
-						position695, tokenIndex695 := position, tokenIndex
+						position716, tokenIndex716 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l696
+							goto l717
 						}
 						position++
-						goto l695
-					l696:
-						position, tokenIndex = position695, tokenIndex695
+						goto l716
+					l717:
+						position, tokenIndex = position716, tokenIndex716
 						if buffer[position] != rune('T') {
-							goto l665
+							goto l686
 						}
 						position++
 					}
-				l695:
+				l716:
 					{
-						position697, tokenIndex697 := position, tokenIndex
+						position718, tokenIndex718 := position, tokenIndex
 						if buffer[position] != rune('h') {
-							goto l698
+							goto l719
 						}
 						position++
-						goto l697
-					l698:
-						position, tokenIndex = position697, tokenIndex697
+						goto l718
+					l719:
+						position, tokenIndex = position718, tokenIndex718
 						if buffer[position] != rune('H') {
-							goto l665
+							goto l686
 						}
 						position++
 					}
-				l697:
+				l718:
 				}
-			l679:
+			l700:
 				if !_rules[rulesp]() {
-					goto l665
+					goto l686
 				}
 				{
-					position699, tokenIndex699 := position, tokenIndex
+					position720, tokenIndex720 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l700
+						goto l721
 					}
 					position++
-					goto l699
-				l700:
-					position, tokenIndex = position699, tokenIndex699
+					goto l720
+				l721:
+					position, tokenIndex = position720, tokenIndex720
 					if buffer[position] != rune('T') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l699:
+			l720:
 				{
-					position701, tokenIndex701 := position, tokenIndex
+					position722, tokenIndex722 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l702
+						goto l723
 					}
 					position++
-					goto l701
-				l702:
-					position, tokenIndex = position701, tokenIndex701
+					goto l722
+				l723:
+					position, tokenIndex = position722, tokenIndex722
 					if buffer[position] != rune('U') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l701:
+			l722:
 				{
-					position703, tokenIndex703 := position, tokenIndex
+					position724, tokenIndex724 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l704
+						goto l725
 					}
 					position++
-					goto l703
-				l704:
-					position, tokenIndex = position703, tokenIndex703
+					goto l724
+				l725:
+					position, tokenIndex = position724, tokenIndex724
 					if buffer[position] != rune('P') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l703:
+			l724:
 				{
-					position705, tokenIndex705 := position, tokenIndex
+					position726, tokenIndex726 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l706
+						goto l727
 					}
 					position++
-					goto l705
-				l706:
-					position, tokenIndex = position705, tokenIndex705
+					goto l726
+				l727:
+					position, tokenIndex = position726, tokenIndex726
 					if buffer[position] != rune('L') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l705:
+			l726:
 				{
-					position707, tokenIndex707 := position, tokenIndex
+					position728, tokenIndex728 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l708
+						goto l729
 					}
 					position++
-					goto l707
-				l708:
-					position, tokenIndex = position707, tokenIndex707
+					goto l728
+				l729:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l686
 					}
 					position++
 				}
-			l707:
-				if !_rules[ruleAction27]() {
-					goto l665
+			l728:
+				if !_rules[ruleAction29]() {
+					goto l686
 				}
-				add(ruleCountBasedSampling, position666)
+				add(ruleCountBasedSampling, position687)
 			}
 			return true
-		l665:
-			position, tokenIndex = position665, tokenIndex665
+		l686:
+			position, tokenIndex = position686, tokenIndex686
 			return false
 		},
-		/* 36 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action28)> */
+		/* 38 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action30)> */
 		func() bool {
-			position709, tokenIndex709 := position, tokenIndex
+			position730, tokenIndex730 := position, tokenIndex
 			{
-				position710 := position
+				position731 := position
 				{
-					position711, tokenIndex711 := position, tokenIndex
+					position732, tokenIndex732 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l712
+						goto l733
 					}
 					position++
-					goto l711
-				l712:
-					position, tokenIndex = position711, tokenIndex711
+					goto l732
+				l733:
+					position, tokenIndex = position732, tokenIndex732
 					if buffer[position] != rune('S') {
-						goto l709
+						goto l730
 					}
 					position++
 				}
-			l711:
+			l732:
 				{
-					position713, tokenIndex713 := position, tokenIndex
+					position734, tokenIndex734 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l714
+						goto l735
 					}
 					position++
-					goto l713
-				l714:
-					position, tokenIndex = position713, tokenIndex713
+					goto l734
+				l735:
+					position, tokenIndex = position734, tokenIndex734
 					if buffer[position] != rune('A') {
-						goto l709
+						goto l730
 					}
 					position++
 				}
-			l713:
+			l734:
 				{
-					position715, tokenIndex715 := position, tokenIndex
+					position736, tokenIndex736 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l716
+						goto l737
 					}
 					position++
-					goto l715
-				l716:
-					position, tokenIndex = position715, tokenIndex715
+					goto l736
+				l737:
+					position, tokenIndex = position736, tokenIndex736
 					if buffer[position] != rune('M') {
-						goto l709
+						goto l730
 					}
 					position++
 				}
-			l715:
+			l736:
 				{
-					position717, tokenIndex717 := position, tokenIndex
+					position738, tokenIndex738 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l718
+						goto l739
 					}
 					position++
-					goto l717
-				l718:
-					position, tokenIndex = position717, tokenIndex717
+					goto l738
+				l739:
+					position, tokenIndex = position738, tokenIndex738
 					if buffer[position] != rune('P') {
-						goto l709
+						goto l730
 					}
 					position++
 				}
-			l717:
+			l738:
 				{
-					position719, tokenIndex719 := position, tokenIndex
+					position740, tokenIndex740 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l720
+						goto l741
 					}
 					position++
-					goto l719
-				l720:
-					position, tokenIndex = position719, tokenIndex719
+					goto l740
+				l741:
+					position, tokenIndex = position740, tokenIndex740
 					if buffer[position] != rune('L') {
-						goto l709
+						goto l730
 					}
 					position++
 				}
-			l719:
+			l740:
 				{
-					position721, tokenIndex721 := position, tokenIndex
+					position742, tokenIndex742 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l722
+						goto l743
 					}
 					position++
-					goto l721
-				l722:
-					position, tokenIndex = position721, tokenIndex721
+					goto l742
+				l743:
+					position, tokenIndex = position742, tokenIndex742
 					if buffer[position] != rune('E') {
-						goto l709
+						goto l730
 					}
 					position++
 				}
-			l721:
+			l742:
 				if !_rules[rulesp]() {
-					goto l709
+					goto l730
 				}
 				{
-					position723, tokenIndex723 := position, tokenIndex
+					position744, tokenIndex744 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l724
+						goto l745
 					}
-					goto l723
-				l724:
-					position, tokenIndex = position723, tokenIndex723
+					goto l744
+				l745:
+					position, tokenIndex = position744, tokenIndex744
 					if !_rules[ruleNumericLiteral]() {
-						goto l709
+						goto l730
 					}
 				}
-			l723:
+			l744:
 				if !_rules[rulespOpt]() {
-					goto l709
+					goto l730
 				}
 				if buffer[position] != rune('%') {
-					goto l709
+					goto l730
 				}
 				position++
-				if !_rules[ruleAction28]() {
-					goto l709
+				if !_rules[ruleAction30]() {
+					goto l730
 				}
-				add(ruleRandomizedSampling, position710)
+				add(ruleRandomizedSampling, position731)
 			}
 			return true
-		l709:
-			position, tokenIndex = position709, tokenIndex709
+		l730:
+			position, tokenIndex = position730, tokenIndex730
 			return false
 		},
-		/* 37 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
+		/* 39 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
 		func() bool {
-			position725, tokenIndex725 := position, tokenIndex
+			position746, tokenIndex746 := position, tokenIndex
 			{
-				position726 := position
+				position747 := position
 				{
-					position727, tokenIndex727 := position, tokenIndex
+					position748, tokenIndex748 := position, tokenIndex
 					if !_rules[ruleTimeBasedSamplingSeconds]() {
-						goto l728
+						goto l749
 					}
-					goto l727
-				l728:
-					position, tokenIndex = position727, tokenIndex727
+					goto l748
+				l749:
+					position, tokenIndex = position748, tokenIndex748
 					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
-						goto l725
+						goto l746
 					}
 				}
-			l727:
-				add(ruleTimeBasedSampling, position726)
+			l748:
+				add(ruleTimeBasedSampling, position747)
 			}
 			return true
-		l725:
-			position, tokenIndex = position725, tokenIndex725
+		l746:
+			position, tokenIndex = position746, tokenIndex746
 			return false
 		},
-		/* 38 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action29)> */
+		/* 40 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action31)> */
 		func() bool {
-			position729, tokenIndex729 := position, tokenIndex
+			position750, tokenIndex750 := position, tokenIndex
 			{
-				position730 := position
+				position751 := position
 				{
-					position731, tokenIndex731 := position, tokenIndex
+					position752, tokenIndex752 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l732
+						goto l753
 					}
 					position++
-					goto l731
-				l732:
-					position, tokenIndex = position731, tokenIndex731
+					goto l752
+				l753:
+					position, tokenIndex = position752, tokenIndex752
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l731:
+			l752:
 				{
-					position733, tokenIndex733 := position, tokenIndex
+					position754, tokenIndex754 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l734
+						goto l755
 					}
 					position++
-					goto l733
-				l734:
-					position, tokenIndex = position733, tokenIndex733
+					goto l754
+				l755:
+					position, tokenIndex = position754, tokenIndex754
 					if buffer[position] != rune('V') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l733:
+			l754:
 				{
-					position735, tokenIndex735 := position, tokenIndex
+					position756, tokenIndex756 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l736
+						goto l757
 					}
 					position++
-					goto l735
-				l736:
-					position, tokenIndex = position735, tokenIndex735
+					goto l756
+				l757:
+					position, tokenIndex = position756, tokenIndex756
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l735:
+			l756:
 				{
-					position737, tokenIndex737 := position, tokenIndex
+					position758, tokenIndex758 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l738
+						goto l759
 					}
 					position++
-					goto l737
-				l738:
-					position, tokenIndex = position737, tokenIndex737
+					goto l758
+				l759:
+					position, tokenIndex = position758, tokenIndex758
 					if buffer[position] != rune('R') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l737:
+			l758:
 				{
-					position739, tokenIndex739 := position, tokenIndex
+					position760, tokenIndex760 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l740
+						goto l761
 					}
 					position++
-					goto l739
-				l740:
-					position, tokenIndex = position739, tokenIndex739
+					goto l760
+				l761:
+					position, tokenIndex = position760, tokenIndex760
 					if buffer[position] != rune('Y') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l739:
+			l760:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l750
 				}
 				{
-					position741, tokenIndex741 := position, tokenIndex
+					position762, tokenIndex762 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l742
+						goto l763
 					}
-					goto l741
-				l742:
-					position, tokenIndex = position741, tokenIndex741
+					goto l762
+				l763:
+					position, tokenIndex = position762, tokenIndex762
 					if !_rules[ruleNumericLiteral]() {
-						goto l729
+						goto l750
 					}
 				}
-			l741:
+			l762:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l750
 				}
 				{
-					position743, tokenIndex743 := position, tokenIndex
+					position764, tokenIndex764 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l744
+						goto l765
 					}
 					position++
-					goto l743
-				l744:
-					position, tokenIndex = position743, tokenIndex743
+					goto l764
+				l765:
+					position, tokenIndex = position764, tokenIndex764
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l743:
+			l764:
 				{
-					position745, tokenIndex745 := position, tokenIndex
+					position766, tokenIndex766 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l746
+						goto l767
 					}
 					position++
-					goto l745
-				l746:
-					position, tokenIndex = position745, tokenIndex745
+					goto l766
+				l767:
+					position, tokenIndex = position766, tokenIndex766
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l745:
+			l766:
 				{
-					position747, tokenIndex747 := position, tokenIndex
+					position768, tokenIndex768 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l748
+						goto l769
 					}
 					position++
-					goto l747
-				l748:
-					position, tokenIndex = position747, tokenIndex747
+					goto l768
+				l769:
+					position, tokenIndex = position768, tokenIndex768
 					if buffer[position] != rune('C') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l747:
+			l768:
 				{
-					position749, tokenIndex749 := position, tokenIndex
+					position770, tokenIndex770 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l750
+						goto l771
 					}
 					position++
-					goto l749
-				l750:
-					position, tokenIndex = position749, tokenIndex749
+					goto l770
+				l771:
+					position, tokenIndex = position770, tokenIndex770
 					if buffer[position] != rune('O') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l749:
+			l770:
 				{
-					position751, tokenIndex751 := position, tokenIndex
+					position772, tokenIndex772 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l752
+						goto l773
 					}
 					position++
-					goto l751
-				l752:
-					position, tokenIndex = position751, tokenIndex751
+					goto l772
+				l773:
+					position, tokenIndex = position772, tokenIndex772
 					if buffer[position] != rune('N') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l751:
+			l772:
 				{
-					position753, tokenIndex753 := position, tokenIndex
+					position774, tokenIndex774 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l754
+						goto l775
 					}
 					position++
-					goto l753
-				l754:
-					position, tokenIndex = position753, tokenIndex753
+					goto l774
+				l775:
+					position, tokenIndex = position774, tokenIndex774
 					if buffer[position] != rune('D') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l753:
+			l774:
 				{
-					position755, tokenIndex755 := position, tokenIndex
+					position776, tokenIndex776 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l756
+						goto l777
 					}
 					position++
-					goto l755
-				l756:
-					position, tokenIndex = position755, tokenIndex755
+					goto l776
+				l777:
+					position, tokenIndex = position776, tokenIndex776
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l750
 					}
 					position++
 				}
-			l755:
-				if !_rules[ruleAction29]() {
-					goto l729
+			l776:
+				if !_rules[ruleAction31]() {
+					goto l750
 				}
-				add(ruleTimeBasedSamplingSeconds, position730)
+				add(ruleTimeBasedSamplingSeconds, position751)
 			}
 			return true
-		l729:
-			position, tokenIndex = position729, tokenIndex729
+		l750:
+			position, tokenIndex = position750, tokenIndex750
 			return false
 		},
-		/* 39 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action30)> */
+		/* 41 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action32)> */
 		func() bool {
-			position757, tokenIndex757 := position, tokenIndex
+			position778, tokenIndex778 := position, tokenIndex
 			{
-				position758 := position
+				position779 := position
 				{
-					position759, tokenIndex759 := position, tokenIndex
+					position780, tokenIndex780 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l760
+						goto l781
 					}
 					position++
-					goto l759
-				l760:
-					position, tokenIndex = position759, tokenIndex759
+					goto l780
+				l781:
+					position, tokenIndex = position780, tokenIndex780
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l759:
+			l780:
 				{
-					position761, tokenIndex761 := position, tokenIndex
+					position782, tokenIndex782 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l762
+						goto l783
 					}
 					position++
-					goto l761
-				l762:
-					position, tokenIndex = position761, tokenIndex761
+					goto l782
+				l783:
+					position, tokenIndex = position782, tokenIndex782
 					if buffer[position] != rune('V') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l761:
+			l782:
 				{
-					position763, tokenIndex763 := position, tokenIndex
+					position784, tokenIndex784 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l764
+						goto l785
 					}
 					position++
-					goto l763
-				l764:
-					position, tokenIndex = position763, tokenIndex763
+					goto l784
+				l785:
+					position, tokenIndex = position784, tokenIndex784
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l763:
+			l784:
 				{
-					position765, tokenIndex765 := position, tokenIndex
+					position786, tokenIndex786 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l766
+						goto l787
 					}
 					position++
-					goto l765
-				l766:
-					position, tokenIndex = position765, tokenIndex765
+					goto l786
+				l787:
+					position, tokenIndex = position786, tokenIndex786
 					if buffer[position] != rune('R') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l765:
+			l786:
 				{
-					position767, tokenIndex767 := position, tokenIndex
+					position788, tokenIndex788 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l768
+						goto l789
 					}
 					position++
-					goto l767
-				l768:
-					position, tokenIndex = position767, tokenIndex767
+					goto l788
+				l789:
+					position, tokenIndex = position788, tokenIndex788
 					if buffer[position] != rune('Y') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l767:
+			l788:
 				if !_rules[rulesp]() {
-					goto l757
+					goto l778
 				}
 				{
-					position769, tokenIndex769 := position, tokenIndex
+					position790, tokenIndex790 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l770
+						goto l791
 					}
-					goto l769
-				l770:
-					position, tokenIndex = position769, tokenIndex769
+					goto l790
+				l791:
+					position, tokenIndex = position790, tokenIndex790
 					if !_rules[ruleNumericLiteral]() {
-						goto l757
+						goto l778
 					}
 				}
-			l769:
+			l790:
 				if !_rules[rulesp]() {
-					goto l757
+					goto l778
 				}
 				{
-					position771, tokenIndex771 := position, tokenIndex
+					position792, tokenIndex792 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l772
+						goto l793
 					}
 					position++
-					goto l771
-				l772:
-					position, tokenIndex = position771, tokenIndex771
+					goto l792
+				l793:
+					position, tokenIndex = position792, tokenIndex792
 					if buffer[position] != rune('M') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l771:
+			l792:
 				{
-					position773, tokenIndex773 := position, tokenIndex
+					position794, tokenIndex794 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l774
+						goto l795
 					}
 					position++
-					goto l773
-				l774:
-					position, tokenIndex = position773, tokenIndex773
+					goto l794
+				l795:
+					position, tokenIndex = position794, tokenIndex794
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l773:
+			l794:
 				{
-					position775, tokenIndex775 := position, tokenIndex
+					position796, tokenIndex796 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l776
+						goto l797
 					}
 					position++
-					goto l775
-				l776:
-					position, tokenIndex = position775, tokenIndex775
+					goto l796
+				l797:
+					position, tokenIndex = position796, tokenIndex796
 					if buffer[position] != rune('L') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l775:
+			l796:
 				{
-					position777, tokenIndex777 := position, tokenIndex
+					position798, tokenIndex798 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l778
+						goto l799
 					}
 					position++
-					goto l777
-				l778:
-					position, tokenIndex = position777, tokenIndex777
+					goto l798
+				l799:
+					position, tokenIndex = position798, tokenIndex798
 					if buffer[position] != rune('L') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l777:
+			l798:
 				{
-					position779, tokenIndex779 := position, tokenIndex
+					position800, tokenIndex800 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l780
+						goto l801
 					}
 					position++
-					goto l779
-				l780:
-					position, tokenIndex = position779, tokenIndex779
+					goto l800
+				l801:
+					position, tokenIndex = position800, tokenIndex800
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l779:
+			l800:
 				{
-					position781, tokenIndex781 := position, tokenIndex
+					position802, tokenIndex802 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l782
+						goto l803
 					}
 					position++
-					goto l781
-				l782:
-					position, tokenIndex = position781, tokenIndex781
+					goto l802
+				l803:
+					position, tokenIndex = position802, tokenIndex802
 					if buffer[position] != rune('S') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l781:
+			l802:
 				{
-					position783, tokenIndex783 := position, tokenIndex
+					position804, tokenIndex804 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l784
+						goto l805
 					}
 					position++
-					goto l783
-				l784:
-					position, tokenIndex = position783, tokenIndex783
+					goto l804
+				l805:
+					position, tokenIndex = position804, tokenIndex804
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l783:
+			l804:
 				{
-					position785, tokenIndex785 := position, tokenIndex
+					position806, tokenIndex806 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l786
+						goto l807
 					}
 					position++
-					goto l785
-				l786:
-					position, tokenIndex = position785, tokenIndex785
+					goto l806
+				l807:
+					position, tokenIndex = position806, tokenIndex806
 					if buffer[position] != rune('C') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l785:
+			l806:
 				{
-					position787, tokenIndex787 := position, tokenIndex
+					position808, tokenIndex808 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l788
+						goto l809
 					}
 					position++
-					goto l787
-				l788:
-					position, tokenIndex = position787, tokenIndex787
+					goto l808
+				l809:
+					position, tokenIndex = position808, tokenIndex808
 					if buffer[position] != rune('O') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l787:
+			l808:
 				{
-					position789, tokenIndex789 := position, tokenIndex
+					position810, tokenIndex810 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l790
+						goto l811
 					}
 					position++
-					goto l789
-				l790:
-					position, tokenIndex = position789, tokenIndex789
+					goto l810
+				l811:
+					position, tokenIndex = position810, tokenIndex810
 					if buffer[position] != rune('N') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l789:
+			l810:
 				{
-					position791, tokenIndex791 := position, tokenIndex
+					position812, tokenIndex812 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l792
+						goto l813
 					}
 					position++
-					goto l791
-				l792:
-					position, tokenIndex = position791, tokenIndex791
+					goto l812
+				l813:
+					position, tokenIndex = position812, tokenIndex812
 					if buffer[position] != rune('D') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l791:
+			l812:
 				{
-					position793, tokenIndex793 := position, tokenIndex
+					position814, tokenIndex814 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l794
+						goto l815
 					}
 					position++
-					goto l793
-				l794:
-					position, tokenIndex = position793, tokenIndex793
+					goto l814
+				l815:
+					position, tokenIndex = position814, tokenIndex814
 					if buffer[position] != rune('S') {
-						goto l757
+						goto l778
 					}
 					position++
 				}
-			l793:
-				if !_rules[ruleAction30]() {
-					goto l757
+			l814:
+				if !_rules[ruleAction32]() {
+					goto l778
+				}
+				add(ruleTimeBasedSamplingMilliseconds, position779)
+			}
+			return true
+		l778:
+			position, tokenIndex = position778, tokenIndex778
+			return false
+		},
+		/* 42 DistinctOpt <- <(<(sp Distinct &sp)?> Action33)> */
+		func() bool {
+			position816, tokenIndex816 := position, tokenIndex
+			{
+				position817 := position
+				{
+					position818 := position
+					{
+						position819, tokenIndex819 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l819
+						}
+						if !_rules[ruleDistinct]() {
+							goto l819
+						}
+						{
+							position821, tokenIndex821 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l819
+							}
+							position, tokenIndex = position821, tokenIndex821
+						}
+						goto l820
+					l819:
+						position, tokenIndex = position819, tokenIndex819
+					}
+				l820:
+					add(rulePegText, position818)
+				}
+				if !_rules[ruleAction33]() {
+					goto l816
 				}
-				add(ruleTimeBasedSamplingMilliseconds, position758)
+				add(ruleDistinctOpt, position817)
 			}
 			return true
-		l757:
-			position, tokenIndex = position757, tokenIndex757
+		l816:
+			position, tokenIndex = position816, tokenIndex816
 			return false
 		},
-		/* 40 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action31)> */
+		/* 43 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action34)> */
 		func() bool {
-			position795, tokenIndex795 := position, tokenIndex
+			position822, tokenIndex822 := position, tokenIndex
 			{
-				position796 := position
+				position823 := position
 				{
-					position797 := position
+					position824 := position
 					if !_rules[rulesp]() {
-						goto l795
+						goto l822
 					}
 					if !_rules[ruleProjection]() {
-						goto l795
+						goto l822
 					}
-				l798:
+				l825:
 					{
-						position799, tokenIndex799 := position, tokenIndex
+						position826, tokenIndex826 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l799
+							goto l826
 						}
 						if buffer[position] != rune(',') {
-							goto l799
+							goto l826
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l799
+							goto l826
 						}
 						if !_rules[ruleProjection]() {
-							goto l799
+							goto l826
 						}
-						goto l798
-					l799:
-						position, tokenIndex = position799, tokenIndex799
+						goto l825
+					l826:
+						position, tokenIndex = position826, tokenIndex826
 					}
-					add(rulePegText, position797)
+					add(rulePegText, position824)
 				}
-				if !_rules[ruleAction31]() {
-					goto l795
+				if !_rules[ruleAction34]() {
+					goto l822
 				}
-				add(ruleProjections, position796)
+				add(ruleProjections, position823)
 			}
 			return true
-		l795:
-			position, tokenIndex = position795, tokenIndex795
+		l822:
+			position, tokenIndex = position822, tokenIndex822
 			return false
 		},
-		/* 41 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
+		/* 44 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
 		func() bool {
-			position800, tokenIndex800 := position, tokenIndex
+			position827, tokenIndex827 := position, tokenIndex
 			{
-				position801 := position
+				position828 := position
 				{
-					position802, tokenIndex802 := position, tokenIndex
+					position829, tokenIndex829 := position, tokenIndex
 					if !_rules[ruleAliasExpression]() {
-						goto l803
+						goto l830
 					}
-					goto l802
-				l803:
-					position, tokenIndex = position802, tokenIndex802
+					goto l829
+				l830:
+					position, tokenIndex = position829, tokenIndex829
 					if !_rules[ruleExpressionOrWildcard]() {
-						goto l800
+						goto l827
 					}
 				}
-			l802:
-				add(ruleProjection, position801)
+			l829:
+				add(ruleProjection, position828)
 			}
 			return true
-		l800:
-			position, tokenIndex = position800, tokenIndex800
+		l827:
+			position, tokenIndex = position827, tokenIndex827
 			return false
 		},
-		/* 42 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action32)> */
+		/* 45 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action35)> */
 		func() bool {
-			position804, tokenIndex804 := position, tokenIndex
+			position831, tokenIndex831 := position, tokenIndex
 			{
-				position805 := position
+				position832 := position
 				if !_rules[ruleExpressionOrWildcard]() {
-					goto l804
+					goto l831
 				}
 				if !_rules[rulesp]() {
-					goto l804
+					goto l831
 				}
 				{
-					position806, tokenIndex806 := position, tokenIndex
+					position833, tokenIndex833 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l807
+						goto l834
 					}
 					position++
-					goto l806
-				l807:
-					position, tokenIndex = position806, tokenIndex806
+					goto l833
+				l834:
+					position, tokenIndex = position833, tokenIndex833
 					if buffer[position] != rune('A') {
-						goto l804
+						goto l831
 					}
 					position++
 				}
-			l806:
+			l833:
 				{
-					position808, tokenIndex808 := position, tokenIndex
+					position835, tokenIndex835 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l809
+						goto l836
 					}
 					position++
-					goto l808
-				l809:
-					position, tokenIndex = position808, tokenIndex808
+					goto l835
+				l836:
+					position, tokenIndex = position835, tokenIndex835
 					if buffer[position] != rune('S') {
-						goto l804
+						goto l831
 					}
 					position++
 				}
-			l808:
+			l835:
 				if !_rules[rulesp]() {
-					goto l804
+					goto l831
 				}
 				if !_rules[ruleTargetIdentifier]() {
-					goto l804
+					goto l831
 				}
-				if !_rules[ruleAction32]() {
-					goto l804
+				if !_rules[ruleAction35]() {
+					goto l831
 				}
-				add(ruleAliasExpression, position805)
+				add(ruleAliasExpression, position832)
 			}
 			return true
-		l804:
-			position, tokenIndex = position804, tokenIndex804
+		l831:
+			position, tokenIndex = position831, tokenIndex831
 			return false
 		},
-		/* 43 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action33)> */
+		/* 46 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp (JoinedTable / JoinedRelations / Relations))?> Action36)> */
 		func() bool {
-			position810, tokenIndex810 := position, tokenIndex
+			position837, tokenIndex837 := position, tokenIndex
 			{
-				position811 := position
+				position838 := position
 				{
-					position812 := position
+					position839 := position
 					{
-						position813, tokenIndex813 := position, tokenIndex
+						position840, tokenIndex840 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l813
+							goto l840
 						}
 						{
-							position815, tokenIndex815 := position, tokenIndex
+							position842, tokenIndex842 := position, tokenIndex
 							if buffer[position] != rune('f') {
-								goto l816
+								goto l843
 							}
 							position++
-							goto l815
-						l816:
-							position, tokenIndex = position815, tokenIndex815
+							goto l842
+						l843:
+							position, tokenIndex = position842, tokenIndex842
 							if buffer[position] != rune('F') {
-								goto l813
+								goto l840
 							}
 							position++
 						}
-					l815:
+					l842:
 						{
-							position817, tokenIndex817 := position, tokenIndex
+							position844, tokenIndex844 := position, tokenIndex
 							if buffer[position] != rune('r') {
-								goto l818
+								goto l845
 							}
 							position++
-							goto l817
-						l818:
-							position, tokenIndex = position817, tokenIndex817
+							goto l844
+						l845:
+							position, tokenIndex = position844, tokenIndex844
 							if buffer[position] != rune('R') {
-								goto l813
+								goto l840
 							}
 							position++
 						}
-					l817:
+					l844:
 						{
-							position819, tokenIndex819 := position, tokenIndex
+							position846, tokenIndex846 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l820
+								goto l847
 							}
 							position++
-							goto l819
-						l820:
-							position, tokenIndex = position819, tokenIndex819
+							goto l846
+						l847:
+							position, tokenIndex = position846, tokenIndex846
 							if buffer[position] != rune('O') {
-								goto l813
+								goto l840
 							}
 							position++
 						}
-					l819:
+					l846:
 						{
-							position821, tokenIndex821 := position, tokenIndex
+							position848, tokenIndex848 := position, tokenIndex
 							if buffer[position] != rune('m') {
-								goto l822
+								goto l849
 							}
 							position++
-							goto l821
-						l822:
-							position, tokenIndex = position821, tokenIndex821
+							goto l848
+						l849:
+							position, tokenIndex = position848, tokenIndex848
 							if buffer[position] != rune('M') {
-								goto l813
+								goto l840
 							}
 							position++
 						}
-					l821:
+					l848:
 						if !_rules[rulesp]() {
-							goto l813
+							goto l840
 						}
-						if !_rules[ruleRelations]() {
-							goto l813
+						{
+							position850, tokenIndex850 := position, tokenIndex
+							if !_rules[ruleJoinedTable]() {
+								goto l851
+							}
+							goto l850
+						l851:
+							position, tokenIndex = position850, tokenIndex850
+							if !_rules[ruleJoinedRelations]() {
+								goto l852
+							}
+							goto l850
+						l852:
+							position, tokenIndex = position850, tokenIndex850
+							if !_rules[ruleRelations]() {
+								goto l840
+							}
 						}
-						goto l814
-					l813:
-						position, tokenIndex = position813, tokenIndex813
+					l850:
+						goto l841
+					l840:
+						position, tokenIndex = position840, tokenIndex840
 					}
-				l814:
-					add(rulePegText, position812)
+				l841:
+					add(rulePegText, position839)
 				}
-				if !_rules[ruleAction33]() {
-					goto l810
+				if !_rules[ruleAction36]() {
+					goto l837
 				}
-				add(ruleWindowedFrom, position811)
+				add(ruleWindowedFrom, position838)
 			}
 			return true
-		l810:
-			position, tokenIndex = position810, tokenIndex810
+		l837:
+			position, tokenIndex = position837, tokenIndex837
 			return false
 		},
-		/* 44 Interval <- <(TimeInterval / TuplesInterval)> */
+		/* 47 Interval <- <(TimeInterval / TuplesInterval)> */
 		func() bool {
-			position823, tokenIndex823 := position, tokenIndex
+			position853, tokenIndex853 := position, tokenIndex
 			{
-				position824 := position
+				position854 := position
 				{
-					position825, tokenIndex825 := position, tokenIndex
+					position855, tokenIndex855 := position, tokenIndex
 					if !_rules[ruleTimeInterval]() {
-						goto l826
+						goto l856
 					}
-					goto l825
-				l826:
-					position, tokenIndex = position825, tokenIndex825
+					goto l855
+				l856:
+					position, tokenIndex = position855, tokenIndex855
 					if !_rules[ruleTuplesInterval]() {
-						goto l823
+						goto l853
 					}
 				}
-			l825:
-				add(ruleInterval, position824)
+			l855:
+				add(ruleInterval, position854)
 			}
 			return true
-		l823:
-			position, tokenIndex = position823, tokenIndex823
+		l853:
+			position, tokenIndex = position853, tokenIndex853
 			return false
 		},
-		/* 45 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action34)> */
+		/* 48 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action37)> */
 		func() bool {
-			position827, tokenIndex827 := position, tokenIndex
+			position857, tokenIndex857 := position, tokenIndex
 			{
-				position828 := position
+				position858 := position
 				{
-					position829, tokenIndex829 := position, tokenIndex
+					position859, tokenIndex859 := position, tokenIndex
 					if !_rules[ruleFloatLiteral]() {
-						goto l830
+						goto l860
 					}
-					goto l829
-				l830:
-					position, tokenIndex = position829, tokenIndex829
+					goto l859
+				l860:
+					position, tokenIndex = position859, tokenIndex859
 					if !_rules[ruleNumericLiteral]() {
-						goto l827
+						goto l857
 					}
 				}
-			l829:
+			l859:
 				if !_rules[rulesp]() {
-					goto l827
+					goto l857
 				}
 				{
-					position831, tokenIndex831 := position, tokenIndex
+					position861, tokenIndex861 := position, tokenIndex
 					if !_rules[ruleSECONDS]() {
-						goto l832
+						goto l862
 					}
-					goto l831
-				l832:
-					position, tokenIndex = position831, tokenIndex831
+					goto l861
+				l862:
+					position, tokenIndex = position861, tokenIndex861
 					if !_rules[ruleMILLISECONDS]() {
-						goto l827
+						goto l857
 					}
 				}
-			l831:
-				if !_rules[ruleAction34]() {
-					goto l827
+			l861:
+				if !_rules[ruleAction37]() {
+					goto l857
 				}
-				add(ruleTimeInterval, position828)
+				add(ruleTimeInterval, position858)
 			}
 			return true
-		l827:
-			position, tokenIndex = position827, tokenIndex827
+		l857:
+			position, tokenIndex = position857, tokenIndex857
 			return false
 		},
-		/* 46 TuplesInterval <- <(NumericLiteral sp TUPLES Action35)> */
+		/* 49 TuplesInterval <- <(NumericLiteral sp TUPLES Action38)> */
 		func() bool {
-			position833, tokenIndex833 := position, tokenIndex
+			position863, tokenIndex863 := position, tokenIndex
 			{
-				position834 := position
+				position864 := position
 				if !_rules[ruleNumericLiteral]() {
-					goto l833
+					goto l863
 				}
 				if !_rules[rulesp]() {
-					goto l833
+					goto l863
 				}
 				if !_rules[ruleTUPLES]() {
-					goto l833
+					goto l863
 				}
-				if !_rules[ruleAction35]() {
-					goto l833
+				if !_rules[ruleAction38]() {
+					goto l863
 				}
-				add(ruleTuplesInterval, position834)
+				add(ruleTuplesInterval, position864)
 			}
 			return true
-		l833:
-			position, tokenIndex = position833, tokenIndex833
+		l863:
+			position, tokenIndex = position863, tokenIndex863
 			return false
 		},
-		/* 47 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
+		/* 50 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
 		func() bool {
-			position835, tokenIndex835 := position, tokenIndex
+			position865, tokenIndex865 := position, tokenIndex
 			{
-				position836 := position
+				position866 := position
 				if !_rules[ruleRelationLike]() {
-					goto l835
+					goto l865
 				}
-			l837:
+			l867:
 				{
-					position838, tokenIndex838 := position, tokenIndex
+					position868, tokenIndex868 := position, tokenIndex
 					if !_rules[rulespOpt]() {
-						goto l838
+						goto l868
 					}
 					if buffer[position] != rune(',') {
-						goto l838
+						goto l868
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l838
+						goto l868
 					}
 					if !_rules[ruleRelationLike]() {
-						goto l838
+						goto l868
 					}
-					goto l837
-				l838:
-					position, tokenIndex = position838, tokenIndex838
+					goto l867
+				l868:
+					position, tokenIndex = position868, tokenIndex868
 				}
-				add(ruleRelations, position836)
+				add(ruleRelations, position866)
 			}
 			return true
-		l835:
-			position, tokenIndex = position835, tokenIndex835
+		l865:
+			position, tokenIndex = position865, tokenIndex865
 			return false
 		},
-		/* 48 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action36)> */
+		/* 51 JoinedRelations <- <(RelationLike sp JoinType sp RelationLike sp (('o' / 'O') ('n' / 'N')) sp Expression Action39)> */
 		func() bool {
-			position839, tokenIndex839 := position, tokenIndex
+			position869, tokenIndex869 := position, tokenIndex
 			{
-				position840 := position
-				{
-					position841 := position
-					{
-						position842, tokenIndex842 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l842
-						}
-						{
-							position844, tokenIndex844 := position, tokenIndex
-							if buffer[position] != rune('w') {
-								goto l845
+				position870 := position
+				if !_rules[ruleRelationLike]() {
+					goto l869
+				}
+				if !_rules[rulesp]() {
+					goto l869
+				}
+				if !_rules[ruleJoinType]() {
+					goto l869
+				}
+				if !_rules[rulesp]() {
+					goto l869
+				}
+				if !_rules[ruleRelationLike]() {
+					goto l869
+				}
+				if !_rules[rulesp]() {
+					goto l869
+				}
+				{
+					position871, tokenIndex871 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l872
+					}
+					position++
+					goto l871
+				l872:
+					position, tokenIndex = position871, tokenIndex871
+					if buffer[position] != rune('O') {
+						goto l869
+					}
+					position++
+				}
+			l871:
+				{
+					position873, tokenIndex873 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l874
+					}
+					position++
+					goto l873
+				l874:
+					position, tokenIndex = position873, tokenIndex873
+					if buffer[position] != rune('N') {
+						goto l869
+					}
+					position++
+				}
+			l873:
+				if !_rules[rulesp]() {
+					goto l869
+				}
+				if !_rules[ruleExpression]() {
+					goto l869
+				}
+				if !_rules[ruleAction39]() {
+					goto l869
+				}
+				add(ruleJoinedRelations, position870)
+			}
+			return true
+		l869:
+			position, tokenIndex = position869, tokenIndex869
+			return false
+		},
+		/* 52 JoinType <- <(LeftOuterJoin / FullOuterJoin / InnerJoin)> */
+		func() bool {
+			position875, tokenIndex875 := position, tokenIndex
+			{
+				position876 := position
+				{
+					position877, tokenIndex877 := position, tokenIndex
+					if !_rules[ruleLeftOuterJoin]() {
+						goto l878
+					}
+					goto l877
+				l878:
+					position, tokenIndex = position877, tokenIndex877
+					if !_rules[ruleFullOuterJoin]() {
+						goto l879
+					}
+					goto l877
+				l879:
+					position, tokenIndex = position877, tokenIndex877
+					if !_rules[ruleInnerJoin]() {
+						goto l875
+					}
+				}
+			l877:
+				add(ruleJoinType, position876)
+			}
+			return true
+		l875:
+			position, tokenIndex = position875, tokenIndex875
+			return false
+		},
+		/* 53 JoinedTable <- <(RelationLike sp JoinType sp (('t' / 'T') ('a' / 'A') ('b' / 'B') ('l' / 'L') ('e' / 'E')) sp TableLike sp (('o' / 'O') ('n' / 'N')) sp Expression Action40)> */
+		func() bool {
+			position880, tokenIndex880 := position, tokenIndex
+			{
+				position881 := position
+				if !_rules[ruleRelationLike]() {
+					goto l880
+				}
+				if !_rules[rulesp]() {
+					goto l880
+				}
+				if !_rules[ruleJoinType]() {
+					goto l880
+				}
+				if !_rules[rulesp]() {
+					goto l880
+				}
+				{
+					position882, tokenIndex882 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l883
+					}
+					position++
+					goto l882
+				l883:
+					position, tokenIndex = position882, tokenIndex882
+					if buffer[position] != rune('T') {
+						goto l880
+					}
+					position++
+				}
+			l882:
+				{
+					position884, tokenIndex884 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l885
+					}
+					position++
+					goto l884
+				l885:
+					position, tokenIndex = position884, tokenIndex884
+					if buffer[position] != rune('A') {
+						goto l880
+					}
+					position++
+				}
+			l884:
+				{
+					position886, tokenIndex886 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l887
+					}
+					position++
+					goto l886
+				l887:
+					position, tokenIndex = position886, tokenIndex886
+					if buffer[position] != rune('B') {
+						goto l880
+					}
+					position++
+				}
+			l886:
+				{
+					position888, tokenIndex888 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l889
+					}
+					position++
+					goto l888
+				l889:
+					position, tokenIndex = position888, tokenIndex888
+					if buffer[position] != rune('L') {
+						goto l880
+					}
+					position++
+				}
+			l888:
+				{
+					position890, tokenIndex890 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l891
+					}
+					position++
+					goto l890
+				l891:
+					position, tokenIndex = position890, tokenIndex890
+					if buffer[position] != rune('E') {
+						goto l880
+					}
+					position++
+				}
+			l890:
+				if !_rules[rulesp]() {
+					goto l880
+				}
+				if !_rules[ruleTableLike]() {
+					goto l880
+				}
+				if !_rules[rulesp]() {
+					goto l880
+				}
+				{
+					position892, tokenIndex892 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l893
+					}
+					position++
+					goto l892
+				l893:
+					position, tokenIndex = position892, tokenIndex892
+					if buffer[position] != rune('O') {
+						goto l880
+					}
+					position++
+				}
+			l892:
+				{
+					position894, tokenIndex894 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l895
+					}
+					position++
+					goto l894
+				l895:
+					position, tokenIndex = position894, tokenIndex894
+					if buffer[position] != rune('N') {
+						goto l880
+					}
+					position++
+				}
+			l894:
+				if !_rules[rulesp]() {
+					goto l880
+				}
+				if !_rules[ruleExpression]() {
+					goto l880
+				}
+				if !_rules[ruleAction40]() {
+					goto l880
+				}
+				add(ruleJoinedTable, position881)
+			}
+			return true
+		l880:
+			position, tokenIndex = position880, tokenIndex880
+			return false
+		},
+		/* 54 TableLike <- <(AliasedTable / Table)> */
+		func() bool {
+			position896, tokenIndex896 := position, tokenIndex
+			{
+				position897 := position
+				{
+					position898, tokenIndex898 := position, tokenIndex
+					if !_rules[ruleAliasedTable]() {
+						goto l899
+					}
+					goto l898
+				l899:
+					position, tokenIndex = position898, tokenIndex898
+					if !_rules[ruleTable]() {
+						goto l896
+					}
+				}
+			l898:
+				add(ruleTableLike, position897)
+			}
+			return true
+		l896:
+			position, tokenIndex = position896, tokenIndex896
+			return false
+		},
+		/* 55 AliasedTable <- <(Table sp (('a' / 'A') ('s' / 'S')) sp Identifier Action41)> */
+		func() bool {
+			position900, tokenIndex900 := position, tokenIndex
+			{
+				position901 := position
+				if !_rules[ruleTable]() {
+					goto l900
+				}
+				if !_rules[rulesp]() {
+					goto l900
+				}
+				{
+					position902, tokenIndex902 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l903
+					}
+					position++
+					goto l902
+				l903:
+					position, tokenIndex = position902, tokenIndex902
+					if buffer[position] != rune('A') {
+						goto l900
+					}
+					position++
+				}
+			l902:
+				{
+					position904, tokenIndex904 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l905
+					}
+					position++
+					goto l904
+				l905:
+					position, tokenIndex = position904, tokenIndex904
+					if buffer[position] != rune('S') {
+						goto l900
+					}
+					position++
+				}
+			l904:
+				if !_rules[rulesp]() {
+					goto l900
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l900
+				}
+				if !_rules[ruleAction41]() {
+					goto l900
+				}
+				add(ruleAliasedTable, position901)
+			}
+			return true
+		l900:
+			position, tokenIndex = position900, tokenIndex900
+			return false
+		},
+		/* 56 Table <- <(<ident> Action42)> */
+		func() bool {
+			position906, tokenIndex906 := position, tokenIndex
+			{
+				position907 := position
+				{
+					position908 := position
+					if !_rules[ruleident]() {
+						goto l906
+					}
+					add(rulePegText, position908)
+				}
+				if !_rules[ruleAction42]() {
+					goto l906
+				}
+				add(ruleTable, position907)
+			}
+			return true
+		l906:
+			position, tokenIndex = position906, tokenIndex906
+			return false
+		},
+		/* 57 MatchRecognize <- <(<(sp (('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') '_' ('r' / 'R') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('g' / 'G') ('n' / 'N') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) spOpt '(' spOpt MatchPartitionOpt MatchMeasuresOpt (('p' / 'P') ('a' / 'A') ('t' / 'T') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('n' / 'N')) spOpt '(' spOpt PatternAlternation spOpt ')' sp (('d' / 'D') ('e' / 'E') ('f' / 'F') ('i' / 'I') ('n' / 'N') ('e' / 'E')) sp PatternDefinition (spOpt ',' spOpt PatternDefinition)* spOpt ')')?> Action43)> */
+		func() bool {
+			position909, tokenIndex909 := position, tokenIndex
+			{
+				position910 := position
+				{
+					position911 := position
+					{
+						position912, tokenIndex912 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l912
+						}
+						{
+							position914, tokenIndex914 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l915
 							}
 							position++
-							goto l844
-						l845:
-							position, tokenIndex = position844, tokenIndex844
-							if buffer[position] != rune('W') {
-								goto l842
+							goto l914
+						l915:
+							position, tokenIndex = position914, tokenIndex914
+							if buffer[position] != rune('M') {
+								goto l912
 							}
 							position++
 						}
-					l844:
+					l914:
 						{
-							position846, tokenIndex846 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l847
+							position916, tokenIndex916 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l917
 							}
 							position++
-							goto l846
-						l847:
-							position, tokenIndex = position846, tokenIndex846
-							if buffer[position] != rune('H') {
-								goto l842
+							goto l916
+						l917:
+							position, tokenIndex = position916, tokenIndex916
+							if buffer[position] != rune('A') {
+								goto l912
 							}
 							position++
 						}
-					l846:
+					l916:
 						{
-							position848, tokenIndex848 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l849
+							position918, tokenIndex918 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l919
 							}
 							position++
-							goto l848
-						l849:
-							position, tokenIndex = position848, tokenIndex848
-							if buffer[position] != rune('E') {
-								goto l842
+							goto l918
+						l919:
+							position, tokenIndex = position918, tokenIndex918
+							if buffer[position] != rune('T') {
+								goto l912
 							}
 							position++
 						}
-					l848:
+					l918:
 						{
-							position850, tokenIndex850 := position, tokenIndex
+							position920, tokenIndex920 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l921
+							}
+							position++
+							goto l920
+						l921:
+							position, tokenIndex = position920, tokenIndex920
+							if buffer[position] != rune('C') {
+								goto l912
+							}
+							position++
+						}
+					l920:
+						{
+							position922, tokenIndex922 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l923
+							}
+							position++
+							goto l922
+						l923:
+							position, tokenIndex = position922, tokenIndex922
+							if buffer[position] != rune('H') {
+								goto l912
+							}
+							position++
+						}
+					l922:
+						if buffer[position] != rune('_') {
+							goto l912
+						}
+						position++
+						{
+							position924, tokenIndex924 := position, tokenIndex
 							if buffer[position] != rune('r') {
-								goto l851
+								goto l925
 							}
 							position++
-							goto l850
-						l851:
-							position, tokenIndex = position850, tokenIndex850
+							goto l924
+						l925:
+							position, tokenIndex = position924, tokenIndex924
+							if buffer[position] != rune('R') {
+								goto l912
+							}
+							position++
+						}
+					l924:
+						{
+							position926, tokenIndex926 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l927
+							}
+							position++
+							goto l926
+						l927:
+							position, tokenIndex = position926, tokenIndex926
+							if buffer[position] != rune('E') {
+								goto l912
+							}
+							position++
+						}
+					l926:
+						{
+							position928, tokenIndex928 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l929
+							}
+							position++
+							goto l928
+						l929:
+							position, tokenIndex = position928, tokenIndex928
+							if buffer[position] != rune('C') {
+								goto l912
+							}
+							position++
+						}
+					l928:
+						{
+							position930, tokenIndex930 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l931
+							}
+							position++
+							goto l930
+						l931:
+							position, tokenIndex = position930, tokenIndex930
+							if buffer[position] != rune('O') {
+								goto l912
+							}
+							position++
+						}
+					l930:
+						{
+							position932, tokenIndex932 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l933
+							}
+							position++
+							goto l932
+						l933:
+							position, tokenIndex = position932, tokenIndex932
+							if buffer[position] != rune('G') {
+								goto l912
+							}
+							position++
+						}
+					l932:
+						{
+							position934, tokenIndex934 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l935
+							}
+							position++
+							goto l934
+						l935:
+							position, tokenIndex = position934, tokenIndex934
+							if buffer[position] != rune('N') {
+								goto l912
+							}
+							position++
+						}
+					l934:
+						{
+							position936, tokenIndex936 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l937
+							}
+							position++
+							goto l936
+						l937:
+							position, tokenIndex = position936, tokenIndex936
+							if buffer[position] != rune('I') {
+								goto l912
+							}
+							position++
+						}
+					l936:
+						{
+							position938, tokenIndex938 := position, tokenIndex
+							if buffer[position] != rune('z') {
+								goto l939
+							}
+							position++
+							goto l938
+						l939:
+							position, tokenIndex = position938, tokenIndex938
+							if buffer[position] != rune('Z') {
+								goto l912
+							}
+							position++
+						}
+					l938:
+						{
+							position940, tokenIndex940 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l941
+							}
+							position++
+							goto l940
+						l941:
+							position, tokenIndex = position940, tokenIndex940
+							if buffer[position] != rune('E') {
+								goto l912
+							}
+							position++
+						}
+					l940:
+						if !_rules[rulespOpt]() {
+							goto l912
+						}
+						if buffer[position] != rune('(') {
+							goto l912
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l912
+						}
+						if !_rules[ruleMatchPartitionOpt]() {
+							goto l912
+						}
+						if !_rules[ruleMatchMeasuresOpt]() {
+							goto l912
+						}
+						{
+							position942, tokenIndex942 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l943
+							}
+							position++
+							goto l942
+						l943:
+							position, tokenIndex = position942, tokenIndex942
+							if buffer[position] != rune('P') {
+								goto l912
+							}
+							position++
+						}
+					l942:
+						{
+							position944, tokenIndex944 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l945
+							}
+							position++
+							goto l944
+						l945:
+							position, tokenIndex = position944, tokenIndex944
+							if buffer[position] != rune('A') {
+								goto l912
+							}
+							position++
+						}
+					l944:
+						{
+							position946, tokenIndex946 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l947
+							}
+							position++
+							goto l946
+						l947:
+							position, tokenIndex = position946, tokenIndex946
+							if buffer[position] != rune('T') {
+								goto l912
+							}
+							position++
+						}
+					l946:
+						{
+							position948, tokenIndex948 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l949
+							}
+							position++
+							goto l948
+						l949:
+							position, tokenIndex = position948, tokenIndex948
+							if buffer[position] != rune('T') {
+								goto l912
+							}
+							position++
+						}
+					l948:
+						{
+							position950, tokenIndex950 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l951
+							}
+							position++
+							goto l950
+						l951:
+							position, tokenIndex = position950, tokenIndex950
+							if buffer[position] != rune('E') {
+								goto l912
+							}
+							position++
+						}
+					l950:
+						{
+							position952, tokenIndex952 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l953
+							}
+							position++
+							goto l952
+						l953:
+							position, tokenIndex = position952, tokenIndex952
+							if buffer[position] != rune('R') {
+								goto l912
+							}
+							position++
+						}
+					l952:
+						{
+							position954, tokenIndex954 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l955
+							}
+							position++
+							goto l954
+						l955:
+							position, tokenIndex = position954, tokenIndex954
+							if buffer[position] != rune('N') {
+								goto l912
+							}
+							position++
+						}
+					l954:
+						if !_rules[rulespOpt]() {
+							goto l912
+						}
+						if buffer[position] != rune('(') {
+							goto l912
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l912
+						}
+						if !_rules[rulePatternAlternation]() {
+							goto l912
+						}
+						if !_rules[rulespOpt]() {
+							goto l912
+						}
+						if buffer[position] != rune(')') {
+							goto l912
+						}
+						position++
+						if !_rules[rulesp]() {
+							goto l912
+						}
+						{
+							position956, tokenIndex956 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l957
+							}
+							position++
+							goto l956
+						l957:
+							position, tokenIndex = position956, tokenIndex956
+							if buffer[position] != rune('D') {
+								goto l912
+							}
+							position++
+						}
+					l956:
+						{
+							position958, tokenIndex958 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l959
+							}
+							position++
+							goto l958
+						l959:
+							position, tokenIndex = position958, tokenIndex958
+							if buffer[position] != rune('E') {
+								goto l912
+							}
+							position++
+						}
+					l958:
+						{
+							position960, tokenIndex960 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l961
+							}
+							position++
+							goto l960
+						l961:
+							position, tokenIndex = position960, tokenIndex960
+							if buffer[position] != rune('F') {
+								goto l912
+							}
+							position++
+						}
+					l960:
+						{
+							position962, tokenIndex962 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l963
+							}
+							position++
+							goto l962
+						l963:
+							position, tokenIndex = position962, tokenIndex962
+							if buffer[position] != rune('I') {
+								goto l912
+							}
+							position++
+						}
+					l962:
+						{
+							position964, tokenIndex964 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l965
+							}
+							position++
+							goto l964
+						l965:
+							position, tokenIndex = position964, tokenIndex964
+							if buffer[position] != rune('N') {
+								goto l912
+							}
+							position++
+						}
+					l964:
+						{
+							position966, tokenIndex966 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l967
+							}
+							position++
+							goto l966
+						l967:
+							position, tokenIndex = position966, tokenIndex966
+							if buffer[position] != rune('E') {
+								goto l912
+							}
+							position++
+						}
+					l966:
+						if !_rules[rulesp]() {
+							goto l912
+						}
+						if !_rules[rulePatternDefinition]() {
+							goto l912
+						}
+					l968:
+						{
+							position969, tokenIndex969 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l969
+							}
+							if buffer[position] != rune(',') {
+								goto l969
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l969
+							}
+							if !_rules[rulePatternDefinition]() {
+								goto l969
+							}
+							goto l968
+						l969:
+							position, tokenIndex = position969, tokenIndex969
+						}
+						if !_rules[rulespOpt]() {
+							goto l912
+						}
+						if buffer[position] != rune(')') {
+							goto l912
+						}
+						position++
+						goto l913
+					l912:
+						position, tokenIndex = position912, tokenIndex912
+					}
+				l913:
+					add(rulePegText, position911)
+				}
+				if !_rules[ruleAction43]() {
+					goto l909
+				}
+				add(ruleMatchRecognize, position910)
+			}
+			return true
+		l909:
+			position, tokenIndex = position909, tokenIndex909
+			return false
+		},
+		/* 58 MatchPartitionOpt <- <(<(('p' / 'P') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('i' / 'I') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('b' / 'B') ('y' / 'Y')) sp Expression (spOpt ',' spOpt Expression)* sp)?> Action44)> */
+		func() bool {
+			position970, tokenIndex970 := position, tokenIndex
+			{
+				position971 := position
+				{
+					position972 := position
+					{
+						position973, tokenIndex973 := position, tokenIndex
+						{
+							position975, tokenIndex975 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l976
+							}
+							position++
+							goto l975
+						l976:
+							position, tokenIndex = position975, tokenIndex975
+							if buffer[position] != rune('P') {
+								goto l973
+							}
+							position++
+						}
+					l975:
+						{
+							position977, tokenIndex977 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l978
+							}
+							position++
+							goto l977
+						l978:
+							position, tokenIndex = position977, tokenIndex977
+							if buffer[position] != rune('A') {
+								goto l973
+							}
+							position++
+						}
+					l977:
+						{
+							position979, tokenIndex979 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l980
+							}
+							position++
+							goto l979
+						l980:
+							position, tokenIndex = position979, tokenIndex979
+							if buffer[position] != rune('R') {
+								goto l973
+							}
+							position++
+						}
+					l979:
+						{
+							position981, tokenIndex981 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l982
+							}
+							position++
+							goto l981
+						l982:
+							position, tokenIndex = position981, tokenIndex981
+							if buffer[position] != rune('T') {
+								goto l973
+							}
+							position++
+						}
+					l981:
+						{
+							position983, tokenIndex983 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l984
+							}
+							position++
+							goto l983
+						l984:
+							position, tokenIndex = position983, tokenIndex983
+							if buffer[position] != rune('I') {
+								goto l973
+							}
+							position++
+						}
+					l983:
+						{
+							position985, tokenIndex985 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l986
+							}
+							position++
+							goto l985
+						l986:
+							position, tokenIndex = position985, tokenIndex985
+							if buffer[position] != rune('T') {
+								goto l973
+							}
+							position++
+						}
+					l985:
+						{
+							position987, tokenIndex987 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l988
+							}
+							position++
+							goto l987
+						l988:
+							position, tokenIndex = position987, tokenIndex987
+							if buffer[position] != rune('I') {
+								goto l973
+							}
+							position++
+						}
+					l987:
+						{
+							position989, tokenIndex989 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l990
+							}
+							position++
+							goto l989
+						l990:
+							position, tokenIndex = position989, tokenIndex989
+							if buffer[position] != rune('O') {
+								goto l973
+							}
+							position++
+						}
+					l989:
+						{
+							position991, tokenIndex991 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l992
+							}
+							position++
+							goto l991
+						l992:
+							position, tokenIndex = position991, tokenIndex991
+							if buffer[position] != rune('N') {
+								goto l973
+							}
+							position++
+						}
+					l991:
+						if !_rules[rulesp]() {
+							goto l973
+						}
+						{
+							position993, tokenIndex993 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l994
+							}
+							position++
+							goto l993
+						l994:
+							position, tokenIndex = position993, tokenIndex993
+							if buffer[position] != rune('B') {
+								goto l973
+							}
+							position++
+						}
+					l993:
+						{
+							position995, tokenIndex995 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l996
+							}
+							position++
+							goto l995
+						l996:
+							position, tokenIndex = position995, tokenIndex995
+							if buffer[position] != rune('Y') {
+								goto l973
+							}
+							position++
+						}
+					l995:
+						if !_rules[rulesp]() {
+							goto l973
+						}
+						if !_rules[ruleExpression]() {
+							goto l973
+						}
+					l997:
+						{
+							position998, tokenIndex998 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l998
+							}
+							if buffer[position] != rune(',') {
+								goto l998
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l998
+							}
+							if !_rules[ruleExpression]() {
+								goto l998
+							}
+							goto l997
+						l998:
+							position, tokenIndex = position998, tokenIndex998
+						}
+						if !_rules[rulesp]() {
+							goto l973
+						}
+						goto l974
+					l973:
+						position, tokenIndex = position973, tokenIndex973
+					}
+				l974:
+					add(rulePegText, position972)
+				}
+				if !_rules[ruleAction44]() {
+					goto l970
+				}
+				add(ruleMatchPartitionOpt, position971)
+			}
+			return true
+		l970:
+			position, tokenIndex = position970, tokenIndex970
+			return false
+		},
+		/* 59 MatchMeasuresOpt <- <(<(('m' / 'M') ('e' / 'E') ('a' / 'A') ('s' / 'S') ('u' / 'U') ('r' / 'R') ('e' / 'E') ('s' / 'S') sp AliasExpression (spOpt ',' spOpt AliasExpression)* sp)?> Action45)> */
+		func() bool {
+			position999, tokenIndex999 := position, tokenIndex
+			{
+				position1000 := position
+				{
+					position1001 := position
+					{
+						position1002, tokenIndex1002 := position, tokenIndex
+						{
+							position1004, tokenIndex1004 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1005
+							}
+							position++
+							goto l1004
+						l1005:
+							position, tokenIndex = position1004, tokenIndex1004
+							if buffer[position] != rune('M') {
+								goto l1002
+							}
+							position++
+						}
+					l1004:
+						{
+							position1006, tokenIndex1006 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1007
+							}
+							position++
+							goto l1006
+						l1007:
+							position, tokenIndex = position1006, tokenIndex1006
+							if buffer[position] != rune('E') {
+								goto l1002
+							}
+							position++
+						}
+					l1006:
+						{
+							position1008, tokenIndex1008 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1009
+							}
+							position++
+							goto l1008
+						l1009:
+							position, tokenIndex = position1008, tokenIndex1008
+							if buffer[position] != rune('A') {
+								goto l1002
+							}
+							position++
+						}
+					l1008:
+						{
+							position1010, tokenIndex1010 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1011
+							}
+							position++
+							goto l1010
+						l1011:
+							position, tokenIndex = position1010, tokenIndex1010
+							if buffer[position] != rune('S') {
+								goto l1002
+							}
+							position++
+						}
+					l1010:
+						{
+							position1012, tokenIndex1012 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1013
+							}
+							position++
+							goto l1012
+						l1013:
+							position, tokenIndex = position1012, tokenIndex1012
+							if buffer[position] != rune('U') {
+								goto l1002
+							}
+							position++
+						}
+					l1012:
+						{
+							position1014, tokenIndex1014 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1015
+							}
+							position++
+							goto l1014
+						l1015:
+							position, tokenIndex = position1014, tokenIndex1014
+							if buffer[position] != rune('R') {
+								goto l1002
+							}
+							position++
+						}
+					l1014:
+						{
+							position1016, tokenIndex1016 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1017
+							}
+							position++
+							goto l1016
+						l1017:
+							position, tokenIndex = position1016, tokenIndex1016
+							if buffer[position] != rune('E') {
+								goto l1002
+							}
+							position++
+						}
+					l1016:
+						{
+							position1018, tokenIndex1018 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1019
+							}
+							position++
+							goto l1018
+						l1019:
+							position, tokenIndex = position1018, tokenIndex1018
+							if buffer[position] != rune('S') {
+								goto l1002
+							}
+							position++
+						}
+					l1018:
+						if !_rules[rulesp]() {
+							goto l1002
+						}
+						if !_rules[ruleAliasExpression]() {
+							goto l1002
+						}
+					l1020:
+						{
+							position1021, tokenIndex1021 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1021
+							}
+							if buffer[position] != rune(',') {
+								goto l1021
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1021
+							}
+							if !_rules[ruleAliasExpression]() {
+								goto l1021
+							}
+							goto l1020
+						l1021:
+							position, tokenIndex = position1021, tokenIndex1021
+						}
+						if !_rules[rulesp]() {
+							goto l1002
+						}
+						goto l1003
+					l1002:
+						position, tokenIndex = position1002, tokenIndex1002
+					}
+				l1003:
+					add(rulePegText, position1001)
+				}
+				if !_rules[ruleAction45]() {
+					goto l999
+				}
+				add(ruleMatchMeasuresOpt, position1000)
+			}
+			return true
+		l999:
+			position, tokenIndex = position999, tokenIndex999
+			return false
+		},
+		/* 60 PatternDefinition <- <(Identifier sp (('a' / 'A') ('s' / 'S')) sp Expression Action46)> */
+		func() bool {
+			position1022, tokenIndex1022 := position, tokenIndex
+			{
+				position1023 := position
+				if !_rules[ruleIdentifier]() {
+					goto l1022
+				}
+				if !_rules[rulesp]() {
+					goto l1022
+				}
+				{
+					position1024, tokenIndex1024 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1025
+					}
+					position++
+					goto l1024
+				l1025:
+					position, tokenIndex = position1024, tokenIndex1024
+					if buffer[position] != rune('A') {
+						goto l1022
+					}
+					position++
+				}
+			l1024:
+				{
+					position1026, tokenIndex1026 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1027
+					}
+					position++
+					goto l1026
+				l1027:
+					position, tokenIndex = position1026, tokenIndex1026
+					if buffer[position] != rune('S') {
+						goto l1022
+					}
+					position++
+				}
+			l1026:
+				if !_rules[rulesp]() {
+					goto l1022
+				}
+				if !_rules[ruleExpression]() {
+					goto l1022
+				}
+				if !_rules[ruleAction46]() {
+					goto l1022
+				}
+				add(rulePatternDefinition, position1023)
+			}
+			return true
+		l1022:
+			position, tokenIndex = position1022, tokenIndex1022
+			return false
+		},
+		/* 61 PatternAlternation <- <(<(PatternSequence (spOpt '|' spOpt PatternSequence)*)> Action47)> */
+		func() bool {
+			position1028, tokenIndex1028 := position, tokenIndex
+			{
+				position1029 := position
+				{
+					position1030 := position
+					if !_rules[rulePatternSequence]() {
+						goto l1028
+					}
+				l1031:
+					{
+						position1032, tokenIndex1032 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1032
+						}
+						if buffer[position] != rune('|') {
+							goto l1032
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1032
+						}
+						if !_rules[rulePatternSequence]() {
+							goto l1032
+						}
+						goto l1031
+					l1032:
+						position, tokenIndex = position1032, tokenIndex1032
+					}
+					add(rulePegText, position1030)
+				}
+				if !_rules[ruleAction47]() {
+					goto l1028
+				}
+				add(rulePatternAlternation, position1029)
+			}
+			return true
+		l1028:
+			position, tokenIndex = position1028, tokenIndex1028
+			return false
+		},
+		/* 62 PatternSequence <- <(<(PatternTerm (spOpt PatternTerm)*)> Action48)> */
+		func() bool {
+			position1033, tokenIndex1033 := position, tokenIndex
+			{
+				position1034 := position
+				{
+					position1035 := position
+					if !_rules[rulePatternTerm]() {
+						goto l1033
+					}
+				l1036:
+					{
+						position1037, tokenIndex1037 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1037
+						}
+						if !_rules[rulePatternTerm]() {
+							goto l1037
+						}
+						goto l1036
+					l1037:
+						position, tokenIndex = position1037, tokenIndex1037
+					}
+					add(rulePegText, position1035)
+				}
+				if !_rules[ruleAction48]() {
+					goto l1033
+				}
+				add(rulePatternSequence, position1034)
+			}
+			return true
+		l1033:
+			position, tokenIndex = position1033, tokenIndex1033
+			return false
+		},
+		/* 63 PatternTerm <- <(PatternPrimary PatternQuantifier Action49)> */
+		func() bool {
+			position1038, tokenIndex1038 := position, tokenIndex
+			{
+				position1039 := position
+				if !_rules[rulePatternPrimary]() {
+					goto l1038
+				}
+				if !_rules[rulePatternQuantifier]() {
+					goto l1038
+				}
+				if !_rules[ruleAction49]() {
+					goto l1038
+				}
+				add(rulePatternTerm, position1039)
+			}
+			return true
+		l1038:
+			position, tokenIndex = position1038, tokenIndex1038
+			return false
+		},
+		/* 64 PatternPrimary <- <(PatternVariable / ('(' spOpt PatternAlternation spOpt ')'))> */
+		func() bool {
+			position1040, tokenIndex1040 := position, tokenIndex
+			{
+				position1041 := position
+				{
+					position1042, tokenIndex1042 := position, tokenIndex
+					if !_rules[rulePatternVariable]() {
+						goto l1043
+					}
+					goto l1042
+				l1043:
+					position, tokenIndex = position1042, tokenIndex1042
+					if buffer[position] != rune('(') {
+						goto l1040
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1040
+					}
+					if !_rules[rulePatternAlternation]() {
+						goto l1040
+					}
+					if !_rules[rulespOpt]() {
+						goto l1040
+					}
+					if buffer[position] != rune(')') {
+						goto l1040
+					}
+					position++
+				}
+			l1042:
+				add(rulePatternPrimary, position1041)
+			}
+			return true
+		l1040:
+			position, tokenIndex = position1040, tokenIndex1040
+			return false
+		},
+		/* 65 PatternVariable <- <(<ident> Action50)> */
+		func() bool {
+			position1044, tokenIndex1044 := position, tokenIndex
+			{
+				position1045 := position
+				{
+					position1046 := position
+					if !_rules[ruleident]() {
+						goto l1044
+					}
+					add(rulePegText, position1046)
+				}
+				if !_rules[ruleAction50]() {
+					goto l1044
+				}
+				add(rulePatternVariable, position1045)
+			}
+			return true
+		l1044:
+			position, tokenIndex = position1044, tokenIndex1044
+			return false
+		},
+		/* 66 PatternQuantifier <- <(<('*' / '+' / '?' / ('{' spOpt (([0-9]+ spOpt (',' spOpt [0-9]*)?) / (',' spOpt [0-9]+)) spOpt '}'))?> Action51)> */
+		func() bool {
+			position1047, tokenIndex1047 := position, tokenIndex
+			{
+				position1048 := position
+				{
+					position1049 := position
+					{
+						position1050, tokenIndex1050 := position, tokenIndex
+						{
+							position1052, tokenIndex1052 := position, tokenIndex
+							if buffer[position] != rune('*') {
+								goto l1053
+							}
+							position++
+							goto l1052
+						l1053:
+							position, tokenIndex = position1052, tokenIndex1052
+							if buffer[position] != rune('+') {
+								goto l1054
+							}
+							position++
+							goto l1052
+						l1054:
+							position, tokenIndex = position1052, tokenIndex1052
+							if buffer[position] != rune('?') {
+								goto l1055
+							}
+							position++
+							goto l1052
+						l1055:
+							position, tokenIndex = position1052, tokenIndex1052
+							if buffer[position] != rune('{') {
+								goto l1050
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1050
+							}
+							{
+								position1056, tokenIndex1056 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1057
+								}
+								position++
+							l1058:
+								{
+									position1059, tokenIndex1059 := position, tokenIndex
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l1059
+									}
+									position++
+									goto l1058
+								l1059:
+									position, tokenIndex = position1059, tokenIndex1059
+								}
+								if !_rules[rulespOpt]() {
+									goto l1057
+								}
+								{
+									position1060, tokenIndex1060 := position, tokenIndex
+									if buffer[position] != rune(',') {
+										goto l1060
+									}
+									position++
+									if !_rules[rulespOpt]() {
+										goto l1060
+									}
+								l1062:
+									{
+										position1063, tokenIndex1063 := position, tokenIndex
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l1063
+										}
+										position++
+										goto l1062
+									l1063:
+										position, tokenIndex = position1063, tokenIndex1063
+									}
+									goto l1061
+								l1060:
+									position, tokenIndex = position1060, tokenIndex1060
+								}
+							l1061:
+								goto l1056
+							l1057:
+								position, tokenIndex = position1056, tokenIndex1056
+								if buffer[position] != rune(',') {
+									goto l1050
+								}
+								position++
+								if !_rules[rulespOpt]() {
+									goto l1050
+								}
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1050
+								}
+								position++
+							l1064:
+								{
+									position1065, tokenIndex1065 := position, tokenIndex
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l1065
+									}
+									position++
+									goto l1064
+								l1065:
+									position, tokenIndex = position1065, tokenIndex1065
+								}
+							}
+						l1056:
+							if !_rules[rulespOpt]() {
+								goto l1050
+							}
+							if buffer[position] != rune('}') {
+								goto l1050
+							}
+							position++
+						}
+					l1052:
+						goto l1051
+					l1050:
+						position, tokenIndex = position1050, tokenIndex1050
+					}
+				l1051:
+					add(rulePegText, position1049)
+				}
+				if !_rules[ruleAction51]() {
+					goto l1047
+				}
+				add(rulePatternQuantifier, position1048)
+			}
+			return true
+		l1047:
+			position, tokenIndex = position1047, tokenIndex1047
+			return false
+		},
+		/* 67 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action52)> */
+		func() bool {
+			position1066, tokenIndex1066 := position, tokenIndex
+			{
+				position1067 := position
+				{
+					position1068 := position
+					{
+						position1069, tokenIndex1069 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1069
+						}
+						{
+							position1071, tokenIndex1071 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1072
+							}
+							position++
+							goto l1071
+						l1072:
+							position, tokenIndex = position1071, tokenIndex1071
+							if buffer[position] != rune('W') {
+								goto l1069
+							}
+							position++
+						}
+					l1071:
+						{
+							position1073, tokenIndex1073 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1074
+							}
+							position++
+							goto l1073
+						l1074:
+							position, tokenIndex = position1073, tokenIndex1073
+							if buffer[position] != rune('H') {
+								goto l1069
+							}
+							position++
+						}
+					l1073:
+						{
+							position1075, tokenIndex1075 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1076
+							}
+							position++
+							goto l1075
+						l1076:
+							position, tokenIndex = position1075, tokenIndex1075
+							if buffer[position] != rune('E') {
+								goto l1069
+							}
+							position++
+						}
+					l1075:
+						{
+							position1077, tokenIndex1077 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1078
+							}
+							position++
+							goto l1077
+						l1078:
+							position, tokenIndex = position1077, tokenIndex1077
+							if buffer[position] != rune('R') {
+								goto l1069
+							}
+							position++
+						}
+					l1077:
+						{
+							position1079, tokenIndex1079 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1080
+							}
+							position++
+							goto l1079
+						l1080:
+							position, tokenIndex = position1079, tokenIndex1079
+							if buffer[position] != rune('E') {
+								goto l1069
+							}
+							position++
+						}
+					l1079:
+						if !_rules[rulesp]() {
+							goto l1069
+						}
+						if !_rules[ruleExpression]() {
+							goto l1069
+						}
+						goto l1070
+					l1069:
+						position, tokenIndex = position1069, tokenIndex1069
+					}
+				l1070:
+					add(rulePegText, position1068)
+				}
+				if !_rules[ruleAction52]() {
+					goto l1066
+				}
+				add(ruleFilter, position1067)
+			}
+			return true
+		l1066:
+			position, tokenIndex = position1066, tokenIndex1066
+			return false
+		},
+		/* 68 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action53)> */
+		func() bool {
+			position1081, tokenIndex1081 := position, tokenIndex
+			{
+				position1082 := position
+				{
+					position1083 := position
+					{
+						position1084, tokenIndex1084 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1084
+						}
+						{
+							position1086, tokenIndex1086 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1087
+							}
+							position++
+							goto l1086
+						l1087:
+							position, tokenIndex = position1086, tokenIndex1086
+							if buffer[position] != rune('G') {
+								goto l1084
+							}
+							position++
+						}
+					l1086:
+						{
+							position1088, tokenIndex1088 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1089
+							}
+							position++
+							goto l1088
+						l1089:
+							position, tokenIndex = position1088, tokenIndex1088
+							if buffer[position] != rune('R') {
+								goto l1084
+							}
+							position++
+						}
+					l1088:
+						{
+							position1090, tokenIndex1090 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1091
+							}
+							position++
+							goto l1090
+						l1091:
+							position, tokenIndex = position1090, tokenIndex1090
+							if buffer[position] != rune('O') {
+								goto l1084
+							}
+							position++
+						}
+					l1090:
+						{
+							position1092, tokenIndex1092 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1093
+							}
+							position++
+							goto l1092
+						l1093:
+							position, tokenIndex = position1092, tokenIndex1092
+							if buffer[position] != rune('U') {
+								goto l1084
+							}
+							position++
+						}
+					l1092:
+						{
+							position1094, tokenIndex1094 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1095
+							}
+							position++
+							goto l1094
+						l1095:
+							position, tokenIndex = position1094, tokenIndex1094
+							if buffer[position] != rune('P') {
+								goto l1084
+							}
+							position++
+						}
+					l1094:
+						if !_rules[rulesp]() {
+							goto l1084
+						}
+						{
+							position1096, tokenIndex1096 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1097
+							}
+							position++
+							goto l1096
+						l1097:
+							position, tokenIndex = position1096, tokenIndex1096
+							if buffer[position] != rune('B') {
+								goto l1084
+							}
+							position++
+						}
+					l1096:
+						{
+							position1098, tokenIndex1098 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1099
+							}
+							position++
+							goto l1098
+						l1099:
+							position, tokenIndex = position1098, tokenIndex1098
+							if buffer[position] != rune('Y') {
+								goto l1084
+							}
+							position++
+						}
+					l1098:
+						if !_rules[rulesp]() {
+							goto l1084
+						}
+						if !_rules[ruleGroupList]() {
+							goto l1084
+						}
+						goto l1085
+					l1084:
+						position, tokenIndex = position1084, tokenIndex1084
+					}
+				l1085:
+					add(rulePegText, position1083)
+				}
+				if !_rules[ruleAction53]() {
+					goto l1081
+				}
+				add(ruleGrouping, position1082)
+			}
+			return true
+		l1081:
+			position, tokenIndex = position1081, tokenIndex1081
+			return false
+		},
+		/* 69 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
+		func() bool {
+			position1100, tokenIndex1100 := position, tokenIndex
+			{
+				position1101 := position
+				if !_rules[ruleExpression]() {
+					goto l1100
+				}
+			l1102:
+				{
+					position1103, tokenIndex1103 := position, tokenIndex
+					if !_rules[rulespOpt]() {
+						goto l1103
+					}
+					if buffer[position] != rune(',') {
+						goto l1103
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1103
+					}
+					if !_rules[ruleExpression]() {
+						goto l1103
+					}
+					goto l1102
+				l1103:
+					position, tokenIndex = position1103, tokenIndex1103
+				}
+				add(ruleGroupList, position1101)
+			}
+			return true
+		l1100:
+			position, tokenIndex = position1100, tokenIndex1100
+			return false
+		},
+		/* 70 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action54)> */
+		func() bool {
+			position1104, tokenIndex1104 := position, tokenIndex
+			{
+				position1105 := position
+				{
+					position1106 := position
+					{
+						position1107, tokenIndex1107 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1107
+						}
+						{
+							position1109, tokenIndex1109 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1110
+							}
+							position++
+							goto l1109
+						l1110:
+							position, tokenIndex = position1109, tokenIndex1109
+							if buffer[position] != rune('H') {
+								goto l1107
+							}
+							position++
+						}
+					l1109:
+						{
+							position1111, tokenIndex1111 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1112
+							}
+							position++
+							goto l1111
+						l1112:
+							position, tokenIndex = position1111, tokenIndex1111
+							if buffer[position] != rune('A') {
+								goto l1107
+							}
+							position++
+						}
+					l1111:
+						{
+							position1113, tokenIndex1113 := position, tokenIndex
+							if buffer[position] != rune('v') {
+								goto l1114
+							}
+							position++
+							goto l1113
+						l1114:
+							position, tokenIndex = position1113, tokenIndex1113
+							if buffer[position] != rune('V') {
+								goto l1107
+							}
+							position++
+						}
+					l1113:
+						{
+							position1115, tokenIndex1115 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1116
+							}
+							position++
+							goto l1115
+						l1116:
+							position, tokenIndex = position1115, tokenIndex1115
+							if buffer[position] != rune('I') {
+								goto l1107
+							}
+							position++
+						}
+					l1115:
+						{
+							position1117, tokenIndex1117 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1118
+							}
+							position++
+							goto l1117
+						l1118:
+							position, tokenIndex = position1117, tokenIndex1117
+							if buffer[position] != rune('N') {
+								goto l1107
+							}
+							position++
+						}
+					l1117:
+						{
+							position1119, tokenIndex1119 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1120
+							}
+							position++
+							goto l1119
+						l1120:
+							position, tokenIndex = position1119, tokenIndex1119
+							if buffer[position] != rune('G') {
+								goto l1107
+							}
+							position++
+						}
+					l1119:
+						if !_rules[rulesp]() {
+							goto l1107
+						}
+						if !_rules[ruleExpression]() {
+							goto l1107
+						}
+						goto l1108
+					l1107:
+						position, tokenIndex = position1107, tokenIndex1107
+					}
+				l1108:
+					add(rulePegText, position1106)
+				}
+				if !_rules[ruleAction54]() {
+					goto l1104
+				}
+				add(ruleHaving, position1105)
+			}
+			return true
+		l1104:
+			position, tokenIndex = position1104, tokenIndex1104
+			return false
+		},
+		/* 71 Ordering <- <(<(sp (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action55)> */
+		func() bool {
+			position1121, tokenIndex1121 := position, tokenIndex
+			{
+				position1122 := position
+				{
+					position1123 := position
+					{
+						position1124, tokenIndex1124 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1124
+						}
+						{
+							position1126, tokenIndex1126 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1127
+							}
+							position++
+							goto l1126
+						l1127:
+							position, tokenIndex = position1126, tokenIndex1126
+							if buffer[position] != rune('O') {
+								goto l1124
+							}
+							position++
+						}
+					l1126:
+						{
+							position1128, tokenIndex1128 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1129
+							}
+							position++
+							goto l1128
+						l1129:
+							position, tokenIndex = position1128, tokenIndex1128
+							if buffer[position] != rune('R') {
+								goto l1124
+							}
+							position++
+						}
+					l1128:
+						{
+							position1130, tokenIndex1130 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1131
+							}
+							position++
+							goto l1130
+						l1131:
+							position, tokenIndex = position1130, tokenIndex1130
+							if buffer[position] != rune('D') {
+								goto l1124
+							}
+							position++
+						}
+					l1130:
+						{
+							position1132, tokenIndex1132 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1133
+							}
+							position++
+							goto l1132
+						l1133:
+							position, tokenIndex = position1132, tokenIndex1132
+							if buffer[position] != rune('E') {
+								goto l1124
+							}
+							position++
+						}
+					l1132:
+						{
+							position1134, tokenIndex1134 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1135
+							}
+							position++
+							goto l1134
+						l1135:
+							position, tokenIndex = position1134, tokenIndex1134
+							if buffer[position] != rune('R') {
+								goto l1124
+							}
+							position++
+						}
+					l1134:
+						if !_rules[rulesp]() {
+							goto l1124
+						}
+						{
+							position1136, tokenIndex1136 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1137
+							}
+							position++
+							goto l1136
+						l1137:
+							position, tokenIndex = position1136, tokenIndex1136
+							if buffer[position] != rune('B') {
+								goto l1124
+							}
+							position++
+						}
+					l1136:
+						{
+							position1138, tokenIndex1138 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1139
+							}
+							position++
+							goto l1138
+						l1139:
+							position, tokenIndex = position1138, tokenIndex1138
+							if buffer[position] != rune('Y') {
+								goto l1124
+							}
+							position++
+						}
+					l1138:
+						if !_rules[rulesp]() {
+							goto l1124
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1124
+						}
+					l1140:
+						{
+							position1141, tokenIndex1141 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1141
+							}
+							if buffer[position] != rune(',') {
+								goto l1141
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1141
+							}
+							if !_rules[ruleSortedExpression]() {
+								goto l1141
+							}
+							goto l1140
+						l1141:
+							position, tokenIndex = position1141, tokenIndex1141
+						}
+						goto l1125
+					l1124:
+						position, tokenIndex = position1124, tokenIndex1124
+					}
+				l1125:
+					add(rulePegText, position1123)
+				}
+				if !_rules[ruleAction55]() {
+					goto l1121
+				}
+				add(ruleOrdering, position1122)
+			}
+			return true
+		l1121:
+			position, tokenIndex = position1121, tokenIndex1121
+			return false
+		},
+		/* 72 Limit <- <(<(sp (('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T')) sp NumericLiteral)?> Action56)> */
+		func() bool {
+			position1142, tokenIndex1142 := position, tokenIndex
+			{
+				position1143 := position
+				{
+					position1144 := position
+					{
+						position1145, tokenIndex1145 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1145
+						}
+						{
+							position1147, tokenIndex1147 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1148
+							}
+							position++
+							goto l1147
+						l1148:
+							position, tokenIndex = position1147, tokenIndex1147
+							if buffer[position] != rune('L') {
+								goto l1145
+							}
+							position++
+						}
+					l1147:
+						{
+							position1149, tokenIndex1149 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1150
+							}
+							position++
+							goto l1149
+						l1150:
+							position, tokenIndex = position1149, tokenIndex1149
+							if buffer[position] != rune('I') {
+								goto l1145
+							}
+							position++
+						}
+					l1149:
+						{
+							position1151, tokenIndex1151 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1152
+							}
+							position++
+							goto l1151
+						l1152:
+							position, tokenIndex = position1151, tokenIndex1151
+							if buffer[position] != rune('M') {
+								goto l1145
+							}
+							position++
+						}
+					l1151:
+						{
+							position1153, tokenIndex1153 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1154
+							}
+							position++
+							goto l1153
+						l1154:
+							position, tokenIndex = position1153, tokenIndex1153
+							if buffer[position] != rune('I') {
+								goto l1145
+							}
+							position++
+						}
+					l1153:
+						{
+							position1155, tokenIndex1155 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1156
+							}
+							position++
+							goto l1155
+						l1156:
+							position, tokenIndex = position1155, tokenIndex1155
+							if buffer[position] != rune('T') {
+								goto l1145
+							}
+							position++
+						}
+					l1155:
+						if !_rules[rulesp]() {
+							goto l1145
+						}
+						if !_rules[ruleNumericLiteral]() {
+							goto l1145
+						}
+						goto l1146
+					l1145:
+						position, tokenIndex = position1145, tokenIndex1145
+					}
+				l1146:
+					add(rulePegText, position1144)
+				}
+				if !_rules[ruleAction56]() {
+					goto l1142
+				}
+				add(ruleLimit, position1143)
+			}
+			return true
+		l1142:
+			position, tokenIndex = position1142, tokenIndex1142
+			return false
+		},
+		/* 73 Offset <- <(<(sp (('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T')) sp NumericLiteral)?> Action57)> */
+		func() bool {
+			position1157, tokenIndex1157 := position, tokenIndex
+			{
+				position1158 := position
+				{
+					position1159 := position
+					{
+						position1160, tokenIndex1160 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1160
+						}
+						{
+							position1162, tokenIndex1162 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1163
+							}
+							position++
+							goto l1162
+						l1163:
+							position, tokenIndex = position1162, tokenIndex1162
+							if buffer[position] != rune('O') {
+								goto l1160
+							}
+							position++
+						}
+					l1162:
+						{
+							position1164, tokenIndex1164 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1165
+							}
+							position++
+							goto l1164
+						l1165:
+							position, tokenIndex = position1164, tokenIndex1164
+							if buffer[position] != rune('F') {
+								goto l1160
+							}
+							position++
+						}
+					l1164:
+						{
+							position1166, tokenIndex1166 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1167
+							}
+							position++
+							goto l1166
+						l1167:
+							position, tokenIndex = position1166, tokenIndex1166
+							if buffer[position] != rune('F') {
+								goto l1160
+							}
+							position++
+						}
+					l1166:
+						{
+							position1168, tokenIndex1168 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1169
+							}
+							position++
+							goto l1168
+						l1169:
+							position, tokenIndex = position1168, tokenIndex1168
+							if buffer[position] != rune('S') {
+								goto l1160
+							}
+							position++
+						}
+					l1168:
+						{
+							position1170, tokenIndex1170 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1171
+							}
+							position++
+							goto l1170
+						l1171:
+							position, tokenIndex = position1170, tokenIndex1170
+							if buffer[position] != rune('E') {
+								goto l1160
+							}
+							position++
+						}
+					l1170:
+						{
+							position1172, tokenIndex1172 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1173
+							}
+							position++
+							goto l1172
+						l1173:
+							position, tokenIndex = position1172, tokenIndex1172
+							if buffer[position] != rune('T') {
+								goto l1160
+							}
+							position++
+						}
+					l1172:
+						if !_rules[rulesp]() {
+							goto l1160
+						}
+						if !_rules[ruleNumericLiteral]() {
+							goto l1160
+						}
+						goto l1161
+					l1160:
+						position, tokenIndex = position1160, tokenIndex1160
+					}
+				l1161:
+					add(rulePegText, position1159)
+				}
+				if !_rules[ruleAction57]() {
+					goto l1157
+				}
+				add(ruleOffset, position1158)
+			}
+			return true
+		l1157:
+			position, tokenIndex = position1157, tokenIndex1157
+			return false
+		},
+		/* 74 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action58))> */
+		func() bool {
+			position1174, tokenIndex1174 := position, tokenIndex
+			{
+				position1175 := position
+				{
+					position1176, tokenIndex1176 := position, tokenIndex
+					if !_rules[ruleAliasedStreamWindow]() {
+						goto l1177
+					}
+					goto l1176
+				l1177:
+					position, tokenIndex = position1176, tokenIndex1176
+					if !_rules[ruleStreamWindow]() {
+						goto l1174
+					}
+					if !_rules[ruleAction58]() {
+						goto l1174
+					}
+				}
+			l1176:
+				add(ruleRelationLike, position1175)
+			}
+			return true
+		l1174:
+			position, tokenIndex = position1174, tokenIndex1174
+			return false
+		},
+		/* 75 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action59)> */
+		func() bool {
+			position1178, tokenIndex1178 := position, tokenIndex
+			{
+				position1179 := position
+				if !_rules[ruleStreamWindow]() {
+					goto l1178
+				}
+				if !_rules[rulesp]() {
+					goto l1178
+				}
+				{
+					position1180, tokenIndex1180 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1181
+					}
+					position++
+					goto l1180
+				l1181:
+					position, tokenIndex = position1180, tokenIndex1180
+					if buffer[position] != rune('A') {
+						goto l1178
+					}
+					position++
+				}
+			l1180:
+				{
+					position1182, tokenIndex1182 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1183
+					}
+					position++
+					goto l1182
+				l1183:
+					position, tokenIndex = position1182, tokenIndex1182
+					if buffer[position] != rune('S') {
+						goto l1178
+					}
+					position++
+				}
+			l1182:
+				if !_rules[rulesp]() {
+					goto l1178
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l1178
+				}
+				if !_rules[ruleAction59]() {
+					goto l1178
+				}
+				add(ruleAliasedStreamWindow, position1179)
+			}
+			return true
+		l1178:
+			position, tokenIndex = position1178, tokenIndex1178
+			return false
+		},
+		/* 76 StreamWindow <- <(StreamLike spOpt '[' spOpt WindowSpec CapacitySpecOpt SheddingSpecOpt spOpt ']' Action60)> */
+		func() bool {
+			position1184, tokenIndex1184 := position, tokenIndex
+			{
+				position1185 := position
+				if !_rules[ruleStreamLike]() {
+					goto l1184
+				}
+				if !_rules[rulespOpt]() {
+					goto l1184
+				}
+				if buffer[position] != rune('[') {
+					goto l1184
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1184
+				}
+				if !_rules[ruleWindowSpec]() {
+					goto l1184
+				}
+				if !_rules[ruleCapacitySpecOpt]() {
+					goto l1184
+				}
+				if !_rules[ruleSheddingSpecOpt]() {
+					goto l1184
+				}
+				if !_rules[rulespOpt]() {
+					goto l1184
+				}
+				if buffer[position] != rune(']') {
+					goto l1184
+				}
+				position++
+				if !_rules[ruleAction60]() {
+					goto l1184
+				}
+				add(ruleStreamWindow, position1185)
+			}
+			return true
+		l1184:
+			position, tokenIndex = position1184, tokenIndex1184
+			return false
+		},
+		/* 77 WindowSpec <- <(HoppingWindowSpec / SessionWindowSpec / SimpleWindowSpec)> */
+		func() bool {
+			position1186, tokenIndex1186 := position, tokenIndex
+			{
+				position1187 := position
+				{
+					position1188, tokenIndex1188 := position, tokenIndex
+					if !_rules[ruleHoppingWindowSpec]() {
+						goto l1189
+					}
+					goto l1188
+				l1189:
+					position, tokenIndex = position1188, tokenIndex1188
+					if !_rules[ruleSessionWindowSpec]() {
+						goto l1190
+					}
+					goto l1188
+				l1190:
+					position, tokenIndex = position1188, tokenIndex1188
+					if !_rules[ruleSimpleWindowSpec]() {
+						goto l1186
+					}
+				}
+			l1188:
+				add(ruleWindowSpec, position1187)
+			}
+			return true
+		l1186:
+			position, tokenIndex = position1186, tokenIndex1186
+			return false
+		},
+		/* 78 SimpleWindowSpec <- <((SlidingWindow / TumblingWindow) sp Interval Action61)> */
+		func() bool {
+			position1191, tokenIndex1191 := position, tokenIndex
+			{
+				position1192 := position
+				{
+					position1193, tokenIndex1193 := position, tokenIndex
+					if !_rules[ruleSlidingWindow]() {
+						goto l1194
+					}
+					goto l1193
+				l1194:
+					position, tokenIndex = position1193, tokenIndex1193
+					if !_rules[ruleTumblingWindow]() {
+						goto l1191
+					}
+				}
+			l1193:
+				if !_rules[rulesp]() {
+					goto l1191
+				}
+				if !_rules[ruleInterval]() {
+					goto l1191
+				}
+				if !_rules[ruleAction61]() {
+					goto l1191
+				}
+				add(ruleSimpleWindowSpec, position1192)
+			}
+			return true
+		l1191:
+			position, tokenIndex = position1191, tokenIndex1191
+			return false
+		},
+		/* 79 HoppingWindowSpec <- <(HoppingWindow sp Interval sp (('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y')) sp Interval Action62)> */
+		func() bool {
+			position1195, tokenIndex1195 := position, tokenIndex
+			{
+				position1196 := position
+				if !_rules[ruleHoppingWindow]() {
+					goto l1195
+				}
+				if !_rules[rulesp]() {
+					goto l1195
+				}
+				if !_rules[ruleInterval]() {
+					goto l1195
+				}
+				if !_rules[rulesp]() {
+					goto l1195
+				}
+				{
+					position1197, tokenIndex1197 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1198
+					}
+					position++
+					goto l1197
+				l1198:
+					position, tokenIndex = position1197, tokenIndex1197
+					if buffer[position] != rune('E') {
+						goto l1195
+					}
+					position++
+				}
+			l1197:
+				{
+					position1199, tokenIndex1199 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l1200
+					}
+					position++
+					goto l1199
+				l1200:
+					position, tokenIndex = position1199, tokenIndex1199
+					if buffer[position] != rune('V') {
+						goto l1195
+					}
+					position++
+				}
+			l1199:
+				{
+					position1201, tokenIndex1201 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1202
+					}
+					position++
+					goto l1201
+				l1202:
+					position, tokenIndex = position1201, tokenIndex1201
+					if buffer[position] != rune('E') {
+						goto l1195
+					}
+					position++
+				}
+			l1201:
+				{
+					position1203, tokenIndex1203 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1204
+					}
+					position++
+					goto l1203
+				l1204:
+					position, tokenIndex = position1203, tokenIndex1203
+					if buffer[position] != rune('R') {
+						goto l1195
+					}
+					position++
+				}
+			l1203:
+				{
+					position1205, tokenIndex1205 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l1206
+					}
+					position++
+					goto l1205
+				l1206:
+					position, tokenIndex = position1205, tokenIndex1205
+					if buffer[position] != rune('Y') {
+						goto l1195
+					}
+					position++
+				}
+			l1205:
+				if !_rules[rulesp]() {
+					goto l1195
+				}
+				if !_rules[ruleInterval]() {
+					goto l1195
+				}
+				if !_rules[ruleAction62]() {
+					goto l1195
+				}
+				add(ruleHoppingWindowSpec, position1196)
+			}
+			return true
+		l1195:
+			position, tokenIndex = position1195, tokenIndex1195
+			return false
+		},
+		/* 80 SessionWindowSpec <- <(SessionWindow sp (('g' / 'G') ('a' / 'A') ('p' / 'P')) sp TimeInterval sp (('b' / 'B') ('y' / 'Y')) sp Expression Action63)> */
+		func() bool {
+			position1207, tokenIndex1207 := position, tokenIndex
+			{
+				position1208 := position
+				if !_rules[ruleSessionWindow]() {
+					goto l1207
+				}
+				if !_rules[rulesp]() {
+					goto l1207
+				}
+				{
+					position1209, tokenIndex1209 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l1210
+					}
+					position++
+					goto l1209
+				l1210:
+					position, tokenIndex = position1209, tokenIndex1209
+					if buffer[position] != rune('G') {
+						goto l1207
+					}
+					position++
+				}
+			l1209:
+				{
+					position1211, tokenIndex1211 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1212
+					}
+					position++
+					goto l1211
+				l1212:
+					position, tokenIndex = position1211, tokenIndex1211
+					if buffer[position] != rune('A') {
+						goto l1207
+					}
+					position++
+				}
+			l1211:
+				{
+					position1213, tokenIndex1213 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1214
+					}
+					position++
+					goto l1213
+				l1214:
+					position, tokenIndex = position1213, tokenIndex1213
+					if buffer[position] != rune('P') {
+						goto l1207
+					}
+					position++
+				}
+			l1213:
+				if !_rules[rulesp]() {
+					goto l1207
+				}
+				if !_rules[ruleTimeInterval]() {
+					goto l1207
+				}
+				if !_rules[rulesp]() {
+					goto l1207
+				}
+				{
+					position1215, tokenIndex1215 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l1216
+					}
+					position++
+					goto l1215
+				l1216:
+					position, tokenIndex = position1215, tokenIndex1215
+					if buffer[position] != rune('B') {
+						goto l1207
+					}
+					position++
+				}
+			l1215:
+				{
+					position1217, tokenIndex1217 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l1218
+					}
+					position++
+					goto l1217
+				l1218:
+					position, tokenIndex = position1217, tokenIndex1217
+					if buffer[position] != rune('Y') {
+						goto l1207
+					}
+					position++
+				}
+			l1217:
+				if !_rules[rulesp]() {
+					goto l1207
+				}
+				if !_rules[ruleExpression]() {
+					goto l1207
+				}
+				if !_rules[ruleAction63]() {
+					goto l1207
+				}
+				add(ruleSessionWindowSpec, position1208)
+			}
+			return true
+		l1207:
+			position, tokenIndex = position1207, tokenIndex1207
+			return false
+		},
+		/* 81 StreamLike <- <(Subquery / UDSFFuncApp / Stream)> */
+		func() bool {
+			position1219, tokenIndex1219 := position, tokenIndex
+			{
+				position1220 := position
+				{
+					position1221, tokenIndex1221 := position, tokenIndex
+					if !_rules[ruleSubquery]() {
+						goto l1222
+					}
+					goto l1221
+				l1222:
+					position, tokenIndex = position1221, tokenIndex1221
+					if !_rules[ruleUDSFFuncApp]() {
+						goto l1223
+					}
+					goto l1221
+				l1223:
+					position, tokenIndex = position1221, tokenIndex1221
+					if !_rules[ruleStream]() {
+						goto l1219
+					}
+				}
+			l1221:
+				add(ruleStreamLike, position1220)
+			}
+			return true
+		l1219:
+			position, tokenIndex = position1219, tokenIndex1219
+			return false
+		},
+		/* 82 Subquery <- <(<('(' spOpt SelectStmt spOpt ')')> Action64)> */
+		func() bool {
+			position1224, tokenIndex1224 := position, tokenIndex
+			{
+				position1225 := position
+				{
+					position1226 := position
+					if buffer[position] != rune('(') {
+						goto l1224
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1224
+					}
+					if !_rules[ruleSelectStmt]() {
+						goto l1224
+					}
+					if !_rules[rulespOpt]() {
+						goto l1224
+					}
+					if buffer[position] != rune(')') {
+						goto l1224
+					}
+					position++
+					add(rulePegText, position1226)
+				}
+				if !_rules[ruleAction64]() {
+					goto l1224
+				}
+				add(ruleSubquery, position1225)
+			}
+			return true
+		l1224:
+			position, tokenIndex = position1224, tokenIndex1224
+			return false
+		},
+		/* 83 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action65)> */
+		func() bool {
+			position1227, tokenIndex1227 := position, tokenIndex
+			{
+				position1228 := position
+				if !_rules[ruleFuncAppWithoutOrderBy]() {
+					goto l1227
+				}
+				if !_rules[ruleAction65]() {
+					goto l1227
+				}
+				add(ruleUDSFFuncApp, position1228)
+			}
+			return true
+		l1227:
+			position, tokenIndex = position1227, tokenIndex1227
+			return false
+		},
+		/* 84 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action66)> */
+		func() bool {
+			position1229, tokenIndex1229 := position, tokenIndex
+			{
+				position1230 := position
+				{
+					position1231 := position
+					{
+						position1232, tokenIndex1232 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1232
+						}
+						if buffer[position] != rune(',') {
+							goto l1232
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1232
+						}
+						{
+							position1234, tokenIndex1234 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1235
+							}
+							position++
+							goto l1234
+						l1235:
+							position, tokenIndex = position1234, tokenIndex1234
+							if buffer[position] != rune('B') {
+								goto l1232
+							}
+							position++
+						}
+					l1234:
+						{
+							position1236, tokenIndex1236 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1237
+							}
+							position++
+							goto l1236
+						l1237:
+							position, tokenIndex = position1236, tokenIndex1236
+							if buffer[position] != rune('U') {
+								goto l1232
+							}
+							position++
+						}
+					l1236:
+						{
+							position1238, tokenIndex1238 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1239
+							}
+							position++
+							goto l1238
+						l1239:
+							position, tokenIndex = position1238, tokenIndex1238
+							if buffer[position] != rune('F') {
+								goto l1232
+							}
+							position++
+						}
+					l1238:
+						{
+							position1240, tokenIndex1240 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1241
+							}
+							position++
+							goto l1240
+						l1241:
+							position, tokenIndex = position1240, tokenIndex1240
+							if buffer[position] != rune('F') {
+								goto l1232
+							}
+							position++
+						}
+					l1240:
+						{
+							position1242, tokenIndex1242 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1243
+							}
+							position++
+							goto l1242
+						l1243:
+							position, tokenIndex = position1242, tokenIndex1242
+							if buffer[position] != rune('E') {
+								goto l1232
+							}
+							position++
+						}
+					l1242:
+						{
+							position1244, tokenIndex1244 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1245
+							}
+							position++
+							goto l1244
+						l1245:
+							position, tokenIndex = position1244, tokenIndex1244
+							if buffer[position] != rune('R') {
+								goto l1232
+							}
+							position++
+						}
+					l1244:
+						if !_rules[rulesp]() {
+							goto l1232
+						}
+						{
+							position1246, tokenIndex1246 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1247
+							}
+							position++
+							goto l1246
+						l1247:
+							position, tokenIndex = position1246, tokenIndex1246
+							if buffer[position] != rune('S') {
+								goto l1232
+							}
+							position++
+						}
+					l1246:
+						{
+							position1248, tokenIndex1248 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1249
+							}
+							position++
+							goto l1248
+						l1249:
+							position, tokenIndex = position1248, tokenIndex1248
+							if buffer[position] != rune('I') {
+								goto l1232
+							}
+							position++
+						}
+					l1248:
+						{
+							position1250, tokenIndex1250 := position, tokenIndex
+							if buffer[position] != rune('z') {
+								goto l1251
+							}
+							position++
+							goto l1250
+						l1251:
+							position, tokenIndex = position1250, tokenIndex1250
+							if buffer[position] != rune('Z') {
+								goto l1232
+							}
+							position++
+						}
+					l1250:
+						{
+							position1252, tokenIndex1252 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1253
+							}
+							position++
+							goto l1252
+						l1253:
+							position, tokenIndex = position1252, tokenIndex1252
+							if buffer[position] != rune('E') {
+								goto l1232
+							}
+							position++
+						}
+					l1252:
+						if !_rules[rulesp]() {
+							goto l1232
+						}
+						if !_rules[ruleNonNegativeNumericLiteral]() {
+							goto l1232
+						}
+						goto l1233
+					l1232:
+						position, tokenIndex = position1232, tokenIndex1232
+					}
+				l1233:
+					add(rulePegText, position1231)
+				}
+				if !_rules[ruleAction66]() {
+					goto l1229
+				}
+				add(ruleCapacitySpecOpt, position1230)
+			}
+			return true
+		l1229:
+			position, tokenIndex = position1229, tokenIndex1229
+			return false
+		},
+		/* 85 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action67)> */
+		func() bool {
+			position1254, tokenIndex1254 := position, tokenIndex
+			{
+				position1255 := position
+				{
+					position1256 := position
+					{
+						position1257, tokenIndex1257 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1257
+						}
+						if buffer[position] != rune(',') {
+							goto l1257
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1257
+						}
+						if !_rules[ruleSheddingOption]() {
+							goto l1257
+						}
+						if !_rules[rulesp]() {
+							goto l1257
+						}
+						{
+							position1259, tokenIndex1259 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1260
+							}
+							position++
+							goto l1259
+						l1260:
+							position, tokenIndex = position1259, tokenIndex1259
+							if buffer[position] != rune('I') {
+								goto l1257
+							}
+							position++
+						}
+					l1259:
+						{
+							position1261, tokenIndex1261 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1262
+							}
+							position++
+							goto l1261
+						l1262:
+							position, tokenIndex = position1261, tokenIndex1261
+							if buffer[position] != rune('F') {
+								goto l1257
+							}
+							position++
+						}
+					l1261:
+						if !_rules[rulesp]() {
+							goto l1257
+						}
+						{
+							position1263, tokenIndex1263 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1264
+							}
+							position++
+							goto l1263
+						l1264:
+							position, tokenIndex = position1263, tokenIndex1263
+							if buffer[position] != rune('F') {
+								goto l1257
+							}
+							position++
+						}
+					l1263:
+						{
+							position1265, tokenIndex1265 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1266
+							}
+							position++
+							goto l1265
+						l1266:
+							position, tokenIndex = position1265, tokenIndex1265
+							if buffer[position] != rune('U') {
+								goto l1257
+							}
+							position++
+						}
+					l1265:
+						{
+							position1267, tokenIndex1267 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1268
+							}
+							position++
+							goto l1267
+						l1268:
+							position, tokenIndex = position1267, tokenIndex1267
+							if buffer[position] != rune('L') {
+								goto l1257
+							}
+							position++
+						}
+					l1267:
+						{
+							position1269, tokenIndex1269 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1270
+							}
+							position++
+							goto l1269
+						l1270:
+							position, tokenIndex = position1269, tokenIndex1269
+							if buffer[position] != rune('L') {
+								goto l1257
+							}
+							position++
+						}
+					l1269:
+						goto l1258
+					l1257:
+						position, tokenIndex = position1257, tokenIndex1257
+					}
+				l1258:
+					add(rulePegText, position1256)
+				}
+				if !_rules[ruleAction67]() {
+					goto l1254
+				}
+				add(ruleSheddingSpecOpt, position1255)
+			}
+			return true
+		l1254:
+			position, tokenIndex = position1254, tokenIndex1254
+			return false
+		},
+		/* 86 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
+		func() bool {
+			position1271, tokenIndex1271 := position, tokenIndex
+			{
+				position1272 := position
+				{
+					position1273, tokenIndex1273 := position, tokenIndex
+					if !_rules[ruleWait]() {
+						goto l1274
+					}
+					goto l1273
+				l1274:
+					position, tokenIndex = position1273, tokenIndex1273
+					if !_rules[ruleDropOldest]() {
+						goto l1275
+					}
+					goto l1273
+				l1275:
+					position, tokenIndex = position1273, tokenIndex1273
+					if !_rules[ruleDropNewest]() {
+						goto l1271
+					}
+				}
+			l1273:
+				add(ruleSheddingOption, position1272)
+			}
+			return true
+		l1271:
+			position, tokenIndex = position1271, tokenIndex1271
+			return false
+		},
+		/* 87 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action68)> */
+		func() bool {
+			position1276, tokenIndex1276 := position, tokenIndex
+			{
+				position1277 := position
+				{
+					position1278 := position
+					{
+						position1279, tokenIndex1279 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1279
+						}
+						{
+							position1281, tokenIndex1281 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1282
+							}
+							position++
+							goto l1281
+						l1282:
+							position, tokenIndex = position1281, tokenIndex1281
+							if buffer[position] != rune('W') {
+								goto l1279
+							}
+							position++
+						}
+					l1281:
+						{
+							position1283, tokenIndex1283 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1284
+							}
+							position++
+							goto l1283
+						l1284:
+							position, tokenIndex = position1283, tokenIndex1283
+							if buffer[position] != rune('I') {
+								goto l1279
+							}
+							position++
+						}
+					l1283:
+						{
+							position1285, tokenIndex1285 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1286
+							}
+							position++
+							goto l1285
+						l1286:
+							position, tokenIndex = position1285, tokenIndex1285
+							if buffer[position] != rune('T') {
+								goto l1279
+							}
+							position++
+						}
+					l1285:
+						{
+							position1287, tokenIndex1287 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1288
+							}
+							position++
+							goto l1287
+						l1288:
+							position, tokenIndex = position1287, tokenIndex1287
+							if buffer[position] != rune('H') {
+								goto l1279
+							}
+							position++
+						}
+					l1287:
+						if !_rules[rulesp]() {
+							goto l1279
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1279
+						}
+					l1289:
+						{
+							position1290, tokenIndex1290 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1290
+							}
+							if buffer[position] != rune(',') {
+								goto l1290
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1290
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1290
+							}
+							goto l1289
+						l1290:
+							position, tokenIndex = position1290, tokenIndex1290
+						}
+						goto l1280
+					l1279:
+						position, tokenIndex = position1279, tokenIndex1279
+					}
+				l1280:
+					add(rulePegText, position1278)
+				}
+				if !_rules[ruleAction68]() {
+					goto l1276
+				}
+				add(ruleSourceSinkSpecs, position1277)
+			}
+			return true
+		l1276:
+			position, tokenIndex = position1276, tokenIndex1276
+			return false
+		},
+		/* 88 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action69)> */
+		func() bool {
+			position1291, tokenIndex1291 := position, tokenIndex
+			{
+				position1292 := position
+				{
+					position1293 := position
+					if !_rules[rulesp]() {
+						goto l1291
+					}
+					{
+						position1294, tokenIndex1294 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1295
+						}
+						position++
+						goto l1294
+					l1295:
+						position, tokenIndex = position1294, tokenIndex1294
+						if buffer[position] != rune('S') {
+							goto l1291
+						}
+						position++
+					}
+				l1294:
+					{
+						position1296, tokenIndex1296 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1297
+						}
+						position++
+						goto l1296
+					l1297:
+						position, tokenIndex = position1296, tokenIndex1296
+						if buffer[position] != rune('E') {
+							goto l1291
+						}
+						position++
+					}
+				l1296:
+					{
+						position1298, tokenIndex1298 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1299
+						}
+						position++
+						goto l1298
+					l1299:
+						position, tokenIndex = position1298, tokenIndex1298
+						if buffer[position] != rune('T') {
+							goto l1291
+						}
+						position++
+					}
+				l1298:
+					if !_rules[rulesp]() {
+						goto l1291
+					}
+					if !_rules[ruleSourceSinkParam]() {
+						goto l1291
+					}
+				l1300:
+					{
+						position1301, tokenIndex1301 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1301
+						}
+						if buffer[position] != rune(',') {
+							goto l1301
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1301
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1301
+						}
+						goto l1300
+					l1301:
+						position, tokenIndex = position1301, tokenIndex1301
+					}
+					add(rulePegText, position1293)
+				}
+				if !_rules[ruleAction69]() {
+					goto l1291
+				}
+				add(ruleUpdateSourceSinkSpecs, position1292)
+			}
+			return true
+		l1291:
+			position, tokenIndex = position1291, tokenIndex1291
+			return false
+		},
+		/* 89 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action70)> */
+		func() bool {
+			position1302, tokenIndex1302 := position, tokenIndex
+			{
+				position1303 := position
+				{
+					position1304 := position
+					{
+						position1305, tokenIndex1305 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1305
+						}
+						{
+							position1307, tokenIndex1307 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1308
+							}
+							position++
+							goto l1307
+						l1308:
+							position, tokenIndex = position1307, tokenIndex1307
+							if buffer[position] != rune('S') {
+								goto l1305
+							}
+							position++
+						}
+					l1307:
+						{
+							position1309, tokenIndex1309 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1310
+							}
+							position++
+							goto l1309
+						l1310:
+							position, tokenIndex = position1309, tokenIndex1309
+							if buffer[position] != rune('E') {
+								goto l1305
+							}
+							position++
+						}
+					l1309:
+						{
+							position1311, tokenIndex1311 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1312
+							}
+							position++
+							goto l1311
+						l1312:
+							position, tokenIndex = position1311, tokenIndex1311
+							if buffer[position] != rune('T') {
+								goto l1305
+							}
+							position++
+						}
+					l1311:
+						if !_rules[rulesp]() {
+							goto l1305
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1305
+						}
+					l1313:
+						{
+							position1314, tokenIndex1314 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1314
+							}
+							if buffer[position] != rune(',') {
+								goto l1314
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1314
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1314
+							}
+							goto l1313
+						l1314:
+							position, tokenIndex = position1314, tokenIndex1314
+						}
+						goto l1306
+					l1305:
+						position, tokenIndex = position1305, tokenIndex1305
+					}
+				l1306:
+					add(rulePegText, position1304)
+				}
+				if !_rules[ruleAction70]() {
+					goto l1302
+				}
+				add(ruleSetOptSpecs, position1303)
+			}
+			return true
+		l1302:
+			position, tokenIndex = position1302, tokenIndex1302
+			return false
+		},
+		/* 90 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action71)> */
+		func() bool {
+			position1315, tokenIndex1315 := position, tokenIndex
+			{
+				position1316 := position
+				{
+					position1317 := position
+					{
+						position1318, tokenIndex1318 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1318
+						}
+						{
+							position1320, tokenIndex1320 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1321
+							}
+							position++
+							goto l1320
+						l1321:
+							position, tokenIndex = position1320, tokenIndex1320
+							if buffer[position] != rune('T') {
+								goto l1318
+							}
+							position++
+						}
+					l1320:
+						{
+							position1322, tokenIndex1322 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1323
+							}
+							position++
+							goto l1322
+						l1323:
+							position, tokenIndex = position1322, tokenIndex1322
+							if buffer[position] != rune('A') {
+								goto l1318
+							}
+							position++
+						}
+					l1322:
+						{
+							position1324, tokenIndex1324 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1325
+							}
+							position++
+							goto l1324
+						l1325:
+							position, tokenIndex = position1324, tokenIndex1324
+							if buffer[position] != rune('G') {
+								goto l1318
+							}
+							position++
+						}
+					l1324:
+						if !_rules[rulesp]() {
+							goto l1318
+						}
+						if !_rules[ruleIdentifier]() {
+							goto l1318
+						}
+						goto l1319
+					l1318:
+						position, tokenIndex = position1318, tokenIndex1318
+					}
+				l1319:
+					add(rulePegText, position1317)
+				}
+				if !_rules[ruleAction71]() {
+					goto l1315
+				}
+				add(ruleStateTagOpt, position1316)
+			}
+			return true
+		l1315:
+			position, tokenIndex = position1315, tokenIndex1315
+			return false
+		},
+		/* 91 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action72)> */
+		func() bool {
+			position1326, tokenIndex1326 := position, tokenIndex
+			{
+				position1327 := position
+				if !_rules[ruleSourceSinkParamKey]() {
+					goto l1326
+				}
+				if !_rules[rulespOpt]() {
+					goto l1326
+				}
+				if buffer[position] != rune('=') {
+					goto l1326
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1326
+				}
+				if !_rules[ruleSourceSinkParamVal]() {
+					goto l1326
+				}
+				if !_rules[ruleAction72]() {
+					goto l1326
+				}
+				add(ruleSourceSinkParam, position1327)
+			}
+			return true
+		l1326:
+			position, tokenIndex = position1326, tokenIndex1326
+			return false
+		},
+		/* 92 SourceSinkParamVal <- <ParamLiteral> */
+		func() bool {
+			position1328, tokenIndex1328 := position, tokenIndex
+			{
+				position1329 := position
+				if !_rules[ruleParamLiteral]() {
+					goto l1328
+				}
+				add(ruleSourceSinkParamVal, position1329)
+			}
+			return true
+		l1328:
+			position, tokenIndex = position1328, tokenIndex1328
+			return false
+		},
+		/* 93 ParamLiteral <- <(BooleanLiteral / Literal / ParamArrayExpr / ParamMapExpr)> */
+		func() bool {
+			position1330, tokenIndex1330 := position, tokenIndex
+			{
+				position1331 := position
+				{
+					position1332, tokenIndex1332 := position, tokenIndex
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1333
+					}
+					goto l1332
+				l1333:
+					position, tokenIndex = position1332, tokenIndex1332
+					if !_rules[ruleLiteral]() {
+						goto l1334
+					}
+					goto l1332
+				l1334:
+					position, tokenIndex = position1332, tokenIndex1332
+					if !_rules[ruleParamArrayExpr]() {
+						goto l1335
+					}
+					goto l1332
+				l1335:
+					position, tokenIndex = position1332, tokenIndex1332
+					if !_rules[ruleParamMapExpr]() {
+						goto l1330
+					}
+				}
+			l1332:
+				add(ruleParamLiteral, position1331)
+			}
+			return true
+		l1330:
+			position, tokenIndex = position1330, tokenIndex1330
+			return false
+		},
+		/* 94 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action73)> */
+		func() bool {
+			position1336, tokenIndex1336 := position, tokenIndex
+			{
+				position1337 := position
+				{
+					position1338 := position
+					if buffer[position] != rune('[') {
+						goto l1336
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1336
+					}
+					{
+						position1339, tokenIndex1339 := position, tokenIndex
+						if !_rules[ruleParamLiteral]() {
+							goto l1339
+						}
+					l1341:
+						{
+							position1342, tokenIndex1342 := position, tokenIndex
+							if buffer[position] != rune(',') {
+								goto l1342
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1342
+							}
+							if !_rules[ruleParamLiteral]() {
+								goto l1342
+							}
+							goto l1341
+						l1342:
+							position, tokenIndex = position1342, tokenIndex1342
+						}
+						goto l1340
+					l1339:
+						position, tokenIndex = position1339, tokenIndex1339
+					}
+				l1340:
+					if !_rules[rulespOpt]() {
+						goto l1336
+					}
+					{
+						position1343, tokenIndex1343 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l1343
+						}
+						position++
+						goto l1344
+					l1343:
+						position, tokenIndex = position1343, tokenIndex1343
+					}
+				l1344:
+					if !_rules[rulespOpt]() {
+						goto l1336
+					}
+					if buffer[position] != rune(']') {
+						goto l1336
+					}
+					position++
+					add(rulePegText, position1338)
+				}
+				if !_rules[ruleAction73]() {
+					goto l1336
+				}
+				add(ruleParamArrayExpr, position1337)
+			}
+			return true
+		l1336:
+			position, tokenIndex = position1336, tokenIndex1336
+			return false
+		},
+		/* 95 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action74)> */
+		func() bool {
+			position1345, tokenIndex1345 := position, tokenIndex
+			{
+				position1346 := position
+				{
+					position1347 := position
+					if buffer[position] != rune('{') {
+						goto l1345
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1345
+					}
+					{
+						position1348, tokenIndex1348 := position, tokenIndex
+						if !_rules[ruleParamKeyValuePair]() {
+							goto l1348
+						}
+					l1350:
+						{
+							position1351, tokenIndex1351 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1351
+							}
+							if buffer[position] != rune(',') {
+								goto l1351
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1351
+							}
+							if !_rules[ruleParamKeyValuePair]() {
+								goto l1351
+							}
+							goto l1350
+						l1351:
+							position, tokenIndex = position1351, tokenIndex1351
+						}
+						goto l1349
+					l1348:
+						position, tokenIndex = position1348, tokenIndex1348
+					}
+				l1349:
+					if !_rules[rulespOpt]() {
+						goto l1345
+					}
+					if buffer[position] != rune('}') {
+						goto l1345
+					}
+					position++
+					add(rulePegText, position1347)
+				}
+				if !_rules[ruleAction74]() {
+					goto l1345
+				}
+				add(ruleParamMapExpr, position1346)
+			}
+			return true
+		l1345:
+			position, tokenIndex = position1345, tokenIndex1345
+			return false
+		},
+		/* 96 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action75)> */
+		func() bool {
+			position1352, tokenIndex1352 := position, tokenIndex
+			{
+				position1353 := position
+				{
+					position1354 := position
+					if !_rules[ruleStringLiteral]() {
+						goto l1352
+					}
+					if !_rules[rulespOpt]() {
+						goto l1352
+					}
+					if buffer[position] != rune(':') {
+						goto l1352
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1352
+					}
+					if !_rules[ruleParamLiteral]() {
+						goto l1352
+					}
+					add(rulePegText, position1354)
+				}
+				if !_rules[ruleAction75]() {
+					goto l1352
+				}
+				add(ruleParamKeyValuePair, position1353)
+			}
+			return true
+		l1352:
+			position, tokenIndex = position1352, tokenIndex1352
+			return false
+		},
+		/* 97 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action76)> */
+		func() bool {
+			position1355, tokenIndex1355 := position, tokenIndex
+			{
+				position1356 := position
+				{
+					position1357 := position
+					{
+						position1358, tokenIndex1358 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1358
+						}
+						{
+							position1360, tokenIndex1360 := position, tokenIndex
+							if !_rules[rulePaused]() {
+								goto l1361
+							}
+							goto l1360
+						l1361:
+							position, tokenIndex = position1360, tokenIndex1360
+							if !_rules[ruleUnpaused]() {
+								goto l1358
+							}
+						}
+					l1360:
+						goto l1359
+					l1358:
+						position, tokenIndex = position1358, tokenIndex1358
+					}
+				l1359:
+					add(rulePegText, position1357)
+				}
+				if !_rules[ruleAction76]() {
+					goto l1355
+				}
+				add(rulePausedOpt, position1356)
+			}
+			return true
+		l1355:
+			position, tokenIndex = position1355, tokenIndex1355
+			return false
+		},
+		/* 98 ExpressionOrWildcard <- <(Wildcard / Expression)> */
+		func() bool {
+			position1362, tokenIndex1362 := position, tokenIndex
+			{
+				position1363 := position
+				{
+					position1364, tokenIndex1364 := position, tokenIndex
+					if !_rules[ruleWildcard]() {
+						goto l1365
+					}
+					goto l1364
+				l1365:
+					position, tokenIndex = position1364, tokenIndex1364
+					if !_rules[ruleExpression]() {
+						goto l1362
+					}
+				}
+			l1364:
+				add(ruleExpressionOrWildcard, position1363)
+			}
+			return true
+		l1362:
+			position, tokenIndex = position1362, tokenIndex1362
+			return false
+		},
+		/* 99 Expression <- <orExpr> */
+		func() bool {
+			position1366, tokenIndex1366 := position, tokenIndex
+			{
+				position1367 := position
+				if !_rules[ruleorExpr]() {
+					goto l1366
+				}
+				add(ruleExpression, position1367)
+			}
+			return true
+		l1366:
+			position, tokenIndex = position1366, tokenIndex1366
+			return false
+		},
+		/* 100 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action77)> */
+		func() bool {
+			position1368, tokenIndex1368 := position, tokenIndex
+			{
+				position1369 := position
+				{
+					position1370 := position
+					if !_rules[ruleandExpr]() {
+						goto l1368
+					}
+				l1371:
+					{
+						position1372, tokenIndex1372 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1372
+						}
+						if !_rules[ruleOr]() {
+							goto l1372
+						}
+						if !_rules[rulesp]() {
+							goto l1372
+						}
+						if !_rules[ruleandExpr]() {
+							goto l1372
+						}
+						goto l1371
+					l1372:
+						position, tokenIndex = position1372, tokenIndex1372
+					}
+					add(rulePegText, position1370)
+				}
+				if !_rules[ruleAction77]() {
+					goto l1368
+				}
+				add(ruleorExpr, position1369)
+			}
+			return true
+		l1368:
+			position, tokenIndex = position1368, tokenIndex1368
+			return false
+		},
+		/* 101 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action78)> */
+		func() bool {
+			position1373, tokenIndex1373 := position, tokenIndex
+			{
+				position1374 := position
+				{
+					position1375 := position
+					if !_rules[rulenotExpr]() {
+						goto l1373
+					}
+				l1376:
+					{
+						position1377, tokenIndex1377 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1377
+						}
+						if !_rules[ruleAnd]() {
+							goto l1377
+						}
+						if !_rules[rulesp]() {
+							goto l1377
+						}
+						if !_rules[rulenotExpr]() {
+							goto l1377
+						}
+						goto l1376
+					l1377:
+						position, tokenIndex = position1377, tokenIndex1377
+					}
+					add(rulePegText, position1375)
+				}
+				if !_rules[ruleAction78]() {
+					goto l1373
+				}
+				add(ruleandExpr, position1374)
+			}
+			return true
+		l1373:
+			position, tokenIndex = position1373, tokenIndex1373
+			return false
+		},
+		/* 102 notExpr <- <(<((Not sp)? comparisonExpr)> Action79)> */
+		func() bool {
+			position1378, tokenIndex1378 := position, tokenIndex
+			{
+				position1379 := position
+				{
+					position1380 := position
+					{
+						position1381, tokenIndex1381 := position, tokenIndex
+						if !_rules[ruleNot]() {
+							goto l1381
+						}
+						if !_rules[rulesp]() {
+							goto l1381
+						}
+						goto l1382
+					l1381:
+						position, tokenIndex = position1381, tokenIndex1381
+					}
+				l1382:
+					if !_rules[rulecomparisonExpr]() {
+						goto l1378
+					}
+					add(rulePegText, position1380)
+				}
+				if !_rules[ruleAction79]() {
+					goto l1378
+				}
+				add(rulenotExpr, position1379)
+			}
+			return true
+		l1378:
+			position, tokenIndex = position1378, tokenIndex1378
+			return false
+		},
+		/* 103 comparisonExpr <- <(<(otherOpExpr ((spOpt ComparisonOp spOpt otherOpExpr) / (sp PatternMatchOp sp otherOpExpr) / InPredicate / BetweenPredicate)?)> Action80)> */
+		func() bool {
+			position1383, tokenIndex1383 := position, tokenIndex
+			{
+				position1384 := position
+				{
+					position1385 := position
+					if !_rules[ruleotherOpExpr]() {
+						goto l1383
+					}
+					{
+						position1386, tokenIndex1386 := position, tokenIndex
+						{
+							position1388, tokenIndex1388 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1389
+							}
+							if !_rules[ruleComparisonOp]() {
+								goto l1389
+							}
+							if !_rules[rulespOpt]() {
+								goto l1389
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1389
+							}
+							goto l1388
+						l1389:
+							position, tokenIndex = position1388, tokenIndex1388
+							if !_rules[rulesp]() {
+								goto l1390
+							}
+							if !_rules[rulePatternMatchOp]() {
+								goto l1390
+							}
+							if !_rules[rulesp]() {
+								goto l1390
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1390
+							}
+							goto l1388
+						l1390:
+							position, tokenIndex = position1388, tokenIndex1388
+							if !_rules[ruleInPredicate]() {
+								goto l1391
+							}
+							goto l1388
+						l1391:
+							position, tokenIndex = position1388, tokenIndex1388
+							if !_rules[ruleBetweenPredicate]() {
+								goto l1386
+							}
+						}
+					l1388:
+						goto l1387
+					l1386:
+						position, tokenIndex = position1386, tokenIndex1386
+					}
+				l1387:
+					add(rulePegText, position1385)
+				}
+				if !_rules[ruleAction80]() {
+					goto l1383
+				}
+				add(rulecomparisonExpr, position1384)
+			}
+			return true
+		l1383:
+			position, tokenIndex = position1383, tokenIndex1383
+			return false
+		},
+		/* 104 InPredicate <- <(<(sp NegationOpt (('i' / 'I') ('n' / 'N')) ((spOpt InList) / (sp otherOpExpr)))> Action81)> */
+		func() bool {
+			position1392, tokenIndex1392 := position, tokenIndex
+			{
+				position1393 := position
+				{
+					position1394 := position
+					if !_rules[rulesp]() {
+						goto l1392
+					}
+					if !_rules[ruleNegationOpt]() {
+						goto l1392
+					}
+					{
+						position1395, tokenIndex1395 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1396
+						}
+						position++
+						goto l1395
+					l1396:
+						position, tokenIndex = position1395, tokenIndex1395
+						if buffer[position] != rune('I') {
+							goto l1392
+						}
+						position++
+					}
+				l1395:
+					{
+						position1397, tokenIndex1397 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1398
+						}
+						position++
+						goto l1397
+					l1398:
+						position, tokenIndex = position1397, tokenIndex1397
+						if buffer[position] != rune('N') {
+							goto l1392
+						}
+						position++
+					}
+				l1397:
+					{
+						position1399, tokenIndex1399 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1400
+						}
+						if !_rules[ruleInList]() {
+							goto l1400
+						}
+						goto l1399
+					l1400:
+						position, tokenIndex = position1399, tokenIndex1399
+						if !_rules[rulesp]() {
+							goto l1392
+						}
+						if !_rules[ruleotherOpExpr]() {
+							goto l1392
+						}
+					}
+				l1399:
+					add(rulePegText, position1394)
+				}
+				if !_rules[ruleAction81]() {
+					goto l1392
+				}
+				add(ruleInPredicate, position1393)
+			}
+			return true
+		l1392:
+			position, tokenIndex = position1392, tokenIndex1392
+			return false
+		},
+		/* 105 InList <- <(<('(' spOpt Expression (spOpt ',' spOpt Expression)* spOpt ')')> Action82)> */
+		func() bool {
+			position1401, tokenIndex1401 := position, tokenIndex
+			{
+				position1402 := position
+				{
+					position1403 := position
+					if buffer[position] != rune('(') {
+						goto l1401
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1401
+					}
+					if !_rules[ruleExpression]() {
+						goto l1401
+					}
+				l1404:
+					{
+						position1405, tokenIndex1405 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1405
+						}
+						if buffer[position] != rune(',') {
+							goto l1405
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1405
+						}
+						if !_rules[ruleExpression]() {
+							goto l1405
+						}
+						goto l1404
+					l1405:
+						position, tokenIndex = position1405, tokenIndex1405
+					}
+					if !_rules[rulespOpt]() {
+						goto l1401
+					}
+					if buffer[position] != rune(')') {
+						goto l1401
+					}
+					position++
+					add(rulePegText, position1403)
+				}
+				if !_rules[ruleAction82]() {
+					goto l1401
+				}
+				add(ruleInList, position1402)
+			}
+			return true
+		l1401:
+			position, tokenIndex = position1401, tokenIndex1401
+			return false
+		},
+		/* 106 BetweenPredicate <- <(<(sp NegationOpt (('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N')) sp otherOpExpr sp (('a' / 'A') ('n' / 'N') ('d' / 'D')) sp otherOpExpr)> Action83)> */
+		func() bool {
+			position1406, tokenIndex1406 := position, tokenIndex
+			{
+				position1407 := position
+				{
+					position1408 := position
+					if !_rules[rulesp]() {
+						goto l1406
+					}
+					if !_rules[ruleNegationOpt]() {
+						goto l1406
+					}
+					{
+						position1409, tokenIndex1409 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1410
+						}
+						position++
+						goto l1409
+					l1410:
+						position, tokenIndex = position1409, tokenIndex1409
+						if buffer[position] != rune('B') {
+							goto l1406
+						}
+						position++
+					}
+				l1409:
+					{
+						position1411, tokenIndex1411 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1412
+						}
+						position++
+						goto l1411
+					l1412:
+						position, tokenIndex = position1411, tokenIndex1411
+						if buffer[position] != rune('E') {
+							goto l1406
+						}
+						position++
+					}
+				l1411:
+					{
+						position1413, tokenIndex1413 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1414
+						}
+						position++
+						goto l1413
+					l1414:
+						position, tokenIndex = position1413, tokenIndex1413
+						if buffer[position] != rune('T') {
+							goto l1406
+						}
+						position++
+					}
+				l1413:
+					{
+						position1415, tokenIndex1415 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l1416
+						}
+						position++
+						goto l1415
+					l1416:
+						position, tokenIndex = position1415, tokenIndex1415
+						if buffer[position] != rune('W') {
+							goto l1406
+						}
+						position++
+					}
+				l1415:
+					{
+						position1417, tokenIndex1417 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1418
+						}
+						position++
+						goto l1417
+					l1418:
+						position, tokenIndex = position1417, tokenIndex1417
+						if buffer[position] != rune('E') {
+							goto l1406
+						}
+						position++
+					}
+				l1417:
+					{
+						position1419, tokenIndex1419 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1420
+						}
+						position++
+						goto l1419
+					l1420:
+						position, tokenIndex = position1419, tokenIndex1419
+						if buffer[position] != rune('E') {
+							goto l1406
+						}
+						position++
+					}
+				l1419:
+					{
+						position1421, tokenIndex1421 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1422
+						}
+						position++
+						goto l1421
+					l1422:
+						position, tokenIndex = position1421, tokenIndex1421
+						if buffer[position] != rune('N') {
+							goto l1406
+						}
+						position++
+					}
+				l1421:
+					if !_rules[rulesp]() {
+						goto l1406
+					}
+					if !_rules[ruleotherOpExpr]() {
+						goto l1406
+					}
+					if !_rules[rulesp]() {
+						goto l1406
+					}
+					{
+						position1423, tokenIndex1423 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1424
+						}
+						position++
+						goto l1423
+					l1424:
+						position, tokenIndex = position1423, tokenIndex1423
+						if buffer[position] != rune('A') {
+							goto l1406
+						}
+						position++
+					}
+				l1423:
+					{
+						position1425, tokenIndex1425 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1426
+						}
+						position++
+						goto l1425
+					l1426:
+						position, tokenIndex = position1425, tokenIndex1425
+						if buffer[position] != rune('N') {
+							goto l1406
+						}
+						position++
+					}
+				l1425:
+					{
+						position1427, tokenIndex1427 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1428
+						}
+						position++
+						goto l1427
+					l1428:
+						position, tokenIndex = position1427, tokenIndex1427
+						if buffer[position] != rune('D') {
+							goto l1406
+						}
+						position++
+					}
+				l1427:
+					if !_rules[rulesp]() {
+						goto l1406
+					}
+					if !_rules[ruleotherOpExpr]() {
+						goto l1406
+					}
+					add(rulePegText, position1408)
+				}
+				if !_rules[ruleAction83]() {
+					goto l1406
+				}
+				add(ruleBetweenPredicate, position1407)
+			}
+			return true
+		l1406:
+			position, tokenIndex = position1406, tokenIndex1406
+			return false
+		},
+		/* 107 NegationOpt <- <(<(Negation sp)?> Action84)> */
+		func() bool {
+			position1429, tokenIndex1429 := position, tokenIndex
+			{
+				position1430 := position
+				{
+					position1431 := position
+					{
+						position1432, tokenIndex1432 := position, tokenIndex
+						if !_rules[ruleNegation]() {
+							goto l1432
+						}
+						if !_rules[rulesp]() {
+							goto l1432
+						}
+						goto l1433
+					l1432:
+						position, tokenIndex = position1432, tokenIndex1432
+					}
+				l1433:
+					add(rulePegText, position1431)
+				}
+				if !_rules[ruleAction84]() {
+					goto l1429
+				}
+				add(ruleNegationOpt, position1430)
+			}
+			return true
+		l1429:
+			position, tokenIndex = position1429, tokenIndex1429
+			return false
+		},
+		/* 108 Negation <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action85)> */
+		func() bool {
+			position1434, tokenIndex1434 := position, tokenIndex
+			{
+				position1435 := position
+				{
+					position1436 := position
+					{
+						position1437, tokenIndex1437 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1438
+						}
+						position++
+						goto l1437
+					l1438:
+						position, tokenIndex = position1437, tokenIndex1437
+						if buffer[position] != rune('N') {
+							goto l1434
+						}
+						position++
+					}
+				l1437:
+					{
+						position1439, tokenIndex1439 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1440
+						}
+						position++
+						goto l1439
+					l1440:
+						position, tokenIndex = position1439, tokenIndex1439
+						if buffer[position] != rune('O') {
+							goto l1434
+						}
+						position++
+					}
+				l1439:
+					{
+						position1441, tokenIndex1441 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1442
+						}
+						position++
+						goto l1441
+					l1442:
+						position, tokenIndex = position1441, tokenIndex1441
+						if buffer[position] != rune('T') {
+							goto l1434
+						}
+						position++
+					}
+				l1441:
+					add(rulePegText, position1436)
+				}
+				if !_rules[ruleAction85]() {
+					goto l1434
+				}
+				add(ruleNegation, position1435)
+			}
+			return true
+		l1434:
+			position, tokenIndex = position1434, tokenIndex1434
+			return false
+		},
+		/* 109 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action86)> */
+		func() bool {
+			position1443, tokenIndex1443 := position, tokenIndex
+			{
+				position1444 := position
+				{
+					position1445 := position
+					if !_rules[ruleisExpr]() {
+						goto l1443
+					}
+				l1446:
+					{
+						position1447, tokenIndex1447 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1447
+						}
+						if !_rules[ruleOtherOp]() {
+							goto l1447
+						}
+						if !_rules[rulespOpt]() {
+							goto l1447
+						}
+						if !_rules[ruleisExpr]() {
+							goto l1447
+						}
+						goto l1446
+					l1447:
+						position, tokenIndex = position1447, tokenIndex1447
+					}
+					add(rulePegText, position1445)
+				}
+				if !_rules[ruleAction86]() {
+					goto l1443
+				}
+				add(ruleotherOpExpr, position1444)
+			}
+			return true
+		l1443:
+			position, tokenIndex = position1443, tokenIndex1443
+			return false
+		},
+		/* 110 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action87)> */
+		func() bool {
+			position1448, tokenIndex1448 := position, tokenIndex
+			{
+				position1449 := position
+				{
+					position1450 := position
+					{
+						position1451, tokenIndex1451 := position, tokenIndex
+						if !_rules[ruleRowValue]() {
+							goto l1452
+						}
+						if !_rules[rulesp]() {
+							goto l1452
+						}
+						if !_rules[ruleIsOp]() {
+							goto l1452
+						}
+						if !_rules[rulesp]() {
+							goto l1452
+						}
+						if !_rules[ruleMissing]() {
+							goto l1452
+						}
+						goto l1451
+					l1452:
+						position, tokenIndex = position1451, tokenIndex1451
+						if !_rules[ruletermExpr]() {
+							goto l1448
+						}
+						{
+							position1453, tokenIndex1453 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l1453
+							}
+							if !_rules[ruleIsOp]() {
+								goto l1453
+							}
+							if !_rules[rulesp]() {
+								goto l1453
+							}
+							if !_rules[ruleNullLiteral]() {
+								goto l1453
+							}
+							goto l1454
+						l1453:
+							position, tokenIndex = position1453, tokenIndex1453
+						}
+					l1454:
+					}
+				l1451:
+					add(rulePegText, position1450)
+				}
+				if !_rules[ruleAction87]() {
+					goto l1448
+				}
+				add(ruleisExpr, position1449)
+			}
+			return true
+		l1448:
+			position, tokenIndex = position1448, tokenIndex1448
+			return false
+		},
+		/* 111 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action88)> */
+		func() bool {
+			position1455, tokenIndex1455 := position, tokenIndex
+			{
+				position1456 := position
+				{
+					position1457 := position
+					if !_rules[ruleproductExpr]() {
+						goto l1455
+					}
+				l1458:
+					{
+						position1459, tokenIndex1459 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1459
+						}
+						if !_rules[rulePlusMinusOp]() {
+							goto l1459
+						}
+						if !_rules[rulespOpt]() {
+							goto l1459
+						}
+						if !_rules[ruleproductExpr]() {
+							goto l1459
+						}
+						goto l1458
+					l1459:
+						position, tokenIndex = position1459, tokenIndex1459
+					}
+					add(rulePegText, position1457)
+				}
+				if !_rules[ruleAction88]() {
+					goto l1455
+				}
+				add(ruletermExpr, position1456)
+			}
+			return true
+		l1455:
+			position, tokenIndex = position1455, tokenIndex1455
+			return false
+		},
+		/* 112 productExpr <- <(<(atTimeZoneExpr (spOpt MultDivOp spOpt atTimeZoneExpr)*)> Action89)> */
+		func() bool {
+			position1460, tokenIndex1460 := position, tokenIndex
+			{
+				position1461 := position
+				{
+					position1462 := position
+					if !_rules[ruleatTimeZoneExpr]() {
+						goto l1460
+					}
+				l1463:
+					{
+						position1464, tokenIndex1464 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1464
+						}
+						if !_rules[ruleMultDivOp]() {
+							goto l1464
+						}
+						if !_rules[rulespOpt]() {
+							goto l1464
+						}
+						if !_rules[ruleatTimeZoneExpr]() {
+							goto l1464
+						}
+						goto l1463
+					l1464:
+						position, tokenIndex = position1464, tokenIndex1464
+					}
+					add(rulePegText, position1462)
+				}
+				if !_rules[ruleAction89]() {
+					goto l1460
+				}
+				add(ruleproductExpr, position1461)
+			}
+			return true
+		l1460:
+			position, tokenIndex = position1460, tokenIndex1460
+			return false
+		},
+		/* 113 atTimeZoneExpr <- <(<(minusExpr (sp AtTimeZone sp minusExpr)*)> Action90)> */
+		func() bool {
+			position1465, tokenIndex1465 := position, tokenIndex
+			{
+				position1466 := position
+				{
+					position1467 := position
+					if !_rules[ruleminusExpr]() {
+						goto l1465
+					}
+				l1468:
+					{
+						position1469, tokenIndex1469 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1469
+						}
+						if !_rules[ruleAtTimeZone]() {
+							goto l1469
+						}
+						if !_rules[rulesp]() {
+							goto l1469
+						}
+						if !_rules[ruleminusExpr]() {
+							goto l1469
+						}
+						goto l1468
+					l1469:
+						position, tokenIndex = position1469, tokenIndex1469
+					}
+					add(rulePegText, position1467)
+				}
+				if !_rules[ruleAction90]() {
+					goto l1465
+				}
+				add(ruleatTimeZoneExpr, position1466)
+			}
+			return true
+		l1465:
+			position, tokenIndex = position1465, tokenIndex1465
+			return false
+		},
+		/* 114 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action91)> */
+		func() bool {
+			position1470, tokenIndex1470 := position, tokenIndex
+			{
+				position1471 := position
+				{
+					position1472 := position
+					{
+						position1473, tokenIndex1473 := position, tokenIndex
+						if !_rules[ruleUnaryMinus]() {
+							goto l1473
+						}
+						if !_rules[rulespOpt]() {
+							goto l1473
+						}
+						goto l1474
+					l1473:
+						position, tokenIndex = position1473, tokenIndex1473
+					}
+				l1474:
+					if !_rules[rulecastExpr]() {
+						goto l1470
+					}
+					add(rulePegText, position1472)
+				}
+				if !_rules[ruleAction91]() {
+					goto l1470
+				}
+				add(ruleminusExpr, position1471)
+			}
+			return true
+		l1470:
+			position, tokenIndex = position1470, tokenIndex1470
+			return false
+		},
+		/* 115 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action92)> */
+		func() bool {
+			position1475, tokenIndex1475 := position, tokenIndex
+			{
+				position1476 := position
+				{
+					position1477 := position
+					if !_rules[rulebaseExpr]() {
+						goto l1475
+					}
+					{
+						position1478, tokenIndex1478 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1478
+						}
+						if buffer[position] != rune(':') {
+							goto l1478
+						}
+						position++
+						if buffer[position] != rune(':') {
+							goto l1478
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1478
+						}
+						if !_rules[ruleType]() {
+							goto l1478
+						}
+						goto l1479
+					l1478:
+						position, tokenIndex = position1478, tokenIndex1478
+					}
+				l1479:
+					add(rulePegText, position1477)
+				}
+				if !_rules[ruleAction92]() {
+					goto l1475
+				}
+				add(rulecastExpr, position1476)
+			}
+			return true
+		l1475:
+			position, tokenIndex = position1475, tokenIndex1475
+			return false
+		},
+		/* 116 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncAppSelector / FuncApp / RowValue / ArrayExpr / Literal)> */
+		func() bool {
+			position1480, tokenIndex1480 := position, tokenIndex
+			{
+				position1481 := position
+				{
+					position1482, tokenIndex1482 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l1483
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1483
+					}
+					if !_rules[ruleExpression]() {
+						goto l1483
+					}
+					if !_rules[rulespOpt]() {
+						goto l1483
+					}
+					if buffer[position] != rune(')') {
+						goto l1483
+					}
+					position++
+					goto l1482
+				l1483:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleMapExpr]() {
+						goto l1484
+					}
+					goto l1482
+				l1484:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1485
+					}
+					goto l1482
+				l1485:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleNullLiteral]() {
+						goto l1486
+					}
+					goto l1482
+				l1486:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleCase]() {
+						goto l1487
+					}
+					goto l1482
+				l1487:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleRowMeta]() {
+						goto l1488
+					}
+					goto l1482
+				l1488:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleFuncTypeCast]() {
+						goto l1489
+					}
+					goto l1482
+				l1489:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleFuncAppSelector]() {
+						goto l1490
+					}
+					goto l1482
+				l1490:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleFuncApp]() {
+						goto l1491
+					}
+					goto l1482
+				l1491:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleRowValue]() {
+						goto l1492
+					}
+					goto l1482
+				l1492:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleArrayExpr]() {
+						goto l1493
+					}
+					goto l1482
+				l1493:
+					position, tokenIndex = position1482, tokenIndex1482
+					if !_rules[ruleLiteral]() {
+						goto l1480
+					}
+				}
+			l1482:
+				add(rulebaseExpr, position1481)
+			}
+			return true
+		l1480:
+			position, tokenIndex = position1480, tokenIndex1480
+			return false
+		},
+		/* 117 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action93)> */
+		func() bool {
+			position1494, tokenIndex1494 := position, tokenIndex
+			{
+				position1495 := position
+				{
+					position1496 := position
+					{
+						position1497, tokenIndex1497 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1498
+						}
+						position++
+						goto l1497
+					l1498:
+						position, tokenIndex = position1497, tokenIndex1497
+						if buffer[position] != rune('C') {
+							goto l1494
+						}
+						position++
+					}
+				l1497:
+					{
+						position1499, tokenIndex1499 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1500
+						}
+						position++
+						goto l1499
+					l1500:
+						position, tokenIndex = position1499, tokenIndex1499
+						if buffer[position] != rune('A') {
+							goto l1494
+						}
+						position++
+					}
+				l1499:
+					{
+						position1501, tokenIndex1501 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1502
+						}
+						position++
+						goto l1501
+					l1502:
+						position, tokenIndex = position1501, tokenIndex1501
+						if buffer[position] != rune('S') {
+							goto l1494
+						}
+						position++
+					}
+				l1501:
+					{
+						position1503, tokenIndex1503 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1504
+						}
+						position++
+						goto l1503
+					l1504:
+						position, tokenIndex = position1503, tokenIndex1503
+						if buffer[position] != rune('T') {
+							goto l1494
+						}
+						position++
+					}
+				l1503:
+					if !_rules[rulespOpt]() {
+						goto l1494
+					}
+					if buffer[position] != rune('(') {
+						goto l1494
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1494
+					}
+					if !_rules[ruleExpression]() {
+						goto l1494
+					}
+					if !_rules[rulesp]() {
+						goto l1494
+					}
+					{
+						position1505, tokenIndex1505 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1506
+						}
+						position++
+						goto l1505
+					l1506:
+						position, tokenIndex = position1505, tokenIndex1505
+						if buffer[position] != rune('A') {
+							goto l1494
+						}
+						position++
+					}
+				l1505:
+					{
+						position1507, tokenIndex1507 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1508
+						}
+						position++
+						goto l1507
+					l1508:
+						position, tokenIndex = position1507, tokenIndex1507
+						if buffer[position] != rune('S') {
+							goto l1494
+						}
+						position++
+					}
+				l1507:
+					if !_rules[rulesp]() {
+						goto l1494
+					}
+					if !_rules[ruleType]() {
+						goto l1494
+					}
+					if !_rules[rulespOpt]() {
+						goto l1494
+					}
+					if buffer[position] != rune(')') {
+						goto l1494
+					}
+					position++
+					add(rulePegText, position1496)
+				}
+				if !_rules[ruleAction93]() {
+					goto l1494
+				}
+				add(ruleFuncTypeCast, position1495)
+			}
+			return true
+		l1494:
+			position, tokenIndex = position1494, tokenIndex1494
+			return false
+		},
+		/* 118 FuncApp <- <(FuncAppWithOver / FuncAppWithinGroup / FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		func() bool {
+			position1509, tokenIndex1509 := position, tokenIndex
+			{
+				position1510 := position
+				{
+					position1511, tokenIndex1511 := position, tokenIndex
+					if !_rules[ruleFuncAppWithOver]() {
+						goto l1512
+					}
+					goto l1511
+				l1512:
+					position, tokenIndex = position1511, tokenIndex1511
+					if !_rules[ruleFuncAppWithinGroup]() {
+						goto l1513
+					}
+					goto l1511
+				l1513:
+					position, tokenIndex = position1511, tokenIndex1511
+					if !_rules[ruleFuncAppWithOrderBy]() {
+						goto l1514
+					}
+					goto l1511
+				l1514:
+					position, tokenIndex = position1511, tokenIndex1511
+					if !_rules[ruleFuncAppWithoutOrderBy]() {
+						goto l1509
+					}
+				}
+			l1511:
+				add(ruleFuncApp, position1510)
+			}
+			return true
+		l1509:
+			position, tokenIndex = position1509, tokenIndex1509
+			return false
+		},
+		/* 119 FuncAppSelector <- <(FuncApp FuncElemAccessor Action94)> */
+		func() bool {
+			position1515, tokenIndex1515 := position, tokenIndex
+			{
+				position1516 := position
+				if !_rules[ruleFuncApp]() {
+					goto l1515
+				}
+				if !_rules[ruleFuncElemAccessor]() {
+					goto l1515
+				}
+				if !_rules[ruleAction94]() {
+					goto l1515
+				}
+				add(ruleFuncAppSelector, position1516)
+			}
+			return true
+		l1515:
+			position, tokenIndex = position1515, tokenIndex1515
+			return false
+		},
+		/* 120 FuncElemAccessor <- <(<jsonGetPathNonHead+> Action95)> */
+		func() bool {
+			position1517, tokenIndex1517 := position, tokenIndex
+			{
+				position1518 := position
+				{
+					position1519 := position
+					if !_rules[rulejsonGetPathNonHead]() {
+						goto l1517
+					}
+				l1520:
+					{
+						position1521, tokenIndex1521 := position, tokenIndex
+						if !_rules[rulejsonGetPathNonHead]() {
+							goto l1521
+						}
+						goto l1520
+					l1521:
+						position, tokenIndex = position1521, tokenIndex1521
+					}
+					add(rulePegText, position1519)
+				}
+				if !_rules[ruleAction95]() {
+					goto l1517
+				}
+				add(ruleFuncElemAccessor, position1518)
+			}
+			return true
+		l1517:
+			position, tokenIndex = position1517, tokenIndex1517
+			return false
+		},
+		/* 121 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt ParamsDistinct FuncParams sp ParamsOrder spOpt ')' Action96)> */
+		func() bool {
+			position1522, tokenIndex1522 := position, tokenIndex
+			{
+				position1523 := position
+				if !_rules[ruleFunction]() {
+					goto l1522
+				}
+				if !_rules[rulespOpt]() {
+					goto l1522
+				}
+				if buffer[position] != rune('(') {
+					goto l1522
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1522
+				}
+				if !_rules[ruleParamsDistinct]() {
+					goto l1522
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1522
+				}
+				if !_rules[rulesp]() {
+					goto l1522
+				}
+				if !_rules[ruleParamsOrder]() {
+					goto l1522
+				}
+				if !_rules[rulespOpt]() {
+					goto l1522
+				}
+				if buffer[position] != rune(')') {
+					goto l1522
+				}
+				position++
+				if !_rules[ruleAction96]() {
+					goto l1522
+				}
+				add(ruleFuncAppWithOrderBy, position1523)
+			}
+			return true
+		l1522:
+			position, tokenIndex = position1522, tokenIndex1522
+			return false
+		},
+		/* 122 FuncAppWithinGroup <- <(Function spOpt '(' spOpt FuncParams spOpt ')' sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H') ('i' / 'I') ('n' / 'N')) sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) spOpt '(' spOpt ParamsOrder spOpt ')' Action97)> */
+		func() bool {
+			position1524, tokenIndex1524 := position, tokenIndex
+			{
+				position1525 := position
+				if !_rules[ruleFunction]() {
+					goto l1524
+				}
+				if !_rules[rulespOpt]() {
+					goto l1524
+				}
+				if buffer[position] != rune('(') {
+					goto l1524
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1524
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1524
+				}
+				if !_rules[rulespOpt]() {
+					goto l1524
+				}
+				if buffer[position] != rune(')') {
+					goto l1524
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l1524
+				}
+				{
+					position1526, tokenIndex1526 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l1527
+					}
+					position++
+					goto l1526
+				l1527:
+					position, tokenIndex = position1526, tokenIndex1526
+					if buffer[position] != rune('W') {
+						goto l1524
+					}
+					position++
+				}
+			l1526:
+				{
+					position1528, tokenIndex1528 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1529
+					}
+					position++
+					goto l1528
+				l1529:
+					position, tokenIndex = position1528, tokenIndex1528
+					if buffer[position] != rune('I') {
+						goto l1524
+					}
+					position++
+				}
+			l1528:
+				{
+					position1530, tokenIndex1530 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1531
+					}
+					position++
+					goto l1530
+				l1531:
+					position, tokenIndex = position1530, tokenIndex1530
+					if buffer[position] != rune('T') {
+						goto l1524
+					}
+					position++
+				}
+			l1530:
+				{
+					position1532, tokenIndex1532 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l1533
+					}
+					position++
+					goto l1532
+				l1533:
+					position, tokenIndex = position1532, tokenIndex1532
+					if buffer[position] != rune('H') {
+						goto l1524
+					}
+					position++
+				}
+			l1532:
+				{
+					position1534, tokenIndex1534 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1535
+					}
+					position++
+					goto l1534
+				l1535:
+					position, tokenIndex = position1534, tokenIndex1534
+					if buffer[position] != rune('I') {
+						goto l1524
+					}
+					position++
+				}
+			l1534:
+				{
+					position1536, tokenIndex1536 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1537
+					}
+					position++
+					goto l1536
+				l1537:
+					position, tokenIndex = position1536, tokenIndex1536
+					if buffer[position] != rune('N') {
+						goto l1524
+					}
+					position++
+				}
+			l1536:
+				if !_rules[rulesp]() {
+					goto l1524
+				}
+				{
+					position1538, tokenIndex1538 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l1539
+					}
+					position++
+					goto l1538
+				l1539:
+					position, tokenIndex = position1538, tokenIndex1538
+					if buffer[position] != rune('G') {
+						goto l1524
+					}
+					position++
+				}
+			l1538:
+				{
+					position1540, tokenIndex1540 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1541
+					}
+					position++
+					goto l1540
+				l1541:
+					position, tokenIndex = position1540, tokenIndex1540
+					if buffer[position] != rune('R') {
+						goto l1524
+					}
+					position++
+				}
+			l1540:
+				{
+					position1542, tokenIndex1542 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1543
+					}
+					position++
+					goto l1542
+				l1543:
+					position, tokenIndex = position1542, tokenIndex1542
+					if buffer[position] != rune('O') {
+						goto l1524
+					}
+					position++
+				}
+			l1542:
+				{
+					position1544, tokenIndex1544 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l1545
+					}
+					position++
+					goto l1544
+				l1545:
+					position, tokenIndex = position1544, tokenIndex1544
+					if buffer[position] != rune('U') {
+						goto l1524
+					}
+					position++
+				}
+			l1544:
+				{
+					position1546, tokenIndex1546 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1547
+					}
+					position++
+					goto l1546
+				l1547:
+					position, tokenIndex = position1546, tokenIndex1546
+					if buffer[position] != rune('P') {
+						goto l1524
+					}
+					position++
+				}
+			l1546:
+				if !_rules[rulespOpt]() {
+					goto l1524
+				}
+				if buffer[position] != rune('(') {
+					goto l1524
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1524
+				}
+				if !_rules[ruleParamsOrder]() {
+					goto l1524
+				}
+				if !_rules[rulespOpt]() {
+					goto l1524
+				}
+				if buffer[position] != rune(')') {
+					goto l1524
+				}
+				position++
+				if !_rules[ruleAction97]() {
+					goto l1524
+				}
+				add(ruleFuncAppWithinGroup, position1525)
+			}
+			return true
+		l1524:
+			position, tokenIndex = position1524, tokenIndex1524
+			return false
+		},
+		/* 123 FuncAppWithOver <- <(FuncAppWithoutOrderBy sp (('o' / 'O') ('v' / 'V') ('e' / 'E') ('r' / 'R')) spOpt '(' spOpt OverPartitionOpt OverOrderOpt spOpt ')' Action98)> */
+		func() bool {
+			position1548, tokenIndex1548 := position, tokenIndex
+			{
+				position1549 := position
+				if !_rules[ruleFuncAppWithoutOrderBy]() {
+					goto l1548
+				}
+				if !_rules[rulesp]() {
+					goto l1548
+				}
+				{
+					position1550, tokenIndex1550 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1551
+					}
+					position++
+					goto l1550
+				l1551:
+					position, tokenIndex = position1550, tokenIndex1550
+					if buffer[position] != rune('O') {
+						goto l1548
+					}
+					position++
+				}
+			l1550:
+				{
+					position1552, tokenIndex1552 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l1553
+					}
+					position++
+					goto l1552
+				l1553:
+					position, tokenIndex = position1552, tokenIndex1552
+					if buffer[position] != rune('V') {
+						goto l1548
+					}
+					position++
+				}
+			l1552:
+				{
+					position1554, tokenIndex1554 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1555
+					}
+					position++
+					goto l1554
+				l1555:
+					position, tokenIndex = position1554, tokenIndex1554
+					if buffer[position] != rune('E') {
+						goto l1548
+					}
+					position++
+				}
+			l1554:
+				{
+					position1556, tokenIndex1556 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1557
+					}
+					position++
+					goto l1556
+				l1557:
+					position, tokenIndex = position1556, tokenIndex1556
+					if buffer[position] != rune('R') {
+						goto l1548
+					}
+					position++
+				}
+			l1556:
+				if !_rules[rulespOpt]() {
+					goto l1548
+				}
+				if buffer[position] != rune('(') {
+					goto l1548
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1548
+				}
+				if !_rules[ruleOverPartitionOpt]() {
+					goto l1548
+				}
+				if !_rules[ruleOverOrderOpt]() {
+					goto l1548
+				}
+				if !_rules[rulespOpt]() {
+					goto l1548
+				}
+				if buffer[position] != rune(')') {
+					goto l1548
+				}
+				position++
+				if !_rules[ruleAction98]() {
+					goto l1548
+				}
+				add(ruleFuncAppWithOver, position1549)
+			}
+			return true
+		l1548:
+			position, tokenIndex = position1548, tokenIndex1548
+			return false
+		},
+		/* 124 OverPartitionOpt <- <(<(('p' / 'P') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('i' / 'I') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('b' / 'B') ('y' / 'Y')) sp Expression (spOpt ',' spOpt Expression)*)?> Action99)> */
+		func() bool {
+			position1558, tokenIndex1558 := position, tokenIndex
+			{
+				position1559 := position
+				{
+					position1560 := position
+					{
+						position1561, tokenIndex1561 := position, tokenIndex
+						{
+							position1563, tokenIndex1563 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1564
+							}
+							position++
+							goto l1563
+						l1564:
+							position, tokenIndex = position1563, tokenIndex1563
+							if buffer[position] != rune('P') {
+								goto l1561
+							}
+							position++
+						}
+					l1563:
+						{
+							position1565, tokenIndex1565 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1566
+							}
+							position++
+							goto l1565
+						l1566:
+							position, tokenIndex = position1565, tokenIndex1565
+							if buffer[position] != rune('A') {
+								goto l1561
+							}
+							position++
+						}
+					l1565:
+						{
+							position1567, tokenIndex1567 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1568
+							}
+							position++
+							goto l1567
+						l1568:
+							position, tokenIndex = position1567, tokenIndex1567
 							if buffer[position] != rune('R') {
-								goto l842
+								goto l1561
 							}
 							position++
 						}
-					l850:
+					l1567:
 						{
-							position852, tokenIndex852 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l853
+							position1569, tokenIndex1569 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1570
 							}
 							position++
-							goto l852
-						l853:
-							position, tokenIndex = position852, tokenIndex852
-							if buffer[position] != rune('E') {
-								goto l842
+							goto l1569
+						l1570:
+							position, tokenIndex = position1569, tokenIndex1569
+							if buffer[position] != rune('T') {
+								goto l1561
 							}
 							position++
 						}
-					l852:
-						if !_rules[rulesp]() {
-							goto l842
-						}
-						if !_rules[ruleExpression]() {
-							goto l842
-						}
-						goto l843
-					l842:
-						position, tokenIndex = position842, tokenIndex842
-					}
-				l843:
-					add(rulePegText, position841)
-				}
-				if !_rules[ruleAction36]() {
-					goto l839
-				}
-				add(ruleFilter, position840)
-			}
-			return true
-		l839:
-			position, tokenIndex = position839, tokenIndex839
-			return false
-		},
-		/* 49 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action37)> */
-		func() bool {
-			position854, tokenIndex854 := position, tokenIndex
-			{
-				position855 := position
-				{
-					position856 := position
-					{
-						position857, tokenIndex857 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l857
-						}
+					l1569:
 						{
-							position859, tokenIndex859 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l860
+							position1571, tokenIndex1571 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1572
 							}
 							position++
-							goto l859
-						l860:
-							position, tokenIndex = position859, tokenIndex859
-							if buffer[position] != rune('G') {
-								goto l857
+							goto l1571
+						l1572:
+							position, tokenIndex = position1571, tokenIndex1571
+							if buffer[position] != rune('I') {
+								goto l1561
 							}
 							position++
 						}
-					l859:
+					l1571:
 						{
-							position861, tokenIndex861 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l862
+							position1573, tokenIndex1573 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1574
 							}
 							position++
-							goto l861
-						l862:
-							position, tokenIndex = position861, tokenIndex861
-							if buffer[position] != rune('R') {
-								goto l857
+							goto l1573
+						l1574:
+							position, tokenIndex = position1573, tokenIndex1573
+							if buffer[position] != rune('T') {
+								goto l1561
 							}
 							position++
 						}
-					l861:
+					l1573:
 						{
-							position863, tokenIndex863 := position, tokenIndex
-							if buffer[position] != rune('o') {
-								goto l864
+							position1575, tokenIndex1575 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1576
 							}
 							position++
-							goto l863
-						l864:
-							position, tokenIndex = position863, tokenIndex863
-							if buffer[position] != rune('O') {
-								goto l857
+							goto l1575
+						l1576:
+							position, tokenIndex = position1575, tokenIndex1575
+							if buffer[position] != rune('I') {
+								goto l1561
 							}
 							position++
 						}
-					l863:
+					l1575:
 						{
-							position865, tokenIndex865 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l866
+							position1577, tokenIndex1577 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1578
 							}
 							position++
-							goto l865
-						l866:
-							position, tokenIndex = position865, tokenIndex865
-							if buffer[position] != rune('U') {
-								goto l857
+							goto l1577
+						l1578:
+							position, tokenIndex = position1577, tokenIndex1577
+							if buffer[position] != rune('O') {
+								goto l1561
 							}
 							position++
 						}
-					l865:
+					l1577:
 						{
-							position867, tokenIndex867 := position, tokenIndex
-							if buffer[position] != rune('p') {
-								goto l868
+							position1579, tokenIndex1579 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1580
 							}
 							position++
-							goto l867
-						l868:
-							position, tokenIndex = position867, tokenIndex867
-							if buffer[position] != rune('P') {
-								goto l857
+							goto l1579
+						l1580:
+							position, tokenIndex = position1579, tokenIndex1579
+							if buffer[position] != rune('N') {
+								goto l1561
 							}
 							position++
 						}
-					l867:
+					l1579:
 						if !_rules[rulesp]() {
-							goto l857
+							goto l1561
 						}
 						{
-							position869, tokenIndex869 := position, tokenIndex
+							position1581, tokenIndex1581 := position, tokenIndex
 							if buffer[position] != rune('b') {
-								goto l870
+								goto l1582
 							}
 							position++
-							goto l869
-						l870:
-							position, tokenIndex = position869, tokenIndex869
+							goto l1581
+						l1582:
+							position, tokenIndex = position1581, tokenIndex1581
 							if buffer[position] != rune('B') {
-								goto l857
+								goto l1561
 							}
 							position++
 						}
-					l869:
+					l1581:
 						{
-							position871, tokenIndex871 := position, tokenIndex
+							position1583, tokenIndex1583 := position, tokenIndex
 							if buffer[position] != rune('y') {
-								goto l872
+								goto l1584
 							}
 							position++
-							goto l871
-						l872:
-							position, tokenIndex = position871, tokenIndex871
+							goto l1583
+						l1584:
+							position, tokenIndex = position1583, tokenIndex1583
 							if buffer[position] != rune('Y') {
-								goto l857
+								goto l1561
 							}
 							position++
 						}
-					l871:
+					l1583:
 						if !_rules[rulesp]() {
-							goto l857
+							goto l1561
 						}
-						if !_rules[ruleGroupList]() {
-							goto l857
+						if !_rules[ruleExpression]() {
+							goto l1561
+						}
+					l1585:
+						{
+							position1586, tokenIndex1586 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1586
+							}
+							if buffer[position] != rune(',') {
+								goto l1586
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1586
+							}
+							if !_rules[ruleExpression]() {
+								goto l1586
+							}
+							goto l1585
+						l1586:
+							position, tokenIndex = position1586, tokenIndex1586
 						}
-						goto l858
-					l857:
-						position, tokenIndex = position857, tokenIndex857
+						goto l1562
+					l1561:
+						position, tokenIndex = position1561, tokenIndex1561
 					}
-				l858:
-					add(rulePegText, position856)
-				}
-				if !_rules[ruleAction37]() {
-					goto l854
-				}
-				add(ruleGrouping, position855)
-			}
-			return true
-		l854:
-			position, tokenIndex = position854, tokenIndex854
-			return false
-		},
-		/* 50 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
-		func() bool {
-			position873, tokenIndex873 := position, tokenIndex
-			{
-				position874 := position
-				if !_rules[ruleExpression]() {
-					goto l873
+				l1562:
+					add(rulePegText, position1560)
 				}
-			l875:
-				{
-					position876, tokenIndex876 := position, tokenIndex
-					if !_rules[rulespOpt]() {
-						goto l876
-					}
-					if buffer[position] != rune(',') {
-						goto l876
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l876
-					}
-					if !_rules[ruleExpression]() {
-						goto l876
-					}
-					goto l875
-				l876:
-					position, tokenIndex = position876, tokenIndex876
+				if !_rules[ruleAction99]() {
+					goto l1558
 				}
-				add(ruleGroupList, position874)
+				add(ruleOverPartitionOpt, position1559)
 			}
 			return true
-		l873:
-			position, tokenIndex = position873, tokenIndex873
+		l1558:
+			position, tokenIndex = position1558, tokenIndex1558
 			return false
 		},
-		/* 51 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action38)> */
+		/* 125 OverOrderOpt <- <(<(spOpt (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action100)> */
 		func() bool {
-			position877, tokenIndex877 := position, tokenIndex
+			position1587, tokenIndex1587 := position, tokenIndex
 			{
-				position878 := position
+				position1588 := position
 				{
-					position879 := position
+					position1589 := position
 					{
-						position880, tokenIndex880 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l880
+						position1590, tokenIndex1590 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1590
 						}
 						{
-							position882, tokenIndex882 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l883
+							position1592, tokenIndex1592 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1593
 							}
 							position++
-							goto l882
-						l883:
-							position, tokenIndex = position882, tokenIndex882
-							if buffer[position] != rune('H') {
-								goto l880
+							goto l1592
+						l1593:
+							position, tokenIndex = position1592, tokenIndex1592
+							if buffer[position] != rune('O') {
+								goto l1590
 							}
 							position++
 						}
-					l882:
+					l1592:
 						{
-							position884, tokenIndex884 := position, tokenIndex
-							if buffer[position] != rune('a') {
-								goto l885
+							position1594, tokenIndex1594 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1595
 							}
 							position++
-							goto l884
-						l885:
-							position, tokenIndex = position884, tokenIndex884
-							if buffer[position] != rune('A') {
-								goto l880
+							goto l1594
+						l1595:
+							position, tokenIndex = position1594, tokenIndex1594
+							if buffer[position] != rune('R') {
+								goto l1590
 							}
 							position++
 						}
-					l884:
+					l1594:
 						{
-							position886, tokenIndex886 := position, tokenIndex
-							if buffer[position] != rune('v') {
-								goto l887
+							position1596, tokenIndex1596 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1597
 							}
 							position++
-							goto l886
-						l887:
-							position, tokenIndex = position886, tokenIndex886
-							if buffer[position] != rune('V') {
-								goto l880
+							goto l1596
+						l1597:
+							position, tokenIndex = position1596, tokenIndex1596
+							if buffer[position] != rune('D') {
+								goto l1590
 							}
 							position++
 						}
-					l886:
+					l1596:
 						{
-							position888, tokenIndex888 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l889
+							position1598, tokenIndex1598 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1599
 							}
 							position++
-							goto l888
-						l889:
-							position, tokenIndex = position888, tokenIndex888
-							if buffer[position] != rune('I') {
-								goto l880
+							goto l1598
+						l1599:
+							position, tokenIndex = position1598, tokenIndex1598
+							if buffer[position] != rune('E') {
+								goto l1590
 							}
 							position++
 						}
-					l888:
+					l1598:
 						{
-							position890, tokenIndex890 := position, tokenIndex
-							if buffer[position] != rune('n') {
-								goto l891
+							position1600, tokenIndex1600 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1601
 							}
 							position++
-							goto l890
-						l891:
-							position, tokenIndex = position890, tokenIndex890
-							if buffer[position] != rune('N') {
-								goto l880
+							goto l1600
+						l1601:
+							position, tokenIndex = position1600, tokenIndex1600
+							if buffer[position] != rune('R') {
+								goto l1590
 							}
 							position++
 						}
-					l890:
+					l1600:
+						if !_rules[rulesp]() {
+							goto l1590
+						}
 						{
-							position892, tokenIndex892 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l893
+							position1602, tokenIndex1602 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1603
 							}
 							position++
-							goto l892
-						l893:
-							position, tokenIndex = position892, tokenIndex892
-							if buffer[position] != rune('G') {
-								goto l880
+							goto l1602
+						l1603:
+							position, tokenIndex = position1602, tokenIndex1602
+							if buffer[position] != rune('B') {
+								goto l1590
+							}
+							position++
+						}
+					l1602:
+						{
+							position1604, tokenIndex1604 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1605
+							}
+							position++
+							goto l1604
+						l1605:
+							position, tokenIndex = position1604, tokenIndex1604
+							if buffer[position] != rune('Y') {
+								goto l1590
 							}
 							position++
 						}
-					l892:
+					l1604:
 						if !_rules[rulesp]() {
-							goto l880
+							goto l1590
 						}
-						if !_rules[ruleExpression]() {
-							goto l880
+						if !_rules[ruleSortedExpression]() {
+							goto l1590
 						}
-						goto l881
-					l880:
-						position, tokenIndex = position880, tokenIndex880
-					}
-				l881:
-					add(rulePegText, position879)
-				}
-				if !_rules[ruleAction38]() {
-					goto l877
-				}
-				add(ruleHaving, position878)
-			}
-			return true
-		l877:
-			position, tokenIndex = position877, tokenIndex877
-			return false
-		},
-		/* 52 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action39))> */
-		func() bool {
-			position894, tokenIndex894 := position, tokenIndex
-			{
-				position895 := position
-				{
-					position896, tokenIndex896 := position, tokenIndex
-					if !_rules[ruleAliasedStreamWindow]() {
-						goto l897
-					}
-					goto l896
-				l897:
-					position, tokenIndex = position896, tokenIndex896
-					if !_rules[ruleStreamWindow]() {
-						goto l894
-					}
-					if !_rules[ruleAction39]() {
-						goto l894
-					}
-				}
-			l896:
-				add(ruleRelationLike, position895)
-			}
-			return true
-		l894:
-			position, tokenIndex = position894, tokenIndex894
-			return false
-		},
-		/* 53 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action40)> */
-		func() bool {
-			position898, tokenIndex898 := position, tokenIndex
-			{
-				position899 := position
-				if !_rules[ruleStreamWindow]() {
-					goto l898
-				}
-				if !_rules[rulesp]() {
-					goto l898
-				}
-				{
-					position900, tokenIndex900 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l901
-					}
-					position++
-					goto l900
-				l901:
-					position, tokenIndex = position900, tokenIndex900
-					if buffer[position] != rune('A') {
-						goto l898
-					}
-					position++
-				}
-			l900:
-				{
-					position902, tokenIndex902 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l903
-					}
-					position++
-					goto l902
-				l903:
-					position, tokenIndex = position902, tokenIndex902
-					if buffer[position] != rune('S') {
-						goto l898
+					l1606:
+						{
+							position1607, tokenIndex1607 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1607
+							}
+							if buffer[position] != rune(',') {
+								goto l1607
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1607
+							}
+							if !_rules[ruleSortedExpression]() {
+								goto l1607
+							}
+							goto l1606
+						l1607:
+							position, tokenIndex = position1607, tokenIndex1607
+						}
+						goto l1591
+					l1590:
+						position, tokenIndex = position1590, tokenIndex1590
 					}
-					position++
-				}
-			l902:
-				if !_rules[rulesp]() {
-					goto l898
-				}
-				if !_rules[ruleIdentifier]() {
-					goto l898
+				l1591:
+					add(rulePegText, position1589)
 				}
-				if !_rules[ruleAction40]() {
-					goto l898
+				if !_rules[ruleAction100]() {
+					goto l1587
 				}
-				add(ruleAliasedStreamWindow, position899)
+				add(ruleOverOrderOpt, position1588)
 			}
 			return true
-		l898:
-			position, tokenIndex = position898, tokenIndex898
+		l1587:
+			position, tokenIndex = position1587, tokenIndex1587
 			return false
 		},
-		/* 54 StreamWindow <- <(StreamLike spOpt '[' spOpt (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) sp Interval CapacitySpecOpt SheddingSpecOpt spOpt ']' Action41)> */
+		/* 126 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt ParamsDistinct FuncParams <spOpt> ')' Action101)> */
 		func() bool {
-			position904, tokenIndex904 := position, tokenIndex
+			position1608, tokenIndex1608 := position, tokenIndex
 			{
-				position905 := position
-				if !_rules[ruleStreamLike]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune('[') {
-					goto l904
+				position1609 := position
+				if !_rules[ruleFunction]() {
+					goto l1608
 				}
-				position++
 				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				{
-					position906, tokenIndex906 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l907
-					}
-					position++
-					goto l906
-				l907:
-					position, tokenIndex = position906, tokenIndex906
-					if buffer[position] != rune('R') {
-						goto l904
-					}
-					position++
-				}
-			l906:
-				{
-					position908, tokenIndex908 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l909
-					}
-					position++
-					goto l908
-				l909:
-					position, tokenIndex = position908, tokenIndex908
-					if buffer[position] != rune('A') {
-						goto l904
-					}
-					position++
-				}
-			l908:
-				{
-					position910, tokenIndex910 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l911
-					}
-					position++
-					goto l910
-				l911:
-					position, tokenIndex = position910, tokenIndex910
-					if buffer[position] != rune('N') {
-						goto l904
-					}
-					position++
-				}
-			l910:
-				{
-					position912, tokenIndex912 := position, tokenIndex
-					if buffer[position] != rune('g') {
-						goto l913
-					}
-					position++
-					goto l912
-				l913:
-					position, tokenIndex = position912, tokenIndex912
-					if buffer[position] != rune('G') {
-						goto l904
-					}
-					position++
-				}
-			l912:
-				{
-					position914, tokenIndex914 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l915
-					}
-					position++
-					goto l914
-				l915:
-					position, tokenIndex = position914, tokenIndex914
-					if buffer[position] != rune('E') {
-						goto l904
-					}
-					position++
+					goto l1608
 				}
-			l914:
-				if !_rules[rulesp]() {
-					goto l904
+				if buffer[position] != rune('(') {
+					goto l1608
 				}
-				if !_rules[ruleInterval]() {
-					goto l904
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1608
 				}
-				if !_rules[ruleCapacitySpecOpt]() {
-					goto l904
+				if !_rules[ruleParamsDistinct]() {
+					goto l1608
 				}
-				if !_rules[ruleSheddingSpecOpt]() {
-					goto l904
+				if !_rules[ruleFuncParams]() {
+					goto l1608
 				}
-				if !_rules[rulespOpt]() {
-					goto l904
+				{
+					position1610 := position
+					if !_rules[rulespOpt]() {
+						goto l1608
+					}
+					add(rulePegText, position1610)
 				}
-				if buffer[position] != rune(']') {
-					goto l904
+				if buffer[position] != rune(')') {
+					goto l1608
 				}
 				position++
-				if !_rules[ruleAction41]() {
-					goto l904
+				if !_rules[ruleAction101]() {
+					goto l1608
 				}
-				add(ruleStreamWindow, position905)
+				add(ruleFuncAppWithoutOrderBy, position1609)
 			}
 			return true
-		l904:
-			position, tokenIndex = position904, tokenIndex904
+		l1608:
+			position, tokenIndex = position1608, tokenIndex1608
 			return false
 		},
-		/* 55 StreamLike <- <(UDSFFuncApp / Stream)> */
+		/* 127 ParamsDistinct <- <(<(Distinct sp)?> Action102)> */
 		func() bool {
-			position916, tokenIndex916 := position, tokenIndex
+			position1611, tokenIndex1611 := position, tokenIndex
 			{
-				position917 := position
+				position1612 := position
 				{
-					position918, tokenIndex918 := position, tokenIndex
-					if !_rules[ruleUDSFFuncApp]() {
-						goto l919
-					}
-					goto l918
-				l919:
-					position, tokenIndex = position918, tokenIndex918
-					if !_rules[ruleStream]() {
-						goto l916
+					position1613 := position
+					{
+						position1614, tokenIndex1614 := position, tokenIndex
+						if !_rules[ruleDistinct]() {
+							goto l1614
+						}
+						if !_rules[rulesp]() {
+							goto l1614
+						}
+						goto l1615
+					l1614:
+						position, tokenIndex = position1614, tokenIndex1614
 					}
+				l1615:
+					add(rulePegText, position1613)
+				}
+				if !_rules[ruleAction102]() {
+					goto l1611
 				}
-			l918:
-				add(ruleStreamLike, position917)
+				add(ruleParamsDistinct, position1612)
 			}
 			return true
-		l916:
-			position, tokenIndex = position916, tokenIndex916
+		l1611:
+			position, tokenIndex = position1611, tokenIndex1611
 			return false
 		},
-		/* 56 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action42)> */
+		/* 128 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action103)> */
 		func() bool {
-			position920, tokenIndex920 := position, tokenIndex
+			position1616, tokenIndex1616 := position, tokenIndex
 			{
-				position921 := position
-				if !_rules[ruleFuncAppWithoutOrderBy]() {
-					goto l920
+				position1617 := position
+				{
+					position1618 := position
+					{
+						position1619, tokenIndex1619 := position, tokenIndex
+						if !_rules[ruleExpressionOrWildcard]() {
+							goto l1619
+						}
+					l1621:
+						{
+							position1622, tokenIndex1622 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1622
+							}
+							if buffer[position] != rune(',') {
+								goto l1622
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1622
+							}
+							if !_rules[ruleExpressionOrWildcard]() {
+								goto l1622
+							}
+							goto l1621
+						l1622:
+							position, tokenIndex = position1622, tokenIndex1622
+						}
+						goto l1620
+					l1619:
+						position, tokenIndex = position1619, tokenIndex1619
+					}
+				l1620:
+					add(rulePegText, position1618)
 				}
-				if !_rules[ruleAction42]() {
-					goto l920
+				if !_rules[ruleAction103]() {
+					goto l1616
 				}
-				add(ruleUDSFFuncApp, position921)
+				add(ruleFuncParams, position1617)
 			}
 			return true
-		l920:
-			position, tokenIndex = position920, tokenIndex920
+		l1616:
+			position, tokenIndex = position1616, tokenIndex1616
 			return false
 		},
-		/* 57 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action43)> */
+		/* 129 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action104)> */
 		func() bool {
-			position922, tokenIndex922 := position, tokenIndex
+			position1623, tokenIndex1623 := position, tokenIndex
 			{
-				position923 := position
+				position1624 := position
 				{
-					position924 := position
+					position1625 := position
 					{
-						position925, tokenIndex925 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l925
+						position1626, tokenIndex1626 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1627
 						}
-						if buffer[position] != rune(',') {
-							goto l925
+						position++
+						goto l1626
+					l1627:
+						position, tokenIndex = position1626, tokenIndex1626
+						if buffer[position] != rune('O') {
+							goto l1623
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l925
+					}
+				l1626:
+					{
+						position1628, tokenIndex1628 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1629
 						}
-						{
-							position927, tokenIndex927 := position, tokenIndex
-							if buffer[position] != rune('b') {
-								goto l928
-							}
-							position++
-							goto l927
-						l928:
-							position, tokenIndex = position927, tokenIndex927
-							if buffer[position] != rune('B') {
-								goto l925
-							}
-							position++
+						position++
+						goto l1628
+					l1629:
+						position, tokenIndex = position1628, tokenIndex1628
+						if buffer[position] != rune('R') {
+							goto l1623
 						}
-					l927:
-						{
-							position929, tokenIndex929 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l930
-							}
-							position++
-							goto l929
-						l930:
-							position, tokenIndex = position929, tokenIndex929
-							if buffer[position] != rune('U') {
-								goto l925
-							}
-							position++
+						position++
+					}
+				l1628:
+					{
+						position1630, tokenIndex1630 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1631
 						}
-					l929:
-						{
-							position931, tokenIndex931 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l932
-							}
-							position++
-							goto l931
-						l932:
-							position, tokenIndex = position931, tokenIndex931
-							if buffer[position] != rune('F') {
-								goto l925
-							}
-							position++
+						position++
+						goto l1630
+					l1631:
+						position, tokenIndex = position1630, tokenIndex1630
+						if buffer[position] != rune('D') {
+							goto l1623
 						}
-					l931:
-						{
-							position933, tokenIndex933 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l934
-							}
-							position++
-							goto l933
-						l934:
-							position, tokenIndex = position933, tokenIndex933
-							if buffer[position] != rune('F') {
-								goto l925
-							}
-							position++
+						position++
+					}
+				l1630:
+					{
+						position1632, tokenIndex1632 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1633
 						}
-					l933:
-						{
-							position935, tokenIndex935 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l936
-							}
-							position++
-							goto l935
-						l936:
-							position, tokenIndex = position935, tokenIndex935
-							if buffer[position] != rune('E') {
-								goto l925
-							}
-							position++
+						position++
+						goto l1632
+					l1633:
+						position, tokenIndex = position1632, tokenIndex1632
+						if buffer[position] != rune('E') {
+							goto l1623
 						}
-					l935:
-						{
-							position937, tokenIndex937 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l938
-							}
-							position++
-							goto l937
-						l938:
-							position, tokenIndex = position937, tokenIndex937
-							if buffer[position] != rune('R') {
-								goto l925
-							}
-							position++
+						position++
+					}
+				l1632:
+					{
+						position1634, tokenIndex1634 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1635
+						}
+						position++
+						goto l1634
+					l1635:
+						position, tokenIndex = position1634, tokenIndex1634
+						if buffer[position] != rune('R') {
+							goto l1623
+						}
+						position++
+					}
+				l1634:
+					if !_rules[rulesp]() {
+						goto l1623
+					}
+					{
+						position1636, tokenIndex1636 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1637
+						}
+						position++
+						goto l1636
+					l1637:
+						position, tokenIndex = position1636, tokenIndex1636
+						if buffer[position] != rune('B') {
+							goto l1623
+						}
+						position++
+					}
+				l1636:
+					{
+						position1638, tokenIndex1638 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l1639
+						}
+						position++
+						goto l1638
+					l1639:
+						position, tokenIndex = position1638, tokenIndex1638
+						if buffer[position] != rune('Y') {
+							goto l1623
+						}
+						position++
+					}
+				l1638:
+					if !_rules[rulesp]() {
+						goto l1623
+					}
+					if !_rules[ruleSortedExpression]() {
+						goto l1623
+					}
+				l1640:
+					{
+						position1641, tokenIndex1641 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1641
+						}
+						if buffer[position] != rune(',') {
+							goto l1641
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1641
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1641
 						}
-					l937:
+						goto l1640
+					l1641:
+						position, tokenIndex = position1641, tokenIndex1641
+					}
+					add(rulePegText, position1625)
+				}
+				if !_rules[ruleAction104]() {
+					goto l1623
+				}
+				add(ruleParamsOrder, position1624)
+			}
+			return true
+		l1623:
+			position, tokenIndex = position1623, tokenIndex1623
+			return false
+		},
+		/* 130 SortedExpression <- <(Expression OrderDirectionOpt Action105)> */
+		func() bool {
+			position1642, tokenIndex1642 := position, tokenIndex
+			{
+				position1643 := position
+				if !_rules[ruleExpression]() {
+					goto l1642
+				}
+				if !_rules[ruleOrderDirectionOpt]() {
+					goto l1642
+				}
+				if !_rules[ruleAction105]() {
+					goto l1642
+				}
+				add(ruleSortedExpression, position1643)
+			}
+			return true
+		l1642:
+			position, tokenIndex = position1642, tokenIndex1642
+			return false
+		},
+		/* 131 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action106)> */
+		func() bool {
+			position1644, tokenIndex1644 := position, tokenIndex
+			{
+				position1645 := position
+				{
+					position1646 := position
+					{
+						position1647, tokenIndex1647 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l925
+							goto l1647
 						}
 						{
-							position939, tokenIndex939 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l940
+							position1649, tokenIndex1649 := position, tokenIndex
+							if !_rules[ruleAscending]() {
+								goto l1650
 							}
-							position++
-							goto l939
-						l940:
-							position, tokenIndex = position939, tokenIndex939
-							if buffer[position] != rune('S') {
-								goto l925
+							goto l1649
+						l1650:
+							position, tokenIndex = position1649, tokenIndex1649
+							if !_rules[ruleDescending]() {
+								goto l1647
 							}
-							position++
 						}
-					l939:
-						{
-							position941, tokenIndex941 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l942
-							}
-							position++
-							goto l941
-						l942:
-							position, tokenIndex = position941, tokenIndex941
-							if buffer[position] != rune('I') {
-								goto l925
-							}
-							position++
+					l1649:
+						goto l1648
+					l1647:
+						position, tokenIndex = position1647, tokenIndex1647
+					}
+				l1648:
+					add(rulePegText, position1646)
+				}
+				if !_rules[ruleAction106]() {
+					goto l1644
+				}
+				add(ruleOrderDirectionOpt, position1645)
+			}
+			return true
+		l1644:
+			position, tokenIndex = position1644, tokenIndex1644
+			return false
+		},
+		/* 132 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action107)> */
+		func() bool {
+			position1651, tokenIndex1651 := position, tokenIndex
+			{
+				position1652 := position
+				{
+					position1653 := position
+					if buffer[position] != rune('[') {
+						goto l1651
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1651
+					}
+					{
+						position1654, tokenIndex1654 := position, tokenIndex
+						if !_rules[ruleExpressionOrWildcard]() {
+							goto l1654
 						}
-					l941:
+					l1656:
 						{
-							position943, tokenIndex943 := position, tokenIndex
-							if buffer[position] != rune('z') {
-								goto l944
+							position1657, tokenIndex1657 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1657
 							}
-							position++
-							goto l943
-						l944:
-							position, tokenIndex = position943, tokenIndex943
-							if buffer[position] != rune('Z') {
-								goto l925
+							if buffer[position] != rune(',') {
+								goto l1657
 							}
 							position++
-						}
-					l943:
-						{
-							position945, tokenIndex945 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l946
+							if !_rules[rulespOpt]() {
+								goto l1657
 							}
-							position++
-							goto l945
-						l946:
-							position, tokenIndex = position945, tokenIndex945
-							if buffer[position] != rune('E') {
-								goto l925
+							if !_rules[ruleExpressionOrWildcard]() {
+								goto l1657
 							}
-							position++
-						}
-					l945:
-						if !_rules[rulesp]() {
-							goto l925
+							goto l1656
+						l1657:
+							position, tokenIndex = position1657, tokenIndex1657
 						}
-						if !_rules[ruleNonNegativeNumericLiteral]() {
-							goto l925
+						goto l1655
+					l1654:
+						position, tokenIndex = position1654, tokenIndex1654
+					}
+				l1655:
+					if !_rules[rulespOpt]() {
+						goto l1651
+					}
+					{
+						position1658, tokenIndex1658 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l1658
 						}
-						goto l926
-					l925:
-						position, tokenIndex = position925, tokenIndex925
+						position++
+						goto l1659
+					l1658:
+						position, tokenIndex = position1658, tokenIndex1658
+					}
+				l1659:
+					if !_rules[rulespOpt]() {
+						goto l1651
+					}
+					if buffer[position] != rune(']') {
+						goto l1651
 					}
-				l926:
-					add(rulePegText, position924)
+					position++
+					add(rulePegText, position1653)
 				}
-				if !_rules[ruleAction43]() {
-					goto l922
+				if !_rules[ruleAction107]() {
+					goto l1651
 				}
-				add(ruleCapacitySpecOpt, position923)
+				add(ruleArrayExpr, position1652)
 			}
 			return true
-		l922:
-			position, tokenIndex = position922, tokenIndex922
+		l1651:
+			position, tokenIndex = position1651, tokenIndex1651
 			return false
 		},
-		/* 58 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action44)> */
+		/* 133 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action108)> */
 		func() bool {
-			position947, tokenIndex947 := position, tokenIndex
+			position1660, tokenIndex1660 := position, tokenIndex
 			{
-				position948 := position
+				position1661 := position
 				{
-					position949 := position
+					position1662 := position
+					if buffer[position] != rune('{') {
+						goto l1660
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1660
+					}
 					{
-						position950, tokenIndex950 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if buffer[position] != rune(',') {
-							goto l950
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if !_rules[ruleSheddingOption]() {
-							goto l950
-						}
-						if !_rules[rulesp]() {
-							goto l950
-						}
-						{
-							position952, tokenIndex952 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l953
-							}
-							position++
-							goto l952
-						l953:
-							position, tokenIndex = position952, tokenIndex952
-							if buffer[position] != rune('I') {
-								goto l950
-							}
-							position++
-						}
-					l952:
-						{
-							position954, tokenIndex954 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l955
-							}
-							position++
-							goto l954
-						l955:
-							position, tokenIndex = position954, tokenIndex954
-							if buffer[position] != rune('F') {
-								goto l950
-							}
-							position++
-						}
-					l954:
-						if !_rules[rulesp]() {
-							goto l950
-						}
-						{
-							position956, tokenIndex956 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l957
-							}
-							position++
-							goto l956
-						l957:
-							position, tokenIndex = position956, tokenIndex956
-							if buffer[position] != rune('F') {
-								goto l950
-							}
-							position++
-						}
-					l956:
-						{
-							position958, tokenIndex958 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l959
-							}
-							position++
-							goto l958
-						l959:
-							position, tokenIndex = position958, tokenIndex958
-							if buffer[position] != rune('U') {
-								goto l950
-							}
-							position++
+						position1663, tokenIndex1663 := position, tokenIndex
+						if !_rules[ruleKeyValuePair]() {
+							goto l1663
 						}
-					l958:
+					l1665:
 						{
-							position960, tokenIndex960 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l961
+							position1666, tokenIndex1666 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1666
 							}
-							position++
-							goto l960
-						l961:
-							position, tokenIndex = position960, tokenIndex960
-							if buffer[position] != rune('L') {
-								goto l950
+							if buffer[position] != rune(',') {
+								goto l1666
 							}
 							position++
-						}
-					l960:
-						{
-							position962, tokenIndex962 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l963
+							if !_rules[rulespOpt]() {
+								goto l1666
 							}
-							position++
-							goto l962
-						l963:
-							position, tokenIndex = position962, tokenIndex962
-							if buffer[position] != rune('L') {
-								goto l950
+							if !_rules[ruleKeyValuePair]() {
+								goto l1666
 							}
-							position++
+							goto l1665
+						l1666:
+							position, tokenIndex = position1666, tokenIndex1666
 						}
-					l962:
-						goto l951
-					l950:
-						position, tokenIndex = position950, tokenIndex950
+						goto l1664
+					l1663:
+						position, tokenIndex = position1663, tokenIndex1663
+					}
+				l1664:
+					if !_rules[rulespOpt]() {
+						goto l1660
+					}
+					if buffer[position] != rune('}') {
+						goto l1660
 					}
-				l951:
-					add(rulePegText, position949)
+					position++
+					add(rulePegText, position1662)
 				}
-				if !_rules[ruleAction44]() {
-					goto l947
+				if !_rules[ruleAction108]() {
+					goto l1660
 				}
-				add(ruleSheddingSpecOpt, position948)
+				add(ruleMapExpr, position1661)
 			}
 			return true
-		l947:
-			position, tokenIndex = position947, tokenIndex947
+		l1660:
+			position, tokenIndex = position1660, tokenIndex1660
 			return false
 		},
-		/* 59 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
+		/* 134 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action109)> */
 		func() bool {
-			position964, tokenIndex964 := position, tokenIndex
+			position1667, tokenIndex1667 := position, tokenIndex
 			{
-				position965 := position
+				position1668 := position
 				{
-					position966, tokenIndex966 := position, tokenIndex
-					if !_rules[ruleWait]() {
-						goto l967
+					position1669 := position
+					if !_rules[ruleStringLiteral]() {
+						goto l1667
 					}
-					goto l966
-				l967:
-					position, tokenIndex = position966, tokenIndex966
-					if !_rules[ruleDropOldest]() {
-						goto l968
+					if !_rules[rulespOpt]() {
+						goto l1667
 					}
-					goto l966
-				l968:
-					position, tokenIndex = position966, tokenIndex966
-					if !_rules[ruleDropNewest]() {
-						goto l964
+					if buffer[position] != rune(':') {
+						goto l1667
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1667
+					}
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1667
+					}
+					add(rulePegText, position1669)
+				}
+				if !_rules[ruleAction109]() {
+					goto l1667
+				}
+				add(ruleKeyValuePair, position1668)
+			}
+			return true
+		l1667:
+			position, tokenIndex = position1667, tokenIndex1667
+			return false
+		},
+		/* 135 Case <- <(ConditionCase / ExpressionCase)> */
+		func() bool {
+			position1670, tokenIndex1670 := position, tokenIndex
+			{
+				position1671 := position
+				{
+					position1672, tokenIndex1672 := position, tokenIndex
+					if !_rules[ruleConditionCase]() {
+						goto l1673
+					}
+					goto l1672
+				l1673:
+					position, tokenIndex = position1672, tokenIndex1672
+					if !_rules[ruleExpressionCase]() {
+						goto l1670
 					}
 				}
-			l966:
-				add(ruleSheddingOption, position965)
+			l1672:
+				add(ruleCase, position1671)
 			}
 			return true
-		l964:
-			position, tokenIndex = position964, tokenIndex964
+		l1670:
+			position, tokenIndex = position1670, tokenIndex1670
 			return false
 		},
-		/* 60 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action45)> */
+		/* 136 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action110)> */
 		func() bool {
-			position969, tokenIndex969 := position, tokenIndex
+			position1674, tokenIndex1674 := position, tokenIndex
 			{
-				position970 := position
+				position1675 := position
+				{
+					position1676, tokenIndex1676 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1677
+					}
+					position++
+					goto l1676
+				l1677:
+					position, tokenIndex = position1676, tokenIndex1676
+					if buffer[position] != rune('C') {
+						goto l1674
+					}
+					position++
+				}
+			l1676:
+				{
+					position1678, tokenIndex1678 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1679
+					}
+					position++
+					goto l1678
+				l1679:
+					position, tokenIndex = position1678, tokenIndex1678
+					if buffer[position] != rune('A') {
+						goto l1674
+					}
+					position++
+				}
+			l1678:
+				{
+					position1680, tokenIndex1680 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1681
+					}
+					position++
+					goto l1680
+				l1681:
+					position, tokenIndex = position1680, tokenIndex1680
+					if buffer[position] != rune('S') {
+						goto l1674
+					}
+					position++
+				}
+			l1680:
+				{
+					position1682, tokenIndex1682 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1683
+					}
+					position++
+					goto l1682
+				l1683:
+					position, tokenIndex = position1682, tokenIndex1682
+					if buffer[position] != rune('E') {
+						goto l1674
+					}
+					position++
+				}
+			l1682:
 				{
-					position971 := position
+					position1684 := position
+					if !_rules[rulesp]() {
+						goto l1674
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1674
+					}
+				l1685:
+					{
+						position1686, tokenIndex1686 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1686
+						}
+						if !_rules[ruleWhenThenPair]() {
+							goto l1686
+						}
+						goto l1685
+					l1686:
+						position, tokenIndex = position1686, tokenIndex1686
+					}
 					{
-						position972, tokenIndex972 := position, tokenIndex
+						position1687, tokenIndex1687 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l972
+							goto l1687
 						}
 						{
-							position974, tokenIndex974 := position, tokenIndex
-							if buffer[position] != rune('w') {
-								goto l975
+							position1689, tokenIndex1689 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1690
 							}
 							position++
-							goto l974
-						l975:
-							position, tokenIndex = position974, tokenIndex974
-							if buffer[position] != rune('W') {
-								goto l972
+							goto l1689
+						l1690:
+							position, tokenIndex = position1689, tokenIndex1689
+							if buffer[position] != rune('E') {
+								goto l1687
 							}
 							position++
 						}
-					l974:
+					l1689:
 						{
-							position976, tokenIndex976 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l977
+							position1691, tokenIndex1691 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1692
 							}
 							position++
-							goto l976
-						l977:
-							position, tokenIndex = position976, tokenIndex976
-							if buffer[position] != rune('I') {
-								goto l972
+							goto l1691
+						l1692:
+							position, tokenIndex = position1691, tokenIndex1691
+							if buffer[position] != rune('L') {
+								goto l1687
 							}
 							position++
 						}
-					l976:
+					l1691:
 						{
-							position978, tokenIndex978 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l979
+							position1693, tokenIndex1693 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1694
 							}
 							position++
-							goto l978
-						l979:
-							position, tokenIndex = position978, tokenIndex978
-							if buffer[position] != rune('T') {
-								goto l972
+							goto l1693
+						l1694:
+							position, tokenIndex = position1693, tokenIndex1693
+							if buffer[position] != rune('S') {
+								goto l1687
 							}
 							position++
 						}
-					l978:
+					l1693:
 						{
-							position980, tokenIndex980 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l981
+							position1695, tokenIndex1695 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1696
 							}
 							position++
-							goto l980
-						l981:
-							position, tokenIndex = position980, tokenIndex980
-							if buffer[position] != rune('H') {
-								goto l972
+							goto l1695
+						l1696:
+							position, tokenIndex = position1695, tokenIndex1695
+							if buffer[position] != rune('E') {
+								goto l1687
 							}
 							position++
 						}
-					l980:
+					l1695:
 						if !_rules[rulesp]() {
-							goto l972
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l972
-						}
-					l982:
-						{
-							position983, tokenIndex983 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l983
-							}
-							if buffer[position] != rune(',') {
-								goto l983
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l983
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l983
-							}
-							goto l982
-						l983:
-							position, tokenIndex = position983, tokenIndex983
+							goto l1687
 						}
-						goto l973
-					l972:
-						position, tokenIndex = position972, tokenIndex972
+						if !_rules[ruleExpression]() {
+							goto l1687
+						}
+						goto l1688
+					l1687:
+						position, tokenIndex = position1687, tokenIndex1687
 					}
-				l973:
-					add(rulePegText, position971)
-				}
-				if !_rules[ruleAction45]() {
-					goto l969
-				}
-				add(ruleSourceSinkSpecs, position970)
-			}
-			return true
-		l969:
-			position, tokenIndex = position969, tokenIndex969
-			return false
-		},
-		/* 61 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action46)> */
-		func() bool {
-			position984, tokenIndex984 := position, tokenIndex
-			{
-				position985 := position
-				{
-					position986 := position
+				l1688:
 					if !_rules[rulesp]() {
-						goto l984
-					}
-					{
-						position987, tokenIndex987 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l988
-						}
-						position++
-						goto l987
-					l988:
-						position, tokenIndex = position987, tokenIndex987
-						if buffer[position] != rune('S') {
-							goto l984
-						}
-						position++
+						goto l1674
 					}
-				l987:
 					{
-						position989, tokenIndex989 := position, tokenIndex
+						position1697, tokenIndex1697 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l990
+							goto l1698
 						}
 						position++
-						goto l989
-					l990:
-						position, tokenIndex = position989, tokenIndex989
+						goto l1697
+					l1698:
+						position, tokenIndex = position1697, tokenIndex1697
 						if buffer[position] != rune('E') {
-							goto l984
+							goto l1674
 						}
 						position++
 					}
-				l989:
+				l1697:
 					{
-						position991, tokenIndex991 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l992
+						position1699, tokenIndex1699 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1700
 						}
 						position++
-						goto l991
-					l992:
-						position, tokenIndex = position991, tokenIndex991
-						if buffer[position] != rune('T') {
-							goto l984
+						goto l1699
+					l1700:
+						position, tokenIndex = position1699, tokenIndex1699
+						if buffer[position] != rune('N') {
+							goto l1674
 						}
 						position++
 					}
-				l991:
-					if !_rules[rulesp]() {
-						goto l984
-					}
-					if !_rules[ruleSourceSinkParam]() {
-						goto l984
-					}
-				l993:
+				l1699:
 					{
-						position994, tokenIndex994 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l994
-						}
-						if buffer[position] != rune(',') {
-							goto l994
+						position1701, tokenIndex1701 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1702
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l994
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l994
+						goto l1701
+					l1702:
+						position, tokenIndex = position1701, tokenIndex1701
+						if buffer[position] != rune('D') {
+							goto l1674
 						}
-						goto l993
-					l994:
-						position, tokenIndex = position994, tokenIndex994
+						position++
 					}
-					add(rulePegText, position986)
+				l1701:
+					add(rulePegText, position1684)
 				}
-				if !_rules[ruleAction46]() {
-					goto l984
+				if !_rules[ruleAction110]() {
+					goto l1674
 				}
-				add(ruleUpdateSourceSinkSpecs, position985)
+				add(ruleConditionCase, position1675)
 			}
 			return true
-		l984:
-			position, tokenIndex = position984, tokenIndex984
+		l1674:
+			position, tokenIndex = position1674, tokenIndex1674
 			return false
 		},
-		/* 62 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action47)> */
+		/* 137 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action111)> */
 		func() bool {
-			position995, tokenIndex995 := position, tokenIndex
+			position1703, tokenIndex1703 := position, tokenIndex
 			{
-				position996 := position
+				position1704 := position
+				{
+					position1705, tokenIndex1705 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1706
+					}
+					position++
+					goto l1705
+				l1706:
+					position, tokenIndex = position1705, tokenIndex1705
+					if buffer[position] != rune('C') {
+						goto l1703
+					}
+					position++
+				}
+			l1705:
+				{
+					position1707, tokenIndex1707 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1708
+					}
+					position++
+					goto l1707
+				l1708:
+					position, tokenIndex = position1707, tokenIndex1707
+					if buffer[position] != rune('A') {
+						goto l1703
+					}
+					position++
+				}
+			l1707:
+				{
+					position1709, tokenIndex1709 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1710
+					}
+					position++
+					goto l1709
+				l1710:
+					position, tokenIndex = position1709, tokenIndex1709
+					if buffer[position] != rune('S') {
+						goto l1703
+					}
+					position++
+				}
+			l1709:
+				{
+					position1711, tokenIndex1711 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1712
+					}
+					position++
+					goto l1711
+				l1712:
+					position, tokenIndex = position1711, tokenIndex1711
+					if buffer[position] != rune('E') {
+						goto l1703
+					}
+					position++
+				}
+			l1711:
+				if !_rules[rulesp]() {
+					goto l1703
+				}
+				if !_rules[ruleExpression]() {
+					goto l1703
+				}
 				{
-					position997 := position
+					position1713 := position
+					if !_rules[rulesp]() {
+						goto l1703
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1703
+					}
+				l1714:
+					{
+						position1715, tokenIndex1715 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1715
+						}
+						if !_rules[ruleWhenThenPair]() {
+							goto l1715
+						}
+						goto l1714
+					l1715:
+						position, tokenIndex = position1715, tokenIndex1715
+					}
 					{
-						position998, tokenIndex998 := position, tokenIndex
+						position1716, tokenIndex1716 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l998
+							goto l1716
 						}
 						{
-							position1000, tokenIndex1000 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1001
+							position1718, tokenIndex1718 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1719
 							}
 							position++
-							goto l1000
-						l1001:
-							position, tokenIndex = position1000, tokenIndex1000
-							if buffer[position] != rune('S') {
-								goto l998
+							goto l1718
+						l1719:
+							position, tokenIndex = position1718, tokenIndex1718
+							if buffer[position] != rune('E') {
+								goto l1716
 							}
 							position++
 						}
-					l1000:
+					l1718:
 						{
-							position1002, tokenIndex1002 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1003
+							position1720, tokenIndex1720 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1721
 							}
 							position++
-							goto l1002
-						l1003:
-							position, tokenIndex = position1002, tokenIndex1002
-							if buffer[position] != rune('E') {
-								goto l998
+							goto l1720
+						l1721:
+							position, tokenIndex = position1720, tokenIndex1720
+							if buffer[position] != rune('L') {
+								goto l1716
 							}
 							position++
 						}
-					l1002:
+					l1720:
 						{
-							position1004, tokenIndex1004 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l1005
+							position1722, tokenIndex1722 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1723
 							}
 							position++
-							goto l1004
-						l1005:
-							position, tokenIndex = position1004, tokenIndex1004
-							if buffer[position] != rune('T') {
-								goto l998
+							goto l1722
+						l1723:
+							position, tokenIndex = position1722, tokenIndex1722
+							if buffer[position] != rune('S') {
+								goto l1716
 							}
 							position++
 						}
-					l1004:
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l998
-						}
-					l1006:
+					l1722:
 						{
-							position1007, tokenIndex1007 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if buffer[position] != rune(',') {
-								goto l1007
+							position1724, tokenIndex1724 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1725
 							}
 							position++
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l1007
+							goto l1724
+						l1725:
+							position, tokenIndex = position1724, tokenIndex1724
+							if buffer[position] != rune('E') {
+								goto l1716
 							}
-							goto l1006
-						l1007:
-							position, tokenIndex = position1007, tokenIndex1007
+							position++
 						}
-						goto l999
-					l998:
-						position, tokenIndex = position998, tokenIndex998
+					l1724:
+						if !_rules[rulesp]() {
+							goto l1716
+						}
+						if !_rules[ruleExpression]() {
+							goto l1716
+						}
+						goto l1717
+					l1716:
+						position, tokenIndex = position1716, tokenIndex1716
+					}
+				l1717:
+					if !_rules[rulesp]() {
+						goto l1703
 					}
-				l999:
-					add(rulePegText, position997)
-				}
-				if !_rules[ruleAction47]() {
-					goto l995
-				}
-				add(ruleSetOptSpecs, position996)
-			}
-			return true
-		l995:
-			position, tokenIndex = position995, tokenIndex995
-			return false
-		},
-		/* 63 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action48)> */
-		func() bool {
-			position1008, tokenIndex1008 := position, tokenIndex
-			{
-				position1009 := position
-				{
-					position1010 := position
 					{
-						position1011, tokenIndex1011 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1011
+						position1726, tokenIndex1726 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1727
 						}
-						{
-							position1013, tokenIndex1013 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l1014
-							}
-							position++
-							goto l1013
-						l1014:
-							position, tokenIndex = position1013, tokenIndex1013
-							if buffer[position] != rune('T') {
-								goto l1011
-							}
-							position++
+						position++
+						goto l1726
+					l1727:
+						position, tokenIndex = position1726, tokenIndex1726
+						if buffer[position] != rune('E') {
+							goto l1703
 						}
-					l1013:
-						{
-							position1015, tokenIndex1015 := position, tokenIndex
-							if buffer[position] != rune('a') {
-								goto l1016
-							}
-							position++
-							goto l1015
-						l1016:
-							position, tokenIndex = position1015, tokenIndex1015
-							if buffer[position] != rune('A') {
-								goto l1011
-							}
-							position++
+						position++
+					}
+				l1726:
+					{
+						position1728, tokenIndex1728 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1729
 						}
-					l1015:
-						{
-							position1017, tokenIndex1017 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l1018
-							}
-							position++
-							goto l1017
-						l1018:
-							position, tokenIndex = position1017, tokenIndex1017
-							if buffer[position] != rune('G') {
-								goto l1011
-							}
-							position++
+						position++
+						goto l1728
+					l1729:
+						position, tokenIndex = position1728, tokenIndex1728
+						if buffer[position] != rune('N') {
+							goto l1703
 						}
-					l1017:
-						if !_rules[rulesp]() {
-							goto l1011
+						position++
+					}
+				l1728:
+					{
+						position1730, tokenIndex1730 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1731
 						}
-						if !_rules[ruleIdentifier]() {
-							goto l1011
+						position++
+						goto l1730
+					l1731:
+						position, tokenIndex = position1730, tokenIndex1730
+						if buffer[position] != rune('D') {
+							goto l1703
 						}
-						goto l1012
-					l1011:
-						position, tokenIndex = position1011, tokenIndex1011
+						position++
 					}
-				l1012:
-					add(rulePegText, position1010)
+				l1730:
+					add(rulePegText, position1713)
 				}
-				if !_rules[ruleAction48]() {
-					goto l1008
+				if !_rules[ruleAction111]() {
+					goto l1703
 				}
-				add(ruleStateTagOpt, position1009)
+				add(ruleExpressionCase, position1704)
 			}
 			return true
-		l1008:
-			position, tokenIndex = position1008, tokenIndex1008
+		l1703:
+			position, tokenIndex = position1703, tokenIndex1703
 			return false
 		},
-		/* 64 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action49)> */
+		/* 138 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action112)> */
 		func() bool {
-			position1019, tokenIndex1019 := position, tokenIndex
+			position1732, tokenIndex1732 := position, tokenIndex
 			{
-				position1020 := position
-				if !_rules[ruleSourceSinkParamKey]() {
-					goto l1019
+				position1733 := position
+				{
+					position1734, tokenIndex1734 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l1735
+					}
+					position++
+					goto l1734
+				l1735:
+					position, tokenIndex = position1734, tokenIndex1734
+					if buffer[position] != rune('W') {
+						goto l1732
+					}
+					position++
 				}
-				if !_rules[rulespOpt]() {
-					goto l1019
+			l1734:
+				{
+					position1736, tokenIndex1736 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l1737
+					}
+					position++
+					goto l1736
+				l1737:
+					position, tokenIndex = position1736, tokenIndex1736
+					if buffer[position] != rune('H') {
+						goto l1732
+					}
+					position++
 				}
-				if buffer[position] != rune('=') {
-					goto l1019
+			l1736:
+				{
+					position1738, tokenIndex1738 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1739
+					}
+					position++
+					goto l1738
+				l1739:
+					position, tokenIndex = position1738, tokenIndex1738
+					if buffer[position] != rune('E') {
+						goto l1732
+					}
+					position++
 				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1019
+			l1738:
+				{
+					position1740, tokenIndex1740 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1741
+					}
+					position++
+					goto l1740
+				l1741:
+					position, tokenIndex = position1740, tokenIndex1740
+					if buffer[position] != rune('N') {
+						goto l1732
+					}
+					position++
 				}
-				if !_rules[ruleSourceSinkParamVal]() {
-					goto l1019
+			l1740:
+				if !_rules[rulesp]() {
+					goto l1732
 				}
-				if !_rules[ruleAction49]() {
-					goto l1019
+				if !_rules[ruleExpression]() {
+					goto l1732
 				}
-				add(ruleSourceSinkParam, position1020)
-			}
-			return true
-		l1019:
-			position, tokenIndex = position1019, tokenIndex1019
-			return false
-		},
-		/* 65 SourceSinkParamVal <- <ParamLiteral> */
-		func() bool {
-			position1021, tokenIndex1021 := position, tokenIndex
-			{
-				position1022 := position
-				if !_rules[ruleParamLiteral]() {
-					goto l1021
+				if !_rules[rulesp]() {
+					goto l1732
 				}
-				add(ruleSourceSinkParamVal, position1022)
-			}
-			return true
-		l1021:
-			position, tokenIndex = position1021, tokenIndex1021
-			return false
-		},
-		/* 66 ParamLiteral <- <(BooleanLiteral / Literal / ParamArrayExpr / ParamMapExpr)> */
-		func() bool {
-			position1023, tokenIndex1023 := position, tokenIndex
-			{
-				position1024 := position
 				{
-					position1025, tokenIndex1025 := position, tokenIndex
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1026
-					}
-					goto l1025
-				l1026:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleLiteral]() {
-						goto l1027
-					}
-					goto l1025
-				l1027:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleParamArrayExpr]() {
-						goto l1028
+					position1742, tokenIndex1742 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1743
 					}
-					goto l1025
-				l1028:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleParamMapExpr]() {
-						goto l1023
+					position++
+					goto l1742
+				l1743:
+					position, tokenIndex = position1742, tokenIndex1742
+					if buffer[position] != rune('T') {
+						goto l1732
 					}
+					position++
 				}
-			l1025:
-				add(ruleParamLiteral, position1024)
-			}
-			return true
-		l1023:
-			position, tokenIndex = position1023, tokenIndex1023
-			return false
-		},
-		/* 67 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action50)> */
-		func() bool {
-			position1029, tokenIndex1029 := position, tokenIndex
-			{
-				position1030 := position
+			l1742:
 				{
-					position1031 := position
-					if buffer[position] != rune('[') {
-						goto l1029
+					position1744, tokenIndex1744 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l1745
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1029
-					}
-					{
-						position1032, tokenIndex1032 := position, tokenIndex
-						if !_rules[ruleParamLiteral]() {
-							goto l1032
-						}
-					l1034:
-						{
-							position1035, tokenIndex1035 := position, tokenIndex
-							if buffer[position] != rune(',') {
-								goto l1035
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1035
-							}
-							if !_rules[ruleParamLiteral]() {
-								goto l1035
-							}
-							goto l1034
-						l1035:
-							position, tokenIndex = position1035, tokenIndex1035
-						}
-						goto l1033
-					l1032:
-						position, tokenIndex = position1032, tokenIndex1032
+					goto l1744
+				l1745:
+					position, tokenIndex = position1744, tokenIndex1744
+					if buffer[position] != rune('H') {
+						goto l1732
 					}
-				l1033:
-					if !_rules[rulespOpt]() {
-						goto l1029
+					position++
+				}
+			l1744:
+				{
+					position1746, tokenIndex1746 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1747
 					}
-					{
-						position1036, tokenIndex1036 := position, tokenIndex
-						if buffer[position] != rune(',') {
-							goto l1036
-						}
-						position++
-						goto l1037
-					l1036:
-						position, tokenIndex = position1036, tokenIndex1036
+					position++
+					goto l1746
+				l1747:
+					position, tokenIndex = position1746, tokenIndex1746
+					if buffer[position] != rune('E') {
+						goto l1732
 					}
-				l1037:
-					if !_rules[rulespOpt]() {
-						goto l1029
+					position++
+				}
+			l1746:
+				{
+					position1748, tokenIndex1748 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1749
 					}
-					if buffer[position] != rune(']') {
-						goto l1029
+					position++
+					goto l1748
+				l1749:
+					position, tokenIndex = position1748, tokenIndex1748
+					if buffer[position] != rune('N') {
+						goto l1732
 					}
 					position++
-					add(rulePegText, position1031)
 				}
-				if !_rules[ruleAction50]() {
-					goto l1029
+			l1748:
+				if !_rules[rulesp]() {
+					goto l1732
+				}
+				if !_rules[ruleExpressionOrWildcard]() {
+					goto l1732
 				}
-				add(ruleParamArrayExpr, position1030)
+				if !_rules[ruleAction112]() {
+					goto l1732
+				}
+				add(ruleWhenThenPair, position1733)
 			}
 			return true
-		l1029:
-			position, tokenIndex = position1029, tokenIndex1029
+		l1732:
+			position, tokenIndex = position1732, tokenIndex1732
 			return false
 		},
-		/* 68 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action51)> */
+		/* 139 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
 		func() bool {
-			position1038, tokenIndex1038 := position, tokenIndex
+			position1750, tokenIndex1750 := position, tokenIndex
 			{
-				position1039 := position
+				position1751 := position
 				{
-					position1040 := position
-					if buffer[position] != rune('{') {
-						goto l1038
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1038
-					}
-					{
-						position1041, tokenIndex1041 := position, tokenIndex
-						if !_rules[ruleParamKeyValuePair]() {
-							goto l1041
-						}
-					l1043:
-						{
-							position1044, tokenIndex1044 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1044
-							}
-							if buffer[position] != rune(',') {
-								goto l1044
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1044
-							}
-							if !_rules[ruleParamKeyValuePair]() {
-								goto l1044
-							}
-							goto l1043
-						l1044:
-							position, tokenIndex = position1044, tokenIndex1044
-						}
-						goto l1042
-					l1041:
-						position, tokenIndex = position1041, tokenIndex1041
+					position1752, tokenIndex1752 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l1753
 					}
-				l1042:
-					if !_rules[rulespOpt]() {
-						goto l1038
+					goto l1752
+				l1753:
+					position, tokenIndex = position1752, tokenIndex1752
+					if !_rules[ruleNumericLiteral]() {
+						goto l1754
 					}
-					if buffer[position] != rune('}') {
-						goto l1038
+					goto l1752
+				l1754:
+					position, tokenIndex = position1752, tokenIndex1752
+					if !_rules[ruleStringLiteral]() {
+						goto l1750
 					}
-					position++
-					add(rulePegText, position1040)
-				}
-				if !_rules[ruleAction51]() {
-					goto l1038
 				}
-				add(ruleParamMapExpr, position1039)
+			l1752:
+				add(ruleLiteral, position1751)
 			}
 			return true
-		l1038:
-			position, tokenIndex = position1038, tokenIndex1038
+		l1750:
+			position, tokenIndex = position1750, tokenIndex1750
 			return false
 		},
-		/* 69 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action52)> */
+		/* 140 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual / NotRegexpIMatch / NotRegexpMatch / RegexpIMatch / RegexpMatch)> */
 		func() bool {
-			position1045, tokenIndex1045 := position, tokenIndex
+			position1755, tokenIndex1755 := position, tokenIndex
 			{
-				position1046 := position
+				position1756 := position
 				{
-					position1047 := position
-					if !_rules[ruleStringLiteral]() {
-						goto l1045
+					position1757, tokenIndex1757 := position, tokenIndex
+					if !_rules[ruleEqual]() {
+						goto l1758
 					}
-					if !_rules[rulespOpt]() {
-						goto l1045
+					goto l1757
+				l1758:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleNotEqual]() {
+						goto l1759
 					}
-					if buffer[position] != rune(':') {
-						goto l1045
+					goto l1757
+				l1759:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleLessOrEqual]() {
+						goto l1760
 					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1045
+					goto l1757
+				l1760:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleLess]() {
+						goto l1761
 					}
-					if !_rules[ruleParamLiteral]() {
-						goto l1045
+					goto l1757
+				l1761:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleGreaterOrEqual]() {
+						goto l1762
 					}
-					add(rulePegText, position1047)
-				}
-				if !_rules[ruleAction52]() {
-					goto l1045
-				}
-				add(ruleParamKeyValuePair, position1046)
-			}
-			return true
-		l1045:
-			position, tokenIndex = position1045, tokenIndex1045
-			return false
-		},
-		/* 70 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action53)> */
-		func() bool {
-			position1048, tokenIndex1048 := position, tokenIndex
-			{
-				position1049 := position
-				{
-					position1050 := position
-					{
-						position1051, tokenIndex1051 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1051
-						}
-						{
-							position1053, tokenIndex1053 := position, tokenIndex
-							if !_rules[rulePaused]() {
-								goto l1054
-							}
-							goto l1053
-						l1054:
-							position, tokenIndex = position1053, tokenIndex1053
-							if !_rules[ruleUnpaused]() {
-								goto l1051
-							}
-						}
-					l1053:
-						goto l1052
-					l1051:
-						position, tokenIndex = position1051, tokenIndex1051
+					goto l1757
+				l1762:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleGreater]() {
+						goto l1763
+					}
+					goto l1757
+				l1763:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleNotEqual]() {
+						goto l1764
+					}
+					goto l1757
+				l1764:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleNotRegexpIMatch]() {
+						goto l1765
+					}
+					goto l1757
+				l1765:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleNotRegexpMatch]() {
+						goto l1766
+					}
+					goto l1757
+				l1766:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleRegexpIMatch]() {
+						goto l1767
+					}
+					goto l1757
+				l1767:
+					position, tokenIndex = position1757, tokenIndex1757
+					if !_rules[ruleRegexpMatch]() {
+						goto l1755
 					}
-				l1052:
-					add(rulePegText, position1050)
-				}
-				if !_rules[ruleAction53]() {
-					goto l1048
 				}
-				add(rulePausedOpt, position1049)
+			l1757:
+				add(ruleComparisonOp, position1756)
 			}
 			return true
-		l1048:
-			position, tokenIndex = position1048, tokenIndex1048
+		l1755:
+			position, tokenIndex = position1755, tokenIndex1755
 			return false
 		},
-		/* 71 ExpressionOrWildcard <- <(Wildcard / Expression)> */
+		/* 141 PatternMatchOp <- <(Like / NotLike / ILike / NotILike / SimilarTo / NotSimilarTo)> */
 		func() bool {
-			position1055, tokenIndex1055 := position, tokenIndex
+			position1768, tokenIndex1768 := position, tokenIndex
 			{
-				position1056 := position
+				position1769 := position
 				{
-					position1057, tokenIndex1057 := position, tokenIndex
-					if !_rules[ruleWildcard]() {
-						goto l1058
+					position1770, tokenIndex1770 := position, tokenIndex
+					if !_rules[ruleLike]() {
+						goto l1771
 					}
-					goto l1057
-				l1058:
-					position, tokenIndex = position1057, tokenIndex1057
-					if !_rules[ruleExpression]() {
-						goto l1055
+					goto l1770
+				l1771:
+					position, tokenIndex = position1770, tokenIndex1770
+					if !_rules[ruleNotLike]() {
+						goto l1772
+					}
+					goto l1770
+				l1772:
+					position, tokenIndex = position1770, tokenIndex1770
+					if !_rules[ruleILike]() {
+						goto l1773
+					}
+					goto l1770
+				l1773:
+					position, tokenIndex = position1770, tokenIndex1770
+					if !_rules[ruleNotILike]() {
+						goto l1774
+					}
+					goto l1770
+				l1774:
+					position, tokenIndex = position1770, tokenIndex1770
+					if !_rules[ruleSimilarTo]() {
+						goto l1775
+					}
+					goto l1770
+				l1775:
+					position, tokenIndex = position1770, tokenIndex1770
+					if !_rules[ruleNotSimilarTo]() {
+						goto l1768
 					}
 				}
-			l1057:
-				add(ruleExpressionOrWildcard, position1056)
+			l1770:
+				add(rulePatternMatchOp, position1769)
 			}
 			return true
-		l1055:
-			position, tokenIndex = position1055, tokenIndex1055
+		l1768:
+			position, tokenIndex = position1768, tokenIndex1768
 			return false
 		},
-		/* 72 Expression <- <orExpr> */
+		/* 142 OtherOp <- <Concat> */
 		func() bool {
-			position1059, tokenIndex1059 := position, tokenIndex
+			position1776, tokenIndex1776 := position, tokenIndex
 			{
-				position1060 := position
-				if !_rules[ruleorExpr]() {
-					goto l1059
+				position1777 := position
+				if !_rules[ruleConcat]() {
+					goto l1776
 				}
-				add(ruleExpression, position1060)
+				add(ruleOtherOp, position1777)
 			}
 			return true
-		l1059:
-			position, tokenIndex = position1059, tokenIndex1059
+		l1776:
+			position, tokenIndex = position1776, tokenIndex1776
 			return false
 		},
-		/* 73 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action54)> */
+		/* 143 IsOp <- <(IsNot / Is)> */
 		func() bool {
-			position1061, tokenIndex1061 := position, tokenIndex
+			position1778, tokenIndex1778 := position, tokenIndex
 			{
-				position1062 := position
+				position1779 := position
 				{
-					position1063 := position
-					if !_rules[ruleandExpr]() {
-						goto l1061
+					position1780, tokenIndex1780 := position, tokenIndex
+					if !_rules[ruleIsNot]() {
+						goto l1781
 					}
-				l1064:
-					{
-						position1065, tokenIndex1065 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1065
-						}
-						if !_rules[ruleOr]() {
-							goto l1065
-						}
-						if !_rules[rulesp]() {
-							goto l1065
-						}
-						if !_rules[ruleandExpr]() {
-							goto l1065
-						}
-						goto l1064
-					l1065:
-						position, tokenIndex = position1065, tokenIndex1065
+					goto l1780
+				l1781:
+					position, tokenIndex = position1780, tokenIndex1780
+					if !_rules[ruleIs]() {
+						goto l1778
 					}
-					add(rulePegText, position1063)
-				}
-				if !_rules[ruleAction54]() {
-					goto l1061
 				}
-				add(ruleorExpr, position1062)
+			l1780:
+				add(ruleIsOp, position1779)
 			}
 			return true
-		l1061:
-			position, tokenIndex = position1061, tokenIndex1061
+		l1778:
+			position, tokenIndex = position1778, tokenIndex1778
 			return false
 		},
-		/* 74 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action55)> */
+		/* 144 PlusMinusOp <- <(Plus / Minus)> */
 		func() bool {
-			position1066, tokenIndex1066 := position, tokenIndex
+			position1782, tokenIndex1782 := position, tokenIndex
 			{
-				position1067 := position
+				position1783 := position
 				{
-					position1068 := position
-					if !_rules[rulenotExpr]() {
-						goto l1066
+					position1784, tokenIndex1784 := position, tokenIndex
+					if !_rules[rulePlus]() {
+						goto l1785
 					}
-				l1069:
-					{
-						position1070, tokenIndex1070 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1070
-						}
-						if !_rules[ruleAnd]() {
-							goto l1070
-						}
-						if !_rules[rulesp]() {
-							goto l1070
-						}
-						if !_rules[rulenotExpr]() {
-							goto l1070
-						}
-						goto l1069
-					l1070:
-						position, tokenIndex = position1070, tokenIndex1070
+					goto l1784
+				l1785:
+					position, tokenIndex = position1784, tokenIndex1784
+					if !_rules[ruleMinus]() {
+						goto l1782
 					}
-					add(rulePegText, position1068)
-				}
-				if !_rules[ruleAction55]() {
-					goto l1066
 				}
-				add(ruleandExpr, position1067)
+			l1784:
+				add(rulePlusMinusOp, position1783)
 			}
 			return true
-		l1066:
-			position, tokenIndex = position1066, tokenIndex1066
+		l1782:
+			position, tokenIndex = position1782, tokenIndex1782
 			return false
 		},
-		/* 75 notExpr <- <(<((Not sp)? comparisonExpr)> Action56)> */
+		/* 145 MultDivOp <- <(Multiply / Divide / Modulo)> */
 		func() bool {
-			position1071, tokenIndex1071 := position, tokenIndex
+			position1786, tokenIndex1786 := position, tokenIndex
 			{
-				position1072 := position
+				position1787 := position
 				{
-					position1073 := position
-					{
-						position1074, tokenIndex1074 := position, tokenIndex
-						if !_rules[ruleNot]() {
-							goto l1074
-						}
-						if !_rules[rulesp]() {
-							goto l1074
-						}
-						goto l1075
-					l1074:
-						position, tokenIndex = position1074, tokenIndex1074
+					position1788, tokenIndex1788 := position, tokenIndex
+					if !_rules[ruleMultiply]() {
+						goto l1789
 					}
-				l1075:
-					if !_rules[rulecomparisonExpr]() {
-						goto l1071
+					goto l1788
+				l1789:
+					position, tokenIndex = position1788, tokenIndex1788
+					if !_rules[ruleDivide]() {
+						goto l1790
+					}
+					goto l1788
+				l1790:
+					position, tokenIndex = position1788, tokenIndex1788
+					if !_rules[ruleModulo]() {
+						goto l1786
 					}
-					add(rulePegText, position1073)
-				}
-				if !_rules[ruleAction56]() {
-					goto l1071
 				}
-				add(rulenotExpr, position1072)
+			l1788:
+				add(ruleMultDivOp, position1787)
 			}
 			return true
-		l1071:
-			position, tokenIndex = position1071, tokenIndex1071
+		l1786:
+			position, tokenIndex = position1786, tokenIndex1786
 			return false
 		},
-		/* 76 comparisonExpr <- <(<(otherOpExpr (spOpt ComparisonOp spOpt otherOpExpr)?)> Action57)> */
+		/* 146 Stream <- <(<ident> Action113)> */
 		func() bool {
-			position1076, tokenIndex1076 := position, tokenIndex
+			position1791, tokenIndex1791 := position, tokenIndex
 			{
-				position1077 := position
+				position1792 := position
 				{
-					position1078 := position
-					if !_rules[ruleotherOpExpr]() {
-						goto l1076
-					}
-					{
-						position1079, tokenIndex1079 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1079
-						}
-						if !_rules[ruleComparisonOp]() {
-							goto l1079
-						}
-						if !_rules[rulespOpt]() {
-							goto l1079
-						}
-						if !_rules[ruleotherOpExpr]() {
-							goto l1079
-						}
-						goto l1080
-					l1079:
-						position, tokenIndex = position1079, tokenIndex1079
+					position1793 := position
+					if !_rules[ruleident]() {
+						goto l1791
 					}
-				l1080:
-					add(rulePegText, position1078)
+					add(rulePegText, position1793)
 				}
-				if !_rules[ruleAction57]() {
-					goto l1076
+				if !_rules[ruleAction113]() {
+					goto l1791
+				}
+				add(ruleStream, position1792)
+			}
+			return true
+		l1791:
+			position, tokenIndex = position1791, tokenIndex1791
+			return false
+		},
+		/* 147 RowMeta <- <RowTimestamp> */
+		func() bool {
+			position1794, tokenIndex1794 := position, tokenIndex
+			{
+				position1795 := position
+				if !_rules[ruleRowTimestamp]() {
+					goto l1794
 				}
-				add(rulecomparisonExpr, position1077)
+				add(ruleRowMeta, position1795)
 			}
 			return true
-		l1076:
-			position, tokenIndex = position1076, tokenIndex1076
+		l1794:
+			position, tokenIndex = position1794, tokenIndex1794
 			return false
 		},
-		/* 77 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action58)> */
+		/* 148 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action114)> */
 		func() bool {
-			position1081, tokenIndex1081 := position, tokenIndex
+			position1796, tokenIndex1796 := position, tokenIndex
 			{
-				position1082 := position
+				position1797 := position
 				{
-					position1083 := position
-					if !_rules[ruleisExpr]() {
-						goto l1081
-					}
-				l1084:
+					position1798 := position
 					{
-						position1085, tokenIndex1085 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1085
-						}
-						if !_rules[ruleOtherOp]() {
-							goto l1085
-						}
-						if !_rules[rulespOpt]() {
-							goto l1085
+						position1799, tokenIndex1799 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l1799
 						}
-						if !_rules[ruleisExpr]() {
-							goto l1085
+						if buffer[position] != rune(':') {
+							goto l1799
 						}
-						goto l1084
-					l1085:
-						position, tokenIndex = position1085, tokenIndex1085
+						position++
+						goto l1800
+					l1799:
+						position, tokenIndex = position1799, tokenIndex1799
 					}
-					add(rulePegText, position1083)
+				l1800:
+					if buffer[position] != rune('t') {
+						goto l1796
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1796
+					}
+					position++
+					if buffer[position] != rune('(') {
+						goto l1796
+					}
+					position++
+					if buffer[position] != rune(')') {
+						goto l1796
+					}
+					position++
+					add(rulePegText, position1798)
 				}
-				if !_rules[ruleAction58]() {
-					goto l1081
+				if !_rules[ruleAction114]() {
+					goto l1796
 				}
-				add(ruleotherOpExpr, position1082)
+				add(ruleRowTimestamp, position1797)
 			}
 			return true
-		l1081:
-			position, tokenIndex = position1081, tokenIndex1081
+		l1796:
+			position, tokenIndex = position1796, tokenIndex1796
 			return false
 		},
-		/* 78 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action59)> */
+		/* 149 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action115)> */
 		func() bool {
-			position1086, tokenIndex1086 := position, tokenIndex
+			position1801, tokenIndex1801 := position, tokenIndex
 			{
-				position1087 := position
+				position1802 := position
 				{
-					position1088 := position
+					position1803 := position
 					{
-						position1089, tokenIndex1089 := position, tokenIndex
-						if !_rules[ruleRowValue]() {
-							goto l1090
-						}
-						if !_rules[rulesp]() {
-							goto l1090
-						}
-						if !_rules[ruleIsOp]() {
-							goto l1090
-						}
-						if !_rules[rulesp]() {
-							goto l1090
-						}
-						if !_rules[ruleMissing]() {
-							goto l1090
+						position1804, tokenIndex1804 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l1804
 						}
-						goto l1089
-					l1090:
-						position, tokenIndex = position1089, tokenIndex1089
-						if !_rules[ruletermExpr]() {
-							goto l1086
+						if buffer[position] != rune(':') {
+							goto l1804
 						}
+						position++
 						{
-							position1091, tokenIndex1091 := position, tokenIndex
-							if !_rules[rulesp]() {
-								goto l1091
-							}
-							if !_rules[ruleIsOp]() {
-								goto l1091
-							}
-							if !_rules[rulesp]() {
-								goto l1091
-							}
-							if !_rules[ruleNullLiteral]() {
-								goto l1091
+							position1806, tokenIndex1806 := position, tokenIndex
+							if buffer[position] != rune(':') {
+								goto l1806
 							}
-							goto l1092
-						l1091:
-							position, tokenIndex = position1091, tokenIndex1091
+							position++
+							goto l1804
+						l1806:
+							position, tokenIndex = position1806, tokenIndex1806
 						}
-					l1092:
+						goto l1805
+					l1804:
+						position, tokenIndex = position1804, tokenIndex1804
+					}
+				l1805:
+					if !_rules[rulejsonGetPath]() {
+						goto l1801
 					}
-				l1089:
-					add(rulePegText, position1088)
+					add(rulePegText, position1803)
 				}
-				if !_rules[ruleAction59]() {
-					goto l1086
+				if !_rules[ruleAction115]() {
+					goto l1801
 				}
-				add(ruleisExpr, position1087)
+				add(ruleRowValue, position1802)
 			}
 			return true
-		l1086:
-			position, tokenIndex = position1086, tokenIndex1086
+		l1801:
+			position, tokenIndex = position1801, tokenIndex1801
 			return false
 		},
-		/* 79 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action60)> */
+		/* 150 NumericLiteral <- <(<('-'? [0-9]+)> Action116)> */
 		func() bool {
-			position1093, tokenIndex1093 := position, tokenIndex
+			position1807, tokenIndex1807 := position, tokenIndex
 			{
-				position1094 := position
+				position1808 := position
 				{
-					position1095 := position
-					if !_rules[ruleproductExpr]() {
-						goto l1093
-					}
-				l1096:
+					position1809 := position
 					{
-						position1097, tokenIndex1097 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1097
-						}
-						if !_rules[rulePlusMinusOp]() {
-							goto l1097
-						}
-						if !_rules[rulespOpt]() {
-							goto l1097
+						position1810, tokenIndex1810 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l1810
 						}
-						if !_rules[ruleproductExpr]() {
-							goto l1097
+						position++
+						goto l1811
+					l1810:
+						position, tokenIndex = position1810, tokenIndex1810
+					}
+				l1811:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1807
+					}
+					position++
+				l1812:
+					{
+						position1813, tokenIndex1813 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1813
 						}
-						goto l1096
-					l1097:
-						position, tokenIndex = position1097, tokenIndex1097
+						position++
+						goto l1812
+					l1813:
+						position, tokenIndex = position1813, tokenIndex1813
 					}
-					add(rulePegText, position1095)
+					add(rulePegText, position1809)
 				}
-				if !_rules[ruleAction60]() {
-					goto l1093
+				if !_rules[ruleAction116]() {
+					goto l1807
 				}
-				add(ruletermExpr, position1094)
+				add(ruleNumericLiteral, position1808)
 			}
 			return true
-		l1093:
-			position, tokenIndex = position1093, tokenIndex1093
+		l1807:
+			position, tokenIndex = position1807, tokenIndex1807
 			return false
 		},
-		/* 80 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action61)> */
+		/* 151 NonNegativeNumericLiteral <- <(<[0-9]+> Action117)> */
 		func() bool {
-			position1098, tokenIndex1098 := position, tokenIndex
+			position1814, tokenIndex1814 := position, tokenIndex
 			{
-				position1099 := position
+				position1815 := position
 				{
-					position1100 := position
-					if !_rules[ruleminusExpr]() {
-						goto l1098
+					position1816 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1814
 					}
-				l1101:
+					position++
+				l1817:
 					{
-						position1102, tokenIndex1102 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1102
-						}
-						if !_rules[ruleMultDivOp]() {
-							goto l1102
-						}
-						if !_rules[rulespOpt]() {
-							goto l1102
-						}
-						if !_rules[ruleminusExpr]() {
-							goto l1102
+						position1818, tokenIndex1818 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1818
 						}
-						goto l1101
-					l1102:
-						position, tokenIndex = position1102, tokenIndex1102
+						position++
+						goto l1817
+					l1818:
+						position, tokenIndex = position1818, tokenIndex1818
 					}
-					add(rulePegText, position1100)
+					add(rulePegText, position1816)
 				}
-				if !_rules[ruleAction61]() {
-					goto l1098
+				if !_rules[ruleAction117]() {
+					goto l1814
 				}
-				add(ruleproductExpr, position1099)
+				add(ruleNonNegativeNumericLiteral, position1815)
 			}
 			return true
-		l1098:
-			position, tokenIndex = position1098, tokenIndex1098
+		l1814:
+			position, tokenIndex = position1814, tokenIndex1814
 			return false
 		},
-		/* 81 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action62)> */
+		/* 152 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action118)> */
 		func() bool {
-			position1103, tokenIndex1103 := position, tokenIndex
+			position1819, tokenIndex1819 := position, tokenIndex
 			{
-				position1104 := position
+				position1820 := position
 				{
-					position1105 := position
+					position1821 := position
 					{
-						position1106, tokenIndex1106 := position, tokenIndex
-						if !_rules[ruleUnaryMinus]() {
-							goto l1106
+						position1822, tokenIndex1822 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l1822
 						}
-						if !_rules[rulespOpt]() {
-							goto l1106
+						position++
+						goto l1823
+					l1822:
+						position, tokenIndex = position1822, tokenIndex1822
+					}
+				l1823:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1819
+					}
+					position++
+				l1824:
+					{
+						position1825, tokenIndex1825 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1825
 						}
-						goto l1107
-					l1106:
-						position, tokenIndex = position1106, tokenIndex1106
+						position++
+						goto l1824
+					l1825:
+						position, tokenIndex = position1825, tokenIndex1825
 					}
-				l1107:
-					if !_rules[rulecastExpr]() {
-						goto l1103
+					if buffer[position] != rune('.') {
+						goto l1819
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1819
+					}
+					position++
+				l1826:
+					{
+						position1827, tokenIndex1827 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1827
+						}
+						position++
+						goto l1826
+					l1827:
+						position, tokenIndex = position1827, tokenIndex1827
 					}
-					add(rulePegText, position1105)
+					add(rulePegText, position1821)
 				}
-				if !_rules[ruleAction62]() {
-					goto l1103
+				if !_rules[ruleAction118]() {
+					goto l1819
 				}
-				add(ruleminusExpr, position1104)
+				add(ruleFloatLiteral, position1820)
 			}
 			return true
-		l1103:
-			position, tokenIndex = position1103, tokenIndex1103
+		l1819:
+			position, tokenIndex = position1819, tokenIndex1819
 			return false
 		},
-		/* 82 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action63)> */
+		/* 153 Function <- <(<ident> Action119)> */
 		func() bool {
-			position1108, tokenIndex1108 := position, tokenIndex
+			position1828, tokenIndex1828 := position, tokenIndex
 			{
-				position1109 := position
+				position1829 := position
 				{
-					position1110 := position
-					if !_rules[rulebaseExpr]() {
-						goto l1108
-					}
-					{
-						position1111, tokenIndex1111 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1111
-						}
-						if buffer[position] != rune(':') {
-							goto l1111
-						}
-						position++
-						if buffer[position] != rune(':') {
-							goto l1111
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l1111
-						}
-						if !_rules[ruleType]() {
-							goto l1111
-						}
-						goto l1112
-					l1111:
-						position, tokenIndex = position1111, tokenIndex1111
+					position1830 := position
+					if !_rules[ruleident]() {
+						goto l1828
 					}
-				l1112:
-					add(rulePegText, position1110)
+					add(rulePegText, position1830)
 				}
-				if !_rules[ruleAction63]() {
-					goto l1108
+				if !_rules[ruleAction119]() {
+					goto l1828
 				}
-				add(rulecastExpr, position1109)
+				add(ruleFunction, position1829)
 			}
 			return true
-		l1108:
-			position, tokenIndex = position1108, tokenIndex1108
+		l1828:
+			position, tokenIndex = position1828, tokenIndex1828
 			return false
 		},
-		/* 83 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncAppSelector / FuncApp / RowValue / ArrayExpr / Literal)> */
+		/* 154 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action120)> */
 		func() bool {
-			position1113, tokenIndex1113 := position, tokenIndex
+			position1831, tokenIndex1831 := position, tokenIndex
 			{
-				position1114 := position
+				position1832 := position
 				{
-					position1115, tokenIndex1115 := position, tokenIndex
-					if buffer[position] != rune('(') {
-						goto l1116
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1116
-					}
-					if !_rules[ruleExpression]() {
-						goto l1116
-					}
-					if !_rules[rulespOpt]() {
-						goto l1116
-					}
-					if buffer[position] != rune(')') {
-						goto l1116
-					}
-					position++
-					goto l1115
-				l1116:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleMapExpr]() {
-						goto l1117
-					}
-					goto l1115
-				l1117:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1118
-					}
-					goto l1115
-				l1118:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleNullLiteral]() {
-						goto l1119
-					}
-					goto l1115
-				l1119:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleCase]() {
-						goto l1120
-					}
-					goto l1115
-				l1120:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleRowMeta]() {
-						goto l1121
-					}
-					goto l1115
-				l1121:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncTypeCast]() {
-						goto l1122
-					}
-					goto l1115
-				l1122:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncAppSelector]() {
-						goto l1123
-					}
-					goto l1115
-				l1123:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncApp]() {
-						goto l1124
+					position1833 := position
+					{
+						position1834, tokenIndex1834 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1835
+						}
+						position++
+						goto l1834
+					l1835:
+						position, tokenIndex = position1834, tokenIndex1834
+						if buffer[position] != rune('N') {
+							goto l1831
+						}
+						position++
 					}
-					goto l1115
-				l1124:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleRowValue]() {
-						goto l1125
+				l1834:
+					{
+						position1836, tokenIndex1836 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1837
+						}
+						position++
+						goto l1836
+					l1837:
+						position, tokenIndex = position1836, tokenIndex1836
+						if buffer[position] != rune('U') {
+							goto l1831
+						}
+						position++
 					}
-					goto l1115
-				l1125:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleArrayExpr]() {
-						goto l1126
+				l1836:
+					{
+						position1838, tokenIndex1838 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1839
+						}
+						position++
+						goto l1838
+					l1839:
+						position, tokenIndex = position1838, tokenIndex1838
+						if buffer[position] != rune('L') {
+							goto l1831
+						}
+						position++
 					}
-					goto l1115
-				l1126:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleLiteral]() {
-						goto l1113
+				l1838:
+					{
+						position1840, tokenIndex1840 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1841
+						}
+						position++
+						goto l1840
+					l1841:
+						position, tokenIndex = position1840, tokenIndex1840
+						if buffer[position] != rune('L') {
+							goto l1831
+						}
+						position++
 					}
+				l1840:
+					add(rulePegText, position1833)
 				}
-			l1115:
-				add(rulebaseExpr, position1114)
+				if !_rules[ruleAction120]() {
+					goto l1831
+				}
+				add(ruleNullLiteral, position1832)
 			}
 			return true
-		l1113:
-			position, tokenIndex = position1113, tokenIndex1113
+		l1831:
+			position, tokenIndex = position1831, tokenIndex1831
 			return false
 		},
-		/* 84 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action64)> */
+		/* 155 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action121)> */
 		func() bool {
-			position1127, tokenIndex1127 := position, tokenIndex
+			position1842, tokenIndex1842 := position, tokenIndex
 			{
-				position1128 := position
+				position1843 := position
 				{
-					position1129 := position
+					position1844 := position
 					{
-						position1130, tokenIndex1130 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1131
+						position1845, tokenIndex1845 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1846
 						}
 						position++
-						goto l1130
-					l1131:
-						position, tokenIndex = position1130, tokenIndex1130
-						if buffer[position] != rune('C') {
-							goto l1127
+						goto l1845
+					l1846:
+						position, tokenIndex = position1845, tokenIndex1845
+						if buffer[position] != rune('M') {
+							goto l1842
 						}
 						position++
 					}
-				l1130:
+				l1845:
 					{
-						position1132, tokenIndex1132 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1133
+						position1847, tokenIndex1847 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1848
 						}
 						position++
-						goto l1132
-					l1133:
-						position, tokenIndex = position1132, tokenIndex1132
-						if buffer[position] != rune('A') {
-							goto l1127
+						goto l1847
+					l1848:
+						position, tokenIndex = position1847, tokenIndex1847
+						if buffer[position] != rune('I') {
+							goto l1842
 						}
 						position++
 					}
-				l1132:
+				l1847:
 					{
-						position1134, tokenIndex1134 := position, tokenIndex
+						position1849, tokenIndex1849 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1135
+							goto l1850
 						}
 						position++
-						goto l1134
-					l1135:
-						position, tokenIndex = position1134, tokenIndex1134
+						goto l1849
+					l1850:
+						position, tokenIndex = position1849, tokenIndex1849
 						if buffer[position] != rune('S') {
-							goto l1127
+							goto l1842
 						}
 						position++
 					}
-				l1134:
+				l1849:
 					{
-						position1136, tokenIndex1136 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1137
+						position1851, tokenIndex1851 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1852
 						}
 						position++
-						goto l1136
-					l1137:
-						position, tokenIndex = position1136, tokenIndex1136
-						if buffer[position] != rune('T') {
-							goto l1127
+						goto l1851
+					l1852:
+						position, tokenIndex = position1851, tokenIndex1851
+						if buffer[position] != rune('S') {
+							goto l1842
 						}
 						position++
 					}
-				l1136:
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if buffer[position] != rune('(') {
-						goto l1127
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if !_rules[ruleExpression]() {
-						goto l1127
-					}
-					if !_rules[rulesp]() {
-						goto l1127
-					}
+				l1851:
 					{
-						position1138, tokenIndex1138 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1139
+						position1853, tokenIndex1853 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1854
 						}
 						position++
-						goto l1138
-					l1139:
-						position, tokenIndex = position1138, tokenIndex1138
-						if buffer[position] != rune('A') {
-							goto l1127
+						goto l1853
+					l1854:
+						position, tokenIndex = position1853, tokenIndex1853
+						if buffer[position] != rune('I') {
+							goto l1842
 						}
 						position++
 					}
-				l1138:
+				l1853:
 					{
-						position1140, tokenIndex1140 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1141
+						position1855, tokenIndex1855 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1856
 						}
 						position++
-						goto l1140
-					l1141:
-						position, tokenIndex = position1140, tokenIndex1140
-						if buffer[position] != rune('S') {
-							goto l1127
+						goto l1855
+					l1856:
+						position, tokenIndex = position1855, tokenIndex1855
+						if buffer[position] != rune('N') {
+							goto l1842
 						}
 						position++
 					}
-				l1140:
-					if !_rules[rulesp]() {
-						goto l1127
-					}
-					if !_rules[ruleType]() {
-						goto l1127
-					}
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if buffer[position] != rune(')') {
-						goto l1127
-					}
-					position++
-					add(rulePegText, position1129)
-				}
-				if !_rules[ruleAction64]() {
-					goto l1127
-				}
-				add(ruleFuncTypeCast, position1128)
-			}
-			return true
-		l1127:
-			position, tokenIndex = position1127, tokenIndex1127
-			return false
-		},
-		/* 85 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
-		func() bool {
-			position1142, tokenIndex1142 := position, tokenIndex
-			{
-				position1143 := position
-				{
-					position1144, tokenIndex1144 := position, tokenIndex
-					if !_rules[ruleFuncAppWithOrderBy]() {
-						goto l1145
-					}
-					goto l1144
-				l1145:
-					position, tokenIndex = position1144, tokenIndex1144
-					if !_rules[ruleFuncAppWithoutOrderBy]() {
-						goto l1142
-					}
-				}
-			l1144:
-				add(ruleFuncApp, position1143)
-			}
-			return true
-		l1142:
-			position, tokenIndex = position1142, tokenIndex1142
-			return false
-		},
-		/* 86 FuncAppSelector <- <(FuncApp FuncElemAccessor Action65)> */
-		func() bool {
-			position1146, tokenIndex1146 := position, tokenIndex
-			{
-				position1147 := position
-				if !_rules[ruleFuncApp]() {
-					goto l1146
-				}
-				if !_rules[ruleFuncElemAccessor]() {
-					goto l1146
-				}
-				if !_rules[ruleAction65]() {
-					goto l1146
-				}
-				add(ruleFuncAppSelector, position1147)
-			}
-			return true
-		l1146:
-			position, tokenIndex = position1146, tokenIndex1146
-			return false
-		},
-		/* 87 FuncElemAccessor <- <(<jsonGetPathNonHead+> Action66)> */
-		func() bool {
-			position1148, tokenIndex1148 := position, tokenIndex
-			{
-				position1149 := position
-				{
-					position1150 := position
-					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1148
-					}
-				l1151:
+				l1855:
 					{
-						position1152, tokenIndex1152 := position, tokenIndex
-						if !_rules[rulejsonGetPathNonHead]() {
-							goto l1152
+						position1857, tokenIndex1857 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1858
+						}
+						position++
+						goto l1857
+					l1858:
+						position, tokenIndex = position1857, tokenIndex1857
+						if buffer[position] != rune('G') {
+							goto l1842
 						}
-						goto l1151
-					l1152:
-						position, tokenIndex = position1152, tokenIndex1152
+						position++
 					}
-					add(rulePegText, position1150)
-				}
-				if !_rules[ruleAction66]() {
-					goto l1148
-				}
-				add(ruleFuncElemAccessor, position1149)
-			}
-			return true
-		l1148:
-			position, tokenIndex = position1148, tokenIndex1148
-			return false
-		},
-		/* 88 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action67)> */
-		func() bool {
-			position1153, tokenIndex1153 := position, tokenIndex
-			{
-				position1154 := position
-				if !_rules[ruleFunction]() {
-					goto l1153
-				}
-				if !_rules[rulespOpt]() {
-					goto l1153
-				}
-				if buffer[position] != rune('(') {
-					goto l1153
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1153
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1153
-				}
-				if !_rules[rulesp]() {
-					goto l1153
-				}
-				if !_rules[ruleParamsOrder]() {
-					goto l1153
-				}
-				if !_rules[rulespOpt]() {
-					goto l1153
-				}
-				if buffer[position] != rune(')') {
-					goto l1153
+				l1857:
+					add(rulePegText, position1844)
 				}
-				position++
-				if !_rules[ruleAction67]() {
-					goto l1153
+				if !_rules[ruleAction121]() {
+					goto l1842
 				}
-				add(ruleFuncAppWithOrderBy, position1154)
+				add(ruleMissing, position1843)
 			}
 			return true
-		l1153:
-			position, tokenIndex = position1153, tokenIndex1153
+		l1842:
+			position, tokenIndex = position1842, tokenIndex1842
 			return false
 		},
-		/* 89 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action68)> */
+		/* 156 BooleanLiteral <- <(TRUE / FALSE)> */
 		func() bool {
-			position1155, tokenIndex1155 := position, tokenIndex
+			position1859, tokenIndex1859 := position, tokenIndex
 			{
-				position1156 := position
-				if !_rules[ruleFunction]() {
-					goto l1155
-				}
-				if !_rules[rulespOpt]() {
-					goto l1155
-				}
-				if buffer[position] != rune('(') {
-					goto l1155
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1155
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1155
-				}
+				position1860 := position
 				{
-					position1157 := position
-					if !_rules[rulespOpt]() {
-						goto l1155
+					position1861, tokenIndex1861 := position, tokenIndex
+					if !_rules[ruleTRUE]() {
+						goto l1862
 					}
-					add(rulePegText, position1157)
-				}
-				if buffer[position] != rune(')') {
-					goto l1155
-				}
-				position++
-				if !_rules[ruleAction68]() {
-					goto l1155
-				}
-				add(ruleFuncAppWithoutOrderBy, position1156)
-			}
-			return true
-		l1155:
-			position, tokenIndex = position1155, tokenIndex1155
-			return false
-		},
-		/* 90 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action69)> */
-		func() bool {
-			position1158, tokenIndex1158 := position, tokenIndex
-			{
-				position1159 := position
-				{
-					position1160 := position
-					{
-						position1161, tokenIndex1161 := position, tokenIndex
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1161
-						}
-					l1163:
-						{
-							position1164, tokenIndex1164 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1164
-							}
-							if buffer[position] != rune(',') {
-								goto l1164
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1164
-							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1164
-							}
-							goto l1163
-						l1164:
-							position, tokenIndex = position1164, tokenIndex1164
-						}
-						goto l1162
-					l1161:
-						position, tokenIndex = position1161, tokenIndex1161
+					goto l1861
+				l1862:
+					position, tokenIndex = position1861, tokenIndex1861
+					if !_rules[ruleFALSE]() {
+						goto l1859
 					}
-				l1162:
-					add(rulePegText, position1160)
-				}
-				if !_rules[ruleAction69]() {
-					goto l1158
 				}
-				add(ruleFuncParams, position1159)
+			l1861:
+				add(ruleBooleanLiteral, position1860)
 			}
 			return true
-		l1158:
-			position, tokenIndex = position1158, tokenIndex1158
+		l1859:
+			position, tokenIndex = position1859, tokenIndex1859
 			return false
 		},
-		/* 91 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action70)> */
+		/* 157 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action122)> */
 		func() bool {
-			position1165, tokenIndex1165 := position, tokenIndex
+			position1863, tokenIndex1863 := position, tokenIndex
 			{
-				position1166 := position
+				position1864 := position
 				{
-					position1167 := position
+					position1865 := position
 					{
-						position1168, tokenIndex1168 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1169
+						position1866, tokenIndex1866 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1867
 						}
 						position++
-						goto l1168
-					l1169:
-						position, tokenIndex = position1168, tokenIndex1168
-						if buffer[position] != rune('O') {
-							goto l1165
+						goto l1866
+					l1867:
+						position, tokenIndex = position1866, tokenIndex1866
+						if buffer[position] != rune('T') {
+							goto l1863
 						}
 						position++
 					}
-				l1168:
+				l1866:
 					{
-						position1170, tokenIndex1170 := position, tokenIndex
+						position1868, tokenIndex1868 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1171
+							goto l1869
 						}
 						position++
-						goto l1170
-					l1171:
-						position, tokenIndex = position1170, tokenIndex1170
+						goto l1868
+					l1869:
+						position, tokenIndex = position1868, tokenIndex1868
 						if buffer[position] != rune('R') {
-							goto l1165
+							goto l1863
 						}
 						position++
 					}
-				l1170:
+				l1868:
 					{
-						position1172, tokenIndex1172 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1173
+						position1870, tokenIndex1870 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1871
 						}
 						position++
-						goto l1172
-					l1173:
-						position, tokenIndex = position1172, tokenIndex1172
-						if buffer[position] != rune('D') {
-							goto l1165
+						goto l1870
+					l1871:
+						position, tokenIndex = position1870, tokenIndex1870
+						if buffer[position] != rune('U') {
+							goto l1863
 						}
 						position++
 					}
-				l1172:
+				l1870:
 					{
-						position1174, tokenIndex1174 := position, tokenIndex
+						position1872, tokenIndex1872 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1175
+							goto l1873
 						}
 						position++
-						goto l1174
-					l1175:
-						position, tokenIndex = position1174, tokenIndex1174
+						goto l1872
+					l1873:
+						position, tokenIndex = position1872, tokenIndex1872
 						if buffer[position] != rune('E') {
-							goto l1165
+							goto l1863
 						}
 						position++
 					}
-				l1174:
+				l1872:
+					add(rulePegText, position1865)
+				}
+				if !_rules[ruleAction122]() {
+					goto l1863
+				}
+				add(ruleTRUE, position1864)
+			}
+			return true
+		l1863:
+			position, tokenIndex = position1863, tokenIndex1863
+			return false
+		},
+		/* 158 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action123)> */
+		func() bool {
+			position1874, tokenIndex1874 := position, tokenIndex
+			{
+				position1875 := position
+				{
+					position1876 := position
 					{
-						position1176, tokenIndex1176 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1177
+						position1877, tokenIndex1877 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l1878
 						}
 						position++
-						goto l1176
-					l1177:
-						position, tokenIndex = position1176, tokenIndex1176
-						if buffer[position] != rune('R') {
-							goto l1165
+						goto l1877
+					l1878:
+						position, tokenIndex = position1877, tokenIndex1877
+						if buffer[position] != rune('F') {
+							goto l1874
 						}
 						position++
 					}
-				l1176:
-					if !_rules[rulesp]() {
-						goto l1165
-					}
+				l1877:
 					{
-						position1178, tokenIndex1178 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1179
+						position1879, tokenIndex1879 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1880
 						}
 						position++
-						goto l1178
-					l1179:
-						position, tokenIndex = position1178, tokenIndex1178
-						if buffer[position] != rune('B') {
-							goto l1165
+						goto l1879
+					l1880:
+						position, tokenIndex = position1879, tokenIndex1879
+						if buffer[position] != rune('A') {
+							goto l1874
 						}
 						position++
 					}
-				l1178:
+				l1879:
 					{
-						position1180, tokenIndex1180 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l1181
-						}
-						position++
-						goto l1180
-					l1181:
-						position, tokenIndex = position1180, tokenIndex1180
-						if buffer[position] != rune('Y') {
-							goto l1165
+						position1881, tokenIndex1881 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1882
 						}
 						position++
-					}
-				l1180:
-					if !_rules[rulesp]() {
-						goto l1165
-					}
-					if !_rules[ruleSortedExpression]() {
-						goto l1165
-					}
-				l1182:
-					{
-						position1183, tokenIndex1183 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1183
-						}
-						if buffer[position] != rune(',') {
-							goto l1183
+						goto l1881
+					l1882:
+						position, tokenIndex = position1881, tokenIndex1881
+						if buffer[position] != rune('L') {
+							goto l1874
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l1183
-						}
-						if !_rules[ruleSortedExpression]() {
-							goto l1183
-						}
-						goto l1182
-					l1183:
-						position, tokenIndex = position1183, tokenIndex1183
 					}
-					add(rulePegText, position1167)
-				}
-				if !_rules[ruleAction70]() {
-					goto l1165
-				}
-				add(ruleParamsOrder, position1166)
-			}
-			return true
-		l1165:
-			position, tokenIndex = position1165, tokenIndex1165
-			return false
-		},
-		/* 92 SortedExpression <- <(Expression OrderDirectionOpt Action71)> */
-		func() bool {
-			position1184, tokenIndex1184 := position, tokenIndex
-			{
-				position1185 := position
-				if !_rules[ruleExpression]() {
-					goto l1184
-				}
-				if !_rules[ruleOrderDirectionOpt]() {
-					goto l1184
-				}
-				if !_rules[ruleAction71]() {
-					goto l1184
-				}
-				add(ruleSortedExpression, position1185)
-			}
-			return true
-		l1184:
-			position, tokenIndex = position1184, tokenIndex1184
-			return false
-		},
-		/* 93 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action72)> */
-		func() bool {
-			position1186, tokenIndex1186 := position, tokenIndex
-			{
-				position1187 := position
-				{
-					position1188 := position
+				l1881:
 					{
-						position1189, tokenIndex1189 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1189
+						position1883, tokenIndex1883 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1884
 						}
-						{
-							position1191, tokenIndex1191 := position, tokenIndex
-							if !_rules[ruleAscending]() {
-								goto l1192
-							}
-							goto l1191
-						l1192:
-							position, tokenIndex = position1191, tokenIndex1191
-							if !_rules[ruleDescending]() {
-								goto l1189
-							}
+						position++
+						goto l1883
+					l1884:
+						position, tokenIndex = position1883, tokenIndex1883
+						if buffer[position] != rune('S') {
+							goto l1874
 						}
-					l1191:
-						goto l1190
-					l1189:
-						position, tokenIndex = position1189, tokenIndex1189
+						position++
 					}
-				l1190:
-					add(rulePegText, position1188)
+				l1883:
+					{
+						position1885, tokenIndex1885 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1886
+						}
+						position++
+						goto l1885
+					l1886:
+						position, tokenIndex = position1885, tokenIndex1885
+						if buffer[position] != rune('E') {
+							goto l1874
+						}
+						position++
+					}
+				l1885:
+					add(rulePegText, position1876)
 				}
-				if !_rules[ruleAction72]() {
-					goto l1186
+				if !_rules[ruleAction123]() {
+					goto l1874
 				}
-				add(ruleOrderDirectionOpt, position1187)
+				add(ruleFALSE, position1875)
 			}
 			return true
-		l1186:
-			position, tokenIndex = position1186, tokenIndex1186
+		l1874:
+			position, tokenIndex = position1874, tokenIndex1874
 			return false
 		},
-		/* 94 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action73)> */
+		/* 159 Wildcard <- <(<((ident ':' !':')? '*')> Action124)> */
 		func() bool {
-			position1193, tokenIndex1193 := position, tokenIndex
+			position1887, tokenIndex1887 := position, tokenIndex
 			{
-				position1194 := position
+				position1888 := position
 				{
-					position1195 := position
-					if buffer[position] != rune('[') {
-						goto l1193
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1193
-					}
+					position1889 := position
 					{
-						position1196, tokenIndex1196 := position, tokenIndex
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1196
+						position1890, tokenIndex1890 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l1890
+						}
+						if buffer[position] != rune(':') {
+							goto l1890
 						}
-					l1198:
+						position++
 						{
-							position1199, tokenIndex1199 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1199
-							}
-							if buffer[position] != rune(',') {
-								goto l1199
+							position1892, tokenIndex1892 := position, tokenIndex
+							if buffer[position] != rune(':') {
+								goto l1892
 							}
 							position++
-							if !_rules[rulespOpt]() {
-								goto l1199
-							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1199
-							}
-							goto l1198
-						l1199:
-							position, tokenIndex = position1199, tokenIndex1199
-						}
-						goto l1197
-					l1196:
-						position, tokenIndex = position1196, tokenIndex1196
-					}
-				l1197:
-					if !_rules[rulespOpt]() {
-						goto l1193
-					}
-					{
-						position1200, tokenIndex1200 := position, tokenIndex
-						if buffer[position] != rune(',') {
-							goto l1200
+							goto l1890
+						l1892:
+							position, tokenIndex = position1892, tokenIndex1892
 						}
-						position++
-						goto l1201
-					l1200:
-						position, tokenIndex = position1200, tokenIndex1200
+						goto l1891
+					l1890:
+						position, tokenIndex = position1890, tokenIndex1890
 					}
-				l1201:
-					if !_rules[rulespOpt]() {
-						goto l1193
-					}
-					if buffer[position] != rune(']') {
-						goto l1193
+				l1891:
+					if buffer[position] != rune('*') {
+						goto l1887
 					}
 					position++
-					add(rulePegText, position1195)
+					add(rulePegText, position1889)
 				}
-				if !_rules[ruleAction73]() {
-					goto l1193
+				if !_rules[ruleAction124]() {
+					goto l1887
 				}
-				add(ruleArrayExpr, position1194)
+				add(ruleWildcard, position1888)
 			}
 			return true
-		l1193:
-			position, tokenIndex = position1193, tokenIndex1193
+		l1887:
+			position, tokenIndex = position1887, tokenIndex1887
 			return false
 		},
-		/* 95 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action74)> */
+		/* 160 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action125)> */
 		func() bool {
-			position1202, tokenIndex1202 := position, tokenIndex
+			position1893, tokenIndex1893 := position, tokenIndex
 			{
-				position1203 := position
+				position1894 := position
 				{
-					position1204 := position
-					if buffer[position] != rune('{') {
-						goto l1202
+					position1895 := position
+					if buffer[position] != rune('"') {
+						goto l1893
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1202
-					}
+				l1896:
 					{
-						position1205, tokenIndex1205 := position, tokenIndex
-						if !_rules[ruleKeyValuePair]() {
-							goto l1205
-						}
-					l1207:
+						position1897, tokenIndex1897 := position, tokenIndex
 						{
-							position1208, tokenIndex1208 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1208
+							position1898, tokenIndex1898 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l1899
 							}
-							if buffer[position] != rune(',') {
-								goto l1208
+							position++
+							if buffer[position] != rune('"') {
+								goto l1899
 							}
 							position++
-							if !_rules[rulespOpt]() {
-								goto l1208
+							goto l1898
+						l1899:
+							position, tokenIndex = position1898, tokenIndex1898
+							{
+								position1900, tokenIndex1900 := position, tokenIndex
+								if buffer[position] != rune('"') {
+									goto l1900
+								}
+								position++
+								goto l1897
+							l1900:
+								position, tokenIndex = position1900, tokenIndex1900
 							}
-							if !_rules[ruleKeyValuePair]() {
-								goto l1208
+							if !matchDot() {
+								goto l1897
 							}
-							goto l1207
-						l1208:
-							position, tokenIndex = position1208, tokenIndex1208
 						}
-						goto l1206
-					l1205:
-						position, tokenIndex = position1205, tokenIndex1205
-					}
-				l1206:
-					if !_rules[rulespOpt]() {
-						goto l1202
+					l1898:
+						goto l1896
+					l1897:
+						position, tokenIndex = position1897, tokenIndex1897
 					}
-					if buffer[position] != rune('}') {
-						goto l1202
+					if buffer[position] != rune('"') {
+						goto l1893
 					}
 					position++
-					add(rulePegText, position1204)
+					add(rulePegText, position1895)
 				}
-				if !_rules[ruleAction74]() {
-					goto l1202
+				if !_rules[ruleAction125]() {
+					goto l1893
 				}
-				add(ruleMapExpr, position1203)
+				add(ruleStringLiteral, position1894)
 			}
 			return true
-		l1202:
-			position, tokenIndex = position1202, tokenIndex1202
+		l1893:
+			position, tokenIndex = position1893, tokenIndex1893
 			return false
 		},
-		/* 96 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action75)> */
+		/* 161 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action126)> */
 		func() bool {
-			position1209, tokenIndex1209 := position, tokenIndex
+			position1901, tokenIndex1901 := position, tokenIndex
 			{
-				position1210 := position
+				position1902 := position
 				{
-					position1211 := position
-					if !_rules[ruleStringLiteral]() {
-						goto l1209
+					position1903 := position
+					{
+						position1904, tokenIndex1904 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1905
+						}
+						position++
+						goto l1904
+					l1905:
+						position, tokenIndex = position1904, tokenIndex1904
+						if buffer[position] != rune('I') {
+							goto l1901
+						}
+						position++
 					}
-					if !_rules[rulespOpt]() {
-						goto l1209
+				l1904:
+					{
+						position1906, tokenIndex1906 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1907
+						}
+						position++
+						goto l1906
+					l1907:
+						position, tokenIndex = position1906, tokenIndex1906
+						if buffer[position] != rune('S') {
+							goto l1901
+						}
+						position++
 					}
-					if buffer[position] != rune(':') {
-						goto l1209
+				l1906:
+					{
+						position1908, tokenIndex1908 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1909
+						}
+						position++
+						goto l1908
+					l1909:
+						position, tokenIndex = position1908, tokenIndex1908
+						if buffer[position] != rune('T') {
+							goto l1901
+						}
+						position++
 					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1209
+				l1908:
+					{
+						position1910, tokenIndex1910 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1911
+						}
+						position++
+						goto l1910
+					l1911:
+						position, tokenIndex = position1910, tokenIndex1910
+						if buffer[position] != rune('R') {
+							goto l1901
+						}
+						position++
 					}
-					if !_rules[ruleExpressionOrWildcard]() {
-						goto l1209
+				l1910:
+					{
+						position1912, tokenIndex1912 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1913
+						}
+						position++
+						goto l1912
+					l1913:
+						position, tokenIndex = position1912, tokenIndex1912
+						if buffer[position] != rune('E') {
+							goto l1901
+						}
+						position++
 					}
-					add(rulePegText, position1211)
-				}
-				if !_rules[ruleAction75]() {
-					goto l1209
-				}
-				add(ruleKeyValuePair, position1210)
-			}
-			return true
-		l1209:
-			position, tokenIndex = position1209, tokenIndex1209
-			return false
-		},
-		/* 97 Case <- <(ConditionCase / ExpressionCase)> */
-		func() bool {
-			position1212, tokenIndex1212 := position, tokenIndex
-			{
-				position1213 := position
-				{
-					position1214, tokenIndex1214 := position, tokenIndex
-					if !_rules[ruleConditionCase]() {
-						goto l1215
+				l1912:
+					{
+						position1914, tokenIndex1914 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1915
+						}
+						position++
+						goto l1914
+					l1915:
+						position, tokenIndex = position1914, tokenIndex1914
+						if buffer[position] != rune('A') {
+							goto l1901
+						}
+						position++
 					}
-					goto l1214
-				l1215:
-					position, tokenIndex = position1214, tokenIndex1214
-					if !_rules[ruleExpressionCase]() {
-						goto l1212
+				l1914:
+					{
+						position1916, tokenIndex1916 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1917
+						}
+						position++
+						goto l1916
+					l1917:
+						position, tokenIndex = position1916, tokenIndex1916
+						if buffer[position] != rune('M') {
+							goto l1901
+						}
+						position++
 					}
+				l1916:
+					add(rulePegText, position1903)
+				}
+				if !_rules[ruleAction126]() {
+					goto l1901
 				}
-			l1214:
-				add(ruleCase, position1213)
+				add(ruleISTREAM, position1902)
 			}
 			return true
-		l1212:
-			position, tokenIndex = position1212, tokenIndex1212
+		l1901:
+			position, tokenIndex = position1901, tokenIndex1901
 			return false
 		},
-		/* 98 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action76)> */
+		/* 162 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action127)> */
 		func() bool {
-			position1216, tokenIndex1216 := position, tokenIndex
+			position1918, tokenIndex1918 := position, tokenIndex
 			{
-				position1217 := position
+				position1919 := position
 				{
-					position1218, tokenIndex1218 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l1219
+					position1920 := position
+					{
+						position1921, tokenIndex1921 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1922
+						}
+						position++
+						goto l1921
+					l1922:
+						position, tokenIndex = position1921, tokenIndex1921
+						if buffer[position] != rune('D') {
+							goto l1918
+						}
+						position++
 					}
-					position++
-					goto l1218
-				l1219:
-					position, tokenIndex = position1218, tokenIndex1218
-					if buffer[position] != rune('C') {
-						goto l1216
+				l1921:
+					{
+						position1923, tokenIndex1923 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1924
+						}
+						position++
+						goto l1923
+					l1924:
+						position, tokenIndex = position1923, tokenIndex1923
+						if buffer[position] != rune('S') {
+							goto l1918
+						}
+						position++
 					}
-					position++
-				}
-			l1218:
-				{
-					position1220, tokenIndex1220 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l1221
+				l1923:
+					{
+						position1925, tokenIndex1925 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1926
+						}
+						position++
+						goto l1925
+					l1926:
+						position, tokenIndex = position1925, tokenIndex1925
+						if buffer[position] != rune('T') {
+							goto l1918
+						}
+						position++
 					}
-					position++
-					goto l1220
-				l1221:
-					position, tokenIndex = position1220, tokenIndex1220
-					if buffer[position] != rune('A') {
-						goto l1216
+				l1925:
+					{
+						position1927, tokenIndex1927 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1928
+						}
+						position++
+						goto l1927
+					l1928:
+						position, tokenIndex = position1927, tokenIndex1927
+						if buffer[position] != rune('R') {
+							goto l1918
+						}
+						position++
 					}
-					position++
-				}
-			l1220:
-				{
-					position1222, tokenIndex1222 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l1223
+				l1927:
+					{
+						position1929, tokenIndex1929 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1930
+						}
+						position++
+						goto l1929
+					l1930:
+						position, tokenIndex = position1929, tokenIndex1929
+						if buffer[position] != rune('E') {
+							goto l1918
+						}
+						position++
 					}
-					position++
-					goto l1222
-				l1223:
-					position, tokenIndex = position1222, tokenIndex1222
-					if buffer[position] != rune('S') {
-						goto l1216
+				l1929:
+					{
+						position1931, tokenIndex1931 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1932
+						}
+						position++
+						goto l1931
+					l1932:
+						position, tokenIndex = position1931, tokenIndex1931
+						if buffer[position] != rune('A') {
+							goto l1918
+						}
+						position++
+					}
+				l1931:
+					{
+						position1933, tokenIndex1933 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1934
+						}
+						position++
+						goto l1933
+					l1934:
+						position, tokenIndex = position1933, tokenIndex1933
+						if buffer[position] != rune('M') {
+							goto l1918
+						}
+						position++
 					}
-					position++
+				l1933:
+					add(rulePegText, position1920)
 				}
-			l1222:
-				{
-					position1224, tokenIndex1224 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1225
-					}
-					position++
-					goto l1224
-				l1225:
-					position, tokenIndex = position1224, tokenIndex1224
-					if buffer[position] != rune('E') {
-						goto l1216
-					}
-					position++
+				if !_rules[ruleAction127]() {
+					goto l1918
 				}
-			l1224:
+				add(ruleDSTREAM, position1919)
+			}
+			return true
+		l1918:
+			position, tokenIndex = position1918, tokenIndex1918
+			return false
+		},
+		/* 163 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action128)> */
+		func() bool {
+			position1935, tokenIndex1935 := position, tokenIndex
+			{
+				position1936 := position
 				{
-					position1226 := position
-					if !_rules[rulesp]() {
-						goto l1216
-					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1216
-					}
-				l1227:
+					position1937 := position
 					{
-						position1228, tokenIndex1228 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1228
+						position1938, tokenIndex1938 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1939
 						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1228
+						position++
+						goto l1938
+					l1939:
+						position, tokenIndex = position1938, tokenIndex1938
+						if buffer[position] != rune('R') {
+							goto l1935
 						}
-						goto l1227
-					l1228:
-						position, tokenIndex = position1228, tokenIndex1228
+						position++
 					}
+				l1938:
 					{
-						position1229, tokenIndex1229 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1229
-						}
-						{
-							position1231, tokenIndex1231 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1232
-							}
-							position++
-							goto l1231
-						l1232:
-							position, tokenIndex = position1231, tokenIndex1231
-							if buffer[position] != rune('E') {
-								goto l1229
-							}
-							position++
+						position1940, tokenIndex1940 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1941
 						}
-					l1231:
-						{
-							position1233, tokenIndex1233 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l1234
-							}
-							position++
-							goto l1233
-						l1234:
-							position, tokenIndex = position1233, tokenIndex1233
-							if buffer[position] != rune('L') {
-								goto l1229
-							}
-							position++
+						position++
+						goto l1940
+					l1941:
+						position, tokenIndex = position1940, tokenIndex1940
+						if buffer[position] != rune('S') {
+							goto l1935
 						}
-					l1233:
-						{
-							position1235, tokenIndex1235 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1236
-							}
-							position++
-							goto l1235
-						l1236:
-							position, tokenIndex = position1235, tokenIndex1235
-							if buffer[position] != rune('S') {
-								goto l1229
-							}
-							position++
+						position++
+					}
+				l1940:
+					{
+						position1942, tokenIndex1942 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1943
 						}
-					l1235:
-						{
-							position1237, tokenIndex1237 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1238
-							}
-							position++
-							goto l1237
-						l1238:
-							position, tokenIndex = position1237, tokenIndex1237
-							if buffer[position] != rune('E') {
-								goto l1229
-							}
-							position++
+						position++
+						goto l1942
+					l1943:
+						position, tokenIndex = position1942, tokenIndex1942
+						if buffer[position] != rune('T') {
+							goto l1935
 						}
-					l1237:
-						if !_rules[rulesp]() {
-							goto l1229
+						position++
+					}
+				l1942:
+					{
+						position1944, tokenIndex1944 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1945
 						}
-						if !_rules[ruleExpression]() {
-							goto l1229
+						position++
+						goto l1944
+					l1945:
+						position, tokenIndex = position1944, tokenIndex1944
+						if buffer[position] != rune('R') {
+							goto l1935
 						}
-						goto l1230
-					l1229:
-						position, tokenIndex = position1229, tokenIndex1229
-					}
-				l1230:
-					if !_rules[rulesp]() {
-						goto l1216
+						position++
 					}
+				l1944:
 					{
-						position1239, tokenIndex1239 := position, tokenIndex
+						position1946, tokenIndex1946 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1240
+							goto l1947
 						}
 						position++
-						goto l1239
-					l1240:
-						position, tokenIndex = position1239, tokenIndex1239
+						goto l1946
+					l1947:
+						position, tokenIndex = position1946, tokenIndex1946
 						if buffer[position] != rune('E') {
-							goto l1216
+							goto l1935
 						}
 						position++
 					}
-				l1239:
+				l1946:
 					{
-						position1241, tokenIndex1241 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1242
+						position1948, tokenIndex1948 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1949
 						}
 						position++
-						goto l1241
-					l1242:
-						position, tokenIndex = position1241, tokenIndex1241
-						if buffer[position] != rune('N') {
-							goto l1216
+						goto l1948
+					l1949:
+						position, tokenIndex = position1948, tokenIndex1948
+						if buffer[position] != rune('A') {
+							goto l1935
 						}
 						position++
 					}
-				l1241:
+				l1948:
 					{
-						position1243, tokenIndex1243 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1244
+						position1950, tokenIndex1950 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1951
 						}
 						position++
-						goto l1243
-					l1244:
-						position, tokenIndex = position1243, tokenIndex1243
-						if buffer[position] != rune('D') {
-							goto l1216
+						goto l1950
+					l1951:
+						position, tokenIndex = position1950, tokenIndex1950
+						if buffer[position] != rune('M') {
+							goto l1935
 						}
 						position++
 					}
-				l1243:
-					add(rulePegText, position1226)
+				l1950:
+					add(rulePegText, position1937)
 				}
-				if !_rules[ruleAction76]() {
-					goto l1216
+				if !_rules[ruleAction128]() {
+					goto l1935
 				}
-				add(ruleConditionCase, position1217)
+				add(ruleRSTREAM, position1936)
 			}
 			return true
-		l1216:
-			position, tokenIndex = position1216, tokenIndex1216
+		l1935:
+			position, tokenIndex = position1935, tokenIndex1935
 			return false
 		},
-		/* 99 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action77)> */
+		/* 164 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action129)> */
 		func() bool {
-			position1245, tokenIndex1245 := position, tokenIndex
+			position1952, tokenIndex1952 := position, tokenIndex
 			{
-				position1246 := position
-				{
-					position1247, tokenIndex1247 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l1248
-					}
-					position++
-					goto l1247
-				l1248:
-					position, tokenIndex = position1247, tokenIndex1247
-					if buffer[position] != rune('C') {
-						goto l1245
-					}
-					position++
-				}
-			l1247:
+				position1953 := position
 				{
-					position1249, tokenIndex1249 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l1250
+					position1954 := position
+					{
+						position1955, tokenIndex1955 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1956
+						}
+						position++
+						goto l1955
+					l1956:
+						position, tokenIndex = position1955, tokenIndex1955
+						if buffer[position] != rune('T') {
+							goto l1952
+						}
+						position++
 					}
-					position++
-					goto l1249
-				l1250:
-					position, tokenIndex = position1249, tokenIndex1249
-					if buffer[position] != rune('A') {
-						goto l1245
+				l1955:
+					{
+						position1957, tokenIndex1957 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1958
+						}
+						position++
+						goto l1957
+					l1958:
+						position, tokenIndex = position1957, tokenIndex1957
+						if buffer[position] != rune('U') {
+							goto l1952
+						}
+						position++
 					}
-					position++
-				}
-			l1249:
-				{
-					position1251, tokenIndex1251 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l1252
+				l1957:
+					{
+						position1959, tokenIndex1959 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1960
+						}
+						position++
+						goto l1959
+					l1960:
+						position, tokenIndex = position1959, tokenIndex1959
+						if buffer[position] != rune('P') {
+							goto l1952
+						}
+						position++
 					}
-					position++
-					goto l1251
-				l1252:
-					position, tokenIndex = position1251, tokenIndex1251
-					if buffer[position] != rune('S') {
-						goto l1245
+				l1959:
+					{
+						position1961, tokenIndex1961 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1962
+						}
+						position++
+						goto l1961
+					l1962:
+						position, tokenIndex = position1961, tokenIndex1961
+						if buffer[position] != rune('L') {
+							goto l1952
+						}
+						position++
 					}
-					position++
-				}
-			l1251:
-				{
-					position1253, tokenIndex1253 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1254
+				l1961:
+					{
+						position1963, tokenIndex1963 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1964
+						}
+						position++
+						goto l1963
+					l1964:
+						position, tokenIndex = position1963, tokenIndex1963
+						if buffer[position] != rune('E') {
+							goto l1952
+						}
+						position++
 					}
-					position++
-					goto l1253
-				l1254:
-					position, tokenIndex = position1253, tokenIndex1253
-					if buffer[position] != rune('E') {
-						goto l1245
+				l1963:
+					{
+						position1965, tokenIndex1965 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1966
+						}
+						position++
+						goto l1965
+					l1966:
+						position, tokenIndex = position1965, tokenIndex1965
+						if buffer[position] != rune('S') {
+							goto l1952
+						}
+						position++
 					}
-					position++
-				}
-			l1253:
-				if !_rules[rulesp]() {
-					goto l1245
+				l1965:
+					add(rulePegText, position1954)
 				}
-				if !_rules[ruleExpression]() {
-					goto l1245
+				if !_rules[ruleAction129]() {
+					goto l1952
 				}
+				add(ruleTUPLES, position1953)
+			}
+			return true
+		l1952:
+			position, tokenIndex = position1952, tokenIndex1952
+			return false
+		},
+		/* 165 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action130)> */
+		func() bool {
+			position1967, tokenIndex1967 := position, tokenIndex
+			{
+				position1968 := position
 				{
-					position1255 := position
-					if !_rules[rulesp]() {
-						goto l1245
-					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1245
-					}
-				l1256:
+					position1969 := position
 					{
-						position1257, tokenIndex1257 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1257
+						position1970, tokenIndex1970 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1971
 						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1257
+						position++
+						goto l1970
+					l1971:
+						position, tokenIndex = position1970, tokenIndex1970
+						if buffer[position] != rune('S') {
+							goto l1967
 						}
-						goto l1256
-					l1257:
-						position, tokenIndex = position1257, tokenIndex1257
+						position++
 					}
+				l1970:
 					{
-						position1258, tokenIndex1258 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1258
-						}
-						{
-							position1260, tokenIndex1260 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1261
-							}
-							position++
-							goto l1260
-						l1261:
-							position, tokenIndex = position1260, tokenIndex1260
-							if buffer[position] != rune('E') {
-								goto l1258
-							}
-							position++
-						}
-					l1260:
-						{
-							position1262, tokenIndex1262 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l1263
-							}
-							position++
-							goto l1262
-						l1263:
-							position, tokenIndex = position1262, tokenIndex1262
-							if buffer[position] != rune('L') {
-								goto l1258
-							}
-							position++
-						}
-					l1262:
-						{
-							position1264, tokenIndex1264 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1265
-							}
-							position++
-							goto l1264
-						l1265:
-							position, tokenIndex = position1264, tokenIndex1264
-							if buffer[position] != rune('S') {
-								goto l1258
-							}
-							position++
-						}
-					l1264:
-						{
-							position1266, tokenIndex1266 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1267
-							}
-							position++
-							goto l1266
-						l1267:
-							position, tokenIndex = position1266, tokenIndex1266
-							if buffer[position] != rune('E') {
-								goto l1258
-							}
-							position++
-						}
-					l1266:
-						if !_rules[rulesp]() {
-							goto l1258
+						position1972, tokenIndex1972 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1973
 						}
-						if !_rules[ruleExpression]() {
-							goto l1258
+						position++
+						goto l1972
+					l1973:
+						position, tokenIndex = position1972, tokenIndex1972
+						if buffer[position] != rune('E') {
+							goto l1967
 						}
-						goto l1259
-					l1258:
-						position, tokenIndex = position1258, tokenIndex1258
+						position++
 					}
-				l1259:
-					if !_rules[rulesp]() {
-						goto l1245
+				l1972:
+					{
+						position1974, tokenIndex1974 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1975
+						}
+						position++
+						goto l1974
+					l1975:
+						position, tokenIndex = position1974, tokenIndex1974
+						if buffer[position] != rune('C') {
+							goto l1967
+						}
+						position++
 					}
+				l1974:
 					{
-						position1268, tokenIndex1268 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1269
+						position1976, tokenIndex1976 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1977
 						}
 						position++
-						goto l1268
-					l1269:
-						position, tokenIndex = position1268, tokenIndex1268
-						if buffer[position] != rune('E') {
-							goto l1245
+						goto l1976
+					l1977:
+						position, tokenIndex = position1976, tokenIndex1976
+						if buffer[position] != rune('O') {
+							goto l1967
 						}
 						position++
 					}
-				l1268:
+				l1976:
 					{
-						position1270, tokenIndex1270 := position, tokenIndex
+						position1978, tokenIndex1978 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1271
+							goto l1979
 						}
 						position++
-						goto l1270
-					l1271:
-						position, tokenIndex = position1270, tokenIndex1270
+						goto l1978
+					l1979:
+						position, tokenIndex = position1978, tokenIndex1978
 						if buffer[position] != rune('N') {
-							goto l1245
+							goto l1967
 						}
 						position++
 					}
-				l1270:
+				l1978:
 					{
-						position1272, tokenIndex1272 := position, tokenIndex
+						position1980, tokenIndex1980 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1273
+							goto l1981
 						}
 						position++
-						goto l1272
-					l1273:
-						position, tokenIndex = position1272, tokenIndex1272
+						goto l1980
+					l1981:
+						position, tokenIndex = position1980, tokenIndex1980
 						if buffer[position] != rune('D') {
-							goto l1245
+							goto l1967
+						}
+						position++
+					}
+				l1980:
+					{
+						position1982, tokenIndex1982 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1983
+						}
+						position++
+						goto l1982
+					l1983:
+						position, tokenIndex = position1982, tokenIndex1982
+						if buffer[position] != rune('S') {
+							goto l1967
 						}
 						position++
 					}
-				l1272:
-					add(rulePegText, position1255)
+				l1982:
+					add(rulePegText, position1969)
 				}
-				if !_rules[ruleAction77]() {
-					goto l1245
+				if !_rules[ruleAction130]() {
+					goto l1967
 				}
-				add(ruleExpressionCase, position1246)
+				add(ruleSECONDS, position1968)
 			}
 			return true
-		l1245:
-			position, tokenIndex = position1245, tokenIndex1245
+		l1967:
+			position, tokenIndex = position1967, tokenIndex1967
 			return false
 		},
-		/* 100 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action78)> */
+		/* 166 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action131)> */
 		func() bool {
-			position1274, tokenIndex1274 := position, tokenIndex
+			position1984, tokenIndex1984 := position, tokenIndex
 			{
-				position1275 := position
-				{
-					position1276, tokenIndex1276 := position, tokenIndex
-					if buffer[position] != rune('w') {
-						goto l1277
-					}
-					position++
-					goto l1276
-				l1277:
-					position, tokenIndex = position1276, tokenIndex1276
-					if buffer[position] != rune('W') {
-						goto l1274
-					}
-					position++
-				}
-			l1276:
-				{
-					position1278, tokenIndex1278 := position, tokenIndex
-					if buffer[position] != rune('h') {
-						goto l1279
-					}
-					position++
-					goto l1278
-				l1279:
-					position, tokenIndex = position1278, tokenIndex1278
-					if buffer[position] != rune('H') {
-						goto l1274
-					}
-					position++
-				}
-			l1278:
+				position1985 := position
 				{
-					position1280, tokenIndex1280 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1281
+					position1986 := position
+					{
+						position1987, tokenIndex1987 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1988
+						}
+						position++
+						goto l1987
+					l1988:
+						position, tokenIndex = position1987, tokenIndex1987
+						if buffer[position] != rune('M') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-					goto l1280
-				l1281:
-					position, tokenIndex = position1280, tokenIndex1280
-					if buffer[position] != rune('E') {
-						goto l1274
+				l1987:
+					{
+						position1989, tokenIndex1989 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1990
+						}
+						position++
+						goto l1989
+					l1990:
+						position, tokenIndex = position1989, tokenIndex1989
+						if buffer[position] != rune('I') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-				}
-			l1280:
-				{
-					position1282, tokenIndex1282 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l1283
+				l1989:
+					{
+						position1991, tokenIndex1991 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1992
+						}
+						position++
+						goto l1991
+					l1992:
+						position, tokenIndex = position1991, tokenIndex1991
+						if buffer[position] != rune('L') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-					goto l1282
-				l1283:
-					position, tokenIndex = position1282, tokenIndex1282
-					if buffer[position] != rune('N') {
-						goto l1274
+				l1991:
+					{
+						position1993, tokenIndex1993 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1994
+						}
+						position++
+						goto l1993
+					l1994:
+						position, tokenIndex = position1993, tokenIndex1993
+						if buffer[position] != rune('L') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-				}
-			l1282:
-				if !_rules[rulesp]() {
-					goto l1274
-				}
-				if !_rules[ruleExpression]() {
-					goto l1274
-				}
-				if !_rules[rulesp]() {
-					goto l1274
-				}
-				{
-					position1284, tokenIndex1284 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l1285
+				l1993:
+					{
+						position1995, tokenIndex1995 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1996
+						}
+						position++
+						goto l1995
+					l1996:
+						position, tokenIndex = position1995, tokenIndex1995
+						if buffer[position] != rune('I') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-					goto l1284
-				l1285:
-					position, tokenIndex = position1284, tokenIndex1284
-					if buffer[position] != rune('T') {
-						goto l1274
+				l1995:
+					{
+						position1997, tokenIndex1997 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1998
+						}
+						position++
+						goto l1997
+					l1998:
+						position, tokenIndex = position1997, tokenIndex1997
+						if buffer[position] != rune('S') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-				}
-			l1284:
-				{
-					position1286, tokenIndex1286 := position, tokenIndex
-					if buffer[position] != rune('h') {
-						goto l1287
+				l1997:
+					{
+						position1999, tokenIndex1999 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2000
+						}
+						position++
+						goto l1999
+					l2000:
+						position, tokenIndex = position1999, tokenIndex1999
+						if buffer[position] != rune('E') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-					goto l1286
-				l1287:
-					position, tokenIndex = position1286, tokenIndex1286
-					if buffer[position] != rune('H') {
-						goto l1274
+				l1999:
+					{
+						position2001, tokenIndex2001 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2002
+						}
+						position++
+						goto l2001
+					l2002:
+						position, tokenIndex = position2001, tokenIndex2001
+						if buffer[position] != rune('C') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-				}
-			l1286:
-				{
-					position1288, tokenIndex1288 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1289
+				l2001:
+					{
+						position2003, tokenIndex2003 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2004
+						}
+						position++
+						goto l2003
+					l2004:
+						position, tokenIndex = position2003, tokenIndex2003
+						if buffer[position] != rune('O') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-					goto l1288
-				l1289:
-					position, tokenIndex = position1288, tokenIndex1288
-					if buffer[position] != rune('E') {
-						goto l1274
+				l2003:
+					{
+						position2005, tokenIndex2005 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2006
+						}
+						position++
+						goto l2005
+					l2006:
+						position, tokenIndex = position2005, tokenIndex2005
+						if buffer[position] != rune('N') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-				}
-			l1288:
-				{
-					position1290, tokenIndex1290 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l1291
+				l2005:
+					{
+						position2007, tokenIndex2007 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2008
+						}
+						position++
+						goto l2007
+					l2008:
+						position, tokenIndex = position2007, tokenIndex2007
+						if buffer[position] != rune('D') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-					goto l1290
-				l1291:
-					position, tokenIndex = position1290, tokenIndex1290
-					if buffer[position] != rune('N') {
-						goto l1274
+				l2007:
+					{
+						position2009, tokenIndex2009 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2010
+						}
+						position++
+						goto l2009
+					l2010:
+						position, tokenIndex = position2009, tokenIndex2009
+						if buffer[position] != rune('S') {
+							goto l1984
+						}
+						position++
 					}
-					position++
-				}
-			l1290:
-				if !_rules[rulesp]() {
-					goto l1274
+				l2009:
+					add(rulePegText, position1986)
 				}
-				if !_rules[ruleExpressionOrWildcard]() {
-					goto l1274
-				}
-				if !_rules[ruleAction78]() {
-					goto l1274
-				}
-				add(ruleWhenThenPair, position1275)
-			}
-			return true
-		l1274:
-			position, tokenIndex = position1274, tokenIndex1274
-			return false
-		},
-		/* 101 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
-		func() bool {
-			position1292, tokenIndex1292 := position, tokenIndex
-			{
-				position1293 := position
-				{
-					position1294, tokenIndex1294 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l1295
-					}
-					goto l1294
-				l1295:
-					position, tokenIndex = position1294, tokenIndex1294
-					if !_rules[ruleNumericLiteral]() {
-						goto l1296
-					}
-					goto l1294
-				l1296:
-					position, tokenIndex = position1294, tokenIndex1294
-					if !_rules[ruleStringLiteral]() {
-						goto l1292
-					}
+				if !_rules[ruleAction131]() {
+					goto l1984
 				}
-			l1294:
-				add(ruleLiteral, position1293)
+				add(ruleMILLISECONDS, position1985)
 			}
 			return true
-		l1292:
-			position, tokenIndex = position1292, tokenIndex1292
+		l1984:
+			position, tokenIndex = position1984, tokenIndex1984
 			return false
 		},
-		/* 102 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
+		/* 167 SlidingWindow <- <(<(('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E'))> Action132)> */
 		func() bool {
-			position1297, tokenIndex1297 := position, tokenIndex
+			position2011, tokenIndex2011 := position, tokenIndex
 			{
-				position1298 := position
+				position2012 := position
 				{
-					position1299, tokenIndex1299 := position, tokenIndex
-					if !_rules[ruleEqual]() {
-						goto l1300
-					}
-					goto l1299
-				l1300:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleNotEqual]() {
-						goto l1301
-					}
-					goto l1299
-				l1301:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleLessOrEqual]() {
-						goto l1302
+					position2013 := position
+					{
+						position2014, tokenIndex2014 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2015
+						}
+						position++
+						goto l2014
+					l2015:
+						position, tokenIndex = position2014, tokenIndex2014
+						if buffer[position] != rune('R') {
+							goto l2011
+						}
+						position++
 					}
-					goto l1299
-				l1302:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleLess]() {
-						goto l1303
+				l2014:
+					{
+						position2016, tokenIndex2016 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2017
+						}
+						position++
+						goto l2016
+					l2017:
+						position, tokenIndex = position2016, tokenIndex2016
+						if buffer[position] != rune('A') {
+							goto l2011
+						}
+						position++
 					}
-					goto l1299
-				l1303:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleGreaterOrEqual]() {
-						goto l1304
+				l2016:
+					{
+						position2018, tokenIndex2018 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2019
+						}
+						position++
+						goto l2018
+					l2019:
+						position, tokenIndex = position2018, tokenIndex2018
+						if buffer[position] != rune('N') {
+							goto l2011
+						}
+						position++
 					}
-					goto l1299
-				l1304:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleGreater]() {
-						goto l1305
+				l2018:
+					{
+						position2020, tokenIndex2020 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2021
+						}
+						position++
+						goto l2020
+					l2021:
+						position, tokenIndex = position2020, tokenIndex2020
+						if buffer[position] != rune('G') {
+							goto l2011
+						}
+						position++
 					}
-					goto l1299
-				l1305:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleNotEqual]() {
-						goto l1297
+				l2020:
+					{
+						position2022, tokenIndex2022 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2023
+						}
+						position++
+						goto l2022
+					l2023:
+						position, tokenIndex = position2022, tokenIndex2022
+						if buffer[position] != rune('E') {
+							goto l2011
+						}
+						position++
 					}
+				l2022:
+					add(rulePegText, position2013)
 				}
-			l1299:
-				add(ruleComparisonOp, position1298)
-			}
-			return true
-		l1297:
-			position, tokenIndex = position1297, tokenIndex1297
-			return false
-		},
-		/* 103 OtherOp <- <Concat> */
-		func() bool {
-			position1306, tokenIndex1306 := position, tokenIndex
-			{
-				position1307 := position
-				if !_rules[ruleConcat]() {
-					goto l1306
+				if !_rules[ruleAction132]() {
+					goto l2011
 				}
-				add(ruleOtherOp, position1307)
+				add(ruleSlidingWindow, position2012)
 			}
 			return true
-		l1306:
-			position, tokenIndex = position1306, tokenIndex1306
+		l2011:
+			position, tokenIndex = position2011, tokenIndex2011
 			return false
 		},
-		/* 104 IsOp <- <(IsNot / Is)> */
+		/* 168 TumblingWindow <- <(<(('t' / 'T') ('u' / 'U') ('m' / 'M') ('b' / 'B') ('l' / 'L') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action133)> */
 		func() bool {
-			position1308, tokenIndex1308 := position, tokenIndex
+			position2024, tokenIndex2024 := position, tokenIndex
 			{
-				position1309 := position
+				position2025 := position
 				{
-					position1310, tokenIndex1310 := position, tokenIndex
-					if !_rules[ruleIsNot]() {
-						goto l1311
+					position2026 := position
+					{
+						position2027, tokenIndex2027 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2028
+						}
+						position++
+						goto l2027
+					l2028:
+						position, tokenIndex = position2027, tokenIndex2027
+						if buffer[position] != rune('T') {
+							goto l2024
+						}
+						position++
 					}
-					goto l1310
-				l1311:
-					position, tokenIndex = position1310, tokenIndex1310
-					if !_rules[ruleIs]() {
-						goto l1308
+				l2027:
+					{
+						position2029, tokenIndex2029 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2030
+						}
+						position++
+						goto l2029
+					l2030:
+						position, tokenIndex = position2029, tokenIndex2029
+						if buffer[position] != rune('U') {
+							goto l2024
+						}
+						position++
 					}
-				}
-			l1310:
-				add(ruleIsOp, position1309)
-			}
-			return true
-		l1308:
-			position, tokenIndex = position1308, tokenIndex1308
-			return false
-		},
-		/* 105 PlusMinusOp <- <(Plus / Minus)> */
-		func() bool {
-			position1312, tokenIndex1312 := position, tokenIndex
-			{
-				position1313 := position
-				{
-					position1314, tokenIndex1314 := position, tokenIndex
-					if !_rules[rulePlus]() {
-						goto l1315
+				l2029:
+					{
+						position2031, tokenIndex2031 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2032
+						}
+						position++
+						goto l2031
+					l2032:
+						position, tokenIndex = position2031, tokenIndex2031
+						if buffer[position] != rune('M') {
+							goto l2024
+						}
+						position++
 					}
-					goto l1314
-				l1315:
-					position, tokenIndex = position1314, tokenIndex1314
-					if !_rules[ruleMinus]() {
-						goto l1312
+				l2031:
+					{
+						position2033, tokenIndex2033 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2034
+						}
+						position++
+						goto l2033
+					l2034:
+						position, tokenIndex = position2033, tokenIndex2033
+						if buffer[position] != rune('B') {
+							goto l2024
+						}
+						position++
 					}
-				}
-			l1314:
-				add(rulePlusMinusOp, position1313)
-			}
-			return true
-		l1312:
-			position, tokenIndex = position1312, tokenIndex1312
-			return false
-		},
-		/* 106 MultDivOp <- <(Multiply / Divide / Modulo)> */
-		func() bool {
-			position1316, tokenIndex1316 := position, tokenIndex
-			{
-				position1317 := position
-				{
-					position1318, tokenIndex1318 := position, tokenIndex
-					if !_rules[ruleMultiply]() {
-						goto l1319
+				l2033:
+					{
+						position2035, tokenIndex2035 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2036
+						}
+						position++
+						goto l2035
+					l2036:
+						position, tokenIndex = position2035, tokenIndex2035
+						if buffer[position] != rune('L') {
+							goto l2024
+						}
+						position++
 					}
-					goto l1318
-				l1319:
-					position, tokenIndex = position1318, tokenIndex1318
-					if !_rules[ruleDivide]() {
-						goto l1320
+				l2035:
+					{
+						position2037, tokenIndex2037 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2038
+						}
+						position++
+						goto l2037
+					l2038:
+						position, tokenIndex = position2037, tokenIndex2037
+						if buffer[position] != rune('I') {
+							goto l2024
+						}
+						position++
 					}
-					goto l1318
-				l1320:
-					position, tokenIndex = position1318, tokenIndex1318
-					if !_rules[ruleModulo]() {
-						goto l1316
+				l2037:
+					{
+						position2039, tokenIndex2039 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2040
+						}
+						position++
+						goto l2039
+					l2040:
+						position, tokenIndex = position2039, tokenIndex2039
+						if buffer[position] != rune('N') {
+							goto l2024
+						}
+						position++
+					}
+				l2039:
+					{
+						position2041, tokenIndex2041 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2042
+						}
+						position++
+						goto l2041
+					l2042:
+						position, tokenIndex = position2041, tokenIndex2041
+						if buffer[position] != rune('G') {
+							goto l2024
+						}
+						position++
 					}
+				l2041:
+					add(rulePegText, position2026)
+				}
+				if !_rules[ruleAction133]() {
+					goto l2024
 				}
-			l1318:
-				add(ruleMultDivOp, position1317)
+				add(ruleTumblingWindow, position2025)
 			}
 			return true
-		l1316:
-			position, tokenIndex = position1316, tokenIndex1316
+		l2024:
+			position, tokenIndex = position2024, tokenIndex2024
 			return false
 		},
-		/* 107 Stream <- <(<ident> Action79)> */
+		/* 169 HoppingWindow <- <(<(('h' / 'H') ('o' / 'O') ('p' / 'P') ('p' / 'P') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action134)> */
 		func() bool {
-			position1321, tokenIndex1321 := position, tokenIndex
+			position2043, tokenIndex2043 := position, tokenIndex
 			{
-				position1322 := position
+				position2044 := position
 				{
-					position1323 := position
-					if !_rules[ruleident]() {
-						goto l1321
+					position2045 := position
+					{
+						position2046, tokenIndex2046 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l2047
+						}
+						position++
+						goto l2046
+					l2047:
+						position, tokenIndex = position2046, tokenIndex2046
+						if buffer[position] != rune('H') {
+							goto l2043
+						}
+						position++
 					}
-					add(rulePegText, position1323)
-				}
-				if !_rules[ruleAction79]() {
-					goto l1321
+				l2046:
+					{
+						position2048, tokenIndex2048 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2049
+						}
+						position++
+						goto l2048
+					l2049:
+						position, tokenIndex = position2048, tokenIndex2048
+						if buffer[position] != rune('O') {
+							goto l2043
+						}
+						position++
+					}
+				l2048:
+					{
+						position2050, tokenIndex2050 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2051
+						}
+						position++
+						goto l2050
+					l2051:
+						position, tokenIndex = position2050, tokenIndex2050
+						if buffer[position] != rune('P') {
+							goto l2043
+						}
+						position++
+					}
+				l2050:
+					{
+						position2052, tokenIndex2052 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2053
+						}
+						position++
+						goto l2052
+					l2053:
+						position, tokenIndex = position2052, tokenIndex2052
+						if buffer[position] != rune('P') {
+							goto l2043
+						}
+						position++
+					}
+				l2052:
+					{
+						position2054, tokenIndex2054 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2055
+						}
+						position++
+						goto l2054
+					l2055:
+						position, tokenIndex = position2054, tokenIndex2054
+						if buffer[position] != rune('I') {
+							goto l2043
+						}
+						position++
+					}
+				l2054:
+					{
+						position2056, tokenIndex2056 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2057
+						}
+						position++
+						goto l2056
+					l2057:
+						position, tokenIndex = position2056, tokenIndex2056
+						if buffer[position] != rune('N') {
+							goto l2043
+						}
+						position++
+					}
+				l2056:
+					{
+						position2058, tokenIndex2058 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2059
+						}
+						position++
+						goto l2058
+					l2059:
+						position, tokenIndex = position2058, tokenIndex2058
+						if buffer[position] != rune('G') {
+							goto l2043
+						}
+						position++
+					}
+				l2058:
+					add(rulePegText, position2045)
 				}
-				add(ruleStream, position1322)
-			}
-			return true
-		l1321:
-			position, tokenIndex = position1321, tokenIndex1321
-			return false
-		},
-		/* 108 RowMeta <- <RowTimestamp> */
-		func() bool {
-			position1324, tokenIndex1324 := position, tokenIndex
-			{
-				position1325 := position
-				if !_rules[ruleRowTimestamp]() {
-					goto l1324
+				if !_rules[ruleAction134]() {
+					goto l2043
 				}
-				add(ruleRowMeta, position1325)
+				add(ruleHoppingWindow, position2044)
 			}
 			return true
-		l1324:
-			position, tokenIndex = position1324, tokenIndex1324
+		l2043:
+			position, tokenIndex = position2043, tokenIndex2043
 			return false
 		},
-		/* 109 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action80)> */
+		/* 170 SessionWindow <- <(<(('s' / 'S') ('e' / 'E') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('o' / 'O') ('n' / 'N'))> Action135)> */
 		func() bool {
-			position1326, tokenIndex1326 := position, tokenIndex
+			position2060, tokenIndex2060 := position, tokenIndex
 			{
-				position1327 := position
+				position2061 := position
 				{
-					position1328 := position
+					position2062 := position
 					{
-						position1329, tokenIndex1329 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1329
+						position2063, tokenIndex2063 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2064
 						}
-						if buffer[position] != rune(':') {
-							goto l1329
+						position++
+						goto l2063
+					l2064:
+						position, tokenIndex = position2063, tokenIndex2063
+						if buffer[position] != rune('S') {
+							goto l2060
 						}
 						position++
-						goto l1330
-					l1329:
-						position, tokenIndex = position1329, tokenIndex1329
 					}
-				l1330:
-					if buffer[position] != rune('t') {
-						goto l1326
+				l2063:
+					{
+						position2065, tokenIndex2065 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2066
+						}
+						position++
+						goto l2065
+					l2066:
+						position, tokenIndex = position2065, tokenIndex2065
+						if buffer[position] != rune('E') {
+							goto l2060
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l1326
+				l2065:
+					{
+						position2067, tokenIndex2067 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2068
+						}
+						position++
+						goto l2067
+					l2068:
+						position, tokenIndex = position2067, tokenIndex2067
+						if buffer[position] != rune('S') {
+							goto l2060
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('(') {
-						goto l1326
+				l2067:
+					{
+						position2069, tokenIndex2069 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2070
+						}
+						position++
+						goto l2069
+					l2070:
+						position, tokenIndex = position2069, tokenIndex2069
+						if buffer[position] != rune('S') {
+							goto l2060
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune(')') {
-						goto l1326
+				l2069:
+					{
+						position2071, tokenIndex2071 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2072
+						}
+						position++
+						goto l2071
+					l2072:
+						position, tokenIndex = position2071, tokenIndex2071
+						if buffer[position] != rune('I') {
+							goto l2060
+						}
+						position++
 					}
-					position++
-					add(rulePegText, position1328)
+				l2071:
+					{
+						position2073, tokenIndex2073 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2074
+						}
+						position++
+						goto l2073
+					l2074:
+						position, tokenIndex = position2073, tokenIndex2073
+						if buffer[position] != rune('O') {
+							goto l2060
+						}
+						position++
+					}
+				l2073:
+					{
+						position2075, tokenIndex2075 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2076
+						}
+						position++
+						goto l2075
+					l2076:
+						position, tokenIndex = position2075, tokenIndex2075
+						if buffer[position] != rune('N') {
+							goto l2060
+						}
+						position++
+					}
+				l2075:
+					add(rulePegText, position2062)
 				}
-				if !_rules[ruleAction80]() {
-					goto l1326
+				if !_rules[ruleAction135]() {
+					goto l2060
 				}
-				add(ruleRowTimestamp, position1327)
+				add(ruleSessionWindow, position2061)
 			}
 			return true
-		l1326:
-			position, tokenIndex = position1326, tokenIndex1326
+		l2060:
+			position, tokenIndex = position2060, tokenIndex2060
 			return false
 		},
-		/* 110 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action81)> */
+		/* 171 InnerJoin <- <(<((('i' / 'I') ('n' / 'N') ('n' / 'N') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action136)> */
 		func() bool {
-			position1331, tokenIndex1331 := position, tokenIndex
+			position2077, tokenIndex2077 := position, tokenIndex
 			{
-				position1332 := position
+				position2078 := position
 				{
-					position1333 := position
+					position2079 := position
 					{
-						position1334, tokenIndex1334 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1334
+						position2080, tokenIndex2080 := position, tokenIndex
+						{
+							position2082, tokenIndex2082 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l2083
+							}
+							position++
+							goto l2082
+						l2083:
+							position, tokenIndex = position2082, tokenIndex2082
+							if buffer[position] != rune('I') {
+								goto l2080
+							}
+							position++
 						}
-						if buffer[position] != rune(':') {
-							goto l1334
+					l2082:
+						{
+							position2084, tokenIndex2084 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l2085
+							}
+							position++
+							goto l2084
+						l2085:
+							position, tokenIndex = position2084, tokenIndex2084
+							if buffer[position] != rune('N') {
+								goto l2080
+							}
+							position++
+						}
+					l2084:
+						{
+							position2086, tokenIndex2086 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l2087
+							}
+							position++
+							goto l2086
+						l2087:
+							position, tokenIndex = position2086, tokenIndex2086
+							if buffer[position] != rune('N') {
+								goto l2080
+							}
+							position++
+						}
+					l2086:
+						{
+							position2088, tokenIndex2088 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l2089
+							}
+							position++
+							goto l2088
+						l2089:
+							position, tokenIndex = position2088, tokenIndex2088
+							if buffer[position] != rune('E') {
+								goto l2080
+							}
+							position++
 						}
-						position++
+					l2088:
 						{
-							position1336, tokenIndex1336 := position, tokenIndex
-							if buffer[position] != rune(':') {
-								goto l1336
+							position2090, tokenIndex2090 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l2091
+							}
+							position++
+							goto l2090
+						l2091:
+							position, tokenIndex = position2090, tokenIndex2090
+							if buffer[position] != rune('R') {
+								goto l2080
 							}
 							position++
-							goto l1334
-						l1336:
-							position, tokenIndex = position1336, tokenIndex1336
 						}
-						goto l1335
-					l1334:
-						position, tokenIndex = position1334, tokenIndex1334
+					l2090:
+						if !_rules[rulesp]() {
+							goto l2080
+						}
+						goto l2081
+					l2080:
+						position, tokenIndex = position2080, tokenIndex2080
 					}
-				l1335:
-					if !_rules[rulejsonGetPath]() {
-						goto l1331
+				l2081:
+					{
+						position2092, tokenIndex2092 := position, tokenIndex
+						if buffer[position] != rune('j') {
+							goto l2093
+						}
+						position++
+						goto l2092
+					l2093:
+						position, tokenIndex = position2092, tokenIndex2092
+						if buffer[position] != rune('J') {
+							goto l2077
+						}
+						position++
 					}
-					add(rulePegText, position1333)
-				}
-				if !_rules[ruleAction81]() {
-					goto l1331
-				}
-				add(ruleRowValue, position1332)
-			}
-			return true
-		l1331:
-			position, tokenIndex = position1331, tokenIndex1331
-			return false
-		},
-		/* 111 NumericLiteral <- <(<('-'? [0-9]+)> Action82)> */
-		func() bool {
-			position1337, tokenIndex1337 := position, tokenIndex
-			{
-				position1338 := position
-				{
-					position1339 := position
+				l2092:
 					{
-						position1340, tokenIndex1340 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1340
+						position2094, tokenIndex2094 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2095
+						}
+						position++
+						goto l2094
+					l2095:
+						position, tokenIndex = position2094, tokenIndex2094
+						if buffer[position] != rune('O') {
+							goto l2077
 						}
 						position++
-						goto l1341
-					l1340:
-						position, tokenIndex = position1340, tokenIndex1340
 					}
-				l1341:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1337
+				l2094:
+					{
+						position2096, tokenIndex2096 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2097
+						}
+						position++
+						goto l2096
+					l2097:
+						position, tokenIndex = position2096, tokenIndex2096
+						if buffer[position] != rune('I') {
+							goto l2077
+						}
+						position++
 					}
-					position++
-				l1342:
+				l2096:
 					{
-						position1343, tokenIndex1343 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1343
+						position2098, tokenIndex2098 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2099
+						}
+						position++
+						goto l2098
+					l2099:
+						position, tokenIndex = position2098, tokenIndex2098
+						if buffer[position] != rune('N') {
+							goto l2077
 						}
 						position++
-						goto l1342
-					l1343:
-						position, tokenIndex = position1343, tokenIndex1343
 					}
-					add(rulePegText, position1339)
+				l2098:
+					add(rulePegText, position2079)
 				}
-				if !_rules[ruleAction82]() {
-					goto l1337
+				if !_rules[ruleAction136]() {
+					goto l2077
 				}
-				add(ruleNumericLiteral, position1338)
+				add(ruleInnerJoin, position2078)
 			}
 			return true
-		l1337:
-			position, tokenIndex = position1337, tokenIndex1337
+		l2077:
+			position, tokenIndex = position2077, tokenIndex2077
 			return false
 		},
-		/* 112 NonNegativeNumericLiteral <- <(<[0-9]+> Action83)> */
+		/* 172 LeftOuterJoin <- <(<(('l' / 'L') ('e' / 'E') ('f' / 'F') ('t' / 'T') sp (('o' / 'O') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action137)> */
 		func() bool {
-			position1344, tokenIndex1344 := position, tokenIndex
+			position2100, tokenIndex2100 := position, tokenIndex
 			{
-				position1345 := position
+				position2101 := position
 				{
-					position1346 := position
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1344
-					}
-					position++
-				l1347:
+					position2102 := position
 					{
-						position1348, tokenIndex1348 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1348
+						position2103, tokenIndex2103 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2104
+						}
+						position++
+						goto l2103
+					l2104:
+						position, tokenIndex = position2103, tokenIndex2103
+						if buffer[position] != rune('L') {
+							goto l2100
 						}
 						position++
-						goto l1347
-					l1348:
-						position, tokenIndex = position1348, tokenIndex1348
 					}
-					add(rulePegText, position1346)
-				}
-				if !_rules[ruleAction83]() {
-					goto l1344
-				}
-				add(ruleNonNegativeNumericLiteral, position1345)
-			}
-			return true
-		l1344:
-			position, tokenIndex = position1344, tokenIndex1344
-			return false
-		},
-		/* 113 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action84)> */
-		func() bool {
-			position1349, tokenIndex1349 := position, tokenIndex
-			{
-				position1350 := position
-				{
-					position1351 := position
+				l2103:
 					{
-						position1352, tokenIndex1352 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1352
+						position2105, tokenIndex2105 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2106
+						}
+						position++
+						goto l2105
+					l2106:
+						position, tokenIndex = position2105, tokenIndex2105
+						if buffer[position] != rune('E') {
+							goto l2100
 						}
 						position++
-						goto l1353
-					l1352:
-						position, tokenIndex = position1352, tokenIndex1352
 					}
-				l1353:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1349
+				l2105:
+					{
+						position2107, tokenIndex2107 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2108
+						}
+						position++
+						goto l2107
+					l2108:
+						position, tokenIndex = position2107, tokenIndex2107
+						if buffer[position] != rune('F') {
+							goto l2100
+						}
+						position++
 					}
-					position++
-				l1354:
+				l2107:
 					{
-						position1355, tokenIndex1355 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1355
+						position2109, tokenIndex2109 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2110
+						}
+						position++
+						goto l2109
+					l2110:
+						position, tokenIndex = position2109, tokenIndex2109
+						if buffer[position] != rune('T') {
+							goto l2100
 						}
 						position++
-						goto l1354
-					l1355:
-						position, tokenIndex = position1355, tokenIndex1355
 					}
-					if buffer[position] != rune('.') {
-						goto l1349
+				l2109:
+					if !_rules[rulesp]() {
+						goto l2100
 					}
-					position++
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1349
+					{
+						position2111, tokenIndex2111 := position, tokenIndex
+						{
+							position2113, tokenIndex2113 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l2114
+							}
+							position++
+							goto l2113
+						l2114:
+							position, tokenIndex = position2113, tokenIndex2113
+							if buffer[position] != rune('O') {
+								goto l2111
+							}
+							position++
+						}
+					l2113:
+						{
+							position2115, tokenIndex2115 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l2116
+							}
+							position++
+							goto l2115
+						l2116:
+							position, tokenIndex = position2115, tokenIndex2115
+							if buffer[position] != rune('U') {
+								goto l2111
+							}
+							position++
+						}
+					l2115:
+						{
+							position2117, tokenIndex2117 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l2118
+							}
+							position++
+							goto l2117
+						l2118:
+							position, tokenIndex = position2117, tokenIndex2117
+							if buffer[position] != rune('T') {
+								goto l2111
+							}
+							position++
+						}
+					l2117:
+						{
+							position2119, tokenIndex2119 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l2120
+							}
+							position++
+							goto l2119
+						l2120:
+							position, tokenIndex = position2119, tokenIndex2119
+							if buffer[position] != rune('E') {
+								goto l2111
+							}
+							position++
+						}
+					l2119:
+						{
+							position2121, tokenIndex2121 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l2122
+							}
+							position++
+							goto l2121
+						l2122:
+							position, tokenIndex = position2121, tokenIndex2121
+							if buffer[position] != rune('R') {
+								goto l2111
+							}
+							position++
+						}
+					l2121:
+						if !_rules[rulesp]() {
+							goto l2111
+						}
+						goto l2112
+					l2111:
+						position, tokenIndex = position2111, tokenIndex2111
 					}
-					position++
-				l1356:
+				l2112:
 					{
-						position1357, tokenIndex1357 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1357
+						position2123, tokenIndex2123 := position, tokenIndex
+						if buffer[position] != rune('j') {
+							goto l2124
+						}
+						position++
+						goto l2123
+					l2124:
+						position, tokenIndex = position2123, tokenIndex2123
+						if buffer[position] != rune('J') {
+							goto l2100
 						}
 						position++
-						goto l1356
-					l1357:
-						position, tokenIndex = position1357, tokenIndex1357
 					}
-					add(rulePegText, position1351)
-				}
-				if !_rules[ruleAction84]() {
-					goto l1349
-				}
-				add(ruleFloatLiteral, position1350)
-			}
-			return true
-		l1349:
-			position, tokenIndex = position1349, tokenIndex1349
-			return false
-		},
-		/* 114 Function <- <(<ident> Action85)> */
-		func() bool {
-			position1358, tokenIndex1358 := position, tokenIndex
-			{
-				position1359 := position
-				{
-					position1360 := position
-					if !_rules[ruleident]() {
-						goto l1358
+				l2123:
+					{
+						position2125, tokenIndex2125 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2126
+						}
+						position++
+						goto l2125
+					l2126:
+						position, tokenIndex = position2125, tokenIndex2125
+						if buffer[position] != rune('O') {
+							goto l2100
+						}
+						position++
+					}
+				l2125:
+					{
+						position2127, tokenIndex2127 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2128
+						}
+						position++
+						goto l2127
+					l2128:
+						position, tokenIndex = position2127, tokenIndex2127
+						if buffer[position] != rune('I') {
+							goto l2100
+						}
+						position++
+					}
+				l2127:
+					{
+						position2129, tokenIndex2129 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2130
+						}
+						position++
+						goto l2129
+					l2130:
+						position, tokenIndex = position2129, tokenIndex2129
+						if buffer[position] != rune('N') {
+							goto l2100
+						}
+						position++
 					}
-					add(rulePegText, position1360)
+				l2129:
+					add(rulePegText, position2102)
 				}
-				if !_rules[ruleAction85]() {
-					goto l1358
+				if !_rules[ruleAction137]() {
+					goto l2100
 				}
-				add(ruleFunction, position1359)
+				add(ruleLeftOuterJoin, position2101)
 			}
 			return true
-		l1358:
-			position, tokenIndex = position1358, tokenIndex1358
+		l2100:
+			position, tokenIndex = position2100, tokenIndex2100
 			return false
 		},
-		/* 115 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action86)> */
+		/* 173 FullOuterJoin <- <(<(('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L') sp (('o' / 'O') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action138)> */
 		func() bool {
-			position1361, tokenIndex1361 := position, tokenIndex
+			position2131, tokenIndex2131 := position, tokenIndex
 			{
-				position1362 := position
+				position2132 := position
 				{
-					position1363 := position
+					position2133 := position
 					{
-						position1364, tokenIndex1364 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1365
+						position2134, tokenIndex2134 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2135
 						}
 						position++
-						goto l1364
-					l1365:
-						position, tokenIndex = position1364, tokenIndex1364
-						if buffer[position] != rune('N') {
-							goto l1361
+						goto l2134
+					l2135:
+						position, tokenIndex = position2134, tokenIndex2134
+						if buffer[position] != rune('F') {
+							goto l2131
 						}
 						position++
 					}
-				l1364:
+				l2134:
 					{
-						position1366, tokenIndex1366 := position, tokenIndex
+						position2136, tokenIndex2136 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l1367
+							goto l2137
 						}
 						position++
-						goto l1366
-					l1367:
-						position, tokenIndex = position1366, tokenIndex1366
+						goto l2136
+					l2137:
+						position, tokenIndex = position2136, tokenIndex2136
 						if buffer[position] != rune('U') {
-							goto l1361
+							goto l2131
 						}
 						position++
 					}
-				l1366:
+				l2136:
 					{
-						position1368, tokenIndex1368 := position, tokenIndex
+						position2138, tokenIndex2138 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1369
+							goto l2139
 						}
 						position++
-						goto l1368
-					l1369:
-						position, tokenIndex = position1368, tokenIndex1368
+						goto l2138
+					l2139:
+						position, tokenIndex = position2138, tokenIndex2138
 						if buffer[position] != rune('L') {
-							goto l1361
+							goto l2131
 						}
 						position++
 					}
-				l1368:
+				l2138:
 					{
-						position1370, tokenIndex1370 := position, tokenIndex
+						position2140, tokenIndex2140 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1371
+							goto l2141
 						}
 						position++
-						goto l1370
-					l1371:
-						position, tokenIndex = position1370, tokenIndex1370
+						goto l2140
+					l2141:
+						position, tokenIndex = position2140, tokenIndex2140
 						if buffer[position] != rune('L') {
-							goto l1361
+							goto l2131
 						}
 						position++
 					}
-				l1370:
-					add(rulePegText, position1363)
-				}
-				if !_rules[ruleAction86]() {
-					goto l1361
-				}
-				add(ruleNullLiteral, position1362)
-			}
-			return true
-		l1361:
-			position, tokenIndex = position1361, tokenIndex1361
-			return false
-		},
-		/* 116 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action87)> */
-		func() bool {
-			position1372, tokenIndex1372 := position, tokenIndex
-			{
-				position1373 := position
-				{
-					position1374 := position
+				l2140:
+					if !_rules[rulesp]() {
+						goto l2131
+					}
 					{
-						position1375, tokenIndex1375 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1376
+						position2142, tokenIndex2142 := position, tokenIndex
+						{
+							position2144, tokenIndex2144 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l2145
+							}
+							position++
+							goto l2144
+						l2145:
+							position, tokenIndex = position2144, tokenIndex2144
+							if buffer[position] != rune('O') {
+								goto l2142
+							}
+							position++
 						}
-						position++
-						goto l1375
-					l1376:
-						position, tokenIndex = position1375, tokenIndex1375
-						if buffer[position] != rune('M') {
-							goto l1372
+					l2144:
+						{
+							position2146, tokenIndex2146 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l2147
+							}
+							position++
+							goto l2146
+						l2147:
+							position, tokenIndex = position2146, tokenIndex2146
+							if buffer[position] != rune('U') {
+								goto l2142
+							}
+							position++
 						}
-						position++
-					}
-				l1375:
-					{
-						position1377, tokenIndex1377 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1378
+					l2146:
+						{
+							position2148, tokenIndex2148 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l2149
+							}
+							position++
+							goto l2148
+						l2149:
+							position, tokenIndex = position2148, tokenIndex2148
+							if buffer[position] != rune('T') {
+								goto l2142
+							}
+							position++
 						}
-						position++
-						goto l1377
-					l1378:
-						position, tokenIndex = position1377, tokenIndex1377
-						if buffer[position] != rune('I') {
-							goto l1372
+					l2148:
+						{
+							position2150, tokenIndex2150 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l2151
+							}
+							position++
+							goto l2150
+						l2151:
+							position, tokenIndex = position2150, tokenIndex2150
+							if buffer[position] != rune('E') {
+								goto l2142
+							}
+							position++
 						}
-						position++
+					l2150:
+						{
+							position2152, tokenIndex2152 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l2153
+							}
+							position++
+							goto l2152
+						l2153:
+							position, tokenIndex = position2152, tokenIndex2152
+							if buffer[position] != rune('R') {
+								goto l2142
+							}
+							position++
+						}
+					l2152:
+						if !_rules[rulesp]() {
+							goto l2142
+						}
+						goto l2143
+					l2142:
+						position, tokenIndex = position2142, tokenIndex2142
 					}
-				l1377:
+				l2143:
 					{
-						position1379, tokenIndex1379 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1380
+						position2154, tokenIndex2154 := position, tokenIndex
+						if buffer[position] != rune('j') {
+							goto l2155
 						}
-						position++
-						goto l1379
-					l1380:
-						position, tokenIndex = position1379, tokenIndex1379
-						if buffer[position] != rune('S') {
-							goto l1372
+						position++
+						goto l2154
+					l2155:
+						position, tokenIndex = position2154, tokenIndex2154
+						if buffer[position] != rune('J') {
+							goto l2131
 						}
 						position++
 					}
-				l1379:
+				l2154:
 					{
-						position1381, tokenIndex1381 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1382
+						position2156, tokenIndex2156 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2157
 						}
 						position++
-						goto l1381
-					l1382:
-						position, tokenIndex = position1381, tokenIndex1381
-						if buffer[position] != rune('S') {
-							goto l1372
+						goto l2156
+					l2157:
+						position, tokenIndex = position2156, tokenIndex2156
+						if buffer[position] != rune('O') {
+							goto l2131
 						}
 						position++
 					}
-				l1381:
+				l2156:
 					{
-						position1383, tokenIndex1383 := position, tokenIndex
+						position2158, tokenIndex2158 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1384
+							goto l2159
 						}
 						position++
-						goto l1383
-					l1384:
-						position, tokenIndex = position1383, tokenIndex1383
+						goto l2158
+					l2159:
+						position, tokenIndex = position2158, tokenIndex2158
 						if buffer[position] != rune('I') {
-							goto l1372
+							goto l2131
 						}
 						position++
 					}
-				l1383:
+				l2158:
 					{
-						position1385, tokenIndex1385 := position, tokenIndex
+						position2160, tokenIndex2160 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1386
+							goto l2161
 						}
 						position++
-						goto l1385
-					l1386:
-						position, tokenIndex = position1385, tokenIndex1385
+						goto l2160
+					l2161:
+						position, tokenIndex = position2160, tokenIndex2160
 						if buffer[position] != rune('N') {
-							goto l1372
-						}
-						position++
-					}
-				l1385:
-					{
-						position1387, tokenIndex1387 := position, tokenIndex
-						if buffer[position] != rune('g') {
-							goto l1388
-						}
-						position++
-						goto l1387
-					l1388:
-						position, tokenIndex = position1387, tokenIndex1387
-						if buffer[position] != rune('G') {
-							goto l1372
+							goto l2131
 						}
 						position++
 					}
-				l1387:
-					add(rulePegText, position1374)
-				}
-				if !_rules[ruleAction87]() {
-					goto l1372
+				l2160:
+					add(rulePegText, position2133)
 				}
-				add(ruleMissing, position1373)
-			}
-			return true
-		l1372:
-			position, tokenIndex = position1372, tokenIndex1372
-			return false
-		},
-		/* 117 BooleanLiteral <- <(TRUE / FALSE)> */
-		func() bool {
-			position1389, tokenIndex1389 := position, tokenIndex
-			{
-				position1390 := position
-				{
-					position1391, tokenIndex1391 := position, tokenIndex
-					if !_rules[ruleTRUE]() {
-						goto l1392
-					}
-					goto l1391
-				l1392:
-					position, tokenIndex = position1391, tokenIndex1391
-					if !_rules[ruleFALSE]() {
-						goto l1389
-					}
+				if !_rules[ruleAction138]() {
+					goto l2131
 				}
-			l1391:
-				add(ruleBooleanLiteral, position1390)
+				add(ruleFullOuterJoin, position2132)
 			}
 			return true
-		l1389:
-			position, tokenIndex = position1389, tokenIndex1389
+		l2131:
+			position, tokenIndex = position2131, tokenIndex2131
 			return false
 		},
-		/* 118 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action88)> */
+		/* 174 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action139)> */
 		func() bool {
-			position1393, tokenIndex1393 := position, tokenIndex
+			position2162, tokenIndex2162 := position, tokenIndex
 			{
-				position1394 := position
+				position2163 := position
 				{
-					position1395 := position
+					position2164 := position
 					{
-						position1396, tokenIndex1396 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1397
+						position2165, tokenIndex2165 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2166
 						}
 						position++
-						goto l1396
-					l1397:
-						position, tokenIndex = position1396, tokenIndex1396
-						if buffer[position] != rune('T') {
-							goto l1393
+						goto l2165
+					l2166:
+						position, tokenIndex = position2165, tokenIndex2165
+						if buffer[position] != rune('W') {
+							goto l2162
 						}
 						position++
 					}
-				l1396:
+				l2165:
 					{
-						position1398, tokenIndex1398 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1399
+						position2167, tokenIndex2167 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2168
 						}
 						position++
-						goto l1398
-					l1399:
-						position, tokenIndex = position1398, tokenIndex1398
-						if buffer[position] != rune('R') {
-							goto l1393
+						goto l2167
+					l2168:
+						position, tokenIndex = position2167, tokenIndex2167
+						if buffer[position] != rune('A') {
+							goto l2162
 						}
 						position++
 					}
-				l1398:
+				l2167:
 					{
-						position1400, tokenIndex1400 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1401
+						position2169, tokenIndex2169 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2170
 						}
 						position++
-						goto l1400
-					l1401:
-						position, tokenIndex = position1400, tokenIndex1400
-						if buffer[position] != rune('U') {
-							goto l1393
+						goto l2169
+					l2170:
+						position, tokenIndex = position2169, tokenIndex2169
+						if buffer[position] != rune('I') {
+							goto l2162
 						}
 						position++
 					}
-				l1400:
+				l2169:
 					{
-						position1402, tokenIndex1402 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1403
+						position2171, tokenIndex2171 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2172
 						}
 						position++
-						goto l1402
-					l1403:
-						position, tokenIndex = position1402, tokenIndex1402
-						if buffer[position] != rune('E') {
-							goto l1393
+						goto l2171
+					l2172:
+						position, tokenIndex = position2171, tokenIndex2171
+						if buffer[position] != rune('T') {
+							goto l2162
 						}
 						position++
 					}
-				l1402:
-					add(rulePegText, position1395)
+				l2171:
+					add(rulePegText, position2164)
 				}
-				if !_rules[ruleAction88]() {
-					goto l1393
+				if !_rules[ruleAction139]() {
+					goto l2162
 				}
-				add(ruleTRUE, position1394)
+				add(ruleWait, position2163)
 			}
 			return true
-		l1393:
-			position, tokenIndex = position1393, tokenIndex1393
+		l2162:
+			position, tokenIndex = position2162, tokenIndex2162
 			return false
 		},
-		/* 119 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action89)> */
+		/* 175 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action140)> */
 		func() bool {
-			position1404, tokenIndex1404 := position, tokenIndex
+			position2173, tokenIndex2173 := position, tokenIndex
 			{
-				position1405 := position
+				position2174 := position
 				{
-					position1406 := position
+					position2175 := position
 					{
-						position1407, tokenIndex1407 := position, tokenIndex
-						if buffer[position] != rune('f') {
-							goto l1408
+						position2176, tokenIndex2176 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2177
 						}
 						position++
-						goto l1407
-					l1408:
-						position, tokenIndex = position1407, tokenIndex1407
-						if buffer[position] != rune('F') {
-							goto l1404
+						goto l2176
+					l2177:
+						position, tokenIndex = position2176, tokenIndex2176
+						if buffer[position] != rune('D') {
+							goto l2173
 						}
 						position++
 					}
-				l1407:
+				l2176:
 					{
-						position1409, tokenIndex1409 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1410
+						position2178, tokenIndex2178 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2179
 						}
 						position++
-						goto l1409
-					l1410:
-						position, tokenIndex = position1409, tokenIndex1409
-						if buffer[position] != rune('A') {
-							goto l1404
+						goto l2178
+					l2179:
+						position, tokenIndex = position2178, tokenIndex2178
+						if buffer[position] != rune('R') {
+							goto l2173
 						}
 						position++
 					}
-				l1409:
+				l2178:
 					{
-						position1411, tokenIndex1411 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1412
+						position2180, tokenIndex2180 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2181
 						}
 						position++
-						goto l1411
-					l1412:
-						position, tokenIndex = position1411, tokenIndex1411
-						if buffer[position] != rune('L') {
-							goto l1404
+						goto l2180
+					l2181:
+						position, tokenIndex = position2180, tokenIndex2180
+						if buffer[position] != rune('O') {
+							goto l2173
 						}
 						position++
 					}
-				l1411:
+				l2180:
 					{
-						position1413, tokenIndex1413 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1414
+						position2182, tokenIndex2182 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2183
 						}
 						position++
-						goto l1413
-					l1414:
-						position, tokenIndex = position1413, tokenIndex1413
-						if buffer[position] != rune('S') {
-							goto l1404
+						goto l2182
+					l2183:
+						position, tokenIndex = position2182, tokenIndex2182
+						if buffer[position] != rune('P') {
+							goto l2173
 						}
 						position++
 					}
-				l1413:
+				l2182:
+					if !_rules[rulesp]() {
+						goto l2173
+					}
 					{
-						position1415, tokenIndex1415 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1416
+						position2184, tokenIndex2184 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2185
 						}
 						position++
-						goto l1415
-					l1416:
-						position, tokenIndex = position1415, tokenIndex1415
-						if buffer[position] != rune('E') {
-							goto l1404
+						goto l2184
+					l2185:
+						position, tokenIndex = position2184, tokenIndex2184
+						if buffer[position] != rune('O') {
+							goto l2173
 						}
 						position++
 					}
-				l1415:
-					add(rulePegText, position1406)
-				}
-				if !_rules[ruleAction89]() {
-					goto l1404
-				}
-				add(ruleFALSE, position1405)
-			}
-			return true
-		l1404:
-			position, tokenIndex = position1404, tokenIndex1404
-			return false
-		},
-		/* 120 Wildcard <- <(<((ident ':' !':')? '*')> Action90)> */
-		func() bool {
-			position1417, tokenIndex1417 := position, tokenIndex
-			{
-				position1418 := position
-				{
-					position1419 := position
+				l2184:
 					{
-						position1420, tokenIndex1420 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1420
-						}
-						if buffer[position] != rune(':') {
-							goto l1420
+						position2186, tokenIndex2186 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2187
 						}
 						position++
-						{
-							position1422, tokenIndex1422 := position, tokenIndex
-							if buffer[position] != rune(':') {
-								goto l1422
-							}
-							position++
-							goto l1420
-						l1422:
-							position, tokenIndex = position1422, tokenIndex1422
+						goto l2186
+					l2187:
+						position, tokenIndex = position2186, tokenIndex2186
+						if buffer[position] != rune('L') {
+							goto l2173
 						}
-						goto l1421
-					l1420:
-						position, tokenIndex = position1420, tokenIndex1420
-					}
-				l1421:
-					if buffer[position] != rune('*') {
-						goto l1417
-					}
-					position++
-					add(rulePegText, position1419)
-				}
-				if !_rules[ruleAction90]() {
-					goto l1417
-				}
-				add(ruleWildcard, position1418)
-			}
-			return true
-		l1417:
-			position, tokenIndex = position1417, tokenIndex1417
-			return false
-		},
-		/* 121 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action91)> */
-		func() bool {
-			position1423, tokenIndex1423 := position, tokenIndex
-			{
-				position1424 := position
-				{
-					position1425 := position
-					if buffer[position] != rune('"') {
-						goto l1423
+						position++
 					}
-					position++
-				l1426:
+				l2186:
 					{
-						position1427, tokenIndex1427 := position, tokenIndex
-						{
-							position1428, tokenIndex1428 := position, tokenIndex
-							if buffer[position] != rune('"') {
-								goto l1429
-							}
-							position++
-							if buffer[position] != rune('"') {
-								goto l1429
-							}
-							position++
-							goto l1428
-						l1429:
-							position, tokenIndex = position1428, tokenIndex1428
-							{
-								position1430, tokenIndex1430 := position, tokenIndex
-								if buffer[position] != rune('"') {
-									goto l1430
-								}
-								position++
-								goto l1427
-							l1430:
-								position, tokenIndex = position1430, tokenIndex1430
-							}
-							if !matchDot() {
-								goto l1427
-							}
+						position2188, tokenIndex2188 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2189
 						}
-					l1428:
-						goto l1426
-					l1427:
-						position, tokenIndex = position1427, tokenIndex1427
-					}
-					if buffer[position] != rune('"') {
-						goto l1423
+						position++
+						goto l2188
+					l2189:
+						position, tokenIndex = position2188, tokenIndex2188
+						if buffer[position] != rune('D') {
+							goto l2173
+						}
+						position++
 					}
-					position++
-					add(rulePegText, position1425)
-				}
-				if !_rules[ruleAction91]() {
-					goto l1423
-				}
-				add(ruleStringLiteral, position1424)
-			}
-			return true
-		l1423:
-			position, tokenIndex = position1423, tokenIndex1423
-			return false
-		},
-		/* 122 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action92)> */
-		func() bool {
-			position1431, tokenIndex1431 := position, tokenIndex
-			{
-				position1432 := position
-				{
-					position1433 := position
+				l2188:
 					{
-						position1434, tokenIndex1434 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1435
+						position2190, tokenIndex2190 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2191
 						}
 						position++
-						goto l1434
-					l1435:
-						position, tokenIndex = position1434, tokenIndex1434
-						if buffer[position] != rune('I') {
-							goto l1431
+						goto l2190
+					l2191:
+						position, tokenIndex = position2190, tokenIndex2190
+						if buffer[position] != rune('E') {
+							goto l2173
 						}
 						position++
 					}
-				l1434:
+				l2190:
 					{
-						position1436, tokenIndex1436 := position, tokenIndex
+						position2192, tokenIndex2192 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1437
+							goto l2193
 						}
 						position++
-						goto l1436
-					l1437:
-						position, tokenIndex = position1436, tokenIndex1436
+						goto l2192
+					l2193:
+						position, tokenIndex = position2192, tokenIndex2192
 						if buffer[position] != rune('S') {
-							goto l1431
+							goto l2173
 						}
 						position++
 					}
-				l1436:
+				l2192:
 					{
-						position1438, tokenIndex1438 := position, tokenIndex
+						position2194, tokenIndex2194 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1439
+							goto l2195
 						}
 						position++
-						goto l1438
-					l1439:
-						position, tokenIndex = position1438, tokenIndex1438
+						goto l2194
+					l2195:
+						position, tokenIndex = position2194, tokenIndex2194
 						if buffer[position] != rune('T') {
-							goto l1431
+							goto l2173
 						}
 						position++
 					}
-				l1438:
+				l2194:
+					add(rulePegText, position2175)
+				}
+				if !_rules[ruleAction140]() {
+					goto l2173
+				}
+				add(ruleDropOldest, position2174)
+			}
+			return true
+		l2173:
+			position, tokenIndex = position2173, tokenIndex2173
+			return false
+		},
+		/* 176 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action141)> */
+		func() bool {
+			position2196, tokenIndex2196 := position, tokenIndex
+			{
+				position2197 := position
+				{
+					position2198 := position
 					{
-						position1440, tokenIndex1440 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1441
+						position2199, tokenIndex2199 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2200
 						}
 						position++
-						goto l1440
-					l1441:
-						position, tokenIndex = position1440, tokenIndex1440
-						if buffer[position] != rune('R') {
-							goto l1431
+						goto l2199
+					l2200:
+						position, tokenIndex = position2199, tokenIndex2199
+						if buffer[position] != rune('D') {
+							goto l2196
 						}
 						position++
 					}
-				l1440:
+				l2199:
 					{
-						position1442, tokenIndex1442 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1443
+						position2201, tokenIndex2201 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2202
 						}
 						position++
-						goto l1442
-					l1443:
-						position, tokenIndex = position1442, tokenIndex1442
-						if buffer[position] != rune('E') {
-							goto l1431
+						goto l2201
+					l2202:
+						position, tokenIndex = position2201, tokenIndex2201
+						if buffer[position] != rune('R') {
+							goto l2196
 						}
 						position++
 					}
-				l1442:
+				l2201:
 					{
-						position1444, tokenIndex1444 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1445
+						position2203, tokenIndex2203 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2204
 						}
 						position++
-						goto l1444
-					l1445:
-						position, tokenIndex = position1444, tokenIndex1444
-						if buffer[position] != rune('A') {
-							goto l1431
+						goto l2203
+					l2204:
+						position, tokenIndex = position2203, tokenIndex2203
+						if buffer[position] != rune('O') {
+							goto l2196
 						}
 						position++
 					}
-				l1444:
+				l2203:
 					{
-						position1446, tokenIndex1446 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1447
+						position2205, tokenIndex2205 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2206
 						}
 						position++
-						goto l1446
-					l1447:
-						position, tokenIndex = position1446, tokenIndex1446
-						if buffer[position] != rune('M') {
-							goto l1431
+						goto l2205
+					l2206:
+						position, tokenIndex = position2205, tokenIndex2205
+						if buffer[position] != rune('P') {
+							goto l2196
 						}
 						position++
 					}
-				l1446:
-					add(rulePegText, position1433)
-				}
-				if !_rules[ruleAction92]() {
-					goto l1431
-				}
-				add(ruleISTREAM, position1432)
-			}
-			return true
-		l1431:
-			position, tokenIndex = position1431, tokenIndex1431
-			return false
-		},
-		/* 123 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action93)> */
-		func() bool {
-			position1448, tokenIndex1448 := position, tokenIndex
-			{
-				position1449 := position
-				{
-					position1450 := position
-					{
-						position1451, tokenIndex1451 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1452
-						}
-						position++
-						goto l1451
-					l1452:
-						position, tokenIndex = position1451, tokenIndex1451
-						if buffer[position] != rune('D') {
-							goto l1448
-						}
-						position++
+				l2205:
+					if !_rules[rulesp]() {
+						goto l2196
 					}
-				l1451:
 					{
-						position1453, tokenIndex1453 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1454
+						position2207, tokenIndex2207 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2208
 						}
 						position++
-						goto l1453
-					l1454:
-						position, tokenIndex = position1453, tokenIndex1453
-						if buffer[position] != rune('S') {
-							goto l1448
+						goto l2207
+					l2208:
+						position, tokenIndex = position2207, tokenIndex2207
+						if buffer[position] != rune('N') {
+							goto l2196
 						}
 						position++
 					}
-				l1453:
+				l2207:
 					{
-						position1455, tokenIndex1455 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1456
+						position2209, tokenIndex2209 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2210
 						}
 						position++
-						goto l1455
-					l1456:
-						position, tokenIndex = position1455, tokenIndex1455
-						if buffer[position] != rune('T') {
-							goto l1448
+						goto l2209
+					l2210:
+						position, tokenIndex = position2209, tokenIndex2209
+						if buffer[position] != rune('E') {
+							goto l2196
 						}
 						position++
 					}
-				l1455:
+				l2209:
 					{
-						position1457, tokenIndex1457 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1458
+						position2211, tokenIndex2211 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2212
 						}
 						position++
-						goto l1457
-					l1458:
-						position, tokenIndex = position1457, tokenIndex1457
-						if buffer[position] != rune('R') {
-							goto l1448
+						goto l2211
+					l2212:
+						position, tokenIndex = position2211, tokenIndex2211
+						if buffer[position] != rune('W') {
+							goto l2196
 						}
 						position++
 					}
-				l1457:
+				l2211:
 					{
-						position1459, tokenIndex1459 := position, tokenIndex
+						position2213, tokenIndex2213 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1460
+							goto l2214
 						}
 						position++
-						goto l1459
-					l1460:
-						position, tokenIndex = position1459, tokenIndex1459
+						goto l2213
+					l2214:
+						position, tokenIndex = position2213, tokenIndex2213
 						if buffer[position] != rune('E') {
-							goto l1448
+							goto l2196
 						}
 						position++
 					}
-				l1459:
+				l2213:
 					{
-						position1461, tokenIndex1461 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1462
+						position2215, tokenIndex2215 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2216
 						}
 						position++
-						goto l1461
-					l1462:
-						position, tokenIndex = position1461, tokenIndex1461
-						if buffer[position] != rune('A') {
-							goto l1448
+						goto l2215
+					l2216:
+						position, tokenIndex = position2215, tokenIndex2215
+						if buffer[position] != rune('S') {
+							goto l2196
 						}
 						position++
 					}
-				l1461:
+				l2215:
 					{
-						position1463, tokenIndex1463 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1464
+						position2217, tokenIndex2217 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2218
 						}
 						position++
-						goto l1463
-					l1464:
-						position, tokenIndex = position1463, tokenIndex1463
-						if buffer[position] != rune('M') {
-							goto l1448
+						goto l2217
+					l2218:
+						position, tokenIndex = position2217, tokenIndex2217
+						if buffer[position] != rune('T') {
+							goto l2196
 						}
 						position++
 					}
-				l1463:
-					add(rulePegText, position1450)
+				l2217:
+					add(rulePegText, position2198)
 				}
-				if !_rules[ruleAction93]() {
-					goto l1448
+				if !_rules[ruleAction141]() {
+					goto l2196
 				}
-				add(ruleDSTREAM, position1449)
+				add(ruleDropNewest, position2197)
 			}
 			return true
-		l1448:
-			position, tokenIndex = position1448, tokenIndex1448
+		l2196:
+			position, tokenIndex = position2196, tokenIndex2196
 			return false
 		},
-		/* 124 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action94)> */
+		/* 177 StreamIdentifier <- <(<ident> Action142)> */
 		func() bool {
-			position1465, tokenIndex1465 := position, tokenIndex
+			position2219, tokenIndex2219 := position, tokenIndex
 			{
-				position1466 := position
+				position2220 := position
 				{
-					position1467 := position
+					position2221 := position
+					if !_rules[ruleident]() {
+						goto l2219
+					}
+					add(rulePegText, position2221)
+				}
+				if !_rules[ruleAction142]() {
+					goto l2219
+				}
+				add(ruleStreamIdentifier, position2220)
+			}
+			return true
+		l2219:
+			position, tokenIndex = position2219, tokenIndex2219
+			return false
+		},
+		/* 178 SourceSinkType <- <(<ident> Action143)> */
+		func() bool {
+			position2222, tokenIndex2222 := position, tokenIndex
+			{
+				position2223 := position
+				{
+					position2224 := position
+					if !_rules[ruleident]() {
+						goto l2222
+					}
+					add(rulePegText, position2224)
+				}
+				if !_rules[ruleAction143]() {
+					goto l2222
+				}
+				add(ruleSourceSinkType, position2223)
+			}
+			return true
+		l2222:
+			position, tokenIndex = position2222, tokenIndex2222
+			return false
+		},
+		/* 179 SourceSinkParamKey <- <(<ident> Action144)> */
+		func() bool {
+			position2225, tokenIndex2225 := position, tokenIndex
+			{
+				position2226 := position
+				{
+					position2227 := position
+					if !_rules[ruleident]() {
+						goto l2225
+					}
+					add(rulePegText, position2227)
+				}
+				if !_rules[ruleAction144]() {
+					goto l2225
+				}
+				add(ruleSourceSinkParamKey, position2226)
+			}
+			return true
+		l2225:
+			position, tokenIndex = position2225, tokenIndex2225
+			return false
+		},
+		/* 180 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action145)> */
+		func() bool {
+			position2228, tokenIndex2228 := position, tokenIndex
+			{
+				position2229 := position
+				{
+					position2230 := position
 					{
-						position1468, tokenIndex1468 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1469
+						position2231, tokenIndex2231 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2232
 						}
 						position++
-						goto l1468
-					l1469:
-						position, tokenIndex = position1468, tokenIndex1468
-						if buffer[position] != rune('R') {
-							goto l1465
+						goto l2231
+					l2232:
+						position, tokenIndex = position2231, tokenIndex2231
+						if buffer[position] != rune('P') {
+							goto l2228
 						}
 						position++
 					}
-				l1468:
+				l2231:
 					{
-						position1470, tokenIndex1470 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1471
+						position2233, tokenIndex2233 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2234
 						}
 						position++
-						goto l1470
-					l1471:
-						position, tokenIndex = position1470, tokenIndex1470
-						if buffer[position] != rune('S') {
-							goto l1465
+						goto l2233
+					l2234:
+						position, tokenIndex = position2233, tokenIndex2233
+						if buffer[position] != rune('A') {
+							goto l2228
 						}
 						position++
 					}
-				l1470:
+				l2233:
 					{
-						position1472, tokenIndex1472 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1473
+						position2235, tokenIndex2235 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2236
 						}
 						position++
-						goto l1472
-					l1473:
-						position, tokenIndex = position1472, tokenIndex1472
-						if buffer[position] != rune('T') {
-							goto l1465
+						goto l2235
+					l2236:
+						position, tokenIndex = position2235, tokenIndex2235
+						if buffer[position] != rune('U') {
+							goto l2228
 						}
 						position++
 					}
-				l1472:
+				l2235:
 					{
-						position1474, tokenIndex1474 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1475
+						position2237, tokenIndex2237 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2238
 						}
 						position++
-						goto l1474
-					l1475:
-						position, tokenIndex = position1474, tokenIndex1474
-						if buffer[position] != rune('R') {
-							goto l1465
+						goto l2237
+					l2238:
+						position, tokenIndex = position2237, tokenIndex2237
+						if buffer[position] != rune('S') {
+							goto l2228
 						}
 						position++
 					}
-				l1474:
+				l2237:
 					{
-						position1476, tokenIndex1476 := position, tokenIndex
+						position2239, tokenIndex2239 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1477
+							goto l2240
 						}
 						position++
-						goto l1476
-					l1477:
-						position, tokenIndex = position1476, tokenIndex1476
+						goto l2239
+					l2240:
+						position, tokenIndex = position2239, tokenIndex2239
 						if buffer[position] != rune('E') {
-							goto l1465
-						}
-						position++
-					}
-				l1476:
-					{
-						position1478, tokenIndex1478 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1479
-						}
-						position++
-						goto l1478
-					l1479:
-						position, tokenIndex = position1478, tokenIndex1478
-						if buffer[position] != rune('A') {
-							goto l1465
+							goto l2228
 						}
 						position++
 					}
-				l1478:
+				l2239:
 					{
-						position1480, tokenIndex1480 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1481
+						position2241, tokenIndex2241 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2242
 						}
 						position++
-						goto l1480
-					l1481:
-						position, tokenIndex = position1480, tokenIndex1480
-						if buffer[position] != rune('M') {
-							goto l1465
+						goto l2241
+					l2242:
+						position, tokenIndex = position2241, tokenIndex2241
+						if buffer[position] != rune('D') {
+							goto l2228
 						}
 						position++
 					}
-				l1480:
-					add(rulePegText, position1467)
+				l2241:
+					add(rulePegText, position2230)
 				}
-				if !_rules[ruleAction94]() {
-					goto l1465
+				if !_rules[ruleAction145]() {
+					goto l2228
 				}
-				add(ruleRSTREAM, position1466)
+				add(rulePaused, position2229)
 			}
 			return true
-		l1465:
-			position, tokenIndex = position1465, tokenIndex1465
+		l2228:
+			position, tokenIndex = position2228, tokenIndex2228
 			return false
 		},
-		/* 125 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action95)> */
+		/* 181 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action146)> */
 		func() bool {
-			position1482, tokenIndex1482 := position, tokenIndex
+			position2243, tokenIndex2243 := position, tokenIndex
 			{
-				position1483 := position
+				position2244 := position
 				{
-					position1484 := position
+					position2245 := position
 					{
-						position1485, tokenIndex1485 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1486
+						position2246, tokenIndex2246 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2247
 						}
 						position++
-						goto l1485
-					l1486:
-						position, tokenIndex = position1485, tokenIndex1485
-						if buffer[position] != rune('T') {
-							goto l1482
+						goto l2246
+					l2247:
+						position, tokenIndex = position2246, tokenIndex2246
+						if buffer[position] != rune('U') {
+							goto l2243
 						}
 						position++
 					}
-				l1485:
+				l2246:
 					{
-						position1487, tokenIndex1487 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1488
+						position2248, tokenIndex2248 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2249
 						}
 						position++
-						goto l1487
-					l1488:
-						position, tokenIndex = position1487, tokenIndex1487
-						if buffer[position] != rune('U') {
-							goto l1482
+						goto l2248
+					l2249:
+						position, tokenIndex = position2248, tokenIndex2248
+						if buffer[position] != rune('N') {
+							goto l2243
 						}
 						position++
 					}
-				l1487:
+				l2248:
 					{
-						position1489, tokenIndex1489 := position, tokenIndex
+						position2250, tokenIndex2250 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l1490
+							goto l2251
 						}
 						position++
-						goto l1489
-					l1490:
-						position, tokenIndex = position1489, tokenIndex1489
+						goto l2250
+					l2251:
+						position, tokenIndex = position2250, tokenIndex2250
 						if buffer[position] != rune('P') {
-							goto l1482
+							goto l2243
 						}
 						position++
 					}
-				l1489:
+				l2250:
 					{
-						position1491, tokenIndex1491 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1492
+						position2252, tokenIndex2252 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2253
 						}
 						position++
-						goto l1491
-					l1492:
-						position, tokenIndex = position1491, tokenIndex1491
-						if buffer[position] != rune('L') {
-							goto l1482
+						goto l2252
+					l2253:
+						position, tokenIndex = position2252, tokenIndex2252
+						if buffer[position] != rune('A') {
+							goto l2243
 						}
 						position++
 					}
-				l1491:
+				l2252:
 					{
-						position1493, tokenIndex1493 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1494
+						position2254, tokenIndex2254 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2255
 						}
 						position++
-						goto l1493
-					l1494:
-						position, tokenIndex = position1493, tokenIndex1493
-						if buffer[position] != rune('E') {
-							goto l1482
+						goto l2254
+					l2255:
+						position, tokenIndex = position2254, tokenIndex2254
+						if buffer[position] != rune('U') {
+							goto l2243
 						}
 						position++
 					}
-				l1493:
+				l2254:
 					{
-						position1495, tokenIndex1495 := position, tokenIndex
+						position2256, tokenIndex2256 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1496
+							goto l2257
 						}
 						position++
-						goto l1495
-					l1496:
-						position, tokenIndex = position1495, tokenIndex1495
+						goto l2256
+					l2257:
+						position, tokenIndex = position2256, tokenIndex2256
 						if buffer[position] != rune('S') {
-							goto l1482
+							goto l2243
+						}
+						position++
+					}
+				l2256:
+					{
+						position2258, tokenIndex2258 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2259
+						}
+						position++
+						goto l2258
+					l2259:
+						position, tokenIndex = position2258, tokenIndex2258
+						if buffer[position] != rune('E') {
+							goto l2243
 						}
 						position++
 					}
-				l1495:
-					add(rulePegText, position1484)
+				l2258:
+					{
+						position2260, tokenIndex2260 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2261
+						}
+						position++
+						goto l2260
+					l2261:
+						position, tokenIndex = position2260, tokenIndex2260
+						if buffer[position] != rune('D') {
+							goto l2243
+						}
+						position++
+					}
+				l2260:
+					add(rulePegText, position2245)
 				}
-				if !_rules[ruleAction95]() {
-					goto l1482
+				if !_rules[ruleAction146]() {
+					goto l2243
 				}
-				add(ruleTUPLES, position1483)
+				add(ruleUnpaused, position2244)
 			}
 			return true
-		l1482:
-			position, tokenIndex = position1482, tokenIndex1482
+		l2243:
+			position, tokenIndex = position2243, tokenIndex2243
 			return false
 		},
-		/* 126 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action96)> */
+		/* 182 Distinct <- <(<(('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T'))> Action147)> */
 		func() bool {
-			position1497, tokenIndex1497 := position, tokenIndex
+			position2262, tokenIndex2262 := position, tokenIndex
 			{
-				position1498 := position
+				position2263 := position
 				{
-					position1499 := position
+					position2264 := position
 					{
-						position1500, tokenIndex1500 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1501
+						position2265, tokenIndex2265 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2266
 						}
 						position++
-						goto l1500
-					l1501:
-						position, tokenIndex = position1500, tokenIndex1500
-						if buffer[position] != rune('S') {
-							goto l1497
+						goto l2265
+					l2266:
+						position, tokenIndex = position2265, tokenIndex2265
+						if buffer[position] != rune('D') {
+							goto l2262
 						}
 						position++
 					}
-				l1500:
+				l2265:
 					{
-						position1502, tokenIndex1502 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1503
+						position2267, tokenIndex2267 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2268
 						}
 						position++
-						goto l1502
-					l1503:
-						position, tokenIndex = position1502, tokenIndex1502
-						if buffer[position] != rune('E') {
-							goto l1497
+						goto l2267
+					l2268:
+						position, tokenIndex = position2267, tokenIndex2267
+						if buffer[position] != rune('I') {
+							goto l2262
 						}
 						position++
 					}
-				l1502:
+				l2267:
 					{
-						position1504, tokenIndex1504 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1505
+						position2269, tokenIndex2269 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2270
 						}
 						position++
-						goto l1504
-					l1505:
-						position, tokenIndex = position1504, tokenIndex1504
-						if buffer[position] != rune('C') {
-							goto l1497
+						goto l2269
+					l2270:
+						position, tokenIndex = position2269, tokenIndex2269
+						if buffer[position] != rune('S') {
+							goto l2262
 						}
 						position++
 					}
-				l1504:
+				l2269:
 					{
-						position1506, tokenIndex1506 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1507
+						position2271, tokenIndex2271 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2272
 						}
 						position++
-						goto l1506
-					l1507:
-						position, tokenIndex = position1506, tokenIndex1506
-						if buffer[position] != rune('O') {
-							goto l1497
+						goto l2271
+					l2272:
+						position, tokenIndex = position2271, tokenIndex2271
+						if buffer[position] != rune('T') {
+							goto l2262
+						}
+						position++
+					}
+				l2271:
+					{
+						position2273, tokenIndex2273 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2274
+						}
+						position++
+						goto l2273
+					l2274:
+						position, tokenIndex = position2273, tokenIndex2273
+						if buffer[position] != rune('I') {
+							goto l2262
 						}
 						position++
 					}
-				l1506:
+				l2273:
 					{
-						position1508, tokenIndex1508 := position, tokenIndex
+						position2275, tokenIndex2275 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1509
+							goto l2276
 						}
 						position++
-						goto l1508
-					l1509:
-						position, tokenIndex = position1508, tokenIndex1508
+						goto l2275
+					l2276:
+						position, tokenIndex = position2275, tokenIndex2275
 						if buffer[position] != rune('N') {
-							goto l1497
+							goto l2262
 						}
 						position++
 					}
-				l1508:
+				l2275:
 					{
-						position1510, tokenIndex1510 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1511
+						position2277, tokenIndex2277 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2278
 						}
 						position++
-						goto l1510
-					l1511:
-						position, tokenIndex = position1510, tokenIndex1510
-						if buffer[position] != rune('D') {
-							goto l1497
+						goto l2277
+					l2278:
+						position, tokenIndex = position2277, tokenIndex2277
+						if buffer[position] != rune('C') {
+							goto l2262
 						}
 						position++
 					}
-				l1510:
+				l2277:
 					{
-						position1512, tokenIndex1512 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1513
+						position2279, tokenIndex2279 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2280
 						}
 						position++
-						goto l1512
-					l1513:
-						position, tokenIndex = position1512, tokenIndex1512
-						if buffer[position] != rune('S') {
-							goto l1497
+						goto l2279
+					l2280:
+						position, tokenIndex = position2279, tokenIndex2279
+						if buffer[position] != rune('T') {
+							goto l2262
 						}
 						position++
 					}
-				l1512:
-					add(rulePegText, position1499)
+				l2279:
+					add(rulePegText, position2264)
 				}
-				if !_rules[ruleAction96]() {
-					goto l1497
+				if !_rules[ruleAction147]() {
+					goto l2262
 				}
-				add(ruleSECONDS, position1498)
+				add(ruleDistinct, position2263)
 			}
 			return true
-		l1497:
-			position, tokenIndex = position1497, tokenIndex1497
+		l2262:
+			position, tokenIndex = position2262, tokenIndex2262
 			return false
 		},
-		/* 127 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action97)> */
+		/* 183 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action148)> */
 		func() bool {
-			position1514, tokenIndex1514 := position, tokenIndex
+			position2281, tokenIndex2281 := position, tokenIndex
 			{
-				position1515 := position
+				position2282 := position
 				{
-					position1516 := position
+					position2283 := position
 					{
-						position1517, tokenIndex1517 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1518
+						position2284, tokenIndex2284 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2285
 						}
 						position++
-						goto l1517
-					l1518:
-						position, tokenIndex = position1517, tokenIndex1517
-						if buffer[position] != rune('M') {
-							goto l1514
+						goto l2284
+					l2285:
+						position, tokenIndex = position2284, tokenIndex2284
+						if buffer[position] != rune('A') {
+							goto l2281
 						}
 						position++
 					}
-				l1517:
+				l2284:
 					{
-						position1519, tokenIndex1519 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1520
+						position2286, tokenIndex2286 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2287
 						}
 						position++
-						goto l1519
-					l1520:
-						position, tokenIndex = position1519, tokenIndex1519
-						if buffer[position] != rune('I') {
-							goto l1514
+						goto l2286
+					l2287:
+						position, tokenIndex = position2286, tokenIndex2286
+						if buffer[position] != rune('S') {
+							goto l2281
 						}
 						position++
 					}
-				l1519:
+				l2286:
 					{
-						position1521, tokenIndex1521 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1522
+						position2288, tokenIndex2288 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2289
 						}
 						position++
-						goto l1521
-					l1522:
-						position, tokenIndex = position1521, tokenIndex1521
-						if buffer[position] != rune('L') {
-							goto l1514
+						goto l2288
+					l2289:
+						position, tokenIndex = position2288, tokenIndex2288
+						if buffer[position] != rune('C') {
+							goto l2281
 						}
 						position++
 					}
-				l1521:
+				l2288:
+					add(rulePegText, position2283)
+				}
+				if !_rules[ruleAction148]() {
+					goto l2281
+				}
+				add(ruleAscending, position2282)
+			}
+			return true
+		l2281:
+			position, tokenIndex = position2281, tokenIndex2281
+			return false
+		},
+		/* 184 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action149)> */
+		func() bool {
+			position2290, tokenIndex2290 := position, tokenIndex
+			{
+				position2291 := position
+				{
+					position2292 := position
 					{
-						position1523, tokenIndex1523 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1524
+						position2293, tokenIndex2293 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2294
 						}
 						position++
-						goto l1523
-					l1524:
-						position, tokenIndex = position1523, tokenIndex1523
-						if buffer[position] != rune('L') {
-							goto l1514
+						goto l2293
+					l2294:
+						position, tokenIndex = position2293, tokenIndex2293
+						if buffer[position] != rune('D') {
+							goto l2290
 						}
 						position++
 					}
-				l1523:
+				l2293:
 					{
-						position1525, tokenIndex1525 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1526
+						position2295, tokenIndex2295 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2296
 						}
 						position++
-						goto l1525
-					l1526:
-						position, tokenIndex = position1525, tokenIndex1525
-						if buffer[position] != rune('I') {
-							goto l1514
+						goto l2295
+					l2296:
+						position, tokenIndex = position2295, tokenIndex2295
+						if buffer[position] != rune('E') {
+							goto l2290
 						}
 						position++
 					}
-				l1525:
+				l2295:
 					{
-						position1527, tokenIndex1527 := position, tokenIndex
+						position2297, tokenIndex2297 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1528
+							goto l2298
 						}
 						position++
-						goto l1527
-					l1528:
-						position, tokenIndex = position1527, tokenIndex1527
+						goto l2297
+					l2298:
+						position, tokenIndex = position2297, tokenIndex2297
 						if buffer[position] != rune('S') {
-							goto l1514
+							goto l2290
 						}
 						position++
 					}
-				l1527:
+				l2297:
 					{
-						position1529, tokenIndex1529 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1530
+						position2299, tokenIndex2299 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2300
 						}
 						position++
-						goto l1529
-					l1530:
-						position, tokenIndex = position1529, tokenIndex1529
-						if buffer[position] != rune('E') {
-							goto l1514
+						goto l2299
+					l2300:
+						position, tokenIndex = position2299, tokenIndex2299
+						if buffer[position] != rune('C') {
+							goto l2290
 						}
 						position++
 					}
-				l1529:
+				l2299:
+					add(rulePegText, position2292)
+				}
+				if !_rules[ruleAction149]() {
+					goto l2290
+				}
+				add(ruleDescending, position2291)
+			}
+			return true
+		l2290:
+			position, tokenIndex = position2290, tokenIndex2290
+			return false
+		},
+		/* 185 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		func() bool {
+			position2301, tokenIndex2301 := position, tokenIndex
+			{
+				position2302 := position
+				{
+					position2303, tokenIndex2303 := position, tokenIndex
+					if !_rules[ruleBool]() {
+						goto l2304
+					}
+					goto l2303
+				l2304:
+					position, tokenIndex = position2303, tokenIndex2303
+					if !_rules[ruleInt]() {
+						goto l2305
+					}
+					goto l2303
+				l2305:
+					position, tokenIndex = position2303, tokenIndex2303
+					if !_rules[ruleFloat]() {
+						goto l2306
+					}
+					goto l2303
+				l2306:
+					position, tokenIndex = position2303, tokenIndex2303
+					if !_rules[ruleString]() {
+						goto l2307
+					}
+					goto l2303
+				l2307:
+					position, tokenIndex = position2303, tokenIndex2303
+					if !_rules[ruleBlob]() {
+						goto l2308
+					}
+					goto l2303
+				l2308:
+					position, tokenIndex = position2303, tokenIndex2303
+					if !_rules[ruleTimestamp]() {
+						goto l2309
+					}
+					goto l2303
+				l2309:
+					position, tokenIndex = position2303, tokenIndex2303
+					if !_rules[ruleArray]() {
+						goto l2310
+					}
+					goto l2303
+				l2310:
+					position, tokenIndex = position2303, tokenIndex2303
+					if !_rules[ruleMap]() {
+						goto l2301
+					}
+				}
+			l2303:
+				add(ruleType, position2302)
+			}
+			return true
+		l2301:
+			position, tokenIndex = position2301, tokenIndex2301
+			return false
+		},
+		/* 186 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action150)> */
+		func() bool {
+			position2311, tokenIndex2311 := position, tokenIndex
+			{
+				position2312 := position
+				{
+					position2313 := position
 					{
-						position1531, tokenIndex1531 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1532
+						position2314, tokenIndex2314 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2315
 						}
 						position++
-						goto l1531
-					l1532:
-						position, tokenIndex = position1531, tokenIndex1531
-						if buffer[position] != rune('C') {
-							goto l1514
+						goto l2314
+					l2315:
+						position, tokenIndex = position2314, tokenIndex2314
+						if buffer[position] != rune('B') {
+							goto l2311
 						}
 						position++
 					}
-				l1531:
+				l2314:
 					{
-						position1533, tokenIndex1533 := position, tokenIndex
+						position2316, tokenIndex2316 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1534
+							goto l2317
 						}
 						position++
-						goto l1533
-					l1534:
-						position, tokenIndex = position1533, tokenIndex1533
+						goto l2316
+					l2317:
+						position, tokenIndex = position2316, tokenIndex2316
 						if buffer[position] != rune('O') {
-							goto l1514
-						}
-						position++
-					}
-				l1533:
-					{
-						position1535, tokenIndex1535 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1536
-						}
-						position++
-						goto l1535
-					l1536:
-						position, tokenIndex = position1535, tokenIndex1535
-						if buffer[position] != rune('N') {
-							goto l1514
+							goto l2311
 						}
 						position++
 					}
-				l1535:
+				l2316:
 					{
-						position1537, tokenIndex1537 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1538
+						position2318, tokenIndex2318 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2319
 						}
 						position++
-						goto l1537
-					l1538:
-						position, tokenIndex = position1537, tokenIndex1537
-						if buffer[position] != rune('D') {
-							goto l1514
+						goto l2318
+					l2319:
+						position, tokenIndex = position2318, tokenIndex2318
+						if buffer[position] != rune('O') {
+							goto l2311
 						}
 						position++
 					}
-				l1537:
+				l2318:
 					{
-						position1539, tokenIndex1539 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1540
+						position2320, tokenIndex2320 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2321
 						}
 						position++
-						goto l1539
-					l1540:
-						position, tokenIndex = position1539, tokenIndex1539
-						if buffer[position] != rune('S') {
-							goto l1514
+						goto l2320
+					l2321:
+						position, tokenIndex = position2320, tokenIndex2320
+						if buffer[position] != rune('L') {
+							goto l2311
 						}
 						position++
 					}
-				l1539:
-					add(rulePegText, position1516)
+				l2320:
+					add(rulePegText, position2313)
 				}
-				if !_rules[ruleAction97]() {
-					goto l1514
+				if !_rules[ruleAction150]() {
+					goto l2311
 				}
-				add(ruleMILLISECONDS, position1515)
+				add(ruleBool, position2312)
 			}
 			return true
-		l1514:
-			position, tokenIndex = position1514, tokenIndex1514
+		l2311:
+			position, tokenIndex = position2311, tokenIndex2311
 			return false
 		},
-		/* 128 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action98)> */
+		/* 187 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action151)> */
 		func() bool {
-			position1541, tokenIndex1541 := position, tokenIndex
+			position2322, tokenIndex2322 := position, tokenIndex
 			{
-				position1542 := position
+				position2323 := position
 				{
-					position1543 := position
-					{
-						position1544, tokenIndex1544 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l1545
-						}
-						position++
-						goto l1544
-					l1545:
-						position, tokenIndex = position1544, tokenIndex1544
-						if buffer[position] != rune('W') {
-							goto l1541
-						}
-						position++
-					}
-				l1544:
+					position2324 := position
 					{
-						position1546, tokenIndex1546 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1547
+						position2325, tokenIndex2325 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2326
 						}
 						position++
-						goto l1546
-					l1547:
-						position, tokenIndex = position1546, tokenIndex1546
-						if buffer[position] != rune('A') {
-							goto l1541
+						goto l2325
+					l2326:
+						position, tokenIndex = position2325, tokenIndex2325
+						if buffer[position] != rune('I') {
+							goto l2322
 						}
 						position++
 					}
-				l1546:
+				l2325:
 					{
-						position1548, tokenIndex1548 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1549
+						position2327, tokenIndex2327 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2328
 						}
 						position++
-						goto l1548
-					l1549:
-						position, tokenIndex = position1548, tokenIndex1548
-						if buffer[position] != rune('I') {
-							goto l1541
+						goto l2327
+					l2328:
+						position, tokenIndex = position2327, tokenIndex2327
+						if buffer[position] != rune('N') {
+							goto l2322
 						}
 						position++
 					}
-				l1548:
+				l2327:
 					{
-						position1550, tokenIndex1550 := position, tokenIndex
+						position2329, tokenIndex2329 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1551
+							goto l2330
 						}
 						position++
-						goto l1550
-					l1551:
-						position, tokenIndex = position1550, tokenIndex1550
+						goto l2329
+					l2330:
+						position, tokenIndex = position2329, tokenIndex2329
 						if buffer[position] != rune('T') {
-							goto l1541
+							goto l2322
 						}
 						position++
 					}
-				l1550:
-					add(rulePegText, position1543)
+				l2329:
+					add(rulePegText, position2324)
 				}
-				if !_rules[ruleAction98]() {
-					goto l1541
+				if !_rules[ruleAction151]() {
+					goto l2322
 				}
-				add(ruleWait, position1542)
+				add(ruleInt, position2323)
 			}
 			return true
-		l1541:
-			position, tokenIndex = position1541, tokenIndex1541
+		l2322:
+			position, tokenIndex = position2322, tokenIndex2322
 			return false
 		},
-		/* 129 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action99)> */
+		/* 188 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action152)> */
 		func() bool {
-			position1552, tokenIndex1552 := position, tokenIndex
+			position2331, tokenIndex2331 := position, tokenIndex
 			{
-				position1553 := position
+				position2332 := position
 				{
-					position1554 := position
+					position2333 := position
 					{
-						position1555, tokenIndex1555 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1556
+						position2334, tokenIndex2334 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2335
 						}
 						position++
-						goto l1555
-					l1556:
-						position, tokenIndex = position1555, tokenIndex1555
-						if buffer[position] != rune('D') {
-							goto l1552
+						goto l2334
+					l2335:
+						position, tokenIndex = position2334, tokenIndex2334
+						if buffer[position] != rune('F') {
+							goto l2331
 						}
 						position++
 					}
-				l1555:
+				l2334:
 					{
-						position1557, tokenIndex1557 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1558
+						position2336, tokenIndex2336 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2337
 						}
 						position++
-						goto l1557
-					l1558:
-						position, tokenIndex = position1557, tokenIndex1557
-						if buffer[position] != rune('R') {
-							goto l1552
+						goto l2336
+					l2337:
+						position, tokenIndex = position2336, tokenIndex2336
+						if buffer[position] != rune('L') {
+							goto l2331
 						}
 						position++
 					}
-				l1557:
+				l2336:
 					{
-						position1559, tokenIndex1559 := position, tokenIndex
+						position2338, tokenIndex2338 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1560
+							goto l2339
 						}
 						position++
-						goto l1559
-					l1560:
-						position, tokenIndex = position1559, tokenIndex1559
+						goto l2338
+					l2339:
+						position, tokenIndex = position2338, tokenIndex2338
 						if buffer[position] != rune('O') {
-							goto l1552
+							goto l2331
 						}
 						position++
 					}
-				l1559:
+				l2338:
 					{
-						position1561, tokenIndex1561 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1562
+						position2340, tokenIndex2340 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2341
 						}
 						position++
-						goto l1561
-					l1562:
-						position, tokenIndex = position1561, tokenIndex1561
-						if buffer[position] != rune('P') {
-							goto l1552
+						goto l2340
+					l2341:
+						position, tokenIndex = position2340, tokenIndex2340
+						if buffer[position] != rune('A') {
+							goto l2331
 						}
 						position++
 					}
-				l1561:
-					if !_rules[rulesp]() {
-						goto l1552
+				l2340:
+					{
+						position2342, tokenIndex2342 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2343
+						}
+						position++
+						goto l2342
+					l2343:
+						position, tokenIndex = position2342, tokenIndex2342
+						if buffer[position] != rune('T') {
+							goto l2331
+						}
+						position++
 					}
+				l2342:
+					add(rulePegText, position2333)
+				}
+				if !_rules[ruleAction152]() {
+					goto l2331
+				}
+				add(ruleFloat, position2332)
+			}
+			return true
+		l2331:
+			position, tokenIndex = position2331, tokenIndex2331
+			return false
+		},
+		/* 189 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action153)> */
+		func() bool {
+			position2344, tokenIndex2344 := position, tokenIndex
+			{
+				position2345 := position
+				{
+					position2346 := position
 					{
-						position1563, tokenIndex1563 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1564
+						position2347, tokenIndex2347 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2348
 						}
 						position++
-						goto l1563
-					l1564:
-						position, tokenIndex = position1563, tokenIndex1563
-						if buffer[position] != rune('O') {
-							goto l1552
+						goto l2347
+					l2348:
+						position, tokenIndex = position2347, tokenIndex2347
+						if buffer[position] != rune('S') {
+							goto l2344
 						}
 						position++
 					}
-				l1563:
+				l2347:
 					{
-						position1565, tokenIndex1565 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1566
+						position2349, tokenIndex2349 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2350
 						}
 						position++
-						goto l1565
-					l1566:
-						position, tokenIndex = position1565, tokenIndex1565
-						if buffer[position] != rune('L') {
-							goto l1552
+						goto l2349
+					l2350:
+						position, tokenIndex = position2349, tokenIndex2349
+						if buffer[position] != rune('T') {
+							goto l2344
 						}
 						position++
 					}
-				l1565:
+				l2349:
 					{
-						position1567, tokenIndex1567 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1568
+						position2351, tokenIndex2351 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2352
 						}
 						position++
-						goto l1567
-					l1568:
-						position, tokenIndex = position1567, tokenIndex1567
-						if buffer[position] != rune('D') {
-							goto l1552
+						goto l2351
+					l2352:
+						position, tokenIndex = position2351, tokenIndex2351
+						if buffer[position] != rune('R') {
+							goto l2344
 						}
 						position++
 					}
-				l1567:
+				l2351:
 					{
-						position1569, tokenIndex1569 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1570
+						position2353, tokenIndex2353 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2354
 						}
 						position++
-						goto l1569
-					l1570:
-						position, tokenIndex = position1569, tokenIndex1569
-						if buffer[position] != rune('E') {
-							goto l1552
+						goto l2353
+					l2354:
+						position, tokenIndex = position2353, tokenIndex2353
+						if buffer[position] != rune('I') {
+							goto l2344
 						}
 						position++
 					}
-				l1569:
+				l2353:
 					{
-						position1571, tokenIndex1571 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1572
+						position2355, tokenIndex2355 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2356
 						}
 						position++
-						goto l1571
-					l1572:
-						position, tokenIndex = position1571, tokenIndex1571
-						if buffer[position] != rune('S') {
-							goto l1552
+						goto l2355
+					l2356:
+						position, tokenIndex = position2355, tokenIndex2355
+						if buffer[position] != rune('N') {
+							goto l2344
 						}
 						position++
 					}
-				l1571:
+				l2355:
 					{
-						position1573, tokenIndex1573 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1574
+						position2357, tokenIndex2357 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2358
 						}
 						position++
-						goto l1573
-					l1574:
-						position, tokenIndex = position1573, tokenIndex1573
-						if buffer[position] != rune('T') {
-							goto l1552
+						goto l2357
+					l2358:
+						position, tokenIndex = position2357, tokenIndex2357
+						if buffer[position] != rune('G') {
+							goto l2344
 						}
 						position++
 					}
-				l1573:
-					add(rulePegText, position1554)
+				l2357:
+					add(rulePegText, position2346)
 				}
-				if !_rules[ruleAction99]() {
-					goto l1552
+				if !_rules[ruleAction153]() {
+					goto l2344
 				}
-				add(ruleDropOldest, position1553)
+				add(ruleString, position2345)
 			}
 			return true
-		l1552:
-			position, tokenIndex = position1552, tokenIndex1552
+		l2344:
+			position, tokenIndex = position2344, tokenIndex2344
 			return false
 		},
-		/* 130 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action100)> */
+		/* 190 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action154)> */
 		func() bool {
-			position1575, tokenIndex1575 := position, tokenIndex
+			position2359, tokenIndex2359 := position, tokenIndex
 			{
-				position1576 := position
+				position2360 := position
 				{
-					position1577 := position
+					position2361 := position
 					{
-						position1578, tokenIndex1578 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1579
+						position2362, tokenIndex2362 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2363
 						}
 						position++
-						goto l1578
-					l1579:
-						position, tokenIndex = position1578, tokenIndex1578
-						if buffer[position] != rune('D') {
-							goto l1575
+						goto l2362
+					l2363:
+						position, tokenIndex = position2362, tokenIndex2362
+						if buffer[position] != rune('B') {
+							goto l2359
 						}
 						position++
 					}
-				l1578:
+				l2362:
 					{
-						position1580, tokenIndex1580 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1581
+						position2364, tokenIndex2364 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2365
 						}
 						position++
-						goto l1580
-					l1581:
-						position, tokenIndex = position1580, tokenIndex1580
-						if buffer[position] != rune('R') {
-							goto l1575
+						goto l2364
+					l2365:
+						position, tokenIndex = position2364, tokenIndex2364
+						if buffer[position] != rune('L') {
+							goto l2359
 						}
 						position++
 					}
-				l1580:
+				l2364:
 					{
-						position1582, tokenIndex1582 := position, tokenIndex
+						position2366, tokenIndex2366 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1583
+							goto l2367
 						}
 						position++
-						goto l1582
-					l1583:
-						position, tokenIndex = position1582, tokenIndex1582
+						goto l2366
+					l2367:
+						position, tokenIndex = position2366, tokenIndex2366
 						if buffer[position] != rune('O') {
-							goto l1575
+							goto l2359
 						}
 						position++
 					}
-				l1582:
+				l2366:
 					{
-						position1584, tokenIndex1584 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1585
+						position2368, tokenIndex2368 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2369
 						}
 						position++
-						goto l1584
-					l1585:
-						position, tokenIndex = position1584, tokenIndex1584
-						if buffer[position] != rune('P') {
-							goto l1575
+						goto l2368
+					l2369:
+						position, tokenIndex = position2368, tokenIndex2368
+						if buffer[position] != rune('B') {
+							goto l2359
 						}
 						position++
 					}
-				l1584:
-					if !_rules[rulesp]() {
-						goto l1575
-					}
+				l2368:
+					add(rulePegText, position2361)
+				}
+				if !_rules[ruleAction154]() {
+					goto l2359
+				}
+				add(ruleBlob, position2360)
+			}
+			return true
+		l2359:
+			position, tokenIndex = position2359, tokenIndex2359
+			return false
+		},
+		/* 191 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action155)> */
+		func() bool {
+			position2370, tokenIndex2370 := position, tokenIndex
+			{
+				position2371 := position
+				{
+					position2372 := position
 					{
-						position1586, tokenIndex1586 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1587
+						position2373, tokenIndex2373 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2374
 						}
 						position++
-						goto l1586
-					l1587:
-						position, tokenIndex = position1586, tokenIndex1586
-						if buffer[position] != rune('N') {
-							goto l1575
+						goto l2373
+					l2374:
+						position, tokenIndex = position2373, tokenIndex2373
+						if buffer[position] != rune('T') {
+							goto l2370
 						}
 						position++
 					}
-				l1586:
+				l2373:
 					{
-						position1588, tokenIndex1588 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1589
+						position2375, tokenIndex2375 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2376
 						}
 						position++
-						goto l1588
-					l1589:
-						position, tokenIndex = position1588, tokenIndex1588
-						if buffer[position] != rune('E') {
-							goto l1575
+						goto l2375
+					l2376:
+						position, tokenIndex = position2375, tokenIndex2375
+						if buffer[position] != rune('I') {
+							goto l2370
 						}
 						position++
 					}
-				l1588:
+				l2375:
 					{
-						position1590, tokenIndex1590 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l1591
+						position2377, tokenIndex2377 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2378
 						}
 						position++
-						goto l1590
-					l1591:
-						position, tokenIndex = position1590, tokenIndex1590
-						if buffer[position] != rune('W') {
-							goto l1575
+						goto l2377
+					l2378:
+						position, tokenIndex = position2377, tokenIndex2377
+						if buffer[position] != rune('M') {
+							goto l2370
 						}
 						position++
 					}
-				l1590:
+				l2377:
 					{
-						position1592, tokenIndex1592 := position, tokenIndex
+						position2379, tokenIndex2379 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1593
+							goto l2380
 						}
 						position++
-						goto l1592
-					l1593:
-						position, tokenIndex = position1592, tokenIndex1592
+						goto l2379
+					l2380:
+						position, tokenIndex = position2379, tokenIndex2379
 						if buffer[position] != rune('E') {
-							goto l1575
+							goto l2370
 						}
 						position++
 					}
-				l1592:
+				l2379:
 					{
-						position1594, tokenIndex1594 := position, tokenIndex
+						position2381, tokenIndex2381 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1595
+							goto l2382
 						}
 						position++
-						goto l1594
-					l1595:
-						position, tokenIndex = position1594, tokenIndex1594
+						goto l2381
+					l2382:
+						position, tokenIndex = position2381, tokenIndex2381
 						if buffer[position] != rune('S') {
-							goto l1575
+							goto l2370
 						}
 						position++
 					}
-				l1594:
+				l2381:
 					{
-						position1596, tokenIndex1596 := position, tokenIndex
+						position2383, tokenIndex2383 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1597
+							goto l2384
 						}
 						position++
-						goto l1596
-					l1597:
-						position, tokenIndex = position1596, tokenIndex1596
+						goto l2383
+					l2384:
+						position, tokenIndex = position2383, tokenIndex2383
 						if buffer[position] != rune('T') {
-							goto l1575
-						}
-						position++
-					}
-				l1596:
-					add(rulePegText, position1577)
-				}
-				if !_rules[ruleAction100]() {
-					goto l1575
-				}
-				add(ruleDropNewest, position1576)
-			}
-			return true
-		l1575:
-			position, tokenIndex = position1575, tokenIndex1575
-			return false
-		},
-		/* 131 StreamIdentifier <- <(<ident> Action101)> */
-		func() bool {
-			position1598, tokenIndex1598 := position, tokenIndex
-			{
-				position1599 := position
-				{
-					position1600 := position
-					if !_rules[ruleident]() {
-						goto l1598
-					}
-					add(rulePegText, position1600)
-				}
-				if !_rules[ruleAction101]() {
-					goto l1598
-				}
-				add(ruleStreamIdentifier, position1599)
-			}
-			return true
-		l1598:
-			position, tokenIndex = position1598, tokenIndex1598
-			return false
-		},
-		/* 132 SourceSinkType <- <(<ident> Action102)> */
-		func() bool {
-			position1601, tokenIndex1601 := position, tokenIndex
-			{
-				position1602 := position
-				{
-					position1603 := position
-					if !_rules[ruleident]() {
-						goto l1601
-					}
-					add(rulePegText, position1603)
-				}
-				if !_rules[ruleAction102]() {
-					goto l1601
-				}
-				add(ruleSourceSinkType, position1602)
-			}
-			return true
-		l1601:
-			position, tokenIndex = position1601, tokenIndex1601
-			return false
-		},
-		/* 133 SourceSinkParamKey <- <(<ident> Action103)> */
-		func() bool {
-			position1604, tokenIndex1604 := position, tokenIndex
-			{
-				position1605 := position
-				{
-					position1606 := position
-					if !_rules[ruleident]() {
-						goto l1604
-					}
-					add(rulePegText, position1606)
-				}
-				if !_rules[ruleAction103]() {
-					goto l1604
-				}
-				add(ruleSourceSinkParamKey, position1605)
-			}
-			return true
-		l1604:
-			position, tokenIndex = position1604, tokenIndex1604
-			return false
-		},
-		/* 134 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action104)> */
-		func() bool {
-			position1607, tokenIndex1607 := position, tokenIndex
-			{
-				position1608 := position
-				{
-					position1609 := position
-					{
-						position1610, tokenIndex1610 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1611
-						}
-						position++
-						goto l1610
-					l1611:
-						position, tokenIndex = position1610, tokenIndex1610
-						if buffer[position] != rune('P') {
-							goto l1607
+							goto l2370
 						}
 						position++
 					}
-				l1610:
+				l2383:
 					{
-						position1612, tokenIndex1612 := position, tokenIndex
+						position2385, tokenIndex2385 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1613
+							goto l2386
 						}
 						position++
-						goto l1612
-					l1613:
-						position, tokenIndex = position1612, tokenIndex1612
+						goto l2385
+					l2386:
+						position, tokenIndex = position2385, tokenIndex2385
 						if buffer[position] != rune('A') {
-							goto l1607
-						}
-						position++
-					}
-				l1612:
-					{
-						position1614, tokenIndex1614 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1615
-						}
-						position++
-						goto l1614
-					l1615:
-						position, tokenIndex = position1614, tokenIndex1614
-						if buffer[position] != rune('U') {
-							goto l1607
-						}
-						position++
-					}
-				l1614:
-					{
-						position1616, tokenIndex1616 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1617
-						}
-						position++
-						goto l1616
-					l1617:
-						position, tokenIndex = position1616, tokenIndex1616
-						if buffer[position] != rune('S') {
-							goto l1607
+							goto l2370
 						}
 						position++
 					}
-				l1616:
+				l2385:
 					{
-						position1618, tokenIndex1618 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1619
+						position2387, tokenIndex2387 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2388
 						}
 						position++
-						goto l1618
-					l1619:
-						position, tokenIndex = position1618, tokenIndex1618
-						if buffer[position] != rune('E') {
-							goto l1607
+						goto l2387
+					l2388:
+						position, tokenIndex = position2387, tokenIndex2387
+						if buffer[position] != rune('M') {
+							goto l2370
 						}
 						position++
 					}
-				l1618:
+				l2387:
 					{
-						position1620, tokenIndex1620 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1621
+						position2389, tokenIndex2389 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2390
 						}
 						position++
-						goto l1620
-					l1621:
-						position, tokenIndex = position1620, tokenIndex1620
-						if buffer[position] != rune('D') {
-							goto l1607
+						goto l2389
+					l2390:
+						position, tokenIndex = position2389, tokenIndex2389
+						if buffer[position] != rune('P') {
+							goto l2370
 						}
 						position++
 					}
-				l1620:
-					add(rulePegText, position1609)
+				l2389:
+					add(rulePegText, position2372)
 				}
-				if !_rules[ruleAction104]() {
-					goto l1607
+				if !_rules[ruleAction155]() {
+					goto l2370
 				}
-				add(rulePaused, position1608)
+				add(ruleTimestamp, position2371)
 			}
 			return true
-		l1607:
-			position, tokenIndex = position1607, tokenIndex1607
+		l2370:
+			position, tokenIndex = position2370, tokenIndex2370
 			return false
 		},
-		/* 135 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action105)> */
+		/* 192 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action156)> */
 		func() bool {
-			position1622, tokenIndex1622 := position, tokenIndex
+			position2391, tokenIndex2391 := position, tokenIndex
 			{
-				position1623 := position
+				position2392 := position
 				{
-					position1624 := position
+					position2393 := position
 					{
-						position1625, tokenIndex1625 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1626
+						position2394, tokenIndex2394 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2395
 						}
 						position++
-						goto l1625
-					l1626:
-						position, tokenIndex = position1625, tokenIndex1625
-						if buffer[position] != rune('U') {
-							goto l1622
+						goto l2394
+					l2395:
+						position, tokenIndex = position2394, tokenIndex2394
+						if buffer[position] != rune('A') {
+							goto l2391
 						}
 						position++
 					}
-				l1625:
+				l2394:
 					{
-						position1627, tokenIndex1627 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1628
+						position2396, tokenIndex2396 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2397
 						}
 						position++
-						goto l1627
-					l1628:
-						position, tokenIndex = position1627, tokenIndex1627
-						if buffer[position] != rune('N') {
-							goto l1622
+						goto l2396
+					l2397:
+						position, tokenIndex = position2396, tokenIndex2396
+						if buffer[position] != rune('R') {
+							goto l2391
 						}
 						position++
 					}
-				l1627:
+				l2396:
 					{
-						position1629, tokenIndex1629 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1630
+						position2398, tokenIndex2398 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2399
 						}
 						position++
-						goto l1629
-					l1630:
-						position, tokenIndex = position1629, tokenIndex1629
-						if buffer[position] != rune('P') {
-							goto l1622
+						goto l2398
+					l2399:
+						position, tokenIndex = position2398, tokenIndex2398
+						if buffer[position] != rune('R') {
+							goto l2391
 						}
 						position++
 					}
-				l1629:
+				l2398:
 					{
-						position1631, tokenIndex1631 := position, tokenIndex
+						position2400, tokenIndex2400 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1632
+							goto l2401
 						}
 						position++
-						goto l1631
-					l1632:
-						position, tokenIndex = position1631, tokenIndex1631
+						goto l2400
+					l2401:
+						position, tokenIndex = position2400, tokenIndex2400
 						if buffer[position] != rune('A') {
-							goto l1622
+							goto l2391
 						}
 						position++
 					}
-				l1631:
+				l2400:
 					{
-						position1633, tokenIndex1633 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1634
+						position2402, tokenIndex2402 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2403
 						}
 						position++
-						goto l1633
-					l1634:
-						position, tokenIndex = position1633, tokenIndex1633
-						if buffer[position] != rune('U') {
-							goto l1622
+						goto l2402
+					l2403:
+						position, tokenIndex = position2402, tokenIndex2402
+						if buffer[position] != rune('Y') {
+							goto l2391
 						}
 						position++
 					}
-				l1633:
+				l2402:
+					add(rulePegText, position2393)
+				}
+				if !_rules[ruleAction156]() {
+					goto l2391
+				}
+				add(ruleArray, position2392)
+			}
+			return true
+		l2391:
+			position, tokenIndex = position2391, tokenIndex2391
+			return false
+		},
+		/* 193 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action157)> */
+		func() bool {
+			position2404, tokenIndex2404 := position, tokenIndex
+			{
+				position2405 := position
+				{
+					position2406 := position
 					{
-						position1635, tokenIndex1635 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1636
+						position2407, tokenIndex2407 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2408
 						}
 						position++
-						goto l1635
-					l1636:
-						position, tokenIndex = position1635, tokenIndex1635
-						if buffer[position] != rune('S') {
-							goto l1622
+						goto l2407
+					l2408:
+						position, tokenIndex = position2407, tokenIndex2407
+						if buffer[position] != rune('M') {
+							goto l2404
 						}
 						position++
 					}
-				l1635:
+				l2407:
 					{
-						position1637, tokenIndex1637 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1638
+						position2409, tokenIndex2409 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2410
 						}
 						position++
-						goto l1637
-					l1638:
-						position, tokenIndex = position1637, tokenIndex1637
-						if buffer[position] != rune('E') {
-							goto l1622
+						goto l2409
+					l2410:
+						position, tokenIndex = position2409, tokenIndex2409
+						if buffer[position] != rune('A') {
+							goto l2404
 						}
 						position++
 					}
-				l1637:
+				l2409:
 					{
-						position1639, tokenIndex1639 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1640
+						position2411, tokenIndex2411 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2412
 						}
 						position++
-						goto l1639
-					l1640:
-						position, tokenIndex = position1639, tokenIndex1639
-						if buffer[position] != rune('D') {
-							goto l1622
+						goto l2411
+					l2412:
+						position, tokenIndex = position2411, tokenIndex2411
+						if buffer[position] != rune('P') {
+							goto l2404
 						}
 						position++
 					}
-				l1639:
-					add(rulePegText, position1624)
+				l2411:
+					add(rulePegText, position2406)
 				}
-				if !_rules[ruleAction105]() {
-					goto l1622
+				if !_rules[ruleAction157]() {
+					goto l2404
 				}
-				add(ruleUnpaused, position1623)
+				add(ruleMap, position2405)
 			}
 			return true
-		l1622:
-			position, tokenIndex = position1622, tokenIndex1622
+		l2404:
+			position, tokenIndex = position2404, tokenIndex2404
 			return false
 		},
-		/* 136 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action106)> */
+		/* 194 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action158)> */
 		func() bool {
-			position1641, tokenIndex1641 := position, tokenIndex
+			position2413, tokenIndex2413 := position, tokenIndex
 			{
-				position1642 := position
+				position2414 := position
 				{
-					position1643 := position
-					{
-						position1644, tokenIndex1644 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1645
-						}
-						position++
-						goto l1644
-					l1645:
-						position, tokenIndex = position1644, tokenIndex1644
-						if buffer[position] != rune('A') {
-							goto l1641
-						}
-						position++
-					}
-				l1644:
+					position2415 := position
 					{
-						position1646, tokenIndex1646 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1647
+						position2416, tokenIndex2416 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2417
 						}
 						position++
-						goto l1646
-					l1647:
-						position, tokenIndex = position1646, tokenIndex1646
-						if buffer[position] != rune('S') {
-							goto l1641
+						goto l2416
+					l2417:
+						position, tokenIndex = position2416, tokenIndex2416
+						if buffer[position] != rune('O') {
+							goto l2413
 						}
 						position++
 					}
-				l1646:
+				l2416:
 					{
-						position1648, tokenIndex1648 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1649
+						position2418, tokenIndex2418 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2419
 						}
 						position++
-						goto l1648
-					l1649:
-						position, tokenIndex = position1648, tokenIndex1648
-						if buffer[position] != rune('C') {
-							goto l1641
+						goto l2418
+					l2419:
+						position, tokenIndex = position2418, tokenIndex2418
+						if buffer[position] != rune('R') {
+							goto l2413
 						}
 						position++
 					}
-				l1648:
-					add(rulePegText, position1643)
+				l2418:
+					add(rulePegText, position2415)
 				}
-				if !_rules[ruleAction106]() {
-					goto l1641
+				if !_rules[ruleAction158]() {
+					goto l2413
 				}
-				add(ruleAscending, position1642)
+				add(ruleOr, position2414)
 			}
 			return true
-		l1641:
-			position, tokenIndex = position1641, tokenIndex1641
+		l2413:
+			position, tokenIndex = position2413, tokenIndex2413
 			return false
 		},
-		/* 137 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action107)> */
+		/* 195 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action159)> */
 		func() bool {
-			position1650, tokenIndex1650 := position, tokenIndex
+			position2420, tokenIndex2420 := position, tokenIndex
 			{
-				position1651 := position
+				position2421 := position
 				{
-					position1652 := position
+					position2422 := position
+					{
+						position2423, tokenIndex2423 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2424
+						}
+						position++
+						goto l2423
+					l2424:
+						position, tokenIndex = position2423, tokenIndex2423
+						if buffer[position] != rune('A') {
+							goto l2420
+						}
+						position++
+					}
+				l2423:
+					{
+						position2425, tokenIndex2425 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2426
+						}
+						position++
+						goto l2425
+					l2426:
+						position, tokenIndex = position2425, tokenIndex2425
+						if buffer[position] != rune('N') {
+							goto l2420
+						}
+						position++
+					}
+				l2425:
 					{
-						position1653, tokenIndex1653 := position, tokenIndex
+						position2427, tokenIndex2427 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1654
+							goto l2428
 						}
 						position++
-						goto l1653
-					l1654:
-						position, tokenIndex = position1653, tokenIndex1653
+						goto l2427
+					l2428:
+						position, tokenIndex = position2427, tokenIndex2427
 						if buffer[position] != rune('D') {
-							goto l1650
+							goto l2420
 						}
 						position++
 					}
-				l1653:
+				l2427:
+					add(rulePegText, position2422)
+				}
+				if !_rules[ruleAction159]() {
+					goto l2420
+				}
+				add(ruleAnd, position2421)
+			}
+			return true
+		l2420:
+			position, tokenIndex = position2420, tokenIndex2420
+			return false
+		},
+		/* 196 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action160)> */
+		func() bool {
+			position2429, tokenIndex2429 := position, tokenIndex
+			{
+				position2430 := position
+				{
+					position2431 := position
 					{
-						position1655, tokenIndex1655 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1656
+						position2432, tokenIndex2432 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2433
 						}
 						position++
-						goto l1655
-					l1656:
-						position, tokenIndex = position1655, tokenIndex1655
-						if buffer[position] != rune('E') {
-							goto l1650
+						goto l2432
+					l2433:
+						position, tokenIndex = position2432, tokenIndex2432
+						if buffer[position] != rune('N') {
+							goto l2429
 						}
 						position++
 					}
-				l1655:
+				l2432:
 					{
-						position1657, tokenIndex1657 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1658
+						position2434, tokenIndex2434 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2435
 						}
 						position++
-						goto l1657
-					l1658:
-						position, tokenIndex = position1657, tokenIndex1657
-						if buffer[position] != rune('S') {
-							goto l1650
+						goto l2434
+					l2435:
+						position, tokenIndex = position2434, tokenIndex2434
+						if buffer[position] != rune('O') {
+							goto l2429
 						}
 						position++
 					}
-				l1657:
+				l2434:
 					{
-						position1659, tokenIndex1659 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1660
+						position2436, tokenIndex2436 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2437
 						}
 						position++
-						goto l1659
-					l1660:
-						position, tokenIndex = position1659, tokenIndex1659
-						if buffer[position] != rune('C') {
-							goto l1650
+						goto l2436
+					l2437:
+						position, tokenIndex = position2436, tokenIndex2436
+						if buffer[position] != rune('T') {
+							goto l2429
 						}
 						position++
 					}
-				l1659:
-					add(rulePegText, position1652)
+				l2436:
+					add(rulePegText, position2431)
 				}
-				if !_rules[ruleAction107]() {
-					goto l1650
+				if !_rules[ruleAction160]() {
+					goto l2429
 				}
-				add(ruleDescending, position1651)
+				add(ruleNot, position2430)
 			}
 			return true
-		l1650:
-			position, tokenIndex = position1650, tokenIndex1650
+		l2429:
+			position, tokenIndex = position2429, tokenIndex2429
 			return false
 		},
-		/* 138 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		/* 197 Equal <- <(<'='> Action161)> */
 		func() bool {
-			position1661, tokenIndex1661 := position, tokenIndex
+			position2438, tokenIndex2438 := position, tokenIndex
 			{
-				position1662 := position
+				position2439 := position
 				{
-					position1663, tokenIndex1663 := position, tokenIndex
-					if !_rules[ruleBool]() {
-						goto l1664
+					position2440 := position
+					if buffer[position] != rune('=') {
+						goto l2438
 					}
-					goto l1663
-				l1664:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleInt]() {
-						goto l1665
+					position++
+					add(rulePegText, position2440)
+				}
+				if !_rules[ruleAction161]() {
+					goto l2438
+				}
+				add(ruleEqual, position2439)
+			}
+			return true
+		l2438:
+			position, tokenIndex = position2438, tokenIndex2438
+			return false
+		},
+		/* 198 Less <- <(<'<'> Action162)> */
+		func() bool {
+			position2441, tokenIndex2441 := position, tokenIndex
+			{
+				position2442 := position
+				{
+					position2443 := position
+					if buffer[position] != rune('<') {
+						goto l2441
 					}
-					goto l1663
-				l1665:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleFloat]() {
-						goto l1666
+					position++
+					add(rulePegText, position2443)
+				}
+				if !_rules[ruleAction162]() {
+					goto l2441
+				}
+				add(ruleLess, position2442)
+			}
+			return true
+		l2441:
+			position, tokenIndex = position2441, tokenIndex2441
+			return false
+		},
+		/* 199 LessOrEqual <- <(<('<' '=')> Action163)> */
+		func() bool {
+			position2444, tokenIndex2444 := position, tokenIndex
+			{
+				position2445 := position
+				{
+					position2446 := position
+					if buffer[position] != rune('<') {
+						goto l2444
 					}
-					goto l1663
-				l1666:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleString]() {
-						goto l1667
+					position++
+					if buffer[position] != rune('=') {
+						goto l2444
 					}
-					goto l1663
-				l1667:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleBlob]() {
-						goto l1668
+					position++
+					add(rulePegText, position2446)
+				}
+				if !_rules[ruleAction163]() {
+					goto l2444
+				}
+				add(ruleLessOrEqual, position2445)
+			}
+			return true
+		l2444:
+			position, tokenIndex = position2444, tokenIndex2444
+			return false
+		},
+		/* 200 Greater <- <(<'>'> Action164)> */
+		func() bool {
+			position2447, tokenIndex2447 := position, tokenIndex
+			{
+				position2448 := position
+				{
+					position2449 := position
+					if buffer[position] != rune('>') {
+						goto l2447
 					}
-					goto l1663
-				l1668:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleTimestamp]() {
-						goto l1669
+					position++
+					add(rulePegText, position2449)
+				}
+				if !_rules[ruleAction164]() {
+					goto l2447
+				}
+				add(ruleGreater, position2448)
+			}
+			return true
+		l2447:
+			position, tokenIndex = position2447, tokenIndex2447
+			return false
+		},
+		/* 201 GreaterOrEqual <- <(<('>' '=')> Action165)> */
+		func() bool {
+			position2450, tokenIndex2450 := position, tokenIndex
+			{
+				position2451 := position
+				{
+					position2452 := position
+					if buffer[position] != rune('>') {
+						goto l2450
 					}
-					goto l1663
-				l1669:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleArray]() {
-						goto l1670
+					position++
+					if buffer[position] != rune('=') {
+						goto l2450
 					}
-					goto l1663
-				l1670:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleMap]() {
-						goto l1661
+					position++
+					add(rulePegText, position2452)
+				}
+				if !_rules[ruleAction165]() {
+					goto l2450
+				}
+				add(ruleGreaterOrEqual, position2451)
+			}
+			return true
+		l2450:
+			position, tokenIndex = position2450, tokenIndex2450
+			return false
+		},
+		/* 202 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action166)> */
+		func() bool {
+			position2453, tokenIndex2453 := position, tokenIndex
+			{
+				position2454 := position
+				{
+					position2455 := position
+					{
+						position2456, tokenIndex2456 := position, tokenIndex
+						if buffer[position] != rune('!') {
+							goto l2457
+						}
+						position++
+						if buffer[position] != rune('=') {
+							goto l2457
+						}
+						position++
+						goto l2456
+					l2457:
+						position, tokenIndex = position2456, tokenIndex2456
+						if buffer[position] != rune('<') {
+							goto l2453
+						}
+						position++
+						if buffer[position] != rune('>') {
+							goto l2453
+						}
+						position++
 					}
+				l2456:
+					add(rulePegText, position2455)
 				}
-			l1663:
-				add(ruleType, position1662)
+				if !_rules[ruleAction166]() {
+					goto l2453
+				}
+				add(ruleNotEqual, position2454)
 			}
 			return true
-		l1661:
-			position, tokenIndex = position1661, tokenIndex1661
+		l2453:
+			position, tokenIndex = position2453, tokenIndex2453
 			return false
 		},
-		/* 139 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action108)> */
+		/* 203 Like <- <(<(('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action167)> */
 		func() bool {
-			position1671, tokenIndex1671 := position, tokenIndex
+			position2458, tokenIndex2458 := position, tokenIndex
 			{
-				position1672 := position
+				position2459 := position
 				{
-					position1673 := position
+					position2460 := position
 					{
-						position1674, tokenIndex1674 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1675
+						position2461, tokenIndex2461 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2462
 						}
 						position++
-						goto l1674
-					l1675:
-						position, tokenIndex = position1674, tokenIndex1674
-						if buffer[position] != rune('B') {
-							goto l1671
+						goto l2461
+					l2462:
+						position, tokenIndex = position2461, tokenIndex2461
+						if buffer[position] != rune('L') {
+							goto l2458
 						}
 						position++
 					}
-				l1674:
+				l2461:
 					{
-						position1676, tokenIndex1676 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1677
+						position2463, tokenIndex2463 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2464
 						}
 						position++
-						goto l1676
-					l1677:
-						position, tokenIndex = position1676, tokenIndex1676
-						if buffer[position] != rune('O') {
-							goto l1671
+						goto l2463
+					l2464:
+						position, tokenIndex = position2463, tokenIndex2463
+						if buffer[position] != rune('I') {
+							goto l2458
 						}
 						position++
 					}
-				l1676:
+				l2463:
 					{
-						position1678, tokenIndex1678 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1679
+						position2465, tokenIndex2465 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2466
 						}
 						position++
-						goto l1678
-					l1679:
-						position, tokenIndex = position1678, tokenIndex1678
-						if buffer[position] != rune('O') {
-							goto l1671
+						goto l2465
+					l2466:
+						position, tokenIndex = position2465, tokenIndex2465
+						if buffer[position] != rune('K') {
+							goto l2458
 						}
 						position++
 					}
-				l1678:
+				l2465:
 					{
-						position1680, tokenIndex1680 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1681
+						position2467, tokenIndex2467 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2468
 						}
 						position++
-						goto l1680
-					l1681:
-						position, tokenIndex = position1680, tokenIndex1680
-						if buffer[position] != rune('L') {
-							goto l1671
+						goto l2467
+					l2468:
+						position, tokenIndex = position2467, tokenIndex2467
+						if buffer[position] != rune('E') {
+							goto l2458
 						}
 						position++
 					}
-				l1680:
-					add(rulePegText, position1673)
+				l2467:
+					add(rulePegText, position2460)
 				}
-				if !_rules[ruleAction108]() {
-					goto l1671
+				if !_rules[ruleAction167]() {
+					goto l2458
 				}
-				add(ruleBool, position1672)
+				add(ruleLike, position2459)
 			}
 			return true
-		l1671:
-			position, tokenIndex = position1671, tokenIndex1671
+		l2458:
+			position, tokenIndex = position2458, tokenIndex2458
 			return false
 		},
-		/* 140 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action109)> */
+		/* 204 NotLike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action168)> */
 		func() bool {
-			position1682, tokenIndex1682 := position, tokenIndex
+			position2469, tokenIndex2469 := position, tokenIndex
 			{
-				position1683 := position
+				position2470 := position
 				{
-					position1684 := position
+					position2471 := position
 					{
-						position1685, tokenIndex1685 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1686
+						position2472, tokenIndex2472 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2473
 						}
 						position++
-						goto l1685
-					l1686:
-						position, tokenIndex = position1685, tokenIndex1685
-						if buffer[position] != rune('I') {
-							goto l1682
+						goto l2472
+					l2473:
+						position, tokenIndex = position2472, tokenIndex2472
+						if buffer[position] != rune('N') {
+							goto l2469
 						}
 						position++
 					}
-				l1685:
+				l2472:
 					{
-						position1687, tokenIndex1687 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1688
+						position2474, tokenIndex2474 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2475
 						}
 						position++
-						goto l1687
-					l1688:
-						position, tokenIndex = position1687, tokenIndex1687
-						if buffer[position] != rune('N') {
-							goto l1682
+						goto l2474
+					l2475:
+						position, tokenIndex = position2474, tokenIndex2474
+						if buffer[position] != rune('O') {
+							goto l2469
 						}
 						position++
 					}
-				l1687:
+				l2474:
 					{
-						position1689, tokenIndex1689 := position, tokenIndex
+						position2476, tokenIndex2476 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1690
+							goto l2477
 						}
 						position++
-						goto l1689
-					l1690:
-						position, tokenIndex = position1689, tokenIndex1689
+						goto l2476
+					l2477:
+						position, tokenIndex = position2476, tokenIndex2476
 						if buffer[position] != rune('T') {
-							goto l1682
+							goto l2469
 						}
 						position++
 					}
-				l1689:
-					add(rulePegText, position1684)
-				}
-				if !_rules[ruleAction109]() {
-					goto l1682
-				}
-				add(ruleInt, position1683)
-			}
-			return true
-		l1682:
-			position, tokenIndex = position1682, tokenIndex1682
-			return false
-		},
-		/* 141 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action110)> */
-		func() bool {
-			position1691, tokenIndex1691 := position, tokenIndex
-			{
-				position1692 := position
-				{
-					position1693 := position
-					{
-						position1694, tokenIndex1694 := position, tokenIndex
-						if buffer[position] != rune('f') {
-							goto l1695
-						}
-						position++
-						goto l1694
-					l1695:
-						position, tokenIndex = position1694, tokenIndex1694
-						if buffer[position] != rune('F') {
-							goto l1691
-						}
-						position++
+				l2476:
+					if !_rules[rulesp]() {
+						goto l2469
 					}
-				l1694:
 					{
-						position1696, tokenIndex1696 := position, tokenIndex
+						position2478, tokenIndex2478 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1697
+							goto l2479
 						}
 						position++
-						goto l1696
-					l1697:
-						position, tokenIndex = position1696, tokenIndex1696
+						goto l2478
+					l2479:
+						position, tokenIndex = position2478, tokenIndex2478
 						if buffer[position] != rune('L') {
-							goto l1691
+							goto l2469
 						}
 						position++
 					}
-				l1696:
+				l2478:
 					{
-						position1698, tokenIndex1698 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1699
+						position2480, tokenIndex2480 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2481
 						}
 						position++
-						goto l1698
-					l1699:
-						position, tokenIndex = position1698, tokenIndex1698
-						if buffer[position] != rune('O') {
-							goto l1691
+						goto l2480
+					l2481:
+						position, tokenIndex = position2480, tokenIndex2480
+						if buffer[position] != rune('I') {
+							goto l2469
 						}
 						position++
 					}
-				l1698:
+				l2480:
 					{
-						position1700, tokenIndex1700 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1701
+						position2482, tokenIndex2482 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2483
 						}
 						position++
-						goto l1700
-					l1701:
-						position, tokenIndex = position1700, tokenIndex1700
-						if buffer[position] != rune('A') {
-							goto l1691
+						goto l2482
+					l2483:
+						position, tokenIndex = position2482, tokenIndex2482
+						if buffer[position] != rune('K') {
+							goto l2469
 						}
 						position++
 					}
-				l1700:
+				l2482:
 					{
-						position1702, tokenIndex1702 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1703
+						position2484, tokenIndex2484 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2485
 						}
 						position++
-						goto l1702
-					l1703:
-						position, tokenIndex = position1702, tokenIndex1702
-						if buffer[position] != rune('T') {
-							goto l1691
+						goto l2484
+					l2485:
+						position, tokenIndex = position2484, tokenIndex2484
+						if buffer[position] != rune('E') {
+							goto l2469
 						}
 						position++
 					}
-				l1702:
-					add(rulePegText, position1693)
+				l2484:
+					add(rulePegText, position2471)
 				}
-				if !_rules[ruleAction110]() {
-					goto l1691
+				if !_rules[ruleAction168]() {
+					goto l2469
 				}
-				add(ruleFloat, position1692)
+				add(ruleNotLike, position2470)
 			}
 			return true
-		l1691:
-			position, tokenIndex = position1691, tokenIndex1691
+		l2469:
+			position, tokenIndex = position2469, tokenIndex2469
 			return false
 		},
-		/* 142 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action111)> */
+		/* 205 ILike <- <(<(('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action169)> */
 		func() bool {
-			position1704, tokenIndex1704 := position, tokenIndex
+			position2486, tokenIndex2486 := position, tokenIndex
 			{
-				position1705 := position
+				position2487 := position
 				{
-					position1706 := position
-					{
-						position1707, tokenIndex1707 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1708
-						}
-						position++
-						goto l1707
-					l1708:
-						position, tokenIndex = position1707, tokenIndex1707
-						if buffer[position] != rune('S') {
-							goto l1704
-						}
-						position++
-					}
-				l1707:
+					position2488 := position
 					{
-						position1709, tokenIndex1709 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1710
+						position2489, tokenIndex2489 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2490
 						}
 						position++
-						goto l1709
-					l1710:
-						position, tokenIndex = position1709, tokenIndex1709
-						if buffer[position] != rune('T') {
-							goto l1704
+						goto l2489
+					l2490:
+						position, tokenIndex = position2489, tokenIndex2489
+						if buffer[position] != rune('I') {
+							goto l2486
 						}
 						position++
 					}
-				l1709:
+				l2489:
 					{
-						position1711, tokenIndex1711 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1712
+						position2491, tokenIndex2491 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2492
 						}
 						position++
-						goto l1711
-					l1712:
-						position, tokenIndex = position1711, tokenIndex1711
-						if buffer[position] != rune('R') {
-							goto l1704
+						goto l2491
+					l2492:
+						position, tokenIndex = position2491, tokenIndex2491
+						if buffer[position] != rune('L') {
+							goto l2486
 						}
 						position++
 					}
-				l1711:
+				l2491:
 					{
-						position1713, tokenIndex1713 := position, tokenIndex
+						position2493, tokenIndex2493 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1714
+							goto l2494
 						}
 						position++
-						goto l1713
-					l1714:
-						position, tokenIndex = position1713, tokenIndex1713
+						goto l2493
+					l2494:
+						position, tokenIndex = position2493, tokenIndex2493
 						if buffer[position] != rune('I') {
-							goto l1704
+							goto l2486
 						}
 						position++
 					}
-				l1713:
+				l2493:
 					{
-						position1715, tokenIndex1715 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1716
+						position2495, tokenIndex2495 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2496
 						}
 						position++
-						goto l1715
-					l1716:
-						position, tokenIndex = position1715, tokenIndex1715
-						if buffer[position] != rune('N') {
-							goto l1704
+						goto l2495
+					l2496:
+						position, tokenIndex = position2495, tokenIndex2495
+						if buffer[position] != rune('K') {
+							goto l2486
 						}
 						position++
 					}
-				l1715:
+				l2495:
 					{
-						position1717, tokenIndex1717 := position, tokenIndex
-						if buffer[position] != rune('g') {
-							goto l1718
+						position2497, tokenIndex2497 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2498
 						}
 						position++
-						goto l1717
-					l1718:
-						position, tokenIndex = position1717, tokenIndex1717
-						if buffer[position] != rune('G') {
-							goto l1704
+						goto l2497
+					l2498:
+						position, tokenIndex = position2497, tokenIndex2497
+						if buffer[position] != rune('E') {
+							goto l2486
 						}
 						position++
 					}
-				l1717:
-					add(rulePegText, position1706)
+				l2497:
+					add(rulePegText, position2488)
 				}
-				if !_rules[ruleAction111]() {
-					goto l1704
+				if !_rules[ruleAction169]() {
+					goto l2486
 				}
-				add(ruleString, position1705)
+				add(ruleILike, position2487)
 			}
 			return true
-		l1704:
-			position, tokenIndex = position1704, tokenIndex1704
+		l2486:
+			position, tokenIndex = position2486, tokenIndex2486
 			return false
 		},
-		/* 143 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action112)> */
+		/* 206 NotILike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action170)> */
 		func() bool {
-			position1719, tokenIndex1719 := position, tokenIndex
+			position2499, tokenIndex2499 := position, tokenIndex
 			{
-				position1720 := position
+				position2500 := position
 				{
-					position1721 := position
+					position2501 := position
 					{
-						position1722, tokenIndex1722 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1723
+						position2502, tokenIndex2502 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2503
 						}
 						position++
-						goto l1722
-					l1723:
-						position, tokenIndex = position1722, tokenIndex1722
-						if buffer[position] != rune('B') {
-							goto l1719
+						goto l2502
+					l2503:
+						position, tokenIndex = position2502, tokenIndex2502
+						if buffer[position] != rune('N') {
+							goto l2499
 						}
 						position++
 					}
-				l1722:
+				l2502:
 					{
-						position1724, tokenIndex1724 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1725
+						position2504, tokenIndex2504 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2505
 						}
 						position++
-						goto l1724
-					l1725:
-						position, tokenIndex = position1724, tokenIndex1724
-						if buffer[position] != rune('L') {
-							goto l1719
+						goto l2504
+					l2505:
+						position, tokenIndex = position2504, tokenIndex2504
+						if buffer[position] != rune('O') {
+							goto l2499
 						}
 						position++
 					}
-				l1724:
+				l2504:
 					{
-						position1726, tokenIndex1726 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1727
+						position2506, tokenIndex2506 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2507
 						}
 						position++
-						goto l1726
-					l1727:
-						position, tokenIndex = position1726, tokenIndex1726
-						if buffer[position] != rune('O') {
-							goto l1719
+						goto l2506
+					l2507:
+						position, tokenIndex = position2506, tokenIndex2506
+						if buffer[position] != rune('T') {
+							goto l2499
 						}
 						position++
 					}
-				l1726:
+				l2506:
+					if !_rules[rulesp]() {
+						goto l2499
+					}
 					{
-						position1728, tokenIndex1728 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1729
+						position2508, tokenIndex2508 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2509
 						}
 						position++
-						goto l1728
-					l1729:
-						position, tokenIndex = position1728, tokenIndex1728
-						if buffer[position] != rune('B') {
-							goto l1719
+						goto l2508
+					l2509:
+						position, tokenIndex = position2508, tokenIndex2508
+						if buffer[position] != rune('I') {
+							goto l2499
 						}
 						position++
 					}
-				l1728:
-					add(rulePegText, position1721)
-				}
-				if !_rules[ruleAction112]() {
-					goto l1719
-				}
-				add(ruleBlob, position1720)
-			}
-			return true
-		l1719:
-			position, tokenIndex = position1719, tokenIndex1719
-			return false
-		},
-		/* 144 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action113)> */
-		func() bool {
-			position1730, tokenIndex1730 := position, tokenIndex
-			{
-				position1731 := position
-				{
-					position1732 := position
+				l2508:
 					{
-						position1733, tokenIndex1733 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1734
+						position2510, tokenIndex2510 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2511
 						}
 						position++
-						goto l1733
-					l1734:
-						position, tokenIndex = position1733, tokenIndex1733
-						if buffer[position] != rune('T') {
-							goto l1730
+						goto l2510
+					l2511:
+						position, tokenIndex = position2510, tokenIndex2510
+						if buffer[position] != rune('L') {
+							goto l2499
 						}
 						position++
 					}
-				l1733:
+				l2510:
 					{
-						position1735, tokenIndex1735 := position, tokenIndex
+						position2512, tokenIndex2512 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1736
+							goto l2513
 						}
 						position++
-						goto l1735
-					l1736:
-						position, tokenIndex = position1735, tokenIndex1735
+						goto l2512
+					l2513:
+						position, tokenIndex = position2512, tokenIndex2512
 						if buffer[position] != rune('I') {
-							goto l1730
+							goto l2499
 						}
 						position++
 					}
-				l1735:
+				l2512:
 					{
-						position1737, tokenIndex1737 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1738
+						position2514, tokenIndex2514 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2515
 						}
 						position++
-						goto l1737
-					l1738:
-						position, tokenIndex = position1737, tokenIndex1737
-						if buffer[position] != rune('M') {
-							goto l1730
+						goto l2514
+					l2515:
+						position, tokenIndex = position2514, tokenIndex2514
+						if buffer[position] != rune('K') {
+							goto l2499
 						}
 						position++
 					}
-				l1737:
+				l2514:
 					{
-						position1739, tokenIndex1739 := position, tokenIndex
+						position2516, tokenIndex2516 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1740
+							goto l2517
 						}
 						position++
-						goto l1739
-					l1740:
-						position, tokenIndex = position1739, tokenIndex1739
+						goto l2516
+					l2517:
+						position, tokenIndex = position2516, tokenIndex2516
 						if buffer[position] != rune('E') {
-							goto l1730
+							goto l2499
 						}
 						position++
 					}
-				l1739:
+				l2516:
+					add(rulePegText, position2501)
+				}
+				if !_rules[ruleAction170]() {
+					goto l2499
+				}
+				add(ruleNotILike, position2500)
+			}
+			return true
+		l2499:
+			position, tokenIndex = position2499, tokenIndex2499
+			return false
+		},
+		/* 207 SimilarTo <- <(<(('s' / 'S') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('l' / 'L') ('a' / 'A') ('r' / 'R') sp (('t' / 'T') ('o' / 'O')))> Action171)> */
+		func() bool {
+			position2518, tokenIndex2518 := position, tokenIndex
+			{
+				position2519 := position
+				{
+					position2520 := position
 					{
-						position1741, tokenIndex1741 := position, tokenIndex
+						position2521, tokenIndex2521 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1742
+							goto l2522
 						}
 						position++
-						goto l1741
-					l1742:
-						position, tokenIndex = position1741, tokenIndex1741
+						goto l2521
+					l2522:
+						position, tokenIndex = position2521, tokenIndex2521
 						if buffer[position] != rune('S') {
-							goto l1730
+							goto l2518
 						}
 						position++
 					}
-				l1741:
+				l2521:
 					{
-						position1743, tokenIndex1743 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1744
+						position2523, tokenIndex2523 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2524
 						}
 						position++
-						goto l1743
-					l1744:
-						position, tokenIndex = position1743, tokenIndex1743
-						if buffer[position] != rune('T') {
-							goto l1730
+						goto l2523
+					l2524:
+						position, tokenIndex = position2523, tokenIndex2523
+						if buffer[position] != rune('I') {
+							goto l2518
 						}
 						position++
 					}
-				l1743:
+				l2523:
 					{
-						position1745, tokenIndex1745 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1746
+						position2525, tokenIndex2525 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2526
 						}
 						position++
-						goto l1745
-					l1746:
-						position, tokenIndex = position1745, tokenIndex1745
-						if buffer[position] != rune('A') {
-							goto l1730
+						goto l2525
+					l2526:
+						position, tokenIndex = position2525, tokenIndex2525
+						if buffer[position] != rune('M') {
+							goto l2518
 						}
 						position++
 					}
-				l1745:
+				l2525:
 					{
-						position1747, tokenIndex1747 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1748
+						position2527, tokenIndex2527 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2528
 						}
 						position++
-						goto l1747
-					l1748:
-						position, tokenIndex = position1747, tokenIndex1747
-						if buffer[position] != rune('M') {
-							goto l1730
+						goto l2527
+					l2528:
+						position, tokenIndex = position2527, tokenIndex2527
+						if buffer[position] != rune('I') {
+							goto l2518
 						}
 						position++
 					}
-				l1747:
+				l2527:
 					{
-						position1749, tokenIndex1749 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1750
+						position2529, tokenIndex2529 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2530
 						}
 						position++
-						goto l1749
-					l1750:
-						position, tokenIndex = position1749, tokenIndex1749
-						if buffer[position] != rune('P') {
-							goto l1730
+						goto l2529
+					l2530:
+						position, tokenIndex = position2529, tokenIndex2529
+						if buffer[position] != rune('L') {
+							goto l2518
 						}
 						position++
 					}
-				l1749:
-					add(rulePegText, position1732)
-				}
-				if !_rules[ruleAction113]() {
-					goto l1730
-				}
-				add(ruleTimestamp, position1731)
-			}
-			return true
-		l1730:
-			position, tokenIndex = position1730, tokenIndex1730
-			return false
-		},
-		/* 145 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action114)> */
-		func() bool {
-			position1751, tokenIndex1751 := position, tokenIndex
-			{
-				position1752 := position
-				{
-					position1753 := position
+				l2529:
 					{
-						position1754, tokenIndex1754 := position, tokenIndex
+						position2531, tokenIndex2531 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1755
+							goto l2532
 						}
 						position++
-						goto l1754
-					l1755:
-						position, tokenIndex = position1754, tokenIndex1754
+						goto l2531
+					l2532:
+						position, tokenIndex = position2531, tokenIndex2531
 						if buffer[position] != rune('A') {
-							goto l1751
+							goto l2518
 						}
 						position++
 					}
-				l1754:
+				l2531:
 					{
-						position1756, tokenIndex1756 := position, tokenIndex
+						position2533, tokenIndex2533 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1757
+							goto l2534
 						}
 						position++
-						goto l1756
-					l1757:
-						position, tokenIndex = position1756, tokenIndex1756
+						goto l2533
+					l2534:
+						position, tokenIndex = position2533, tokenIndex2533
 						if buffer[position] != rune('R') {
-							goto l1751
+							goto l2518
 						}
 						position++
 					}
-				l1756:
-					{
-						position1758, tokenIndex1758 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1759
-						}
-						position++
-						goto l1758
-					l1759:
-						position, tokenIndex = position1758, tokenIndex1758
-						if buffer[position] != rune('R') {
-							goto l1751
-						}
-						position++
+				l2533:
+					if !_rules[rulesp]() {
+						goto l2518
 					}
-				l1758:
 					{
-						position1760, tokenIndex1760 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1761
+						position2535, tokenIndex2535 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2536
 						}
 						position++
-						goto l1760
-					l1761:
-						position, tokenIndex = position1760, tokenIndex1760
-						if buffer[position] != rune('A') {
-							goto l1751
+						goto l2535
+					l2536:
+						position, tokenIndex = position2535, tokenIndex2535
+						if buffer[position] != rune('T') {
+							goto l2518
 						}
 						position++
 					}
-				l1760:
+				l2535:
 					{
-						position1762, tokenIndex1762 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l1763
+						position2537, tokenIndex2537 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2538
 						}
 						position++
-						goto l1762
-					l1763:
-						position, tokenIndex = position1762, tokenIndex1762
-						if buffer[position] != rune('Y') {
-							goto l1751
+						goto l2537
+					l2538:
+						position, tokenIndex = position2537, tokenIndex2537
+						if buffer[position] != rune('O') {
+							goto l2518
 						}
 						position++
 					}
-				l1762:
-					add(rulePegText, position1753)
+				l2537:
+					add(rulePegText, position2520)
 				}
-				if !_rules[ruleAction114]() {
-					goto l1751
+				if !_rules[ruleAction171]() {
+					goto l2518
 				}
-				add(ruleArray, position1752)
+				add(ruleSimilarTo, position2519)
 			}
 			return true
-		l1751:
-			position, tokenIndex = position1751, tokenIndex1751
+		l2518:
+			position, tokenIndex = position2518, tokenIndex2518
 			return false
 		},
-		/* 146 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action115)> */
+		/* 208 NotSimilarTo <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('s' / 'S') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('l' / 'L') ('a' / 'A') ('r' / 'R')) sp (('t' / 'T') ('o' / 'O')))> Action172)> */
 		func() bool {
-			position1764, tokenIndex1764 := position, tokenIndex
+			position2539, tokenIndex2539 := position, tokenIndex
 			{
-				position1765 := position
+				position2540 := position
 				{
-					position1766 := position
+					position2541 := position
 					{
-						position1767, tokenIndex1767 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1768
+						position2542, tokenIndex2542 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2543
 						}
 						position++
-						goto l1767
-					l1768:
-						position, tokenIndex = position1767, tokenIndex1767
-						if buffer[position] != rune('M') {
-							goto l1764
+						goto l2542
+					l2543:
+						position, tokenIndex = position2542, tokenIndex2542
+						if buffer[position] != rune('N') {
+							goto l2539
 						}
 						position++
 					}
-				l1767:
+				l2542:
 					{
-						position1769, tokenIndex1769 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1770
+						position2544, tokenIndex2544 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2545
 						}
 						position++
-						goto l1769
-					l1770:
-						position, tokenIndex = position1769, tokenIndex1769
-						if buffer[position] != rune('A') {
-							goto l1764
+						goto l2544
+					l2545:
+						position, tokenIndex = position2544, tokenIndex2544
+						if buffer[position] != rune('O') {
+							goto l2539
 						}
 						position++
 					}
-				l1769:
+				l2544:
 					{
-						position1771, tokenIndex1771 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1772
+						position2546, tokenIndex2546 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2547
 						}
 						position++
-						goto l1771
-					l1772:
-						position, tokenIndex = position1771, tokenIndex1771
-						if buffer[position] != rune('P') {
-							goto l1764
+						goto l2546
+					l2547:
+						position, tokenIndex = position2546, tokenIndex2546
+						if buffer[position] != rune('T') {
+							goto l2539
 						}
 						position++
 					}
-				l1771:
-					add(rulePegText, position1766)
-				}
-				if !_rules[ruleAction115]() {
-					goto l1764
-				}
-				add(ruleMap, position1765)
-			}
-			return true
-		l1764:
-			position, tokenIndex = position1764, tokenIndex1764
-			return false
-		},
-		/* 147 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action116)> */
-		func() bool {
-			position1773, tokenIndex1773 := position, tokenIndex
-			{
-				position1774 := position
-				{
-					position1775 := position
+				l2546:
+					if !_rules[rulesp]() {
+						goto l2539
+					}
 					{
-						position1776, tokenIndex1776 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1777
+						position2548, tokenIndex2548 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2549
 						}
 						position++
-						goto l1776
-					l1777:
-						position, tokenIndex = position1776, tokenIndex1776
-						if buffer[position] != rune('O') {
-							goto l1773
+						goto l2548
+					l2549:
+						position, tokenIndex = position2548, tokenIndex2548
+						if buffer[position] != rune('S') {
+							goto l2539
 						}
 						position++
 					}
-				l1776:
+				l2548:
 					{
-						position1778, tokenIndex1778 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1779
+						position2550, tokenIndex2550 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2551
 						}
 						position++
-						goto l1778
-					l1779:
-						position, tokenIndex = position1778, tokenIndex1778
-						if buffer[position] != rune('R') {
-							goto l1773
+						goto l2550
+					l2551:
+						position, tokenIndex = position2550, tokenIndex2550
+						if buffer[position] != rune('I') {
+							goto l2539
 						}
 						position++
 					}
-				l1778:
-					add(rulePegText, position1775)
-				}
-				if !_rules[ruleAction116]() {
-					goto l1773
-				}
-				add(ruleOr, position1774)
-			}
-			return true
-		l1773:
-			position, tokenIndex = position1773, tokenIndex1773
-			return false
-		},
-		/* 148 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action117)> */
-		func() bool {
-			position1780, tokenIndex1780 := position, tokenIndex
-			{
-				position1781 := position
-				{
-					position1782 := position
+				l2550:
 					{
-						position1783, tokenIndex1783 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1784
+						position2552, tokenIndex2552 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2553
 						}
 						position++
-						goto l1783
-					l1784:
-						position, tokenIndex = position1783, tokenIndex1783
-						if buffer[position] != rune('A') {
-							goto l1780
+						goto l2552
+					l2553:
+						position, tokenIndex = position2552, tokenIndex2552
+						if buffer[position] != rune('M') {
+							goto l2539
 						}
 						position++
 					}
-				l1783:
+				l2552:
 					{
-						position1785, tokenIndex1785 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1786
+						position2554, tokenIndex2554 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2555
 						}
 						position++
-						goto l1785
-					l1786:
-						position, tokenIndex = position1785, tokenIndex1785
-						if buffer[position] != rune('N') {
-							goto l1780
+						goto l2554
+					l2555:
+						position, tokenIndex = position2554, tokenIndex2554
+						if buffer[position] != rune('I') {
+							goto l2539
 						}
 						position++
 					}
-				l1785:
+				l2554:
 					{
-						position1787, tokenIndex1787 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1788
+						position2556, tokenIndex2556 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2557
 						}
 						position++
-						goto l1787
-					l1788:
-						position, tokenIndex = position1787, tokenIndex1787
-						if buffer[position] != rune('D') {
-							goto l1780
+						goto l2556
+					l2557:
+						position, tokenIndex = position2556, tokenIndex2556
+						if buffer[position] != rune('L') {
+							goto l2539
 						}
 						position++
 					}
-				l1787:
-					add(rulePegText, position1782)
-				}
-				if !_rules[ruleAction117]() {
-					goto l1780
-				}
-				add(ruleAnd, position1781)
-			}
-			return true
-		l1780:
-			position, tokenIndex = position1780, tokenIndex1780
-			return false
-		},
-		/* 149 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action118)> */
-		func() bool {
-			position1789, tokenIndex1789 := position, tokenIndex
-			{
-				position1790 := position
-				{
-					position1791 := position
+				l2556:
 					{
-						position1792, tokenIndex1792 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1793
+						position2558, tokenIndex2558 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2559
 						}
 						position++
-						goto l1792
-					l1793:
-						position, tokenIndex = position1792, tokenIndex1792
-						if buffer[position] != rune('N') {
-							goto l1789
+						goto l2558
+					l2559:
+						position, tokenIndex = position2558, tokenIndex2558
+						if buffer[position] != rune('A') {
+							goto l2539
 						}
 						position++
 					}
-				l1792:
+				l2558:
 					{
-						position1794, tokenIndex1794 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1795
+						position2560, tokenIndex2560 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2561
 						}
 						position++
-						goto l1794
-					l1795:
-						position, tokenIndex = position1794, tokenIndex1794
-						if buffer[position] != rune('O') {
-							goto l1789
+						goto l2560
+					l2561:
+						position, tokenIndex = position2560, tokenIndex2560
+						if buffer[position] != rune('R') {
+							goto l2539
 						}
 						position++
 					}
-				l1794:
+				l2560:
+					if !_rules[rulesp]() {
+						goto l2539
+					}
 					{
-						position1796, tokenIndex1796 := position, tokenIndex
+						position2562, tokenIndex2562 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1797
+							goto l2563
 						}
 						position++
-						goto l1796
-					l1797:
-						position, tokenIndex = position1796, tokenIndex1796
+						goto l2562
+					l2563:
+						position, tokenIndex = position2562, tokenIndex2562
 						if buffer[position] != rune('T') {
-							goto l1789
+							goto l2539
+						}
+						position++
+					}
+				l2562:
+					{
+						position2564, tokenIndex2564 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2565
+						}
+						position++
+						goto l2564
+					l2565:
+						position, tokenIndex = position2564, tokenIndex2564
+						if buffer[position] != rune('O') {
+							goto l2539
 						}
 						position++
 					}
-				l1796:
-					add(rulePegText, position1791)
+				l2564:
+					add(rulePegText, position2541)
 				}
-				if !_rules[ruleAction118]() {
-					goto l1789
+				if !_rules[ruleAction172]() {
+					goto l2539
 				}
-				add(ruleNot, position1790)
+				add(ruleNotSimilarTo, position2540)
 			}
 			return true
-		l1789:
-			position, tokenIndex = position1789, tokenIndex1789
+		l2539:
+			position, tokenIndex = position2539, tokenIndex2539
 			return false
 		},
-		/* 150 Equal <- <(<'='> Action119)> */
+		/* 209 RegexpMatch <- <(<'~'> Action173)> */
 		func() bool {
-			position1798, tokenIndex1798 := position, tokenIndex
+			position2566, tokenIndex2566 := position, tokenIndex
 			{
-				position1799 := position
+				position2567 := position
 				{
-					position1800 := position
-					if buffer[position] != rune('=') {
-						goto l1798
+					position2568 := position
+					if buffer[position] != rune('~') {
+						goto l2566
 					}
 					position++
-					add(rulePegText, position1800)
+					add(rulePegText, position2568)
 				}
-				if !_rules[ruleAction119]() {
-					goto l1798
+				if !_rules[ruleAction173]() {
+					goto l2566
 				}
-				add(ruleEqual, position1799)
+				add(ruleRegexpMatch, position2567)
 			}
 			return true
-		l1798:
-			position, tokenIndex = position1798, tokenIndex1798
+		l2566:
+			position, tokenIndex = position2566, tokenIndex2566
 			return false
 		},
-		/* 151 Less <- <(<'<'> Action120)> */
+		/* 210 NotRegexpMatch <- <(<('!' '~')> Action174)> */
 		func() bool {
-			position1801, tokenIndex1801 := position, tokenIndex
+			position2569, tokenIndex2569 := position, tokenIndex
 			{
-				position1802 := position
+				position2570 := position
 				{
-					position1803 := position
-					if buffer[position] != rune('<') {
-						goto l1801
+					position2571 := position
+					if buffer[position] != rune('!') {
+						goto l2569
 					}
 					position++
-					add(rulePegText, position1803)
+					if buffer[position] != rune('~') {
+						goto l2569
+					}
+					position++
+					add(rulePegText, position2571)
 				}
-				if !_rules[ruleAction120]() {
-					goto l1801
+				if !_rules[ruleAction174]() {
+					goto l2569
 				}
-				add(ruleLess, position1802)
+				add(ruleNotRegexpMatch, position2570)
 			}
 			return true
-		l1801:
-			position, tokenIndex = position1801, tokenIndex1801
+		l2569:
+			position, tokenIndex = position2569, tokenIndex2569
 			return false
 		},
-		/* 152 LessOrEqual <- <(<('<' '=')> Action121)> */
+		/* 211 RegexpIMatch <- <(<('~' '*')> Action175)> */
 		func() bool {
-			position1804, tokenIndex1804 := position, tokenIndex
+			position2572, tokenIndex2572 := position, tokenIndex
 			{
-				position1805 := position
+				position2573 := position
 				{
-					position1806 := position
-					if buffer[position] != rune('<') {
-						goto l1804
+					position2574 := position
+					if buffer[position] != rune('~') {
+						goto l2572
 					}
 					position++
-					if buffer[position] != rune('=') {
-						goto l1804
+					if buffer[position] != rune('*') {
+						goto l2572
 					}
 					position++
-					add(rulePegText, position1806)
+					add(rulePegText, position2574)
 				}
-				if !_rules[ruleAction121]() {
-					goto l1804
+				if !_rules[ruleAction175]() {
+					goto l2572
 				}
-				add(ruleLessOrEqual, position1805)
+				add(ruleRegexpIMatch, position2573)
 			}
 			return true
-		l1804:
-			position, tokenIndex = position1804, tokenIndex1804
+		l2572:
+			position, tokenIndex = position2572, tokenIndex2572
 			return false
 		},
-		/* 153 Greater <- <(<'>'> Action122)> */
+		/* 212 NotRegexpIMatch <- <(<('!' '~' '*')> Action176)> */
 		func() bool {
-			position1807, tokenIndex1807 := position, tokenIndex
+			position2575, tokenIndex2575 := position, tokenIndex
 			{
-				position1808 := position
+				position2576 := position
 				{
-					position1809 := position
-					if buffer[position] != rune('>') {
-						goto l1807
+					position2577 := position
+					if buffer[position] != rune('!') {
+						goto l2575
 					}
 					position++
-					add(rulePegText, position1809)
-				}
-				if !_rules[ruleAction122]() {
-					goto l1807
-				}
-				add(ruleGreater, position1808)
-			}
-			return true
-		l1807:
-			position, tokenIndex = position1807, tokenIndex1807
-			return false
-		},
-		/* 154 GreaterOrEqual <- <(<('>' '=')> Action123)> */
-		func() bool {
-			position1810, tokenIndex1810 := position, tokenIndex
-			{
-				position1811 := position
-				{
-					position1812 := position
-					if buffer[position] != rune('>') {
-						goto l1810
+					if buffer[position] != rune('~') {
+						goto l2575
 					}
 					position++
-					if buffer[position] != rune('=') {
-						goto l1810
+					if buffer[position] != rune('*') {
+						goto l2575
 					}
 					position++
-					add(rulePegText, position1812)
-				}
-				if !_rules[ruleAction123]() {
-					goto l1810
-				}
-				add(ruleGreaterOrEqual, position1811)
-			}
-			return true
-		l1810:
-			position, tokenIndex = position1810, tokenIndex1810
-			return false
-		},
-		/* 155 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action124)> */
-		func() bool {
-			position1813, tokenIndex1813 := position, tokenIndex
-			{
-				position1814 := position
-				{
-					position1815 := position
-					{
-						position1816, tokenIndex1816 := position, tokenIndex
-						if buffer[position] != rune('!') {
-							goto l1817
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l1817
-						}
-						position++
-						goto l1816
-					l1817:
-						position, tokenIndex = position1816, tokenIndex1816
-						if buffer[position] != rune('<') {
-							goto l1813
-						}
-						position++
-						if buffer[position] != rune('>') {
-							goto l1813
-						}
-						position++
-					}
-				l1816:
-					add(rulePegText, position1815)
+					add(rulePegText, position2577)
 				}
-				if !_rules[ruleAction124]() {
-					goto l1813
+				if !_rules[ruleAction176]() {
+					goto l2575
 				}
-				add(ruleNotEqual, position1814)
+				add(ruleNotRegexpIMatch, position2576)
 			}
 			return true
-		l1813:
-			position, tokenIndex = position1813, tokenIndex1813
+		l2575:
+			position, tokenIndex = position2575, tokenIndex2575
 			return false
 		},
-		/* 156 Concat <- <(<('|' '|')> Action125)> */
+		/* 213 Concat <- <(<('|' '|')> Action177)> */
 		func() bool {
-			position1818, tokenIndex1818 := position, tokenIndex
+			position2578, tokenIndex2578 := position, tokenIndex
 			{
-				position1819 := position
+				position2579 := position
 				{
-					position1820 := position
+					position2580 := position
 					if buffer[position] != rune('|') {
-						goto l1818
+						goto l2578
 					}
 					position++
 					if buffer[position] != rune('|') {
-						goto l1818
+						goto l2578
 					}
 					position++
-					add(rulePegText, position1820)
+					add(rulePegText, position2580)
 				}
-				if !_rules[ruleAction125]() {
-					goto l1818
+				if !_rules[ruleAction177]() {
+					goto l2578
 				}
-				add(ruleConcat, position1819)
+				add(ruleConcat, position2579)
 			}
 			return true
-		l1818:
-			position, tokenIndex = position1818, tokenIndex1818
+		l2578:
+			position, tokenIndex = position2578, tokenIndex2578
 			return false
 		},
-		/* 157 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action126)> */
+		/* 214 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action178)> */
 		func() bool {
-			position1821, tokenIndex1821 := position, tokenIndex
+			position2581, tokenIndex2581 := position, tokenIndex
 			{
-				position1822 := position
+				position2582 := position
 				{
-					position1823 := position
+					position2583 := position
 					{
-						position1824, tokenIndex1824 := position, tokenIndex
+						position2584, tokenIndex2584 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1825
+							goto l2585
 						}
 						position++
-						goto l1824
-					l1825:
-						position, tokenIndex = position1824, tokenIndex1824
+						goto l2584
+					l2585:
+						position, tokenIndex = position2584, tokenIndex2584
 						if buffer[position] != rune('I') {
-							goto l1821
+							goto l2581
 						}
 						position++
 					}
-				l1824:
+				l2584:
 					{
-						position1826, tokenIndex1826 := position, tokenIndex
+						position2586, tokenIndex2586 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1827
+							goto l2587
 						}
 						position++
-						goto l1826
-					l1827:
-						position, tokenIndex = position1826, tokenIndex1826
+						goto l2586
+					l2587:
+						position, tokenIndex = position2586, tokenIndex2586
 						if buffer[position] != rune('S') {
-							goto l1821
+							goto l2581
 						}
 						position++
 					}
-				l1826:
-					add(rulePegText, position1823)
+				l2586:
+					add(rulePegText, position2583)
 				}
-				if !_rules[ruleAction126]() {
-					goto l1821
+				if !_rules[ruleAction178]() {
+					goto l2581
 				}
-				add(ruleIs, position1822)
+				add(ruleIs, position2582)
 			}
 			return true
-		l1821:
-			position, tokenIndex = position1821, tokenIndex1821
+		l2581:
+			position, tokenIndex = position2581, tokenIndex2581
 			return false
 		},
-		/* 158 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action127)> */
+		/* 215 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action179)> */
 		func() bool {
-			position1828, tokenIndex1828 := position, tokenIndex
+			position2588, tokenIndex2588 := position, tokenIndex
 			{
-				position1829 := position
+				position2589 := position
 				{
-					position1830 := position
+					position2590 := position
 					{
-						position1831, tokenIndex1831 := position, tokenIndex
+						position2591, tokenIndex2591 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1832
+							goto l2592
 						}
 						position++
-						goto l1831
-					l1832:
-						position, tokenIndex = position1831, tokenIndex1831
+						goto l2591
+					l2592:
+						position, tokenIndex = position2591, tokenIndex2591
 						if buffer[position] != rune('I') {
-							goto l1828
+							goto l2588
 						}
 						position++
 					}
-				l1831:
+				l2591:
 					{
-						position1833, tokenIndex1833 := position, tokenIndex
+						position2593, tokenIndex2593 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1834
+							goto l2594
 						}
 						position++
-						goto l1833
-					l1834:
-						position, tokenIndex = position1833, tokenIndex1833
+						goto l2593
+					l2594:
+						position, tokenIndex = position2593, tokenIndex2593
 						if buffer[position] != rune('S') {
-							goto l1828
+							goto l2588
 						}
 						position++
 					}
-				l1833:
+				l2593:
 					if !_rules[rulesp]() {
-						goto l1828
+						goto l2588
 					}
 					{
-						position1835, tokenIndex1835 := position, tokenIndex
+						position2595, tokenIndex2595 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1836
+							goto l2596
 						}
 						position++
-						goto l1835
-					l1836:
-						position, tokenIndex = position1835, tokenIndex1835
+						goto l2595
+					l2596:
+						position, tokenIndex = position2595, tokenIndex2595
 						if buffer[position] != rune('N') {
-							goto l1828
+							goto l2588
 						}
 						position++
 					}
-				l1835:
+				l2595:
 					{
-						position1837, tokenIndex1837 := position, tokenIndex
+						position2597, tokenIndex2597 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1838
+							goto l2598
 						}
 						position++
-						goto l1837
-					l1838:
-						position, tokenIndex = position1837, tokenIndex1837
+						goto l2597
+					l2598:
+						position, tokenIndex = position2597, tokenIndex2597
 						if buffer[position] != rune('O') {
-							goto l1828
+							goto l2588
 						}
 						position++
 					}
-				l1837:
+				l2597:
 					{
-						position1839, tokenIndex1839 := position, tokenIndex
+						position2599, tokenIndex2599 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l1840
+							goto l2600
 						}
 						position++
-						goto l1839
-					l1840:
-						position, tokenIndex = position1839, tokenIndex1839
+						goto l2599
+					l2600:
+						position, tokenIndex = position2599, tokenIndex2599
 						if buffer[position] != rune('T') {
-							goto l1828
+							goto l2588
 						}
 						position++
 					}
-				l1839:
-					add(rulePegText, position1830)
+				l2599:
+					add(rulePegText, position2590)
 				}
-				if !_rules[ruleAction127]() {
-					goto l1828
+				if !_rules[ruleAction179]() {
+					goto l2588
 				}
-				add(ruleIsNot, position1829)
+				add(ruleIsNot, position2589)
 			}
 			return true
-		l1828:
-			position, tokenIndex = position1828, tokenIndex1828
+		l2588:
+			position, tokenIndex = position2588, tokenIndex2588
 			return false
 		},
-		/* 159 Plus <- <(<'+'> Action128)> */
+		/* 216 Plus <- <(<'+'> Action180)> */
 		func() bool {
-			position1841, tokenIndex1841 := position, tokenIndex
+			position2601, tokenIndex2601 := position, tokenIndex
 			{
-				position1842 := position
+				position2602 := position
 				{
-					position1843 := position
+					position2603 := position
 					if buffer[position] != rune('+') {
-						goto l1841
+						goto l2601
 					}
 					position++
-					add(rulePegText, position1843)
+					add(rulePegText, position2603)
 				}
-				if !_rules[ruleAction128]() {
-					goto l1841
+				if !_rules[ruleAction180]() {
+					goto l2601
 				}
-				add(rulePlus, position1842)
+				add(rulePlus, position2602)
 			}
 			return true
-		l1841:
-			position, tokenIndex = position1841, tokenIndex1841
+		l2601:
+			position, tokenIndex = position2601, tokenIndex2601
 			return false
 		},
-		/* 160 Minus <- <(<'-'> Action129)> */
+		/* 217 Minus <- <(<'-'> Action181)> */
 		func() bool {
-			position1844, tokenIndex1844 := position, tokenIndex
+			position2604, tokenIndex2604 := position, tokenIndex
 			{
-				position1845 := position
+				position2605 := position
 				{
-					position1846 := position
+					position2606 := position
 					if buffer[position] != rune('-') {
-						goto l1844
+						goto l2604
 					}
 					position++
-					add(rulePegText, position1846)
+					add(rulePegText, position2606)
 				}
-				if !_rules[ruleAction129]() {
-					goto l1844
+				if !_rules[ruleAction181]() {
+					goto l2604
 				}
-				add(ruleMinus, position1845)
+				add(ruleMinus, position2605)
 			}
 			return true
-		l1844:
-			position, tokenIndex = position1844, tokenIndex1844
+		l2604:
+			position, tokenIndex = position2604, tokenIndex2604
 			return false
 		},
-		/* 161 Multiply <- <(<'*'> Action130)> */
+		/* 218 Multiply <- <(<'*'> Action182)> */
 		func() bool {
-			position1847, tokenIndex1847 := position, tokenIndex
+			position2607, tokenIndex2607 := position, tokenIndex
 			{
-				position1848 := position
+				position2608 := position
 				{
-					position1849 := position
+					position2609 := position
 					if buffer[position] != rune('*') {
-						goto l1847
+						goto l2607
 					}
 					position++
-					add(rulePegText, position1849)
+					add(rulePegText, position2609)
 				}
-				if !_rules[ruleAction130]() {
-					goto l1847
+				if !_rules[ruleAction182]() {
+					goto l2607
 				}
-				add(ruleMultiply, position1848)
+				add(ruleMultiply, position2608)
 			}
 			return true
-		l1847:
-			position, tokenIndex = position1847, tokenIndex1847
+		l2607:
+			position, tokenIndex = position2607, tokenIndex2607
 			return false
 		},
-		/* 162 Divide <- <(<'/'> Action131)> */
+		/* 219 Divide <- <(<'/'> Action183)> */
 		func() bool {
-			position1850, tokenIndex1850 := position, tokenIndex
+			position2610, tokenIndex2610 := position, tokenIndex
 			{
-				position1851 := position
+				position2611 := position
 				{
-					position1852 := position
+					position2612 := position
 					if buffer[position] != rune('/') {
-						goto l1850
+						goto l2610
 					}
 					position++
-					add(rulePegText, position1852)
+					add(rulePegText, position2612)
 				}
-				if !_rules[ruleAction131]() {
-					goto l1850
+				if !_rules[ruleAction183]() {
+					goto l2610
 				}
-				add(ruleDivide, position1851)
+				add(ruleDivide, position2611)
 			}
 			return true
-		l1850:
-			position, tokenIndex = position1850, tokenIndex1850
+		l2610:
+			position, tokenIndex = position2610, tokenIndex2610
 			return false
 		},
-		/* 163 Modulo <- <(<'%'> Action132)> */
+		/* 220 Modulo <- <(<'%'> Action184)> */
 		func() bool {
-			position1853, tokenIndex1853 := position, tokenIndex
+			position2613, tokenIndex2613 := position, tokenIndex
 			{
-				position1854 := position
+				position2614 := position
 				{
-					position1855 := position
+					position2615 := position
 					if buffer[position] != rune('%') {
-						goto l1853
+						goto l2613
 					}
 					position++
-					add(rulePegText, position1855)
+					add(rulePegText, position2615)
 				}
-				if !_rules[ruleAction132]() {
-					goto l1853
+				if !_rules[ruleAction184]() {
+					goto l2613
+				}
+				add(ruleModulo, position2614)
+			}
+			return true
+		l2613:
+			position, tokenIndex = position2613, tokenIndex2613
+			return false
+		},
+		/* 221 AtTimeZone <- <(<(('a' / 'A') ('t' / 'T') sp (('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E')) sp (('z' / 'Z') ('o' / 'O') ('n' / 'N') ('e' / 'E')))> Action185)> */
+		func() bool {
+			position2616, tokenIndex2616 := position, tokenIndex
+			{
+				position2617 := position
+				{
+					position2618 := position
+					{
+						position2619, tokenIndex2619 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2620
+						}
+						position++
+						goto l2619
+					l2620:
+						position, tokenIndex = position2619, tokenIndex2619
+						if buffer[position] != rune('A') {
+							goto l2616
+						}
+						position++
+					}
+				l2619:
+					{
+						position2621, tokenIndex2621 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2622
+						}
+						position++
+						goto l2621
+					l2622:
+						position, tokenIndex = position2621, tokenIndex2621
+						if buffer[position] != rune('T') {
+							goto l2616
+						}
+						position++
+					}
+				l2621:
+					if !_rules[rulesp]() {
+						goto l2616
+					}
+					{
+						position2623, tokenIndex2623 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2624
+						}
+						position++
+						goto l2623
+					l2624:
+						position, tokenIndex = position2623, tokenIndex2623
+						if buffer[position] != rune('T') {
+							goto l2616
+						}
+						position++
+					}
+				l2623:
+					{
+						position2625, tokenIndex2625 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2626
+						}
+						position++
+						goto l2625
+					l2626:
+						position, tokenIndex = position2625, tokenIndex2625
+						if buffer[position] != rune('I') {
+							goto l2616
+						}
+						position++
+					}
+				l2625:
+					{
+						position2627, tokenIndex2627 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2628
+						}
+						position++
+						goto l2627
+					l2628:
+						position, tokenIndex = position2627, tokenIndex2627
+						if buffer[position] != rune('M') {
+							goto l2616
+						}
+						position++
+					}
+				l2627:
+					{
+						position2629, tokenIndex2629 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2630
+						}
+						position++
+						goto l2629
+					l2630:
+						position, tokenIndex = position2629, tokenIndex2629
+						if buffer[position] != rune('E') {
+							goto l2616
+						}
+						position++
+					}
+				l2629:
+					if !_rules[rulesp]() {
+						goto l2616
+					}
+					{
+						position2631, tokenIndex2631 := position, tokenIndex
+						if buffer[position] != rune('z') {
+							goto l2632
+						}
+						position++
+						goto l2631
+					l2632:
+						position, tokenIndex = position2631, tokenIndex2631
+						if buffer[position] != rune('Z') {
+							goto l2616
+						}
+						position++
+					}
+				l2631:
+					{
+						position2633, tokenIndex2633 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2634
+						}
+						position++
+						goto l2633
+					l2634:
+						position, tokenIndex = position2633, tokenIndex2633
+						if buffer[position] != rune('O') {
+							goto l2616
+						}
+						position++
+					}
+				l2633:
+					{
+						position2635, tokenIndex2635 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2636
+						}
+						position++
+						goto l2635
+					l2636:
+						position, tokenIndex = position2635, tokenIndex2635
+						if buffer[position] != rune('N') {
+							goto l2616
+						}
+						position++
+					}
+				l2635:
+					{
+						position2637, tokenIndex2637 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2638
+						}
+						position++
+						goto l2637
+					l2638:
+						position, tokenIndex = position2637, tokenIndex2637
+						if buffer[position] != rune('E') {
+							goto l2616
+						}
+						position++
+					}
+				l2637:
+					add(rulePegText, position2618)
+				}
+				if !_rules[ruleAction185]() {
+					goto l2616
 				}
-				add(ruleModulo, position1854)
+				add(ruleAtTimeZone, position2617)
 			}
 			return true
-		l1853:
-			position, tokenIndex = position1853, tokenIndex1853
+		l2616:
+			position, tokenIndex = position2616, tokenIndex2616
 			return false
 		},
-		/* 164 UnaryMinus <- <(<'-'> Action133)> */
+		/* 222 UnaryMinus <- <(<'-'> Action186)> */
 		func() bool {
-			position1856, tokenIndex1856 := position, tokenIndex
+			position2639, tokenIndex2639 := position, tokenIndex
 			{
-				position1857 := position
+				position2640 := position
 				{
-					position1858 := position
+					position2641 := position
 					if buffer[position] != rune('-') {
-						goto l1856
+						goto l2639
 					}
 					position++
-					add(rulePegText, position1858)
+					add(rulePegText, position2641)
 				}
-				if !_rules[ruleAction133]() {
-					goto l1856
+				if !_rules[ruleAction186]() {
+					goto l2639
 				}
-				add(ruleUnaryMinus, position1857)
+				add(ruleUnaryMinus, position2640)
 			}
 			return true
-		l1856:
-			position, tokenIndex = position1856, tokenIndex1856
+		l2639:
+			position, tokenIndex = position2639, tokenIndex2639
 			return false
 		},
-		/* 165 Identifier <- <(<ident> Action134)> */
+		/* 223 Identifier <- <(<ident> Action187)> */
 		func() bool {
-			position1859, tokenIndex1859 := position, tokenIndex
+			position2642, tokenIndex2642 := position, tokenIndex
 			{
-				position1860 := position
+				position2643 := position
 				{
-					position1861 := position
+					position2644 := position
 					if !_rules[ruleident]() {
-						goto l1859
+						goto l2642
 					}
-					add(rulePegText, position1861)
+					add(rulePegText, position2644)
 				}
-				if !_rules[ruleAction134]() {
-					goto l1859
+				if !_rules[ruleAction187]() {
+					goto l2642
 				}
-				add(ruleIdentifier, position1860)
+				add(ruleIdentifier, position2643)
 			}
 			return true
-		l1859:
-			position, tokenIndex = position1859, tokenIndex1859
+		l2642:
+			position, tokenIndex = position2642, tokenIndex2642
 			return false
 		},
-		/* 166 TargetIdentifier <- <(<('*' / jsonSetPath)> Action135)> */
+		/* 224 TargetIdentifier <- <(<('*' / jsonSetPath)> Action188)> */
 		func() bool {
-			position1862, tokenIndex1862 := position, tokenIndex
+			position2645, tokenIndex2645 := position, tokenIndex
 			{
-				position1863 := position
+				position2646 := position
 				{
-					position1864 := position
+					position2647 := position
 					{
-						position1865, tokenIndex1865 := position, tokenIndex
+						position2648, tokenIndex2648 := position, tokenIndex
 						if buffer[position] != rune('*') {
-							goto l1866
+							goto l2649
 						}
 						position++
-						goto l1865
-					l1866:
-						position, tokenIndex = position1865, tokenIndex1865
+						goto l2648
+					l2649:
+						position, tokenIndex = position2648, tokenIndex2648
 						if !_rules[rulejsonSetPath]() {
-							goto l1862
+							goto l2645
 						}
 					}
-				l1865:
-					add(rulePegText, position1864)
+				l2648:
+					add(rulePegText, position2647)
 				}
-				if !_rules[ruleAction135]() {
-					goto l1862
+				if !_rules[ruleAction188]() {
+					goto l2645
 				}
-				add(ruleTargetIdentifier, position1863)
+				add(ruleTargetIdentifier, position2646)
 			}
 			return true
-		l1862:
-			position, tokenIndex = position1862, tokenIndex1862
+		l2645:
+			position, tokenIndex = position2645, tokenIndex2645
 			return false
 		},
-		/* 167 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		/* 225 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
 		func() bool {
-			position1867, tokenIndex1867 := position, tokenIndex
+			position2650, tokenIndex2650 := position, tokenIndex
 			{
-				position1868 := position
+				position2651 := position
 				{
-					position1869, tokenIndex1869 := position, tokenIndex
+					position2652, tokenIndex2652 := position, tokenIndex
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l1870
+						goto l2653
 					}
 					position++
-					goto l1869
-				l1870:
-					position, tokenIndex = position1869, tokenIndex1869
+					goto l2652
+				l2653:
+					position, tokenIndex = position2652, tokenIndex2652
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l1867
+						goto l2650
 					}
 					position++
 				}
-			l1869:
-			l1871:
+			l2652:
+			l2654:
 				{
-					position1872, tokenIndex1872 := position, tokenIndex
+					position2655, tokenIndex2655 := position, tokenIndex
 					{
-						position1873, tokenIndex1873 := position, tokenIndex
+						position2656, tokenIndex2656 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1874
+							goto l2657
 						}
 						position++
-						goto l1873
-					l1874:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l2656
+					l2657:
+						position, tokenIndex = position2656, tokenIndex2656
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1875
+							goto l2658
 						}
 						position++
-						goto l1873
-					l1875:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l2656
+					l2658:
+						position, tokenIndex = position2656, tokenIndex2656
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1876
+							goto l2659
 						}
 						position++
-						goto l1873
-					l1876:
-						position, tokenIndex = position1873, tokenIndex1873
+						goto l2656
+					l2659:
+						position, tokenIndex = position2656, tokenIndex2656
 						if buffer[position] != rune('_') {
-							goto l1872
+							goto l2655
 						}
 						position++
 					}
-				l1873:
-					goto l1871
-				l1872:
-					position, tokenIndex = position1872, tokenIndex1872
+				l2656:
+					goto l2654
+				l2655:
+					position, tokenIndex = position2655, tokenIndex2655
 				}
-				add(ruleident, position1868)
+				add(ruleident, position2651)
 			}
 			return true
-		l1867:
-			position, tokenIndex = position1867, tokenIndex1867
+		l2650:
+			position, tokenIndex = position2650, tokenIndex2650
 			return false
 		},
-		/* 168 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		/* 226 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
 		func() bool {
-			position1877, tokenIndex1877 := position, tokenIndex
+			position2660, tokenIndex2660 := position, tokenIndex
 			{
-				position1878 := position
+				position2661 := position
 				if !_rules[rulejsonPathHead]() {
-					goto l1877
+					goto l2660
 				}
-			l1879:
+			l2662:
 				{
-					position1880, tokenIndex1880 := position, tokenIndex
+					position2663, tokenIndex2663 := position, tokenIndex
 					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1880
+						goto l2663
 					}
-					goto l1879
-				l1880:
-					position, tokenIndex = position1880, tokenIndex1880
+					goto l2662
+				l2663:
+					position, tokenIndex = position2663, tokenIndex2663
 				}
-				add(rulejsonGetPath, position1878)
+				add(rulejsonGetPath, position2661)
 			}
 			return true
-		l1877:
-			position, tokenIndex = position1877, tokenIndex1877
+		l2660:
+			position, tokenIndex = position2660, tokenIndex2660
 			return false
 		},
-		/* 169 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
+		/* 227 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
 		func() bool {
-			position1881, tokenIndex1881 := position, tokenIndex
+			position2664, tokenIndex2664 := position, tokenIndex
 			{
-				position1882 := position
+				position2665 := position
 				if !_rules[rulejsonPathHead]() {
-					goto l1881
+					goto l2664
 				}
-			l1883:
+			l2666:
 				{
-					position1884, tokenIndex1884 := position, tokenIndex
+					position2667, tokenIndex2667 := position, tokenIndex
 					if !_rules[rulejsonSetPathNonHead]() {
-						goto l1884
+						goto l2667
 					}
-					goto l1883
-				l1884:
-					position, tokenIndex = position1884, tokenIndex1884
+					goto l2666
+				l2667:
+					position, tokenIndex = position2667, tokenIndex2667
 				}
-				add(rulejsonSetPath, position1882)
+				add(rulejsonSetPath, position2665)
 			}
 			return true
-		l1881:
-			position, tokenIndex = position1881, tokenIndex1881
+		l2664:
+			position, tokenIndex = position2664, tokenIndex2664
 			return false
 		},
-		/* 170 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		/* 228 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
 		func() bool {
-			position1885, tokenIndex1885 := position, tokenIndex
+			position2668, tokenIndex2668 := position, tokenIndex
 			{
-				position1886 := position
+				position2669 := position
 				{
-					position1887, tokenIndex1887 := position, tokenIndex
+					position2670, tokenIndex2670 := position, tokenIndex
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1888
+						goto l2671
 					}
-					goto l1887
-				l1888:
-					position, tokenIndex = position1887, tokenIndex1887
+					goto l2670
+				l2671:
+					position, tokenIndex = position2670, tokenIndex2670
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1885
+						goto l2668
 					}
 				}
-			l1887:
-				add(rulejsonPathHead, position1886)
+			l2670:
+				add(rulejsonPathHead, position2669)
 			}
 			return true
-		l1885:
-			position, tokenIndex = position1885, tokenIndex1885
+		l2668:
+			position, tokenIndex = position2668, tokenIndex2668
 			return false
 		},
-		/* 171 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		/* 229 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
 		func() bool {
-			position1889, tokenIndex1889 := position, tokenIndex
+			position2672, tokenIndex2672 := position, tokenIndex
 			{
-				position1890 := position
+				position2673 := position
 				{
-					position1891, tokenIndex1891 := position, tokenIndex
+					position2674, tokenIndex2674 := position, tokenIndex
 					if !_rules[rulejsonMapMultipleLevel]() {
-						goto l1892
+						goto l2675
 					}
-					goto l1891
-				l1892:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2674
+				l2675:
+					position, tokenIndex = position2674, tokenIndex2674
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1893
+						goto l2676
 					}
-					goto l1891
-				l1893:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2674
+				l2676:
+					position, tokenIndex = position2674, tokenIndex2674
 					if !_rules[rulejsonArrayFullSlice]() {
-						goto l1894
+						goto l2677
 					}
-					goto l1891
-				l1894:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2674
+				l2677:
+					position, tokenIndex = position2674, tokenIndex2674
 					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l1895
+						goto l2678
 					}
-					goto l1891
-				l1895:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2674
+				l2678:
+					position, tokenIndex = position2674, tokenIndex2674
 					if !_rules[rulejsonArraySlice]() {
-						goto l1896
+						goto l2679
 					}
-					goto l1891
-				l1896:
-					position, tokenIndex = position1891, tokenIndex1891
+					goto l2674
+				l2679:
+					position, tokenIndex = position2674, tokenIndex2674
 					if !_rules[rulejsonArrayAccess]() {
-						goto l1889
+						goto l2672
 					}
 				}
-			l1891:
-				add(rulejsonGetPathNonHead, position1890)
+			l2674:
+				add(rulejsonGetPathNonHead, position2673)
 			}
 			return true
-		l1889:
-			position, tokenIndex = position1889, tokenIndex1889
+		l2672:
+			position, tokenIndex = position2672, tokenIndex2672
 			return false
 		},
-		/* 172 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		/* 230 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
 		func() bool {
-			position1897, tokenIndex1897 := position, tokenIndex
+			position2680, tokenIndex2680 := position, tokenIndex
 			{
-				position1898 := position
+				position2681 := position
 				{
-					position1899, tokenIndex1899 := position, tokenIndex
+					position2682, tokenIndex2682 := position, tokenIndex
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1900
+						goto l2683
 					}
-					goto l1899
-				l1900:
-					position, tokenIndex = position1899, tokenIndex1899
+					goto l2682
+				l2683:
+					position, tokenIndex = position2682, tokenIndex2682
 					if !_rules[rulejsonNonNegativeArrayAccess]() {
-						goto l1897
+						goto l2680
 					}
 				}
-			l1899:
-				add(rulejsonSetPathNonHead, position1898)
+			l2682:
+				add(rulejsonSetPathNonHead, position2681)
 			}
 			return true
-		l1897:
-			position, tokenIndex = position1897, tokenIndex1897
+		l2680:
+			position, tokenIndex = position2680, tokenIndex2680
 			return false
 		},
-		/* 173 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		/* 231 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
 		func() bool {
-			position1901, tokenIndex1901 := position, tokenIndex
+			position2684, tokenIndex2684 := position, tokenIndex
 			{
-				position1902 := position
+				position2685 := position
 				{
-					position1903, tokenIndex1903 := position, tokenIndex
+					position2686, tokenIndex2686 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l1904
+						goto l2687
 					}
 					position++
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1904
+						goto l2687
 					}
-					goto l1903
-				l1904:
-					position, tokenIndex = position1903, tokenIndex1903
+					goto l2686
+				l2687:
+					position, tokenIndex = position2686, tokenIndex2686
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1901
+						goto l2684
 					}
 				}
-			l1903:
-				add(rulejsonMapSingleLevel, position1902)
+			l2686:
+				add(rulejsonMapSingleLevel, position2685)
 			}
 			return true
-		l1901:
-			position, tokenIndex = position1901, tokenIndex1901
+		l2684:
+			position, tokenIndex = position2684, tokenIndex2684
 			return false
 		},
-		/* 174 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		/* 232 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
 		func() bool {
-			position1905, tokenIndex1905 := position, tokenIndex
+			position2688, tokenIndex2688 := position, tokenIndex
 			{
-				position1906 := position
+				position2689 := position
 				if buffer[position] != rune('.') {
-					goto l1905
+					goto l2688
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l1905
+					goto l2688
 				}
 				position++
 				{
-					position1907, tokenIndex1907 := position, tokenIndex
+					position2690, tokenIndex2690 := position, tokenIndex
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1908
+						goto l2691
 					}
-					goto l1907
-				l1908:
-					position, tokenIndex = position1907, tokenIndex1907
+					goto l2690
+				l2691:
+					position, tokenIndex = position2690, tokenIndex2690
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1905
+						goto l2688
 					}
 				}
-			l1907:
-				add(rulejsonMapMultipleLevel, position1906)
+			l2690:
+				add(rulejsonMapMultipleLevel, position2689)
 			}
 			return true
-		l1905:
-			position, tokenIndex = position1905, tokenIndex1905
+		l2688:
+			position, tokenIndex = position2688, tokenIndex2688
 			return false
 		},
-		/* 175 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		/* 233 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
 		func() bool {
-			position1909, tokenIndex1909 := position, tokenIndex
+			position2692, tokenIndex2692 := position, tokenIndex
 			{
-				position1910 := position
+				position2693 := position
 				{
-					position1911 := position
+					position2694 := position
 					{
-						position1912, tokenIndex1912 := position, tokenIndex
+						position2695, tokenIndex2695 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1913
+							goto l2696
 						}
 						position++
-						goto l1912
-					l1913:
-						position, tokenIndex = position1912, tokenIndex1912
+						goto l2695
+					l2696:
+						position, tokenIndex = position2695, tokenIndex2695
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1909
+							goto l2692
 						}
 						position++
 					}
-				l1912:
-				l1914:
+				l2695:
+				l2697:
 					{
-						position1915, tokenIndex1915 := position, tokenIndex
+						position2698, tokenIndex2698 := position, tokenIndex
 						{
-							position1916, tokenIndex1916 := position, tokenIndex
+							position2699, tokenIndex2699 := position, tokenIndex
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1917
+								goto l2700
 							}
 							position++
-							goto l1916
-						l1917:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l2699
+						l2700:
+							position, tokenIndex = position2699, tokenIndex2699
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1918
+								goto l2701
 							}
 							position++
-							goto l1916
-						l1918:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l2699
+						l2701:
+							position, tokenIndex = position2699, tokenIndex2699
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1919
+								goto l2702
 							}
 							position++
-							goto l1916
-						l1919:
-							position, tokenIndex = position1916, tokenIndex1916
+							goto l2699
+						l2702:
+							position, tokenIndex = position2699, tokenIndex2699
 							if buffer[position] != rune('_') {
-								goto l1915
+								goto l2698
 							}
 							position++
 						}
-					l1916:
-						goto l1914
-					l1915:
-						position, tokenIndex = position1915, tokenIndex1915
+					l2699:
+						goto l2697
+					l2698:
+						position, tokenIndex = position2698, tokenIndex2698
 					}
-					add(rulePegText, position1911)
+					add(rulePegText, position2694)
 				}
-				add(rulejsonMapAccessString, position1910)
+				add(rulejsonMapAccessString, position2693)
 			}
 			return true
-		l1909:
-			position, tokenIndex = position1909, tokenIndex1909
+		l2692:
+			position, tokenIndex = position2692, tokenIndex2692
 			return false
 		},
-		/* 176 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		/* 234 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
 		func() bool {
-			position1920, tokenIndex1920 := position, tokenIndex
+			position2703, tokenIndex2703 := position, tokenIndex
 			{
-				position1921 := position
+				position2704 := position
 				if buffer[position] != rune('[') {
-					goto l1920
+					goto l2703
 				}
 				position++
 				if !_rules[ruledoubleQuotedString]() {
-					goto l1920
+					goto l2703
 				}
 				if buffer[position] != rune(']') {
-					goto l1920
+					goto l2703
 				}
 				position++
-				add(rulejsonMapAccessBracket, position1921)
+				add(rulejsonMapAccessBracket, position2704)
 			}
 			return true
-		l1920:
-			position, tokenIndex = position1920, tokenIndex1920
+		l2703:
+			position, tokenIndex = position2703, tokenIndex2703
 			return false
 		},
-		/* 177 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
+		/* 235 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
 		func() bool {
-			position1922, tokenIndex1922 := position, tokenIndex
+			position2705, tokenIndex2705 := position, tokenIndex
 			{
-				position1923 := position
+				position2706 := position
 				if buffer[position] != rune('"') {
-					goto l1922
+					goto l2705
 				}
 				position++
 				{
-					position1924 := position
-				l1925:
+					position2707 := position
+				l2708:
 					{
-						position1926, tokenIndex1926 := position, tokenIndex
+						position2709, tokenIndex2709 := position, tokenIndex
 						{
-							position1927, tokenIndex1927 := position, tokenIndex
+							position2710, tokenIndex2710 := position, tokenIndex
 							if buffer[position] != rune('"') {
-								goto l1928
+								goto l2711
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l1928
+								goto l2711
 							}
 							position++
-							goto l1927
-						l1928:
-							position, tokenIndex = position1927, tokenIndex1927
+							goto l2710
+						l2711:
+							position, tokenIndex = position2710, tokenIndex2710
 							{
-								position1929, tokenIndex1929 := position, tokenIndex
+								position2712, tokenIndex2712 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l1929
+									goto l2712
 								}
 								position++
-								goto l1926
-							l1929:
-								position, tokenIndex = position1929, tokenIndex1929
+								goto l2709
+							l2712:
+								position, tokenIndex = position2712, tokenIndex2712
 							}
 							if !matchDot() {
-								goto l1926
+								goto l2709
 							}
 						}
-					l1927:
-						goto l1925
-					l1926:
-						position, tokenIndex = position1926, tokenIndex1926
+					l2710:
+						goto l2708
+					l2709:
+						position, tokenIndex = position2709, tokenIndex2709
 					}
-					add(rulePegText, position1924)
+					add(rulePegText, position2707)
 				}
 				if buffer[position] != rune('"') {
-					goto l1922
+					goto l2705
 				}
 				position++
-				add(ruledoubleQuotedString, position1923)
+				add(ruledoubleQuotedString, position2706)
 			}
 			return true
-		l1922:
-			position, tokenIndex = position1922, tokenIndex1922
+		l2705:
+			position, tokenIndex = position2705, tokenIndex2705
 			return false
 		},
-		/* 178 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
+		/* 236 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
 		func() bool {
-			position1930, tokenIndex1930 := position, tokenIndex
+			position2713, tokenIndex2713 := position, tokenIndex
 			{
-				position1931 := position
+				position2714 := position
 				if buffer[position] != rune('[') {
-					goto l1930
+					goto l2713
 				}
 				position++
 				{
-					position1932 := position
+					position2715 := position
 					{
-						position1933, tokenIndex1933 := position, tokenIndex
+						position2716, tokenIndex2716 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1933
+							goto l2716
 						}
 						position++
-						goto l1934
-					l1933:
-						position, tokenIndex = position1933, tokenIndex1933
+						goto l2717
+					l2716:
+						position, tokenIndex = position2716, tokenIndex2716
 					}
-				l1934:
+				l2717:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1930
+						goto l2713
 					}
 					position++
-				l1935:
+				l2718:
 					{
-						position1936, tokenIndex1936 := position, tokenIndex
+						position2719, tokenIndex2719 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1936
+							goto l2719
 						}
 						position++
-						goto l1935
-					l1936:
-						position, tokenIndex = position1936, tokenIndex1936
+						goto l2718
+					l2719:
+						position, tokenIndex = position2719, tokenIndex2719
 					}
-					add(rulePegText, position1932)
+					add(rulePegText, position2715)
 				}
 				if buffer[position] != rune(']') {
-					goto l1930
+					goto l2713
 				}
 				position++
-				add(rulejsonArrayAccess, position1931)
+				add(rulejsonArrayAccess, position2714)
 			}
 			return true
-		l1930:
-			position, tokenIndex = position1930, tokenIndex1930
+		l2713:
+			position, tokenIndex = position2713, tokenIndex2713
 			return false
 		},
-		/* 179 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
+		/* 237 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
 		func() bool {
-			position1937, tokenIndex1937 := position, tokenIndex
+			position2720, tokenIndex2720 := position, tokenIndex
 			{
-				position1938 := position
+				position2721 := position
 				if buffer[position] != rune('[') {
-					goto l1937
+					goto l2720
 				}
 				position++
 				{
-					position1939 := position
+					position2722 := position
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1937
+						goto l2720
 					}
 					position++
-				l1940:
+				l2723:
 					{
-						position1941, tokenIndex1941 := position, tokenIndex
+						position2724, tokenIndex2724 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1941
+							goto l2724
 						}
 						position++
-						goto l1940
-					l1941:
-						position, tokenIndex = position1941, tokenIndex1941
+						goto l2723
+					l2724:
+						position, tokenIndex = position2724, tokenIndex2724
 					}
-					add(rulePegText, position1939)
+					add(rulePegText, position2722)
 				}
 				if buffer[position] != rune(']') {
-					goto l1937
+					goto l2720
 				}
 				position++
-				add(rulejsonNonNegativeArrayAccess, position1938)
+				add(rulejsonNonNegativeArrayAccess, position2721)
 			}
 			return true
-		l1937:
-			position, tokenIndex = position1937, tokenIndex1937
+		l2720:
+			position, tokenIndex = position2720, tokenIndex2720
 			return false
 		},
-		/* 180 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
+		/* 238 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
 		func() bool {
-			position1942, tokenIndex1942 := position, tokenIndex
+			position2725, tokenIndex2725 := position, tokenIndex
 			{
-				position1943 := position
+				position2726 := position
 				if buffer[position] != rune('[') {
-					goto l1942
+					goto l2725
 				}
 				position++
 				{
-					position1944 := position
+					position2727 := position
 					{
-						position1945, tokenIndex1945 := position, tokenIndex
+						position2728, tokenIndex2728 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1945
+							goto l2728
 						}
 						position++
-						goto l1946
-					l1945:
-						position, tokenIndex = position1945, tokenIndex1945
+						goto l2729
+					l2728:
+						position, tokenIndex = position2728, tokenIndex2728
 					}
-				l1946:
+				l2729:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1942
+						goto l2725
 					}
 					position++
-				l1947:
+				l2730:
 					{
-						position1948, tokenIndex1948 := position, tokenIndex
+						position2731, tokenIndex2731 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1948
+							goto l2731
 						}
 						position++
-						goto l1947
-					l1948:
-						position, tokenIndex = position1948, tokenIndex1948
+						goto l2730
+					l2731:
+						position, tokenIndex = position2731, tokenIndex2731
 					}
 					if buffer[position] != rune(':') {
-						goto l1942
+						goto l2725
 					}
 					position++
 					{
-						position1949, tokenIndex1949 := position, tokenIndex
+						position2732, tokenIndex2732 := position, tokenIndex
 						if buffer[position] != rune('-') {
-							goto l1949
+							goto l2732
 						}
 						position++
-						goto l1950
-					l1949:
-						position, tokenIndex = position1949, tokenIndex1949
+						goto l2733
+					l2732:
+						position, tokenIndex = position2732, tokenIndex2732
 					}
-				l1950:
+				l2733:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1942
+						goto l2725
 					}
 					position++
-				l1951:
+				l2734:
 					{
-						position1952, tokenIndex1952 := position, tokenIndex
+						position2735, tokenIndex2735 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1952
+							goto l2735
 						}
 						position++
-						goto l1951
-					l1952:
-						position, tokenIndex = position1952, tokenIndex1952
+						goto l2734
+					l2735:
+						position, tokenIndex = position2735, tokenIndex2735
 					}
 					{
-						position1953, tokenIndex1953 := position, tokenIndex
+						position2736, tokenIndex2736 := position, tokenIndex
 						if buffer[position] != rune(':') {
-							goto l1953
+							goto l2736
 						}
 						position++
 						{
-							position1955, tokenIndex1955 := position, tokenIndex
+							position2738, tokenIndex2738 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1955
+								goto l2738
 							}
 							position++
-							goto l1956
-						l1955:
-							position, tokenIndex = position1955, tokenIndex1955
+							goto l2739
+						l2738:
+							position, tokenIndex = position2738, tokenIndex2738
 						}
-					l1956:
+					l2739:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1953
+							goto l2736
 						}
 						position++
-					l1957:
+					l2740:
 						{
-							position1958, tokenIndex1958 := position, tokenIndex
+							position2741, tokenIndex2741 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1958
+								goto l2741
 							}
 							position++
-							goto l1957
-						l1958:
-							position, tokenIndex = position1958, tokenIndex1958
+							goto l2740
+						l2741:
+							position, tokenIndex = position2741, tokenIndex2741
 						}
-						goto l1954
-					l1953:
-						position, tokenIndex = position1953, tokenIndex1953
+						goto l2737
+					l2736:
+						position, tokenIndex = position2736, tokenIndex2736
 					}
-				l1954:
-					add(rulePegText, position1944)
+				l2737:
+					add(rulePegText, position2727)
 				}
 				if buffer[position] != rune(']') {
-					goto l1942
+					goto l2725
 				}
 				position++
-				add(rulejsonArraySlice, position1943)
+				add(rulejsonArraySlice, position2726)
 			}
 			return true
-		l1942:
-			position, tokenIndex = position1942, tokenIndex1942
+		l2725:
+			position, tokenIndex = position2725, tokenIndex2725
 			return false
 		},
-		/* 181 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
+		/* 239 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
 		func() bool {
-			position1959, tokenIndex1959 := position, tokenIndex
+			position2742, tokenIndex2742 := position, tokenIndex
 			{
-				position1960 := position
+				position2743 := position
 				if buffer[position] != rune('[') {
-					goto l1959
+					goto l2742
 				}
 				position++
 				{
-					position1961 := position
+					position2744 := position
 					{
-						position1962, tokenIndex1962 := position, tokenIndex
+						position2745, tokenIndex2745 := position, tokenIndex
 						if buffer[position] != rune(':') {
-							goto l1963
+							goto l2746
 						}
 						position++
 						{
-							position1964, tokenIndex1964 := position, tokenIndex
+							position2747, tokenIndex2747 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1964
+								goto l2747
 							}
 							position++
-							goto l1965
-						l1964:
-							position, tokenIndex = position1964, tokenIndex1964
+							goto l2748
+						l2747:
+							position, tokenIndex = position2747, tokenIndex2747
 						}
-					l1965:
+					l2748:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1963
+							goto l2746
 						}
 						position++
-					l1966:
+					l2749:
 						{
-							position1967, tokenIndex1967 := position, tokenIndex
+							position2750, tokenIndex2750 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1967
+								goto l2750
 							}
 							position++
-							goto l1966
-						l1967:
-							position, tokenIndex = position1967, tokenIndex1967
+							goto l2749
+						l2750:
+							position, tokenIndex = position2750, tokenIndex2750
 						}
-						goto l1962
-					l1963:
-						position, tokenIndex = position1962, tokenIndex1962
+						goto l2745
+					l2746:
+						position, tokenIndex = position2745, tokenIndex2745
 						{
-							position1968, tokenIndex1968 := position, tokenIndex
+							position2751, tokenIndex2751 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l1968
+								goto l2751
 							}
 							position++
-							goto l1969
-						l1968:
-							position, tokenIndex = position1968, tokenIndex1968
+							goto l2752
+						l2751:
+							position, tokenIndex = position2751, tokenIndex2751
 						}
-					l1969:
+					l2752:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1959
+							goto l2742
 						}
 						position++
-					l1970:
+					l2753:
 						{
-							position1971, tokenIndex1971 := position, tokenIndex
+							position2754, tokenIndex2754 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1971
+								goto l2754
 							}
 							position++
-							goto l1970
-						l1971:
-							position, tokenIndex = position1971, tokenIndex1971
+							goto l2753
+						l2754:
+							position, tokenIndex = position2754, tokenIndex2754
 						}
 						if buffer[position] != rune(':') {
-							goto l1959
+							goto l2742
 						}
 						position++
 					}
-				l1962:
-					add(rulePegText, position1961)
+				l2745:
+					add(rulePegText, position2744)
 				}
 				if buffer[position] != rune(']') {
-					goto l1959
+					goto l2742
 				}
 				position++
-				add(rulejsonArrayPartialSlice, position1960)
+				add(rulejsonArrayPartialSlice, position2743)
 			}
 			return true
-		l1959:
-			position, tokenIndex = position1959, tokenIndex1959
+		l2742:
+			position, tokenIndex = position2742, tokenIndex2742
 			return false
 		},
-		/* 182 jsonArrayFullSlice <- <('[' ':' ']')> */
+		/* 240 jsonArrayFullSlice <- <('[' ':' ']')> */
 		func() bool {
-			position1972, tokenIndex1972 := position, tokenIndex
+			position2755, tokenIndex2755 := position, tokenIndex
 			{
-				position1973 := position
+				position2756 := position
 				if buffer[position] != rune('[') {
-					goto l1972
+					goto l2755
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l1972
+					goto l2755
 				}
 				position++
 				if buffer[position] != rune(']') {
-					goto l1972
+					goto l2755
 				}
 				position++
-				add(rulejsonArrayFullSlice, position1973)
+				add(rulejsonArrayFullSlice, position2756)
 			}
 			return true
-		l1972:
-			position, tokenIndex = position1972, tokenIndex1972
+		l2755:
+			position, tokenIndex = position2755, tokenIndex2755
 			return false
 		},
-		/* 183 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
+		/* 241 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
 		func() bool {
-			position1974, tokenIndex1974 := position, tokenIndex
+			position2757, tokenIndex2757 := position, tokenIndex
 			{
-				position1975 := position
+				position2758 := position
 				{
-					position1976, tokenIndex1976 := position, tokenIndex
+					position2759, tokenIndex2759 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l1977
+						goto l2760
 					}
 					position++
-					goto l1976
-				l1977:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2759
+				l2760:
+					position, tokenIndex = position2759, tokenIndex2759
 					if buffer[position] != rune('\t') {
-						goto l1978
+						goto l2761
 					}
 					position++
-					goto l1976
-				l1978:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2759
+				l2761:
+					position, tokenIndex = position2759, tokenIndex2759
 					if buffer[position] != rune('\n') {
-						goto l1979
+						goto l2762
 					}
 					position++
-					goto l1976
-				l1979:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2759
+				l2762:
+					position, tokenIndex = position2759, tokenIndex2759
 					if buffer[position] != rune('\r') {
-						goto l1980
+						goto l2763
 					}
 					position++
-					goto l1976
-				l1980:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2759
+				l2763:
+					position, tokenIndex = position2759, tokenIndex2759
 					if !_rules[rulecomment]() {
-						goto l1981
+						goto l2764
 					}
-					goto l1976
-				l1981:
-					position, tokenIndex = position1976, tokenIndex1976
+					goto l2759
+				l2764:
+					position, tokenIndex = position2759, tokenIndex2759
 					if !_rules[rulefinalComment]() {
-						goto l1974
+						goto l2757
 					}
 				}
-			l1976:
-				add(rulespElem, position1975)
+			l2759:
+				add(rulespElem, position2758)
 			}
 			return true
-		l1974:
-			position, tokenIndex = position1974, tokenIndex1974
+		l2757:
+			position, tokenIndex = position2757, tokenIndex2757
 			return false
 		},
-		/* 184 sp <- <spElem+> */
+		/* 242 sp <- <spElem+> */
 		func() bool {
-			position1982, tokenIndex1982 := position, tokenIndex
+			position2765, tokenIndex2765 := position, tokenIndex
 			{
-				position1983 := position
+				position2766 := position
 				if !_rules[rulespElem]() {
-					goto l1982
+					goto l2765
 				}
-			l1984:
+			l2767:
 				{
-					position1985, tokenIndex1985 := position, tokenIndex
+					position2768, tokenIndex2768 := position, tokenIndex
 					if !_rules[rulespElem]() {
-						goto l1985
+						goto l2768
 					}
-					goto l1984
-				l1985:
-					position, tokenIndex = position1985, tokenIndex1985
+					goto l2767
+				l2768:
+					position, tokenIndex = position2768, tokenIndex2768
 				}
-				add(rulesp, position1983)
+				add(rulesp, position2766)
 			}
 			return true
-		l1982:
-			position, tokenIndex = position1982, tokenIndex1982
+		l2765:
+			position, tokenIndex = position2765, tokenIndex2765
 			return false
 		},
-		/* 185 spOpt <- <spElem*> */
+		/* 243 spOpt <- <spElem*> */
 		func() bool {
 			{
-				position1987 := position
-			l1988:
+				position2770 := position
+			l2771:
 				{
-					position1989, tokenIndex1989 := position, tokenIndex
+					position2772, tokenIndex2772 := position, tokenIndex
 					if !_rules[rulespElem]() {
-						goto l1989
+						goto l2772
 					}
-					goto l1988
-				l1989:
-					position, tokenIndex = position1989, tokenIndex1989
+					goto l2771
+				l2772:
+					position, tokenIndex = position2772, tokenIndex2772
 				}
-				add(rulespOpt, position1987)
+				add(rulespOpt, position2770)
 			}
 			return true
 		},
-		/* 186 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 244 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
 		func() bool {
-			position1990, tokenIndex1990 := position, tokenIndex
+			position2773, tokenIndex2773 := position, tokenIndex
 			{
-				position1991 := position
+				position2774 := position
 				if buffer[position] != rune('-') {
-					goto l1990
+					goto l2773
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1990
+					goto l2773
 				}
 				position++
-			l1992:
+			l2775:
 				{
-					position1993, tokenIndex1993 := position, tokenIndex
+					position2776, tokenIndex2776 := position, tokenIndex
 					{
-						position1994, tokenIndex1994 := position, tokenIndex
+						position2777, tokenIndex2777 := position, tokenIndex
 						{
-							position1995, tokenIndex1995 := position, tokenIndex
+							position2778, tokenIndex2778 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l1996
+								goto l2779
 							}
 							position++
-							goto l1995
-						l1996:
-							position, tokenIndex = position1995, tokenIndex1995
+							goto l2778
+						l2779:
+							position, tokenIndex = position2778, tokenIndex2778
 							if buffer[position] != rune('\n') {
-								goto l1994
+								goto l2777
 							}
 							position++
 						}
-					l1995:
-						goto l1993
-					l1994:
-						position, tokenIndex = position1994, tokenIndex1994
+					l2778:
+						goto l2776
+					l2777:
+						position, tokenIndex = position2777, tokenIndex2777
 					}
 					if !matchDot() {
-						goto l1993
+						goto l2776
 					}
-					goto l1992
-				l1993:
-					position, tokenIndex = position1993, tokenIndex1993
+					goto l2775
+				l2776:
+					position, tokenIndex = position2776, tokenIndex2776
 				}
 				{
-					position1997, tokenIndex1997 := position, tokenIndex
+					position2780, tokenIndex2780 := position, tokenIndex
 					if buffer[position] != rune('\r') {
-						goto l1998
+						goto l2781
 					}
 					position++
-					goto l1997
-				l1998:
-					position, tokenIndex = position1997, tokenIndex1997
+					goto l2780
+				l2781:
+					position, tokenIndex = position2780, tokenIndex2780
 					if buffer[position] != rune('\n') {
-						goto l1990
+						goto l2773
 					}
 					position++
 				}
-			l1997:
-				add(rulecomment, position1991)
+			l2780:
+				add(rulecomment, position2774)
 			}
 			return true
-		l1990:
-			position, tokenIndex = position1990, tokenIndex1990
+		l2773:
+			position, tokenIndex = position2773, tokenIndex2773
 			return false
 		},
-		/* 187 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
+		/* 245 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
 		func() bool {
-			position1999, tokenIndex1999 := position, tokenIndex
+			position2782, tokenIndex2782 := position, tokenIndex
 			{
-				position2000 := position
+				position2783 := position
 				if buffer[position] != rune('-') {
-					goto l1999
+					goto l2782
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1999
+					goto l2782
 				}
 				position++
-			l2001:
+			l2784:
 				{
-					position2002, tokenIndex2002 := position, tokenIndex
+					position2785, tokenIndex2785 := position, tokenIndex
 					{
-						position2003, tokenIndex2003 := position, tokenIndex
+						position2786, tokenIndex2786 := position, tokenIndex
 						{
-							position2004, tokenIndex2004 := position, tokenIndex
+							position2787, tokenIndex2787 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l2005
+								goto l2788
 							}
 							position++
-							goto l2004
-						l2005:
-							position, tokenIndex = position2004, tokenIndex2004
+							goto l2787
+						l2788:
+							position, tokenIndex = position2787, tokenIndex2787
 							if buffer[position] != rune('\n') {
-								goto l2003
+								goto l2786
 							}
 							position++
 						}
-					l2004:
-						goto l2002
-					l2003:
-						position, tokenIndex = position2003, tokenIndex2003
+					l2787:
+						goto l2785
+					l2786:
+						position, tokenIndex = position2786, tokenIndex2786
 					}
 					if !matchDot() {
-						goto l2002
+						goto l2785
 					}
-					goto l2001
-				l2002:
-					position, tokenIndex = position2002, tokenIndex2002
+					goto l2784
+				l2785:
+					position, tokenIndex = position2785, tokenIndex2785
 				}
 				{
-					position2006, tokenIndex2006 := position, tokenIndex
+					position2789, tokenIndex2789 := position, tokenIndex
 					if !matchDot() {
-						goto l2006
+						goto l2789
 					}
-					goto l1999
-				l2006:
-					position, tokenIndex = position2006, tokenIndex2006
+					goto l2782
+				l2789:
+					position, tokenIndex = position2789, tokenIndex2789
 				}
-				add(rulefinalComment, position2000)
+				add(rulefinalComment, position2783)
+			}
+			return true
+		l2782:
+			position, tokenIndex = position2782, tokenIndex2782
+			return false
+		},
+		nil,
+		/* 248 Action0 <- <{
+		    p.IncludeTrailingWhitespace(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction0, position)
+			}
+			return true
+		},
+		/* 249 Action1 <- <{
+		    p.IncludeTrailingWhitespace(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction1, position)
+			}
+			return true
+		},
+		/* 250 Action2 <- <{
+		    p.AssembleSelect()
+		}> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
+		/* 251 Action3 <- <{
+		    p.AssembleSelectUnion(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction3, position)
+			}
+			return true
+		},
+		/* 252 Action4 <- <{
+		    p.AssembleCreateStreamAsSelect()
+		}> */
+		func() bool {
+			{
+				add(ruleAction4, position)
+			}
+			return true
+		},
+		/* 253 Action5 <- <{
+		    p.AssembleCreateStreamAsSelectUnion()
+		}> */
+		func() bool {
+			{
+				add(ruleAction5, position)
+			}
+			return true
+		},
+		/* 254 Action6 <- <{
+		    p.AssembleCreateSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction6, position)
+			}
+			return true
+		},
+		/* 255 Action7 <- <{
+		    p.AssembleCreateSink()
+		}> */
+		func() bool {
+			{
+				add(ruleAction7, position)
+			}
+			return true
+		},
+		/* 256 Action8 <- <{
+		    p.AssembleCreateState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction8, position)
+			}
+			return true
+		},
+		/* 257 Action9 <- <{
+		    p.AssembleUpdateState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction9, position)
+			}
+			return true
+		},
+		/* 258 Action10 <- <{
+		    p.AssembleUpdateSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction10, position)
+			}
+			return true
+		},
+		/* 259 Action11 <- <{
+		    p.AssembleUpdateSink()
+		}> */
+		func() bool {
+			{
+				add(ruleAction11, position)
+			}
+			return true
+		},
+		/* 260 Action12 <- <{
+		    p.AssembleInsertIntoFrom()
+		}> */
+		func() bool {
+			{
+				add(ruleAction12, position)
+			}
+			return true
+		},
+		/* 261 Action13 <- <{
+		    p.AssemblePauseSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 262 Action14 <- <{
+		    p.AssembleResumeSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction14, position)
+			}
+			return true
+		},
+		/* 263 Action15 <- <{
+		    p.AssembleRewindSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction15, position)
+			}
+			return true
+		},
+		/* 264 Action16 <- <{
+		    p.AssembleDropSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction16, position)
+			}
+			return true
+		},
+		/* 265 Action17 <- <{
+		    p.AssembleDropStream()
+		}> */
+		func() bool {
+			{
+				add(ruleAction17, position)
+			}
+			return true
+		},
+		/* 266 Action18 <- <{
+		    p.AssembleDropSink()
+		}> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 267 Action19 <- <{
+		    p.AssembleDropState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
+		/* 268 Action20 <- <{
+		    p.AssembleLoadState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction20, position)
+			}
+			return true
+		},
+		/* 269 Action21 <- <{
+		    p.AssembleLoadStateOrCreate()
+		}> */
+		func() bool {
+			{
+				add(ruleAction21, position)
+			}
+			return true
+		},
+		/* 270 Action22 <- <{
+		    p.AssembleSaveState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction22, position)
+			}
+			return true
+		},
+		/* 271 Action23 <- <{
+		    p.AssembleEval(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction23, position)
+			}
+			return true
+		},
+		/* 272 Action24 <- <{
+		    // This is *always* executed, even if there is no
+		    // WITH clause present in the statement.
+		    p.AssembleWith(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction24, position)
+			}
+			return true
+		},
+		/* 273 Action25 <- <{
+		    p.AssembleCommonTableExpression()
+		}> */
+		func() bool {
+			{
+				add(ruleAction25, position)
+			}
+			return true
+		},
+		/* 274 Action26 <- <{
+		    p.AssembleEmitter()
+		}> */
+		func() bool {
+			{
+				add(ruleAction26, position)
+			}
+			return true
+		},
+		/* 275 Action27 <- <{
+		    p.AssembleEmitterOptions(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction27, position)
+			}
+			return true
+		},
+		/* 276 Action28 <- <{
+		    p.AssembleEmitterLimit()
+		}> */
+		func() bool {
+			{
+				add(ruleAction28, position)
+			}
+			return true
+		},
+		/* 277 Action29 <- <{
+		    p.AssembleEmitterSampling(CountBasedSampling, 1)
+		}> */
+		func() bool {
+			{
+				add(ruleAction29, position)
+			}
+			return true
+		},
+		/* 278 Action30 <- <{
+		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		}> */
+		func() bool {
+			{
+				add(ruleAction30, position)
 			}
 			return true
-		l1999:
-			position, tokenIndex = position1999, tokenIndex1999
-			return false
 		},
-		nil,
-		/* 190 Action0 <- <{
-		    p.IncludeTrailingWhitespace(begin, end)
+		/* 279 Action31 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
 		}> */
 		func() bool {
 			{
-				add(ruleAction0, position)
+				add(ruleAction31, position)
 			}
 			return true
 		},
-		/* 191 Action1 <- <{
-		    p.IncludeTrailingWhitespace(begin, end)
+		/* 280 Action32 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 		}> */
 		func() bool {
 			{
-				add(ruleAction1, position)
+				add(ruleAction32, position)
 			}
 			return true
 		},
-		/* 192 Action2 <- <{
-		    p.AssembleSelect()
+		/* 281 Action33 <- <{
+		    p.AssembleDistinct(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction33, position)
 			}
 			return true
 		},
-		/* 193 Action3 <- <{
-		    p.AssembleSelectUnion(begin, end)
+		/* 282 Action34 <- <{
+		    p.AssembleProjections(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction34, position)
 			}
 			return true
 		},
-		/* 194 Action4 <- <{
-		    p.AssembleCreateStreamAsSelect()
+		/* 283 Action35 <- <{
+		    p.AssembleAlias()
 		}> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction35, position)
 			}
 			return true
 		},
-		/* 195 Action5 <- <{
-		    p.AssembleCreateStreamAsSelectUnion()
+		/* 284 Action36 <- <{
+		    // This is *always* executed, even if there is no
+		    // FROM clause present in the statement.
+		    p.AssembleWindowedFrom(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction36, position)
 			}
 			return true
 		},
-		/* 196 Action6 <- <{
-		    p.AssembleCreateSource()
+		/* 285 Action37 <- <{
+		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction37, position)
 			}
 			return true
 		},
-		/* 197 Action7 <- <{
-		    p.AssembleCreateSink()
+		/* 286 Action38 <- <{
+		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction38, position)
 			}
 			return true
 		},
-		/* 198 Action8 <- <{
-		    p.AssembleCreateState()
+		/* 287 Action39 <- <{
+		    p.AssembleJoin()
 		}> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction39, position)
 			}
 			return true
 		},
-		/* 199 Action9 <- <{
-		    p.AssembleUpdateState()
+		/* 288 Action40 <- <{
+		    p.AssembleTableJoin()
 		}> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction40, position)
 			}
 			return true
 		},
-		/* 200 Action10 <- <{
-		    p.AssembleUpdateSource()
+		/* 289 Action41 <- <{
+		    p.AssembleAliasedTable()
 		}> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction41, position)
 			}
 			return true
 		},
-		/* 201 Action11 <- <{
-		    p.AssembleUpdateSink()
+		/* 290 Action42 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, TableAST{Name: substr})
 		}> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction42, position)
 			}
 			return true
 		},
-		/* 202 Action12 <- <{
-		    p.AssembleInsertIntoFrom()
+		/* 291 Action43 <- <{
+		    // This is *always* executed, even if there is no
+		    // MATCH_RECOGNIZE clause present in the statement.
+		    p.AssembleMatchRecognize(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction43, position)
 			}
 			return true
 		},
-		/* 203 Action13 <- <{
-		    p.AssemblePauseSource()
+		/* 292 Action44 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction44, position)
 			}
 			return true
 		},
-		/* 204 Action14 <- <{
-		    p.AssembleResumeSource()
+		/* 293 Action45 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction14, position)
+				add(ruleAction45, position)
 			}
 			return true
 		},
-		/* 205 Action15 <- <{
-		    p.AssembleRewindSource()
+		/* 294 Action46 <- <{
+		    p.AssemblePatternDefinition()
 		}> */
 		func() bool {
 			{
-				add(ruleAction15, position)
+				add(ruleAction46, position)
 			}
 			return true
 		},
-		/* 206 Action16 <- <{
-		    p.AssembleDropSource()
+		/* 295 Action47 <- <{
+		    p.AssemblePatternAlternation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction16, position)
+				add(ruleAction47, position)
 			}
 			return true
 		},
-		/* 207 Action17 <- <{
-		    p.AssembleDropStream()
+		/* 296 Action48 <- <{
+		    p.AssemblePatternSequence(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction17, position)
+				add(ruleAction48, position)
 			}
 			return true
 		},
-		/* 208 Action18 <- <{
-		    p.AssembleDropSink()
+		/* 297 Action49 <- <{
+		    p.AssemblePatternTerm()
 		}> */
 		func() bool {
 			{
-				add(ruleAction18, position)
+				add(ruleAction49, position)
 			}
 			return true
 		},
-		/* 209 Action19 <- <{
-		    p.AssembleDropState()
+		/* 298 Action50 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, PatternAST{Variable: substr, Min: 1, Max: 1})
 		}> */
 		func() bool {
 			{
-				add(ruleAction19, position)
+				add(ruleAction50, position)
 			}
 			return true
 		},
-		/* 210 Action20 <- <{
-		    p.AssembleLoadState()
+		/* 299 Action51 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.AssemblePatternQuantifier(begin, end, substr)
 		}> */
 		func() bool {
 			{
-				add(ruleAction20, position)
+				add(ruleAction51, position)
 			}
 			return true
 		},
-		/* 211 Action21 <- <{
-		    p.AssembleLoadStateOrCreate()
+		/* 300 Action52 <- <{
+		    // This is *always* executed, even if there is no
+		    // WHERE clause present in the statement.
+		    p.AssembleFilter(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction21, position)
+				add(ruleAction52, position)
 			}
 			return true
 		},
-		/* 212 Action22 <- <{
-		    p.AssembleSaveState()
+		/* 301 Action53 <- <{
+		    // This is *always* executed, even if there is no
+		    // GROUP BY clause present in the statement.
+		    p.AssembleGrouping(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction22, position)
+				add(ruleAction53, position)
 			}
 			return true
 		},
-		/* 213 Action23 <- <{
-		    p.AssembleEval(begin, end)
+		/* 302 Action54 <- <{
+		    // This is *always* executed, even if there is no
+		    // HAVING clause present in the statement.
+		    p.AssembleHaving(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction23, position)
+				add(ruleAction54, position)
 			}
 			return true
 		},
-		/* 214 Action24 <- <{
-		    p.AssembleEmitter()
+		/* 303 Action55 <- <{
+		    // This is *always* executed, even if there is no
+		    // ORDER BY clause present in the statement.
+		    p.AssembleOrdering(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction24, position)
+				add(ruleAction55, position)
 			}
 			return true
 		},
-		/* 215 Action25 <- <{
-		    p.AssembleEmitterOptions(begin, end)
+		/* 304 Action56 <- <{
+		    // This is *always* executed, even if there is no
+		    // LIMIT clause present in the statement.
+		    p.AssembleLimit(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction25, position)
+				add(ruleAction56, position)
 			}
 			return true
 		},
-		/* 216 Action26 <- <{
-		    p.AssembleEmitterLimit()
+		/* 305 Action57 <- <{
+		    p.AssembleOffset(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction26, position)
+				add(ruleAction57, position)
 			}
 			return true
 		},
-		/* 217 Action27 <- <{
-		    p.AssembleEmitterSampling(CountBasedSampling, 1)
+		/* 306 Action58 <- <{
+		    p.EnsureAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction27, position)
+				add(ruleAction58, position)
 			}
 			return true
 		},
-		/* 218 Action28 <- <{
-		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		/* 307 Action59 <- <{
+		    p.AssembleAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction28, position)
+				add(ruleAction59, position)
 			}
 			return true
 		},
-		/* 219 Action29 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
+		/* 308 Action60 <- <{
+		    p.AssembleStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction29, position)
+				add(ruleAction60, position)
 			}
 			return true
 		},
-		/* 220 Action30 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		/* 309 Action61 <- <{
+		    p.AssembleWindowSpec()
 		}> */
 		func() bool {
 			{
-				add(ruleAction30, position)
+				add(ruleAction61, position)
 			}
 			return true
 		},
-		/* 221 Action31 <- <{
-		    p.AssembleProjections(begin, end)
+		/* 310 Action62 <- <{
+		    p.AssembleHoppingWindowSpec()
 		}> */
 		func() bool {
 			{
-				add(ruleAction31, position)
+				add(ruleAction62, position)
 			}
 			return true
 		},
-		/* 222 Action32 <- <{
-		    p.AssembleAlias()
+		/* 311 Action63 <- <{
+		    p.AssembleSessionWindowSpec()
 		}> */
 		func() bool {
 			{
-				add(ruleAction32, position)
+				add(ruleAction63, position)
 			}
 			return true
 		},
-		/* 223 Action33 <- <{
-		    // This is *always* executed, even if there is no
-		    // FROM clause present in the statement.
-		    p.AssembleWindowedFrom(begin, end)
+		/* 312 Action64 <- <{
+		    p.AssembleSubquery(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction64, position)
 			}
 			return true
 		},
-		/* 224 Action34 <- <{
-		    p.AssembleInterval()
+		/* 313 Action65 <- <{
+		    p.AssembleUDSFFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction65, position)
 			}
 			return true
 		},
-		/* 225 Action35 <- <{
-		    p.AssembleInterval()
+		/* 314 Action66 <- <{
+		    p.EnsureCapacitySpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction66, position)
 			}
 			return true
 		},
-		/* 226 Action36 <- <{
-		    // This is *always* executed, even if there is no
-		    // WHERE clause present in the statement.
-		    p.AssembleFilter(begin, end)
+		/* 315 Action67 <- <{
+		    p.EnsureSheddingSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction67, position)
 			}
 			return true
 		},
-		/* 227 Action37 <- <{
-		    // This is *always* executed, even if there is no
-		    // GROUP BY clause present in the statement.
-		    p.AssembleGrouping(begin, end)
+		/* 316 Action68 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction68, position)
 			}
 			return true
 		},
-		/* 228 Action38 <- <{
-		    // This is *always* executed, even if there is no
-		    // HAVING clause present in the statement.
-		    p.AssembleHaving(begin, end)
+		/* 317 Action69 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction69, position)
 			}
 			return true
 		},
-		/* 229 Action39 <- <{
-		    p.EnsureAliasedStreamWindow()
+		/* 318 Action70 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction70, position)
 			}
 			return true
 		},
-		/* 230 Action40 <- <{
-		    p.AssembleAliasedStreamWindow()
+		/* 319 Action71 <- <{
+		    p.EnsureIdentifier(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction71, position)
 			}
 			return true
 		},
-		/* 231 Action41 <- <{
-		    p.AssembleStreamWindow()
+		/* 320 Action72 <- <{
+		    p.AssembleSourceSinkParam()
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction72, position)
 			}
 			return true
 		},
-		/* 232 Action42 <- <{
-		    p.AssembleUDSFFuncApp()
+		/* 321 Action73 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction42, position)
+				add(ruleAction73, position)
 			}
 			return true
 		},
-		/* 233 Action43 <- <{
-		    p.EnsureCapacitySpec(begin, end)
+		/* 322 Action74 <- <{
+		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction43, position)
+				add(ruleAction74, position)
 			}
 			return true
 		},
-		/* 234 Action44 <- <{
-		    p.EnsureSheddingSpec(begin, end)
+		/* 323 Action75 <- <{
+		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction44, position)
+				add(ruleAction75, position)
 			}
 			return true
 		},
-		/* 235 Action45 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 324 Action76 <- <{
+		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction45, position)
+				add(ruleAction76, position)
 			}
 			return true
 		},
-		/* 236 Action46 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 325 Action77 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction46, position)
+				add(ruleAction77, position)
 			}
 			return true
 		},
-		/* 237 Action47 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 326 Action78 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction47, position)
+				add(ruleAction78, position)
 			}
 			return true
 		},
-		/* 238 Action48 <- <{
-		    p.EnsureIdentifier(begin, end)
+		/* 327 Action79 <- <{
+		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction48, position)
+				add(ruleAction79, position)
 			}
 			return true
 		},
-		/* 239 Action49 <- <{
-		    p.AssembleSourceSinkParam()
+		/* 328 Action80 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction49, position)
+				add(ruleAction80, position)
 			}
 			return true
 		},
-		/* 240 Action50 <- <{
-		    p.AssembleExpressions(begin, end)
-		    p.AssembleArray()
+		/* 329 Action81 <- <{
+		    p.AssembleIn(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction50, position)
+				add(ruleAction81, position)
 			}
 			return true
 		},
-		/* 241 Action51 <- <{
-		    p.AssembleMap(begin, end)
+		/* 330 Action82 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction51, position)
+				add(ruleAction82, position)
 			}
 			return true
 		},
-		/* 242 Action52 <- <{
-		    p.AssembleKeyValuePair()
+		/* 331 Action83 <- <{
+		    p.AssembleBetween(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction52, position)
+				add(ruleAction83, position)
 			}
 			return true
 		},
-		/* 243 Action53 <- <{
+		/* 332 Action84 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction53, position)
+				add(ruleAction84, position)
+			}
+			return true
+		},
+		/* 333 Action85 <- <{
+		    p.PushComponent(begin, end, Yes)
+		}> */
+		func() bool {
+			{
+				add(ruleAction85, position)
 			}
 			return true
 		},
-		/* 244 Action54 <- <{
+		/* 334 Action86 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction54, position)
+				add(ruleAction86, position)
 			}
 			return true
 		},
-		/* 245 Action55 <- <{
+		/* 335 Action87 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction55, position)
+				add(ruleAction87, position)
 			}
 			return true
 		},
-		/* 246 Action56 <- <{
-		    p.AssembleUnaryPrefixOperation(begin, end)
+		/* 336 Action88 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction56, position)
+				add(ruleAction88, position)
 			}
 			return true
 		},
-		/* 247 Action57 <- <{
+		/* 337 Action89 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction57, position)
+				add(ruleAction89, position)
 			}
 			return true
 		},
-		/* 248 Action58 <- <{
+		/* 338 Action90 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction58, position)
+				add(ruleAction90, position)
+			}
+			return true
+		},
+		/* 339 Action91 <- <{
+		    p.AssembleUnaryPrefixOperation(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction91, position)
+			}
+			return true
+		},
+		/* 340 Action92 <- <{
+		    p.AssembleTypeCast(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction92, position)
 			}
 			return true
 		},
-		/* 249 Action59 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 341 Action93 <- <{
+		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction59, position)
+				add(ruleAction93, position)
 			}
 			return true
 		},
-		/* 250 Action60 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 342 Action94 <- <{
+		    p.AssembleFuncAppSelector()
 		}> */
 		func() bool {
 			{
-				add(ruleAction60, position)
+				add(ruleAction94, position)
 			}
 			return true
 		},
-		/* 251 Action61 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 343 Action95 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewRaw(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction61, position)
+				add(ruleAction95, position)
 			}
 			return true
 		},
-		/* 252 Action62 <- <{
-		    p.AssembleUnaryPrefixOperation(begin, end)
+		/* 344 Action96 <- <{
+		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction62, position)
+				add(ruleAction96, position)
 			}
 			return true
 		},
-		/* 253 Action63 <- <{
-		    p.AssembleTypeCast(begin, end)
+		/* 345 Action97 <- <{
+		    p.AssembleFuncAppWithinGroup()
 		}> */
 		func() bool {
 			{
-				add(ruleAction63, position)
+				add(ruleAction97, position)
 			}
 			return true
 		},
-		/* 254 Action64 <- <{
-		    p.AssembleTypeCast(begin, end)
+		/* 346 Action98 <- <{
+		    p.AssembleFuncAppOver()
 		}> */
 		func() bool {
 			{
-				add(ruleAction64, position)
+				add(ruleAction98, position)
 			}
 			return true
 		},
-		/* 255 Action65 <- <{
-		    p.AssembleFuncAppSelector()
+		/* 347 Action99 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction65, position)
+				add(ruleAction99, position)
 			}
 			return true
 		},
-		/* 256 Action66 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewRaw(substr))
+		/* 348 Action100 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction66, position)
+				add(ruleAction100, position)
 			}
 			return true
 		},
-		/* 257 Action67 <- <{
+		/* 349 Action101 <- <{
+		    p.AssembleExpressions(begin, end)
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction67, position)
+				add(ruleAction101, position)
 			}
 			return true
 		},
-		/* 258 Action68 <- <{
-		    p.AssembleExpressions(begin, end)
-		    p.AssembleFuncApp()
+		/* 350 Action102 <- <{
+		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction68, position)
+				add(ruleAction102, position)
 			}
 			return true
 		},
-		/* 259 Action69 <- <{
+		/* 351 Action103 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction69, position)
+				add(ruleAction103, position)
 			}
 			return true
 		},
-		/* 260 Action70 <- <{
+		/* 352 Action104 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction70, position)
+				add(ruleAction104, position)
 			}
 			return true
 		},
-		/* 261 Action71 <- <{
+		/* 353 Action105 <- <{
 		    p.AssembleSortedExpression()
 		}> */
 		func() bool {
 			{
-				add(ruleAction71, position)
+				add(ruleAction105, position)
 			}
 			return true
 		},
-		/* 262 Action72 <- <{
+		/* 354 Action106 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction72, position)
+				add(ruleAction106, position)
 			}
 			return true
 		},
-		/* 263 Action73 <- <{
+		/* 355 Action107 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction73, position)
+				add(ruleAction107, position)
 			}
 			return true
 		},
-		/* 264 Action74 <- <{
+		/* 356 Action108 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction74, position)
+				add(ruleAction108, position)
 			}
 			return true
 		},
-		/* 265 Action75 <- <{
+		/* 357 Action109 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction75, position)
+				add(ruleAction109, position)
 			}
 			return true
 		},
-		/* 266 Action76 <- <{
+		/* 358 Action110 <- <{
 		    p.AssembleConditionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction76, position)
+				add(ruleAction110, position)
 			}
 			return true
 		},
-		/* 267 Action77 <- <{
+		/* 359 Action111 <- <{
 		    p.AssembleExpressionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction77, position)
+				add(ruleAction111, position)
 			}
 			return true
 		},
-		/* 268 Action78 <- <{
+		/* 360 Action112 <- <{
 		    p.AssembleWhenThenPair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction78, position)
+				add(ruleAction112, position)
 			}
 			return true
 		},
-		/* 269 Action79 <- <{
+		/* 361 Action113 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStream(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction79, position)
+				add(ruleAction113, position)
 			}
 			return true
 		},
-		/* 270 Action80 <- <{
+		/* 362 Action114 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 		}> */
 		func() bool {
 			{
-				add(ruleAction80, position)
+				add(ruleAction114, position)
 			}
 			return true
 		},
-		/* 271 Action81 <- <{
+		/* 363 Action115 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowValue(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction81, position)
+				add(ruleAction115, position)
 			}
 			return true
 		},
-		/* 272 Action82 <- <{
+		/* 364 Action116 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction82, position)
+				add(ruleAction116, position)
 			}
 			return true
 		},
-		/* 273 Action83 <- <{
+		/* 365 Action117 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction83, position)
+				add(ruleAction117, position)
 			}
 			return true
 		},
-		/* 274 Action84 <- <{
+		/* 366 Action118 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewFloatLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction84, position)
+				add(ruleAction118, position)
 			}
 			return true
 		},
-		/* 275 Action85 <- <{
+		/* 367 Action119 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, FuncName(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction85, position)
+				add(ruleAction119, position)
 			}
 			return true
 		},
-		/* 276 Action86 <- <{
+		/* 368 Action120 <- <{
 		    p.PushComponent(begin, end, NewNullLiteral())
 		}> */
 		func() bool {
 			{
-				add(ruleAction86, position)
+				add(ruleAction120, position)
 			}
 			return true
 		},
-		/* 277 Action87 <- <{
+		/* 369 Action121 <- <{
 		    p.PushComponent(begin, end, NewMissing())
 		}> */
 		func() bool {
 			{
-				add(ruleAction87, position)
+				add(ruleAction121, position)
 			}
 			return true
 		},
-		/* 278 Action88 <- <{
+		/* 370 Action122 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(true))
 		}> */
 		func() bool {
 			{
-				add(ruleAction88, position)
+				add(ruleAction122, position)
 			}
 			return true
 		},
-		/* 279 Action89 <- <{
+		/* 371 Action123 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(false))
 		}> */
 		func() bool {
 			{
-				add(ruleAction89, position)
+				add(ruleAction123, position)
 			}
 			return true
 		},
-		/* 280 Action90 <- <{
+		/* 372 Action124 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewWildcard(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction90, position)
+				add(ruleAction124, position)
 			}
 			return true
 		},
-		/* 281 Action91 <- <{
+		/* 373 Action125 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStringLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction91, position)
+				add(ruleAction125, position)
 			}
 			return true
 		},
-		/* 282 Action92 <- <{
+		/* 374 Action126 <- <{
 		    p.PushComponent(begin, end, Istream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction92, position)
+				add(ruleAction126, position)
 			}
 			return true
 		},
-		/* 283 Action93 <- <{
+		/* 375 Action127 <- <{
 		    p.PushComponent(begin, end, Dstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction93, position)
+				add(ruleAction127, position)
 			}
 			return true
 		},
-		/* 284 Action94 <- <{
+		/* 376 Action128 <- <{
 		    p.PushComponent(begin, end, Rstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction94, position)
+				add(ruleAction128, position)
 			}
 			return true
 		},
-		/* 285 Action95 <- <{
+		/* 377 Action129 <- <{
 		    p.PushComponent(begin, end, Tuples)
 		}> */
 		func() bool {
 			{
-				add(ruleAction95, position)
+				add(ruleAction129, position)
 			}
 			return true
 		},
-		/* 286 Action96 <- <{
+		/* 378 Action130 <- <{
 		    p.PushComponent(begin, end, Seconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction96, position)
+				add(ruleAction130, position)
 			}
 			return true
 		},
-		/* 287 Action97 <- <{
+		/* 379 Action131 <- <{
 		    p.PushComponent(begin, end, Milliseconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction97, position)
+				add(ruleAction131, position)
+			}
+			return true
+		},
+		/* 380 Action132 <- <{
+		    p.PushComponent(begin, end, SlidingWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction132, position)
+			}
+			return true
+		},
+		/* 381 Action133 <- <{
+		    p.PushComponent(begin, end, TumblingWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction133, position)
+			}
+			return true
+		},
+		/* 382 Action134 <- <{
+		    p.PushComponent(begin, end, HoppingWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction134, position)
+			}
+			return true
+		},
+		/* 383 Action135 <- <{
+		    p.PushComponent(begin, end, SessionWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction135, position)
+			}
+			return true
+		},
+		/* 384 Action136 <- <{
+		    p.PushComponent(begin, end, InnerJoin)
+		}> */
+		func() bool {
+			{
+				add(ruleAction136, position)
+			}
+			return true
+		},
+		/* 385 Action137 <- <{
+		    p.PushComponent(begin, end, LeftOuterJoin)
+		}> */
+		func() bool {
+			{
+				add(ruleAction137, position)
+			}
+			return true
+		},
+		/* 386 Action138 <- <{
+		    p.PushComponent(begin, end, FullOuterJoin)
+		}> */
+		func() bool {
+			{
+				add(ruleAction138, position)
 			}
 			return true
 		},
-		/* 288 Action98 <- <{
+		/* 387 Action139 <- <{
 		    p.PushComponent(begin, end, Wait)
 		}> */
 		func() bool {
 			{
-				add(ruleAction98, position)
+				add(ruleAction139, position)
 			}
 			return true
 		},
-		/* 289 Action99 <- <{
+		/* 388 Action140 <- <{
 		    p.PushComponent(begin, end, DropOldest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction99, position)
+				add(ruleAction140, position)
 			}
 			return true
 		},
-		/* 290 Action100 <- <{
+		/* 389 Action141 <- <{
 		    p.PushComponent(begin, end, DropNewest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction100, position)
+				add(ruleAction141, position)
 			}
 			return true
 		},
-		/* 291 Action101 <- <{
+		/* 390 Action142 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, StreamIdentifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction101, position)
+				add(ruleAction142, position)
 			}
 			return true
 		},
-		/* 292 Action102 <- <{
+		/* 391 Action143 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkType(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction102, position)
+				add(ruleAction143, position)
 			}
 			return true
 		},
-		/* 293 Action103 <- <{
+		/* 392 Action144 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkParamKey(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction103, position)
+				add(ruleAction144, position)
 			}
 			return true
 		},
-		/* 294 Action104 <- <{
+		/* 393 Action145 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction104, position)
+				add(ruleAction145, position)
 			}
 			return true
 		},
-		/* 295 Action105 <- <{
+		/* 394 Action146 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction105, position)
+				add(ruleAction146, position)
 			}
 			return true
 		},
-		/* 296 Action106 <- <{
+		/* 395 Action147 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction106, position)
+				add(ruleAction147, position)
+			}
+			return true
+		},
+		/* 396 Action148 <- <{
+		    p.PushComponent(begin, end, Yes)
+		}> */
+		func() bool {
+			{
+				add(ruleAction148, position)
 			}
 			return true
 		},
-		/* 297 Action107 <- <{
+		/* 397 Action149 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction107, position)
+				add(ruleAction149, position)
 			}
 			return true
 		},
-		/* 298 Action108 <- <{
+		/* 398 Action150 <- <{
 		    p.PushComponent(begin, end, Bool)
 		}> */
 		func() bool {
 			{
-				add(ruleAction108, position)
+				add(ruleAction150, position)
 			}
 			return true
 		},
-		/* 299 Action109 <- <{
+		/* 399 Action151 <- <{
 		    p.PushComponent(begin, end, Int)
 		}> */
 		func() bool {
 			{
-				add(ruleAction109, position)
+				add(ruleAction151, position)
 			}
 			return true
 		},
-		/* 300 Action110 <- <{
+		/* 400 Action152 <- <{
 		    p.PushComponent(begin, end, Float)
 		}> */
 		func() bool {
 			{
-				add(ruleAction110, position)
+				add(ruleAction152, position)
 			}
 			return true
 		},
-		/* 301 Action111 <- <{
+		/* 401 Action153 <- <{
 		    p.PushComponent(begin, end, String)
 		}> */
 		func() bool {
 			{
-				add(ruleAction111, position)
+				add(ruleAction153, position)
 			}
 			return true
 		},
-		/* 302 Action112 <- <{
+		/* 402 Action154 <- <{
 		    p.PushComponent(begin, end, Blob)
 		}> */
 		func() bool {
 			{
-				add(ruleAction112, position)
+				add(ruleAction154, position)
 			}
 			return true
 		},
-		/* 303 Action113 <- <{
+		/* 403 Action155 <- <{
 		    p.PushComponent(begin, end, Timestamp)
 		}> */
 		func() bool {
 			{
-				add(ruleAction113, position)
+				add(ruleAction155, position)
 			}
 			return true
 		},
-		/* 304 Action114 <- <{
+		/* 404 Action156 <- <{
 		    p.PushComponent(begin, end, Array)
 		}> */
 		func() bool {
 			{
-				add(ruleAction114, position)
+				add(ruleAction156, position)
 			}
 			return true
 		},
-		/* 305 Action115 <- <{
+		/* 405 Action157 <- <{
 		    p.PushComponent(begin, end, Map)
 		}> */
 		func() bool {
 			{
-				add(ruleAction115, position)
+				add(ruleAction157, position)
 			}
 			return true
 		},
-		/* 306 Action116 <- <{
+		/* 406 Action158 <- <{
 		    p.PushComponent(begin, end, Or)
 		}> */
 		func() bool {
 			{
-				add(ruleAction116, position)
+				add(ruleAction158, position)
 			}
 			return true
 		},
-		/* 307 Action117 <- <{
+		/* 407 Action159 <- <{
 		    p.PushComponent(begin, end, And)
 		}> */
 		func() bool {
 			{
-				add(ruleAction117, position)
+				add(ruleAction159, position)
 			}
 			return true
 		},
-		/* 308 Action118 <- <{
+		/* 408 Action160 <- <{
 		    p.PushComponent(begin, end, Not)
 		}> */
 		func() bool {
 			{
-				add(ruleAction118, position)
+				add(ruleAction160, position)
 			}
 			return true
 		},
-		/* 309 Action119 <- <{
+		/* 409 Action161 <- <{
 		    p.PushComponent(begin, end, Equal)
 		}> */
 		func() bool {
 			{
-				add(ruleAction119, position)
+				add(ruleAction161, position)
 			}
 			return true
 		},
-		/* 310 Action120 <- <{
+		/* 410 Action162 <- <{
 		    p.PushComponent(begin, end, Less)
 		}> */
 		func() bool {
 			{
-				add(ruleAction120, position)
+				add(ruleAction162, position)
 			}
 			return true
 		},
-		/* 311 Action121 <- <{
+		/* 411 Action163 <- <{
 		    p.PushComponent(begin, end, LessOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction121, position)
+				add(ruleAction163, position)
 			}
 			return true
 		},
-		/* 312 Action122 <- <{
+		/* 412 Action164 <- <{
 		    p.PushComponent(begin, end, Greater)
 		}> */
 		func() bool {
 			{
-				add(ruleAction122, position)
+				add(ruleAction164, position)
 			}
 			return true
 		},
-		/* 313 Action123 <- <{
+		/* 413 Action165 <- <{
 		    p.PushComponent(begin, end, GreaterOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction123, position)
+				add(ruleAction165, position)
 			}
 			return true
 		},
-		/* 314 Action124 <- <{
+		/* 414 Action166 <- <{
 		    p.PushComponent(begin, end, NotEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction124, position)
+				add(ruleAction166, position)
+			}
+			return true
+		},
+		/* 415 Action167 <- <{
+		    p.PushComponent(begin, end, Like)
+		}> */
+		func() bool {
+			{
+				add(ruleAction167, position)
+			}
+			return true
+		},
+		/* 416 Action168 <- <{
+		    p.PushComponent(begin, end, NotLike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction168, position)
+			}
+			return true
+		},
+		/* 417 Action169 <- <{
+		    p.PushComponent(begin, end, ILike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction169, position)
+			}
+			return true
+		},
+		/* 418 Action170 <- <{
+		    p.PushComponent(begin, end, NotILike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction170, position)
+			}
+			return true
+		},
+		/* 419 Action171 <- <{
+		    p.PushComponent(begin, end, SimilarTo)
+		}> */
+		func() bool {
+			{
+				add(ruleAction171, position)
+			}
+			return true
+		},
+		/* 420 Action172 <- <{
+		    p.PushComponent(begin, end, NotSimilarTo)
+		}> */
+		func() bool {
+			{
+				add(ruleAction172, position)
+			}
+			return true
+		},
+		/* 421 Action173 <- <{
+		    p.PushComponent(begin, end, RegexpMatch)
+		}> */
+		func() bool {
+			{
+				add(ruleAction173, position)
+			}
+			return true
+		},
+		/* 422 Action174 <- <{
+		    p.PushComponent(begin, end, NotRegexpMatch)
+		}> */
+		func() bool {
+			{
+				add(ruleAction174, position)
+			}
+			return true
+		},
+		/* 423 Action175 <- <{
+		    p.PushComponent(begin, end, RegexpIMatch)
+		}> */
+		func() bool {
+			{
+				add(ruleAction175, position)
+			}
+			return true
+		},
+		/* 424 Action176 <- <{
+		    p.PushComponent(begin, end, NotRegexpIMatch)
+		}> */
+		func() bool {
+			{
+				add(ruleAction176, position)
 			}
 			return true
 		},
-		/* 315 Action125 <- <{
+		/* 425 Action177 <- <{
 		    p.PushComponent(begin, end, Concat)
 		}> */
 		func() bool {
 			{
-				add(ruleAction125, position)
+				add(ruleAction177, position)
 			}
 			return true
 		},
-		/* 316 Action126 <- <{
+		/* 426 Action178 <- <{
 		    p.PushComponent(begin, end, Is)
 		}> */
 		func() bool {
 			{
-				add(ruleAction126, position)
+				add(ruleAction178, position)
 			}
 			return true
 		},
-		/* 317 Action127 <- <{
+		/* 427 Action179 <- <{
 		    p.PushComponent(begin, end, IsNot)
 		}> */
 		func() bool {
 			{
-				add(ruleAction127, position)
+				add(ruleAction179, position)
 			}
 			return true
 		},
-		/* 318 Action128 <- <{
+		/* 428 Action180 <- <{
 		    p.PushComponent(begin, end, Plus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction128, position)
+				add(ruleAction180, position)
 			}
 			return true
 		},
-		/* 319 Action129 <- <{
+		/* 429 Action181 <- <{
 		    p.PushComponent(begin, end, Minus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction129, position)
+				add(ruleAction181, position)
 			}
 			return true
 		},
-		/* 320 Action130 <- <{
+		/* 430 Action182 <- <{
 		    p.PushComponent(begin, end, Multiply)
 		}> */
 		func() bool {
 			{
-				add(ruleAction130, position)
+				add(ruleAction182, position)
 			}
 			return true
 		},
-		/* 321 Action131 <- <{
+		/* 431 Action183 <- <{
 		    p.PushComponent(begin, end, Divide)
 		}> */
 		func() bool {
 			{
-				add(ruleAction131, position)
+				add(ruleAction183, position)
 			}
 			return true
 		},
-		/* 322 Action132 <- <{
+		/* 432 Action184 <- <{
 		    p.PushComponent(begin, end, Modulo)
 		}> */
 		func() bool {
 			{
-				add(ruleAction132, position)
+				add(ruleAction184, position)
+			}
+			return true
+		},
+		/* 433 Action185 <- <{
+		    p.PushComponent(begin, end, AtTimeZone)
+		}> */
+		func() bool {
+			{
+				add(ruleAction185, position)
 			}
 			return true
 		},
-		/* 323 Action133 <- <{
+		/* 434 Action186 <- <{
 		    p.PushComponent(begin, end, UnaryMinus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction133, position)
+				add(ruleAction186, position)
 			}
 			return true
 		},
-		/* 324 Action134 <- <{
+		/* 435 Action187 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction134, position)
+				add(ruleAction187, position)
 			}
 			return true
 		},
-		/* 325 Action135 <- <{
+		/* 436 Action188 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction135, position)
+				add(ruleAction188, position)
 			}
 			return true
 		},
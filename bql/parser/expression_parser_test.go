@@ -20,27 +20,52 @@ func TestExpressionParser(t *testing.T) {
 		"NULL": {[]Expression{NullLiteral{}}, "NULL"},
 		// Function Application
 		"f()": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{}}, nil}}, "f()"},
+			ExpressionsAST{[]Expression{}}, nil, false, nil}}, "f()"},
 		"now()": {[]Expression{FuncAppAST{FuncName("now"),
-			ExpressionsAST{[]Expression{}}, nil}}, "now()"},
+			ExpressionsAST{[]Expression{}}, nil, false, nil}}, "now()"},
 		"f(a)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil}}, "f(a)"},
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, false, nil}}, "f(a)"},
 		"f(*)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{Wildcard{}}}, nil}}, "f(*)"},
+			ExpressionsAST{[]Expression{Wildcard{}}}, nil, false, nil}}, "f(*)"},
 		"f(x:*)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{Wildcard{"x"}}}, nil}}, "f(x:*)"},
+			ExpressionsAST{[]Expression{Wildcard{"x"}}}, nil, false, nil}}, "f(x:*)"},
 		"f(x:* ORDER BY a)": {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{Wildcard{"x"}}},
-			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}}}, "f(x:* ORDER BY a)"},
+			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}, false, nil}}, "f(x:* ORDER BY a)"},
 		"f(a ORDER BY a DESC, b, c ASC)": {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
-			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}, {RowValue{"", "c"}, Yes}}}}, "f(a ORDER BY a DESC, b, c ASC)"},
+			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}, {RowValue{"", "c"}, Yes}}, false, nil}}, "f(a ORDER BY a DESC, b, c ASC)"},
 		"count(a ORDER BY count(b))": {[]Expression{FuncAppAST{FuncName("count"),
 			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
 			[]SortedExpressionAST{{FuncAppAST{FuncName("count"),
-				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil}, UnspecifiedKeyword}}}}, "count(a ORDER BY count(b))"},
+				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil, false, nil}, UnspecifiedKeyword}}, false, nil}}, "count(a ORDER BY count(b))"},
+		"count(DISTINCT a)": {[]Expression{FuncAppAST{FuncName("count"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, true, nil}}, "count(DISTINCT a)"},
+		"f(DISTINCT a, b ORDER BY a)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}, RowValue{"", "b"}}},
+			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}, true, nil}}, "f(DISTINCT a, b ORDER BY a)"},
+		"percentile_cont(0.5) WITHIN GROUP (ORDER BY a)": {[]Expression{FuncAppAST{FuncName("percentile_cont"),
+			ExpressionsAST{[]Expression{FloatLiteral{0.5}, RowValue{"", "a"}}},
+			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}, false, nil}}, "percentile_cont(0.5, a ORDER BY a)"},
+		"f() WITHIN GROUP(ORDER BY a DESC, b)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}, RowValue{"", "b"}}},
+			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}}, false, nil}}, "f(a, b ORDER BY a DESC, b)"},
+		"lag(a, 2) OVER (PARTITION BY b, c ORDER BY d DESC)": {[]Expression{FuncAppAST{FuncName("lag"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}, NumericLiteral{2}}}, nil, false,
+			&OverClauseAST{[]Expression{RowValue{"", "b"}, RowValue{"", "c"}},
+				[]SortedExpressionAST{{RowValue{"", "d"}, No}}}}},
+			"lag(a, 2) OVER (PARTITION BY b, c ORDER BY d DESC)"},
+		"row_number() over(order by a)": {[]Expression{FuncAppAST{FuncName("row_number"),
+			ExpressionsAST{[]Expression{}}, nil, false,
+			&OverClauseAST{nil, []SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}}}},
+			"row_number() OVER (ORDER BY a)"},
+		"first_value(a) OVER ( )": {[]Expression{FuncAppAST{FuncName("first_value"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, false,
+			&OverClauseAST{}}}, "first_value(a) OVER ()"},
+		"f(distinct_a)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{RowValue{"", "distinct_a"}}}, nil, false, nil}}, "f(distinct_a)"},
 		`f(2.1, "a")`: {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil}}, `f(2.1, "a")`},
+			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil, false, nil}}, `f(2.1, "a")`},
 		// Type Cast
 		"CAST(2.1 AS BOOL)":    {[]Expression{TypeCastAST{FloatLiteral{2.1}, Bool}}, "CAST(2.1 AS BOOL)"},
 		"CAST(2.1 AS INT)":     {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
@@ -148,6 +173,37 @@ func TestExpressionParser(t *testing.T) {
 		"a >= 2": {[]Expression{BinaryOpAST{GreaterOrEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a >= 2"},
 		"a != 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
 		"a <> 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
+		// Pattern matching
+		`a LIKE "x%"`:            {[]Expression{BinaryOpAST{Like, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a LIKE "x%"`},
+		`a NOT LIKE "x%"`:        {[]Expression{BinaryOpAST{NotLike, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a NOT LIKE "x%"`},
+		`a ilike "x%"`:           {[]Expression{BinaryOpAST{ILike, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a ILIKE "x%"`},
+		`a NOT ILIKE "x%"`:       {[]Expression{BinaryOpAST{NotILike, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a NOT ILIKE "x%"`},
+		`a SIMILAR TO "x|y"`:     {[]Expression{BinaryOpAST{SimilarTo, RowValue{"", "a"}, StringLiteral{"x|y"}}}, `a SIMILAR TO "x|y"`},
+		`a NOT SIMILAR TO "x|y"`: {[]Expression{BinaryOpAST{NotSimilarTo, RowValue{"", "a"}, StringLiteral{"x|y"}}}, `a NOT SIMILAR TO "x|y"`},
+		`a ~ "^x"`:               {[]Expression{BinaryOpAST{RegexpMatch, RowValue{"", "a"}, StringLiteral{"^x"}}}, `a ~ "^x"`},
+		`a!~"^x"`:                {[]Expression{BinaryOpAST{NotRegexpMatch, RowValue{"", "a"}, StringLiteral{"^x"}}}, `a !~ "^x"`},
+		`a ~* "^x"`:              {[]Expression{BinaryOpAST{RegexpIMatch, RowValue{"", "a"}, StringLiteral{"^x"}}}, `a ~* "^x"`},
+		`a !~* "^x"`:             {[]Expression{BinaryOpAST{NotRegexpIMatch, RowValue{"", "a"}, StringLiteral{"^x"}}}, `a !~* "^x"`},
+		`a LIKE"x%"`:             {nil, ""}, // LIKE needs a hard space
+		// IN and BETWEEN
+		"a IN (1, 2, 3)": {[]Expression{InAST{RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}, NumericLiteral{2}, NumericLiteral{3}}}}, true, false}},
+			"a IN (1, 2, 3)"},
+		"a NOT IN(b)": {[]Expression{InAST{RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{RowValue{"", "b"}}}}, true, true}},
+			"a NOT IN (b)"},
+		"a IN [1, 2]": {[]Expression{InAST{RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}, NumericLiteral{2}}}}, false, false}},
+			"a IN [1, 2]"},
+		"a not in b": {[]Expression{InAST{RowValue{"", "a"}, RowValue{"", "b"}, false, true}}, "a NOT IN b"},
+		"a IN ()":    {nil, ""},
+		"a INb":      {nil, ""},
+		"a BETWEEN 1 AND b + 2": {[]Expression{BetweenAST{RowValue{"", "a"}, NumericLiteral{1},
+			BinaryOpAST{Plus, RowValue{"", "b"}, NumericLiteral{2}}, false}},
+			"a BETWEEN 1 AND b + 2"},
+		"a NOT BETWEEN 1 AND 2": {[]Expression{BetweenAST{RowValue{"", "a"}, NumericLiteral{1},
+			NumericLiteral{2}, true}},
+			"a NOT BETWEEN 1 AND 2"},
 		// Other operators
 		"a || 2": {[]Expression{BinaryOpAST{Concat, RowValue{"", "a"}, NumericLiteral{2}}}, "a || 2"},
 		// IS Expressions
@@ -168,6 +224,12 @@ func TestExpressionParser(t *testing.T) {
 			UnaryOpAST{UnaryMinus, NumericLiteral{2}}}}, "-a * -2"},
 		"a / 2": {[]Expression{BinaryOpAST{Divide, RowValue{"", "a"}, NumericLiteral{2}}}, "a / 2"},
 		"a % 2": {[]Expression{BinaryOpAST{Modulo, RowValue{"", "a"}, NumericLiteral{2}}}, "a % 2"},
+		`a AT TIME ZONE "Asia/Tokyo"`: {[]Expression{BinaryOpAST{AtTimeZone, RowValue{"", "a"},
+			StringLiteral{"Asia/Tokyo"}}}, `a AT TIME ZONE "Asia/Tokyo"`},
+		"a at time  zone b": {[]Expression{BinaryOpAST{AtTimeZone, RowValue{"", "a"},
+			RowValue{"", "b"}}}, "a AT TIME ZONE b"},
+		"a AT TIMEZONE b": {nil, ""},
+		"a AT TIME ZONEb": {nil, ""},
 		/// Operator Precedence
 		"a AND b OR 2": {[]Expression{BinaryOpAST{Or,
 			BinaryOpAST{And, RowValue{"", "a"}, RowValue{"", "b"}},
@@ -199,9 +261,36 @@ func TestExpressionParser(t *testing.T) {
 		"2 || a = 4": {[]Expression{BinaryOpAST{Equal,
 			BinaryOpAST{Concat, NumericLiteral{2}, RowValue{"", "a"}},
 			NumericLiteral{4}}}, "2 || a = 4"},
+		`a || "x" LIKE b || "%"`: {[]Expression{BinaryOpAST{Like,
+			BinaryOpAST{Concat, RowValue{"", "a"}, StringLiteral{"x"}},
+			BinaryOpAST{Concat, RowValue{"", "b"}, StringLiteral{"%"}}}}, `a || "x" LIKE b || "%"`},
+		`NOT a LIKE "x" AND b ~ "y"`: {[]Expression{BinaryOpAST{And,
+			UnaryOpAST{Not, BinaryOpAST{Like, RowValue{"", "a"}, StringLiteral{"x"}}},
+			BinaryOpAST{RegexpMatch, RowValue{"", "b"}, StringLiteral{"y"}}}}, `NOT (a LIKE "x") AND b ~ "y"`},
+		"a BETWEEN 1 AND 2 AND b IN (3)": {[]Expression{BinaryOpAST{And,
+			BetweenAST{RowValue{"", "a"}, NumericLiteral{1}, NumericLiteral{2}, false},
+			InAST{RowValue{"", "b"}, ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{3}}}}, true, false}}},
+			"a BETWEEN 1 AND 2 AND b IN (3)"},
+		"NOT a IN (1) OR b": {[]Expression{BinaryOpAST{Or,
+			UnaryOpAST{Not, InAST{RowValue{"", "a"}, ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}}}}, true, false}},
+			RowValue{"", "b"}}},
+			"NOT a IN (1) OR b"},
+		"(a = 1) IN (true, b < 2)": {[]Expression{InAST{
+			BinaryOpAST{Equal, RowValue{"", "a"}, NumericLiteral{1}},
+			ArrayAST{ExpressionsAST{[]Expression{BoolLiteral{true},
+				BinaryOpAST{Less, RowValue{"", "b"}, NumericLiteral{2}}}}}, true, false}},
+			"(a = 1) IN (TRUE, b < 2)"},
 		"-2.1::INT": {[]Expression{UnaryOpAST{UnaryMinus,
 			TypeCastAST{FloatLiteral{2.1}, Int}}}, "-CAST(2.1 AS INT)"},
+		`a AT TIME ZONE "UTC" || "x"`: {[]Expression{BinaryOpAST{Concat,
+			BinaryOpAST{AtTimeZone, RowValue{"", "a"}, StringLiteral{"UTC"}},
+			StringLiteral{"x"}}}, `a AT TIME ZONE "UTC" || "x"`},
+		"a * b AT TIME ZONE c": {[]Expression{BinaryOpAST{Multiply, RowValue{"", "a"},
+			BinaryOpAST{AtTimeZone, RowValue{"", "b"}, RowValue{"", "c"}}}}, "a * b AT TIME ZONE c"},
 		/// Left-Associativity
+		"a AT TIME ZONE b AT TIME ZONE c": {[]Expression{BinaryOpAST{AtTimeZone,
+			BinaryOpAST{AtTimeZone, RowValue{"", "a"}, RowValue{"", "b"}}, RowValue{"", "c"}}},
+			"(a AT TIME ZONE b) AT TIME ZONE c"},
 		`a || "2" || b`: {[]Expression{BinaryOpAST{Concat,
 			BinaryOpAST{Concat, RowValue{"", "a"}, StringLiteral{"2"}}, RowValue{"", "b"}}}, `(a || "2") || b`},
 		"a - 2 - b": {[]Expression{BinaryOpAST{Minus,
@@ -277,7 +366,7 @@ func TestExpressionParser(t *testing.T) {
 		"a, 3.1, false,-2": {[]Expression{RowValue{"", "a"}, FloatLiteral{3.1}, BoolLiteral{false}, UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "a, 3.1, FALSE, -2"},
 		`"日本語", 13`:        {[]Expression{StringLiteral{"日本語"}, NumericLiteral{13}}, `"日本語", 13`},
 		`concat(a, "Pi", 3.1), b`: {[]Expression{FuncAppAST{FuncName("concat"), ExpressionsAST{
-			[]Expression{RowValue{"", "a"}, StringLiteral{"Pi"}, FloatLiteral{3.1}}}, nil},
+			[]Expression{RowValue{"", "a"}, StringLiteral{"Pi"}, FloatLiteral{3.1}}}, nil, false, nil},
 			RowValue{"", "b"}}, `concat(a, "Pi", 3.1), b`},
 	}
 
@@ -2,6 +2,9 @@ package parser
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
@@ -68,30 +71,49 @@ func (ps *parseStack) Peek() (value *ParsedComponent) {
 // they are components of a SELECT statement, and replaces them by
 // a single SelectStmt element.
 //
-//  EmitterAST
+//  LimitAST
+//  OrderingAST
 //  HavingAST
 //  GroupingAST
 //  FilterAST
+//  MatchRecognizeAST
 //  WindowedFromAST
 //  ProjectionsAST
+//  DistinctAST
+//  EmitterAST
+//  WithAST
 //   =>
-//  SelectStmt{EmitterAST, ProjectionsAST, WindowedFromAST, FilterAST, GroupingAST, HavingAST}
+//  SelectStmt{WithAST, EmitterAST, DistinctAST, ProjectionsAST,
+//    WindowedFromAST, MatchRecognizeAST, FilterAST, GroupingAST,
+//    HavingAST, OrderingAST, LimitAST}
 func (ps *parseStack) AssembleSelect() {
 	// pop the components from the stack in reverse order
-	_having, _grouping, _filter, _from, _projections, _emitter := ps.pop6()
+	_limit, _ordering, _having, _grouping := ps.pop4()
+	_filter, _match, _from, _projections, _distinct, _emitter := ps.pop6()
+	_with := ps.Pop()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
 	having := _having.comp.(HavingAST)
 	grouping := _grouping.comp.(GroupingAST)
 	filter := _filter.comp.(FilterAST)
+	match := _match.comp.(MatchRecognizeAST)
 	from := _from.comp.(WindowedFromAST)
 	projections := _projections.comp.(ProjectionsAST)
+	distinct := _distinct.comp.(DistinctAST)
 	emitter := _emitter.comp.(EmitterAST)
+	ordering := _ordering.comp.(OrderingAST)
+	limit := _limit.comp.(LimitAST)
+	with := _with.comp.(WithAST)
 
 	// assemble the SelectStmt and push it back
-	s := SelectStmt{emitter, projections, from, filter, grouping, having}
-	se := ParsedComponent{_emitter.begin, _having.end, s}
+	s := SelectStmt{with, emitter, distinct, projections, from, match, filter,
+		grouping, having, ordering, limit}
+	begin := _emitter.begin
+	if len(with.CommonTableExpressions) > 0 {
+		begin = _with.begin
+	}
+	se := ParsedComponent{begin, _limit.end, s}
 	ps.Push(&se)
 }
 
@@ -118,22 +140,24 @@ func (ps *parseStack) AssembleSelectUnion(begin int, end int) {
 // assuming they are components of a CREATE STREAM statement, and
 // replaces them by a single CreateStreamAsSelectStmt element.
 //
+//  SourceSinkSpecsAST
 //  SelectStmt
 //  StreamIdentifier
 //   =>
-//  CreateStreamAsSelectStmt{StreamIdentifier, SelectStmt}
+//  CreateStreamAsSelectStmt{StreamIdentifier, SelectStmt, SourceSinkSpecsAST}
 func (ps *parseStack) AssembleCreateStreamAsSelect() {
 	// now pop the components from the stack in reverse order
-	_select, _name := ps.pop2()
+	_specs, _select, _name := ps.pop3()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
+	specs := _specs.comp.(SourceSinkSpecsAST)
 	s := _select.comp.(SelectStmt)
 	name := _name.comp.(StreamIdentifier)
 
 	// assemble the SelectStmt and push it back
-	css := CreateStreamAsSelectStmt{name, s}
-	se := ParsedComponent{_name.begin, _select.end, css}
+	css := CreateStreamAsSelectStmt{name, s, specs}
+	se := ParsedComponent{_name.begin, _specs.end, css}
 	ps.Push(&se)
 }
 
@@ -606,6 +630,26 @@ func (ps *parseStack) AssembleEmitterSampling(samplingType EmitterSamplingType,
 	ps.PushComponent(_value.begin, _value.end, EmitterSampling{value * factor, samplingType})
 }
 
+// AssembleDistinct takes the BinaryKeyword on top of the stack (if
+// there is a DISTINCT keyword) and replaces it by a DistinctAST struct.
+// If there is no DISTINCT keyword, an empty DistinctAST struct is used.
+//
+//  BinaryKeyword
+//   =>
+//  DistinctAST{true}
+func (ps *parseStack) AssembleDistinct(begin int, end int) {
+	if begin == end {
+		ps.PushComponent(begin, end, DistinctAST{})
+		return
+	}
+	_distinct := ps.Pop()
+	if begin > _distinct.begin || end < _distinct.end {
+		panic("the item on top of the stack is not within given range")
+	}
+	distinct := _distinct.comp.(BinaryKeyword)
+	ps.PushComponent(begin, end, DistinctAST{distinct == Yes})
+}
+
 // AssembleProjections takes the elements from the stack that
 // correspond to the input[begin:end] string and wraps a
 // ProjectionsAST struct around them.
@@ -647,31 +691,105 @@ func (ps *parseStack) AssembleAlias() {
 
 // AssembleWindowedFrom takes the elements from the stack that
 // correspond to the input[begin:end] string, makes sure they are all
-// AliasedStreamWindowAST elements and wraps a WindowedFromAST struct
+// AliasedStreamWindowAST elements (except for a JoinAST element when
+// the JOIN syntax is used) and wraps a WindowedFromAST struct
 // around them. If there are no such elements, adds an
 // empty WindowedFromAST struct to the stack.
 //
 //  AliasedStreamWindowAST
 //  AliasedStreamWindowAST
 //   =>
-//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST]}
+//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST], JoinAST{}}
 func (ps *parseStack) AssembleWindowedFrom(begin int, end int) {
 	if begin == end {
 		// push an empty FROM clause
 		ps.PushComponent(begin, end, WindowedFromAST{})
 	} else {
 		elems := ps.collectElements(begin, end)
-		rels := make([]AliasedStreamWindowAST, len(elems), len(elems))
-		for i, elem := range elems {
+		rels := make([]AliasedStreamWindowAST, 0, len(elems))
+		join := JoinAST{}
+		for _, elem := range elems {
+			if j, ok := elem.(JoinAST); ok {
+				join = j
+				continue
+			}
 			// (if this conversion fails, this is a fundamental parser bug)
 			e := elem.(AliasedStreamWindowAST)
-			rels[i] = e
+			rels = append(rels, e)
 		}
 		// push the grouped list back
-		ps.PushComponent(begin, end, WindowedFromAST{rels})
+		ps.PushComponent(begin, end, WindowedFromAST{rels, join})
 	}
 }
 
+// AssembleJoin takes the topmost elements from the stack, assuming
+// they are components of a JOIN clause, and replaces the join type
+// and the join condition by a single JoinAST element that is pushed
+// after the two relations.
+//
+//  Expression
+//  AliasedStreamWindowAST
+//  JoinType
+//  AliasedStreamWindowAST
+//   =>
+//  AliasedStreamWindowAST
+//  AliasedStreamWindowAST
+//  JoinAST{JoinType, Expression}
+func (ps *parseStack) AssembleJoin() {
+	// pop the components from the stack in reverse order
+	_on, _right, _joinType, _left := ps.pop4()
+
+	on := _on.comp.(Expression)
+	joinType := _joinType.comp.(JoinType)
+
+	ps.Push(_left)
+	ps.Push(_right)
+	ps.PushComponent(_right.end, _on.end, JoinAST{joinType, on, TableAST{}})
+}
+
+// AssembleTableJoin takes the topmost elements from the stack, assuming
+// they are components of a JOIN TABLE clause, and replaces the join type,
+// the table and the join condition by a single JoinAST element that is
+// pushed after the relation.
+//
+//  Expression
+//  TableAST
+//  JoinType
+//  AliasedStreamWindowAST
+//   =>
+//  AliasedStreamWindowAST
+//  JoinAST{JoinType, Expression, TableAST}
+func (ps *parseStack) AssembleTableJoin() {
+	// pop the components from the stack in reverse order
+	_on, _table, _joinType, _rel := ps.pop4()
+
+	on := _on.comp.(Expression)
+	table := _table.comp.(TableAST)
+	joinType := _joinType.comp.(JoinType)
+
+	ps.Push(_rel)
+	ps.PushComponent(_joinType.begin, _on.end, JoinAST{joinType, on, table})
+}
+
+// AssembleAliasedTable takes the topmost elements from the stack, assuming
+// they are components of an AS clause of a JOIN TABLE clause, and replaces
+// them by a single TableAST element.
+//
+//  Identifier
+//  TableAST
+//   =>
+//  TableAST{Name, Identifier}
+func (ps *parseStack) AssembleAliasedTable() {
+	// pop the components from the stack in reverse order
+	_name, _table := ps.pop2()
+
+	name := _name.comp.(Identifier)
+	table := _table.comp.(TableAST)
+	table.Alias = string(name)
+
+	ps.PushComponent(_table.begin, _name.end, table)
+}
+
 // AssembleInterval takes the topmost elements from the stack, assuming
 // they are components of a RANGE clause, and replaces them by
 // a single IntervalAST element.
@@ -704,6 +822,271 @@ func (ps *parseStack) AssembleInterval() {
 	ps.PushComponent(_num.begin, _unit.end, IntervalAST{FloatLiteral{val}, unit})
 }
 
+// AssembleWindowSpec takes the topmost elements from the stack, assuming
+// they are components of a RANGE or TUMBLING clause, and replaces them by
+// a single WindowSpecAST element.
+//
+//  IntervalAST
+//  WindowType
+//   =>
+//  WindowSpecAST{WindowType, IntervalAST, IntervalAST{}, nil}
+func (ps *parseStack) AssembleWindowSpec() {
+	// pop the components from the stack in reverse order
+	_size, _window := ps.pop2()
+
+	size := _size.comp.(IntervalAST)
+	window := _window.comp.(WindowType)
+
+	ps.PushComponent(_window.begin, _size.end, WindowSpecAST{window, size, IntervalAST{}, nil})
+}
+
+// AssembleHoppingWindowSpec takes the topmost elements from the stack,
+// assuming they are components of a HOPPING clause, and replaces them by
+// a single WindowSpecAST element.
+//
+//  IntervalAST
+//  IntervalAST
+//  WindowType
+//   =>
+//  WindowSpecAST{WindowType, IntervalAST, IntervalAST, nil}
+func (ps *parseStack) AssembleHoppingWindowSpec() {
+	// pop the components from the stack in reverse order
+	_slide, _size, _window := ps.pop3()
+
+	slide := _slide.comp.(IntervalAST)
+	size := _size.comp.(IntervalAST)
+	window := _window.comp.(WindowType)
+
+	ps.PushComponent(_window.begin, _slide.end, WindowSpecAST{window, size, slide, nil})
+}
+
+// AssembleSessionWindowSpec takes the topmost elements from the stack,
+// assuming they are components of a SESSION clause, and replaces them by
+// a single WindowSpecAST element.
+//
+//  Expression
+//  IntervalAST
+//  WindowType
+//   =>
+//  WindowSpecAST{WindowType, IntervalAST, IntervalAST{}, Expression}
+func (ps *parseStack) AssembleSessionWindowSpec() {
+	// pop the components from the stack in reverse order
+	_key, _gap, _window := ps.pop3()
+
+	key := _key.comp.(Expression)
+	gap := _gap.comp.(IntervalAST)
+	window := _window.comp.(WindowType)
+
+	ps.PushComponent(_window.begin, _key.end, WindowSpecAST{window, gap, IntervalAST{}, key})
+}
+
+/* WITH clause */
+
+// AssembleWith takes the elements from the stack that correspond to
+// the input[begin:end] string (if there is a WITH clause) and wraps
+// a WithAST struct around them. If there is no WITH clause, an empty
+// WithAST struct is used.
+//
+//  CommonTableExpressionAST
+//  CommonTableExpressionAST
+//   =>
+//  WithAST{[CommonTableExpressionAST, CommonTableExpressionAST]}
+func (ps *parseStack) AssembleWith(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	var ctes []CommonTableExpressionAST
+	for _, e := range elems {
+		ctes = append(ctes, e.(CommonTableExpressionAST))
+	}
+	ps.PushComponent(begin, end, WithAST{ctes})
+}
+
+// AssembleCommonTableExpression takes the topmost elements from the
+// stack, assuming they are components of a common table expression in
+// a WITH clause, and replaces them by a single CommonTableExpressionAST
+// element.
+//
+//  SelectStmt
+//  StreamIdentifier
+//   =>
+//  CommonTableExpressionAST{StreamIdentifier, SelectStmt}
+func (ps *parseStack) AssembleCommonTableExpression() {
+	_select, _name := ps.pop2()
+
+	s := _select.comp.(SelectStmt)
+	name := _name.comp.(StreamIdentifier)
+
+	ps.PushComponent(_name.begin, _select.end, CommonTableExpressionAST{name, s})
+}
+
+/* MATCH_RECOGNIZE clause */
+
+// AssembleMatchRecognize takes the elements from the stack that
+// correspond to the input[begin:end] string (if there is a
+// MATCH_RECOGNIZE clause) and wraps a MatchRecognizeAST struct
+// around them. If there is no MATCH_RECOGNIZE clause, an empty
+// MatchRecognizeAST struct is used.
+//
+//  PatternDefinitionAST
+//  PatternDefinitionAST
+//  PatternAST
+//  ExpressionsAST (measures)
+//  ExpressionsAST (partition)
+//   =>
+//  MatchRecognizeAST{&MatchRecognizeClauseAST{partition, measures,
+//    PatternAST, [PatternDefinitionAST, PatternDefinitionAST]}}
+func (ps *parseStack) AssembleMatchRecognize(begin int, end int) {
+	if begin == end {
+		ps.PushComponent(begin, end, MatchRecognizeAST{})
+		return
+	}
+	elems := ps.collectElements(begin, end)
+	partition := elems[0].(ExpressionsAST)
+	measures := elems[1].(ExpressionsAST)
+	pattern := elems[2].(PatternAST)
+	defs := make([]PatternDefinitionAST, len(elems)-3)
+	for i, e := range elems[3:] {
+		defs[i] = e.(PatternDefinitionAST)
+	}
+	ps.PushComponent(begin, end, MatchRecognizeAST{&MatchRecognizeClauseAST{
+		partition.Expressions, measures.Expressions, pattern, defs}})
+}
+
+// AssemblePatternDefinition takes the topmost elements from the stack,
+// assuming they are components of a DEFINE clause of MATCH_RECOGNIZE,
+// and replaces them by a single PatternDefinitionAST element.
+//
+//  Any
+//  Identifier
+//   =>
+//  PatternDefinitionAST{Identifier, Any}
+func (ps *parseStack) AssemblePatternDefinition() {
+	_cond, _name := ps.pop2()
+
+	cond := _cond.comp.(Expression)
+	name := _name.comp.(Identifier)
+
+	ps.PushComponent(_name.begin, _cond.end, PatternDefinitionAST{string(name), cond})
+}
+
+// AssemblePatternAlternation takes the PatternAST elements from the
+// stack that correspond to the input[begin:end] string and combines
+// them into an alternation. A single element is left as it is.
+//
+//  PatternAST
+//  PatternAST
+//   =>
+//  PatternAST{Alternation: true, Elements: [PatternAST, PatternAST]}
+func (ps *parseStack) AssemblePatternAlternation(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) == 1 {
+		ps.PushComponent(begin, end, elems[0])
+		return
+	}
+	alt := PatternAST{Alternation: true, Min: 1, Max: 1}
+	for _, e := range elems {
+		alt.Elements = append(alt.Elements, e.(PatternAST))
+	}
+	ps.PushComponent(begin, end, alt)
+}
+
+// AssemblePatternSequence takes the PatternAST elements from the
+// stack that correspond to the input[begin:end] string and combines
+// them into a sequence. A single element is left as it is and nested
+// sequences without a quantifier are flattened.
+//
+//  PatternAST
+//  PatternAST
+//   =>
+//  PatternAST{Elements: [PatternAST, PatternAST]}
+func (ps *parseStack) AssemblePatternSequence(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) == 1 {
+		ps.PushComponent(begin, end, elems[0])
+		return
+	}
+	seq := PatternAST{Min: 1, Max: 1}
+	for _, e := range elems {
+		p := e.(PatternAST)
+		if p.Variable == "" && !p.Alternation && p.quantifier() == "" {
+			seq.Elements = append(seq.Elements, p.Elements...)
+		} else {
+			seq.Elements = append(seq.Elements, p)
+		}
+	}
+	ps.PushComponent(begin, end, seq)
+}
+
+// patternQuantifier is the quantifier of a pattern, which is only
+// used while parsing.
+type patternQuantifier struct {
+	min int
+	max int
+}
+
+// AssemblePatternQuantifier parses the quantifier of a pattern such
+// as `+` or `{2,5}` and pushes it to the stack. When there is no
+// quantifier, a quantifier matching exactly once is used. Numbers
+// too large for an int are replaced by math.MaxInt32 so that the
+// pattern is rejected later.
+func (ps *parseStack) AssemblePatternQuantifier(begin int, end int, quantifier string) {
+	q := patternQuantifier{1, 1}
+	switch quantifier {
+	case "":
+	case "*":
+		q = patternQuantifier{0, -1}
+	case "+":
+		q = patternQuantifier{1, -1}
+	case "?":
+		q = patternQuantifier{0, 1}
+	default:
+		// the grammar makes sure that this has the form {n}, {n,},
+		// {,m} or {n,m} with optional spaces
+		toInt := func(s string, def int) int {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				return def
+			}
+			i, err := strconv.Atoi(s)
+			if err != nil || i > math.MaxInt32 {
+				return math.MaxInt32
+			}
+			return i
+		}
+		bounds := strings.Split(quantifier[1:len(quantifier)-1], ",")
+		q.min = toInt(bounds[0], 0)
+		if len(bounds) == 1 {
+			q.max = q.min
+		} else {
+			q.max = toInt(bounds[1], -1)
+		}
+	}
+	ps.PushComponent(begin, end, q)
+}
+
+// AssemblePatternTerm takes the topmost elements from the stack,
+// assuming they are a pattern and its quantifier, and applies the
+// quantifier to the pattern. A pattern which already has a quantifier
+// is wrapped in a sequence.
+//
+//  patternQuantifier
+//  PatternAST
+//   =>
+//  PatternAST{..., Min: patternQuantifier.min, Max: patternQuantifier.max}
+func (ps *parseStack) AssemblePatternTerm() {
+	_quantifier, _pattern := ps.pop2()
+
+	q := _quantifier.comp.(patternQuantifier)
+	p := _pattern.comp.(PatternAST)
+
+	if q.min != 1 || q.max != 1 {
+		if p.quantifier() != "" {
+			p = PatternAST{Elements: []PatternAST{p}}
+		}
+		p.Min, p.Max = q.min, q.max
+	}
+	ps.PushComponent(_pattern.begin, _quantifier.end, p)
+}
+
 /* WHERE clause */
 
 // AssembleFilter takes the expression on top of the stack
@@ -779,6 +1162,74 @@ func (ps *parseStack) AssembleHaving(begin int, end int) {
 	}
 }
 
+/* ORDER BY clause */
+
+// AssembleOrdering takes the SortedExpressionAST elements from the
+// stack that correspond to the input[begin:end] string and wraps an
+// OrderingAST struct around them. If there is no ORDER BY clause, an
+// empty OrderingAST struct is used.
+//
+//  SortedExpressionAST
+//  SortedExpressionAST
+//   =>
+//  OrderingAST{[SortedExpressionAST, SortedExpressionAST]}
+func (ps *parseStack) AssembleOrdering(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	var exprs []SortedExpressionAST
+	if len(elems) > 0 {
+		exprs = make([]SortedExpressionAST, len(elems))
+	}
+	for i := range elems {
+		exprs[i] = elems[i].(SortedExpressionAST)
+	}
+	// push the grouped list back
+	ps.PushComponent(begin, end, OrderingAST{exprs})
+}
+
+/* LIMIT and OFFSET clauses */
+
+// AssembleLimit takes the NumericLiteral on top of the stack (if
+// there is a LIMIT clause) and wraps a LimitAST struct around it.
+// If there is no LIMIT clause, an empty LimitAST struct is used.
+//
+//  NumericLiteral
+//   =>
+//  LimitAST{true, NumericLiteral, 0}
+func (ps *parseStack) AssembleLimit(begin int, end int) {
+	if begin == end {
+		ps.PushComponent(begin, end, LimitAST{})
+		return
+	}
+	_limit := ps.Pop()
+	if begin > _limit.begin || end < _limit.end {
+		panic("the item on top of the stack is not within given range")
+	}
+	limit := _limit.comp.(NumericLiteral)
+	ps.PushComponent(begin, end, LimitAST{HasLimit: true, Limit: limit.Value})
+}
+
+// AssembleOffset takes the NumericLiteral on top of the stack (if
+// there is an OFFSET clause) and stores it in the LimitAST below.
+// If there is no OFFSET clause, the stack is left unchanged.
+//
+//  NumericLiteral
+//  LimitAST{HasLimit, Limit, 0}
+//   =>
+//  LimitAST{HasLimit, Limit, NumericLiteral}
+func (ps *parseStack) AssembleOffset(begin int, end int) {
+	if begin == end {
+		return
+	}
+	_offset, _limit := ps.pop2()
+	if begin > _offset.begin || end < _offset.end {
+		panic("the item on top of the stack is not within given range")
+	}
+	offset := _offset.comp.(NumericLiteral)
+	limit := _limit.comp.(LimitAST)
+	limit.Offset = offset.Value
+	ps.PushComponent(_limit.begin, end, limit)
+}
+
 // AssembleAliasedStreamWindow takes the topmost elements from the stack, assuming
 // they are components of an AS clause, and replaces them by
 // a single AliasedStreamWindowAST element.
@@ -820,16 +1271,18 @@ func (ps *parseStack) EnsureAliasedStreamWindow() {
 // they are components of an AS clause, and replaces them by
 // a single StreamWindowAST element.
 //
-//  IntervalAST
+//  SheddingOption
+//  NumericLiteral
+//  WindowSpecAST
 //  Stream
 //   =>
-//  StreamWindowAST{Stream, IntervalAST}
+//  StreamWindowAST{Stream, WindowSpecAST, NumericLiteral.Value, SheddingOption}
 func (ps *parseStack) AssembleStreamWindow() {
 	// pop the components from the stack in reverse order
 	_shedding, _capacity, _range, _rel := ps.pop4()
 
 	rel := _rel.comp.(Stream)
-	rangeAst := _range.comp.(IntervalAST)
+	rangeAst := _range.comp.(WindowSpecAST)
 	capacity := _capacity.comp.(NumericLiteral)
 	shedding := _shedding.comp.(SheddingOption)
 
@@ -843,17 +1296,32 @@ func (ps *parseStack) AssembleStreamWindow() {
 //
 //  FuncAppAST{Function, ExpressionsAST}
 //   =>
-//  Stream{UDSFStream, Function, ExpressionAST.Expressions}
+//  Stream{UDSFStream, Function, ExpressionAST.Expressions, nil}
 func (ps *parseStack) AssembleUDSFFuncApp() {
 	_fun := ps.Pop()
 
 	fun := _fun.comp.(FuncAppAST)
 
 	se := ParsedComponent{_fun.begin, _fun.end,
-		Stream{UDSFStream, string(fun.Function), fun.Expressions}}
+		Stream{UDSFStream, string(fun.Function), fun.Expressions, nil}}
 	ps.Push(&se)
 }
 
+// AssembleSubquery takes the SelectStmt on top of the stack and
+// replaces it by a Stream element. The name of the stream is left
+// empty; it is assigned when the subquery is added to a topology.
+//
+//  SelectStmt
+//   =>
+//  Stream{SubqueryStream, "", nil, SelectStmt}
+func (ps *parseStack) AssembleSubquery(begin int, end int) {
+	_select := ps.Pop()
+
+	sel := _select.comp.(SelectStmt)
+
+	ps.PushComponent(begin, end, Stream{SubqueryStream, "", nil, &sel})
+}
+
 // EnsureCapacitySpec makes sure that the top element of the stack
 // is a NumericLiteral element.
 func (ps *parseStack) EnsureCapacitySpec(begin int, end int) {
@@ -1075,7 +1543,58 @@ func (ps *parseStack) AssembleUnaryPrefixOperation(begin int, end int) {
 	}
 }
 
-// AssembleTypeCast takes the two elements from the stack that
+// AssembleIn takes the topmost elements from the stack, assuming they
+// are components of an IN predicate, and replaces them by a single
+// InAST element. If the values are given as a parenthesized list, the
+// topmost element is an ExpressionsAST.
+//
+//  Any
+//  BinaryKeyword
+//  ExpressionsAST
+//   =>
+//  InAST{Any, ArrayAST{ExpressionsAST}, true, BinaryKeyword}
+// or
+//  Any
+//  BinaryKeyword
+//  Any
+//   =>
+//  InAST{Any, Any, false, BinaryKeyword}
+func (ps *parseStack) AssembleIn(begin int, end int) {
+	_values, _not, _expr := ps.pop3()
+
+	not := _not.comp.(BinaryKeyword)
+	expr := _expr.comp.(Expression)
+
+	if list, ok := _values.comp.(ExpressionsAST); ok {
+		ps.PushComponent(_expr.begin, end, InAST{expr, ArrayAST{list}, true, not == Yes})
+		return
+	}
+	values := _values.comp.(Expression)
+	ps.PushComponent(_expr.begin, end, InAST{expr, values, false, not == Yes})
+}
+
+// AssembleBetween takes the topmost elements from the stack, assuming
+// they are components of a BETWEEN predicate, and replaces them by a
+// single BetweenAST element.
+//
+//  Any
+//  BinaryKeyword
+//  Any
+//  Any
+//   =>
+//  BetweenAST{Any, Any, Any, BinaryKeyword}
+func (ps *parseStack) AssembleBetween(begin int, end int) {
+	_upper, _lower, _not, _expr := ps.pop4()
+
+	expr := _expr.comp.(Expression)
+	not := _not.comp.(BinaryKeyword)
+	lower := _lower.comp.(Expression)
+	upper := _upper.comp.(Expression)
+
+	ps.PushComponent(_expr.begin, end, BetweenAST{expr, lower, upper, not == Yes})
+}
+
+// AssembleTypeCast takes// AssembleTypeCast takes the two elements from the stack that
 // correspond to the input[begin:end] string and replaces them by
 // a single TypeCastAST element. If there is just one element, push
 // it back unmodified.
@@ -1109,16 +1628,18 @@ func (ps *parseStack) AssembleTypeCast(begin int, end int) {
 //
 //  ExpressionsAST
 //  ExpressionsAST
+//  BinaryKeyword
 //  FuncName
 //   =>
-//  FuncAppAST{FuncName, ExpressionsAST}
+//  FuncAppAST{FuncName, ExpressionsAST, ExpressionsAST, BinaryKeyword}
 func (ps *parseStack) AssembleFuncApp() {
-	_ordering, _exprs, _funcName := ps.pop3()
+	_ordering, _exprs, _distinct, _funcName := ps.pop4()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
 	ordering := _ordering.comp.(ExpressionsAST)
 	exprs := _exprs.comp.(ExpressionsAST)
+	distinct := _distinct.comp.(BinaryKeyword)
 	funcName := _funcName.comp.(FuncName)
 
 	orderExprs := make([]SortedExpressionAST, len(ordering.Expressions))
@@ -1130,7 +1651,75 @@ func (ps *parseStack) AssembleFuncApp() {
 	}
 
 	// assemble the FuncAppAST and push it back
-	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs, orderExprs})
+	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs,
+		orderExprs, distinct == Yes, nil})
+}
+
+// AssembleFuncAppWithinGroup takes the topmost elements from the stack,
+// assuming they are components of a function application clause with
+// a `WITHIN GROUP (ORDER BY ...)` suffix, and replaces them by a single
+// FuncAppAST element. The sorted expressions are appended to the
+// function parameters, i.e., `f(a) WITHIN GROUP (ORDER BY b)` is the
+// same as `f(a, b ORDER BY b)`.
+//
+//  ExpressionsAST
+//  ExpressionsAST
+//  FuncName
+//   =>
+//  FuncAppAST{FuncName, ExpressionsAST, ExpressionsAST, No}
+func (ps *parseStack) AssembleFuncAppWithinGroup() {
+	_ordering, _exprs, _funcName := ps.pop3()
+
+	// extract and convert the contained structure
+	// (if this fails, this is a fundamental parser bug => panic ok)
+	ordering := _ordering.comp.(ExpressionsAST)
+	exprs := _exprs.comp.(ExpressionsAST)
+	funcName := _funcName.comp.(FuncName)
+
+	params := make([]Expression, 0, len(exprs.Expressions)+len(ordering.Expressions))
+	params = append(params, exprs.Expressions...)
+	orderExprs := make([]SortedExpressionAST, len(ordering.Expressions))
+	for i, e := range ordering.Expressions {
+		orderExprs[i] = e.(SortedExpressionAST)
+		params = append(params, orderExprs[i].Expr)
+	}
+
+	// assemble the FuncAppAST and push it back
+	ps.PushComponent(_funcName.begin, _ordering.end, FuncAppAST{funcName,
+		ExpressionsAST{params}, orderExprs, false, nil})
+}
+
+// AssembleFuncAppOver takes the topmost elements from the stack,
+// assuming they are components of a function application clause with
+// an `OVER (PARTITION BY ... ORDER BY ...)` suffix, and replaces them
+// by a single FuncAppAST element.
+//
+//  ExpressionsAST
+//  ExpressionsAST
+//  FuncAppAST
+//   =>
+//  FuncAppAST{FuncName, ExpressionsAST, nil, BinaryKeyword, OverClauseAST}
+func (ps *parseStack) AssembleFuncAppOver() {
+	_ordering, _partition, _funcApp := ps.pop3()
+
+	// extract and convert the contained structure
+	// (if this fails, this is a fundamental parser bug => panic ok)
+	ordering := _ordering.comp.(ExpressionsAST)
+	partition := _partition.comp.(ExpressionsAST)
+	funcApp := _funcApp.comp.(FuncAppAST)
+
+	var orderExprs []SortedExpressionAST
+	for _, e := range ordering.Expressions {
+		orderExprs = append(orderExprs, e.(SortedExpressionAST))
+	}
+	var partitionExprs []Expression
+	if len(partition.Expressions) > 0 {
+		partitionExprs = partition.Expressions
+	}
+	funcApp.Over = &OverClauseAST{partitionExprs, orderExprs}
+
+	// assemble the FuncAppAST and push it back
+	ps.PushComponent(_funcApp.begin, _ordering.end, funcApp)
 }
 
 func (ps *parseStack) AssembleFuncAppSelector() {
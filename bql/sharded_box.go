@@ -0,0 +1,170 @@
+package bql
+
+import (
+	"io"
+	"sync"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// shardedBox executes a SELECT statement with multiple bqlBoxes called
+// shards. Each input tuple is routed to one of the shards by its partition
+// key computed from the GROUP BY clause, and shards process their tuples
+// in parallel. Other shards are notified of the tuple so that all shards
+// emit the same results as a single bqlBox. All shards write their results
+// to the same Writer.
+type shardedBox struct {
+	// name is the name of the node of the box. It's used to report tuples
+	// dropped by shards.
+	name   string
+	shards []*bqlBox
+	key    func(t *core.Tuple) (data.Value, error)
+
+	// m protects w
+	m sync.Mutex
+	// w routes tuples to shards. It's created when the first tuple arrives
+	// because shards need the Writer passed to Process.
+	w core.WriteCloser
+}
+
+func newShardedBox(name string, stmt *parser.SelectStmt, reg udf.FunctionRegistry, n int) (*shardedBox, error) {
+	lp, err := execution.Analyze(*stmt, reg)
+	if err != nil {
+		return nil, err
+	}
+	key, err := lp.PartitionKeyFunc(reg)
+	if err != nil {
+		return nil, err
+	}
+	b := &shardedBox{
+		name:   name,
+		shards: make([]*bqlBox, n),
+		key:    key,
+	}
+	for i := range b.shards {
+		b.shards[i] = NewBQLBox(stmt, reg)
+	}
+	return b, nil
+}
+
+func (b *shardedBox) Init(ctx *core.Context) error {
+	for i, s := range b.shards {
+		if err := s.Init(ctx); err != nil {
+			for _, s := range b.shards[:i] {
+				s.Terminate(ctx)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *shardedBox) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	b.m.Lock()
+	if b.w == nil {
+		ws := make([]core.Writer, len(b.shards))
+		for i, s := range b.shards {
+			ws[i] = &shardWriter{
+				shard: s,
+				w:     w,
+			}
+		}
+		b.w = core.NewPartitioningWriter(ctx, b.name, b.key, ws, 1024)
+	}
+	pw := b.w
+	b.m.Unlock()
+	return pw.Write(ctx, t)
+}
+
+// shardWriter writes tuples routed to a shard to the shard. The shard is
+// notified of tuples routed to other shards through Progress so that it
+// expires tuples and closes windows as a single bqlBox does.
+type shardWriter struct {
+	shard *bqlBox
+	w     core.Writer
+}
+
+func (s *shardWriter) Write(ctx *core.Context, t *core.Tuple) error {
+	return s.shard.Process(ctx, t, s.w)
+}
+
+func (s *shardWriter) Progress(ctx *core.Context, t *core.Tuple) error {
+	return s.shard.progress(ctx, t, s.w)
+}
+
+func (b *shardedBox) Terminate(ctx *core.Context) error {
+	// wait until shards process all tuples routed to them
+	b.m.Lock()
+	if b.w != nil {
+		b.w.Close(ctx)
+	}
+	b.m.Unlock()
+
+	for _, s := range b.shards {
+		s.Terminate(ctx)
+	}
+	return nil
+}
+
+// SaveCheckpoint saves the tuples retained by all shards in the same format
+// as bqlBox does, so that the state can be restored with a different number
+// of shards.
+func (b *shardedBox) SaveCheckpoint(ctx *core.Context, w io.Writer) error {
+	// Tuples queued for shards have to be processed before saving states.
+	// The writer will be created again by the next Process call.
+	b.m.Lock()
+	if b.w != nil {
+		b.w.Close(ctx)
+		b.w = nil
+	}
+	b.m.Unlock()
+
+	var ts []*core.Tuple
+	for _, s := range b.shards {
+		s.mutex.Lock()
+		ts = append(ts, s.retainedTuples()...)
+		s.mutex.Unlock()
+	}
+	return writeBoxState(w, data.Map{
+		"tuples": encodeTuples(ts),
+	})
+}
+
+// LoadCheckpoint routes the saved tuples to shards by their partition keys
+// and lets each shard process them again.
+func (b *shardedBox) LoadCheckpoint(ctx *core.Context, r io.Reader) error {
+	m, err := readBoxState(r)
+	if err != nil {
+		return err
+	}
+	ts, err := decodeTuples(m)
+	if err != nil {
+		return err
+	}
+
+	shards := make([][]*core.Tuple, len(b.shards))
+	for _, t := range ts {
+		k, err := b.key(t)
+		if err != nil {
+			return err
+		}
+		i := data.Hash(k) % data.HashValue(len(b.shards))
+		shards[i] = append(shards[i], t)
+	}
+	for i, s := range b.shards {
+		if err := s.replay(shards[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *shardedBox) Status() data.Map {
+	return data.Map{
+		"shards": data.Int(len(b.shards)),
+	}
+}
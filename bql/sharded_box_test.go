@@ -0,0 +1,106 @@
+package bql
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestShardedBoxResults(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	// run processes tuples with a box having the given number of shards
+	// and returns the emitted tuples as sorted strings because shards
+	// emit results of different groups in a different order.
+	run := func(s string, shards int, ts []*core.Tuple) []string {
+		stmt, _, err := parser.New().ParseStmt(s)
+		So(err, ShouldBeNil)
+		sel := stmt.(parser.SelectStmt)
+		reg := udf.CopyGlobalUDFRegistry(ctx)
+		var b core.StatefulBox
+		if shards > 1 {
+			b, err = newShardedBox("box", &sel, reg, shards)
+			So(err, ShouldBeNil)
+		} else {
+			b = NewBQLBox(&sel, reg)
+		}
+		So(b.Init(ctx), ShouldBeNil)
+
+		var m sync.Mutex
+		var res []string
+		w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+			m.Lock()
+			defer m.Unlock()
+			res = append(res, fmt.Sprintf("%v %v", t.Timestamp.UnixNano(), t.Data))
+			return nil
+		})
+		for _, t := range ts {
+			So(b.Process(ctx, t.Copy(), w), ShouldBeNil)
+		}
+		// wait until all shards process tuples
+		So(b.Terminate(ctx), ShouldBeNil)
+		sort.Strings(res)
+		return res
+	}
+
+	// keys and gaps between timestamps vary so that groups change by
+	// expired tuples between tuples of the same group
+	mkInput := func(watermark bool) []*core.Tuple {
+		base := time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)
+		ts := make([]*core.Tuple, 60)
+		for i := range ts {
+			d := time.Duration(i*700+i%4*300) * time.Millisecond
+			ts[i] = &core.Tuple{
+				Data: data.Map{
+					"k": data.Int(i * 7 % 5),
+					"v": data.Int(i),
+				},
+				InputName: "s",
+				Timestamp: base.Add(d),
+			}
+			if watermark {
+				// swap neighboring tuples and let the watermark lag
+				// behind so that tuples arrive out of order
+				ts[i].Watermark = base.Add(d - time.Second)
+				if i%2 == 1 {
+					ts[i-1], ts[i] = ts[i], ts[i-1]
+				}
+			}
+		}
+		return ts
+	}
+
+	for _, s := range []string{
+		`SELECT ISTREAM k, count(*) AS c, sum(v) AS s FROM s [RANGE 2 SECONDS] GROUP BY k`,
+		`SELECT DSTREAM k, count(*) AS c FROM s [RANGE 2 SECONDS] GROUP BY k`,
+		`SELECT ISTREAM k, max(v) AS m FROM s [RANGE 5 SECONDS] GROUP BY k HAVING count(*) > 1`,
+		`SELECT ISTREAM count(*) AS c FROM s [TUMBLING 2 SECONDS] GROUP BY k`,
+		`SELECT ISTREAM k, sum(v) AS s FROM s [HOPPING 3 SECONDS EVERY 1 SECONDS] GROUP BY k`,
+	} {
+		s := s
+		for _, watermark := range []bool{false, true} {
+			watermark := watermark
+			Convey(fmt.Sprintf("Given a statement %v and tuples having watermarks: %v", s, watermark), t, func() {
+				ts := mkInput(watermark)
+
+				Convey("When processing the same tuples with a sharded box and a single box", func() {
+					expected := run(s, 1, ts)
+					actual := run(s, 4, ts)
+
+					Convey("Then both should emit the same results", func() {
+						So(expected, ShouldNotBeEmpty)
+						So(actual, ShouldResemble, expected)
+					})
+				})
+			})
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
@@ -141,6 +142,7 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			tmpStmt := parser.CreateStreamAsSelectStmt{
 				parser.StreamIdentifier(tmpName),
 				selStmt,
+				parser.SourceSinkSpecsAST{},
 			}
 			box, err := tb.AddStmt(tmpStmt)
 			if err != nil {
@@ -177,6 +179,14 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 	case parser.CreateSinkStmt:
 		// load params into map for faster access
 		paramsMap := tb.mkParamsMap(stmt.Params)
+		parallelism, err := popParallelism(paramsMap)
+		if err != nil {
+			return nil, err
+		}
+		maxBatchSize, maxBatchLatency, err := popBatchParams(paramsMap)
+		if err != nil {
+			return nil, err
+		}
 
 		// check if we know this type of sink
 		creator, err := tb.SinkCreators.Lookup(string(stmt.Type))
@@ -195,7 +205,11 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		// we insert a sink, but cannot connect it to
 		// any streams yet, therefore we have to keep track
 		// of the SinkDeclarer
-		return tb.topology.AddSink(string(stmt.Name), sink, nil)
+		return tb.topology.AddSink(string(stmt.Name), sink, &core.SinkConfig{
+			Parallelism:     parallelism,
+			MaxBatchSize:    maxBatchSize,
+			MaxBatchLatency: maxBatchLatency,
+		})
 
 	case parser.CreateStateStmt:
 		c, err := tb.UDSCreators.Lookup(string(stmt.Type))
@@ -379,21 +393,29 @@ func (b *udsfBox) Terminate(ctx *core.Context) error {
 type udsfSource struct {
 	f       udf.UDSF
 	stopped core.AtomicFlag
+
+	// watermarkDelay is the delay declared by UDSFDeclarer.SetWatermarkDelay.
+	// Tuples don't have watermarks when it's less than 0.
+	watermarkDelay time.Duration
 }
 
 var (
 	_ core.Source = &udsfSource{}
 )
 
-func newUDSFSource(f udf.UDSF) *udsfSource {
+func newUDSFSource(f udf.UDSF, watermarkDelay time.Duration) *udsfSource {
 	return &udsfSource{
-		f: f,
+		f:              f,
+		watermarkDelay: watermarkDelay,
 	}
 }
 
 func (s *udsfSource) GenerateStream(ctx *core.Context, w core.Writer) error {
 	// In the source mode, UDSF.Process is only called once. It can generate
 	// as many tuples as it wants.
+	if s.watermarkDelay >= 0 {
+		w = core.NewWatermarkWriter(w, s.watermarkDelay)
+	}
 	return s.f.Process(ctx, core.NewTuple(data.Map{"b": data.True}),
 		core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
 			if s.stopped.Enabled() {
@@ -411,22 +433,67 @@ func (s *udsfSource) Stop(ctx *core.Context) error {
 func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsSelectStmt) (core.Node, error) {
 	// insert a bqlBox that executes the SELECT statement
 	outName := string(stmt.Name)
-	box := NewBQLBox(&stmt.Select, tb.Reg)
-	// add all the referenced relations as named inputs
-	dbox, err := tb.topology.AddBox(outName, box, nil)
+
+	params := tb.mkParamsMap(stmt.Params)
+	parallelism, err := popParallelism(params)
+	if err != nil {
+		return nil, err
+	}
+	shards, err := popShards(params)
+	if err != nil {
+		return nil, err
+	}
+	for key := range params {
+		return nil, fmt.Errorf("unknown stream parameter: %s", key)
+	}
+
+	// every subquery in the FROM clause is computed by a box with
+	// a temporary name, which is also used as the name of the relation
+	sel := stmt.Select
+	sel.Relations = make([]parser.AliasedStreamWindowAST, len(stmt.Select.Relations))
+	for i, rel := range stmt.Select.Relations {
+		if rel.Type == parser.SubqueryStream {
+			rel.Name = fmt.Sprintf("sensorbee_tmp_subquery_%v", topologyBuilderNextTemporaryID())
+		}
+		sel.Relations[i] = rel
+	}
+
+	// every common table expression in the WITH clause referred to by
+	// the statement is also computed by a box with a temporary name
+	ctes, err := resolveCommonTableExpressions(&sel)
 	if err != nil {
 		return nil, err
 	}
 
 	// TODO: this check doesn't prevent a user from creating selfloops using UDSFs
-	for _, rel := range stmt.Select.Relations {
-		if strings.ToLower(rel.Name) == strings.ToLower(outName) {
-			return nil, fmt.Errorf("a stream '%v' contains a selfloop", outName)
+	refs := map[string]bool{}
+	collectStreamNames(&stmt.Select, refs)
+	if refs[strings.ToLower(outName)] {
+		return nil, fmt.Errorf("a stream '%v' contains a selfloop", outName)
+	}
+
+	var box core.Box
+	if shards > 1 {
+		// tuples are processed by multiple bqlBoxes in parallel
+		b, err := newShardedBox(outName, &sel, tb.Reg, shards)
+		if err != nil {
+			return nil, err
 		}
+		box = b
+	} else {
+		b := NewBQLBox(&sel, tb.Reg)
+		// provide a function to the BQL box to remove itself from the topology
+		b.removeMe = func() { go tb.topology.Remove(outName) }
+		box = b
 	}
 
-	// provide a function to the BQL box to remove itself from the topology
-	box.removeMe = func() { go tb.topology.Remove(outName) }
+	// add all the referenced relations as named inputs
+	dbox, err := tb.topology.AddBox(outName, box, &core.BoxConfig{
+		Parallelism: parallelism,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	removeNodes := true
 	var temporaryNodes []string
@@ -441,9 +508,24 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 		tb.topology.Remove(outName)
 	}()
 
+	// the boxes of common table expressions must exist before the boxes
+	// using them are connected to them
+	cteBoxes := make([]core.BoxNode, len(ctes))
+	for i, cte := range ctes {
+		temporaryNodes = append(temporaryNodes, string(cte.Name))
+		node, err := tb.createStreamAsSelectStmt(&parser.CreateStreamAsSelectStmt{
+			Name:   cte.Name,
+			Select: cte.Select,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cteBoxes[i] = node.(core.BoxNode)
+	}
+
 	connected := map[string]bool{}
 	var pausedSources []core.SourceNode
-	for _, rel := range stmt.Select.Relations {
+	for _, rel := range sel.Relations {
 		switch rel.Type {
 		case parser.ActualStream:
 			if connected[rel.Name] {
@@ -451,36 +533,26 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 				// and we already have connected x to this box before
 				continue
 			}
-			conf := &core.BoxInputConfig{
-				// For self-join statements like
-				//   ... FROM x AS a [RANGE 2 TUPLES],
-				//            x AS b [RANGE 3 TUPLES],
-				//            y AS c [RANGE 4 TUPLES] ...
-				// the bqlBox will have only *two* inputs:
-				//  - one is `x` with `InputName: x`,
-				//  - one is `y` with `InputName: y`.
-				//
-				// When a tuple arrives with `InputName: x`, it will be added
-				// to the input buffer for `a` and to the input buffer for `b`
-				// in the execution plan. When a tuple arrives with `InputName: y`,
-				// it will be added to the input buffer for `c`.
-				//
-				// If we used the rel.Alias here, then we would have to make multiple
-				// input connections to the same box, which is not possible.
-				InputName: rel.Name,
-			}
-			// set capacity of input pipe
-			if rel.Capacity != parser.UnspecifiedCapacity {
-				conf.Capacity = int(rel.Capacity)
-			}
-			// set drop mode for box
-			if rel.Shedding == parser.DropOldest {
-				conf.DropMode = core.DropOldest
-			} else if rel.Shedding == parser.DropNewest {
-				conf.DropMode = core.DropLatest
-			} else if rel.Shedding == parser.Wait {
-				conf.DropMode = core.DropNone
+			conf, err := newBoxInputConfig(&rel)
+			if err != nil {
+				return nil, err
 			}
+			// For self-join statements like
+			//   ... FROM x AS a [RANGE 2 TUPLES],
+			//            x AS b [RANGE 3 TUPLES],
+			//            y AS c [RANGE 4 TUPLES] ...
+			// the bqlBox will have only *two* inputs:
+			//  - one is `x` with `InputName: x`,
+			//  - one is `y` with `InputName: y`.
+			//
+			// When a tuple arrives with `InputName: x`, it will be added
+			// to the input buffer for `a` and to the input buffer for `b`
+			// in the execution plan. When a tuple arrives with `InputName: y`,
+			// it will be added to the input buffer for `c`.
+			//
+			// If we used the rel.Alias here, then we would have to make multiple
+			// input connections to the same box, which is not possible.
+			conf.InputName = rel.Name
 			if err := dbox.Input(rel.Name, conf); err != nil {
 				return nil, err
 			}
@@ -496,6 +568,12 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 				pausedSources = append(pausedSources, sn)
 			}
 
+		case parser.SubqueryStream:
+			temporaryNodes = append(temporaryNodes, rel.Name)
+			if err := tb.setUpSubquery(dbox, &rel); err != nil {
+				return nil, err
+			}
+
 		default:
 			return nil, fmt.Errorf("input stream of type %s not implemented",
 				rel.Type)
@@ -503,6 +581,13 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 	}
 	dbox.(core.BoxNode).StopOnDisconnect(core.Inbound)
 
+	// Like subqueries, the boxes of common table expressions are owned
+	// by the box using them. They're stopped and removed when it's gone.
+	for _, bn := range cteBoxes {
+		bn.StopOnDisconnect(core.Inbound | core.Outbound)
+		bn.RemoveOnStop()
+	}
+
 	// Resume all UDSFs running in the source mode as fairly as possible.
 	for _, sn := range pausedSources {
 		if err := sn.Resume(); err != nil {
@@ -516,7 +601,8 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 // setUpUDSFStream creates a Source or a Box from a UDSF. When it creates a
 // Source, it will return the corresponding core.SourceNode of it. Otherwise,
 // it returns nil for core.SourceNode. It also returns the temporary name of
-// the UDSF node.
+// the UDSF node. Like boxes of subqueries, the Box is always processed by
+// one goroutine regardless of the parallelism of the stream using it.
 func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *parser.AliasedStreamWindowAST) (core.SourceNode, string, error) {
 	// Compute the values of the UDSF parameters (if there was
 	// an unusable parameter, as in `udsf(7, col)` this will fail).
@@ -562,42 +648,26 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 		if alias == "" {
 			alias = rel.Name
 		}
-		conf := &core.BoxInputConfig{
-			// As opposed to actual streams, for `udsf("s") AS a, udsf("s") AS b`,
-			// there will be *multiple* boxes and we will have one connection to
-			// udsf 1 (the one aliased to `a`) and udsf 2 (the one aliased to `b`).
-			// Therefore we need to have different InputNames for them. Note that
-			// we cannot just take the alias, as there would be the danger of
-			// overriding an input stream with that same name, as in
-			// `FROM x AS b, udsf("s") AS x`, and we should also use a string
-			// that does not possibly conflict with any input name.
-			// Note that `addTupleToBuffer` in defaultSelectExecutionPlan needs
-			// to use that same method.
-			InputName: fmt.Sprintf("%s/%s", rel.Name, alias),
-		}
-		// set capacity of input pipe
-		if rel.Capacity != parser.UnspecifiedCapacity {
-			if rel.Capacity > math.MaxInt32 {
-				return fmt.Errorf("specified buffer capacity %d is too large", rel.Capacity)
-			} else if rel.Capacity < 0 {
-				// the parser should not allow this to happen, actually
-				return fmt.Errorf("specified buffer capacity %d must not be negative", rel.Capacity)
-			}
-			conf.Capacity = int(rel.Capacity)
-		}
-		// set drop mode for box
-		if rel.Shedding == parser.DropOldest {
-			conf.DropMode = core.DropOldest
-		} else if rel.Shedding == parser.DropNewest {
-			conf.DropMode = core.DropLatest
-		} else if rel.Shedding == parser.Wait {
-			conf.DropMode = core.DropNone
+		conf, err := newBoxInputConfig(rel)
+		if err != nil {
+			return err
 		}
+		// As opposed to actual streams, for `udsf("s") AS a, udsf("s") AS b`,
+		// there will be *multiple* boxes and we will have one connection to
+		// udsf 1 (the one aliased to `a`) and udsf 2 (the one aliased to `b`).
+		// Therefore we need to have different InputNames for them. Note that
+		// we cannot just take the alias, as there would be the danger of
+		// overriding an input stream with that same name, as in
+		// `FROM x AS b, udsf("s") AS x`, and we should also use a string
+		// that does not possibly conflict with any input name.
+		// Note that `addTupleToBuffer` in defaultSelectExecutionPlan needs
+		// to use that same method.
+		conf.InputName = fmt.Sprintf("%s/%s", rel.Name, alias)
 		return subsequentBox.Input(temporaryName, conf)
 	}
 
 	if len(decl.ListInputs()) == 0 { // Source mode
-		sn, err := tb.topology.AddSource(temporaryName, newUDSFSource(udsf), &core.SourceConfig{
+		sn, err := tb.topology.AddSource(temporaryName, newUDSFSource(udsf, decl.WatermarkDelay()), &core.SourceConfig{
 			PausedOnStartup: true,
 		})
 		if err != nil {
@@ -613,7 +683,7 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 	}
 
 	bn, err := tb.topology.AddBox(temporaryName, newUDSFBox(udsf), &core.BoxConfig{
-	// TODO: add information of the statement
+		// TODO: add information of the statement
 	})
 	if err != nil {
 		return nil, "", err
@@ -643,6 +713,174 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 	return nil, temporaryName, nil
 }
 
+// setUpSubquery creates a Box computing a subquery in the FROM clause
+// and connects it to the given Box. Like a UDSF, the Box is stopped and
+// removed when it's disconnected, e.g. when the stream using the subquery
+// is dropped.
+func (tb *TopologyBuilder) setUpSubquery(subsequentBox core.BoxNode, rel *parser.AliasedStreamWindowAST) error {
+	node, err := tb.createStreamAsSelectStmt(&parser.CreateStreamAsSelectStmt{
+		Name:   parser.StreamIdentifier(rel.Name),
+		Select: *rel.Select,
+	})
+	if err != nil {
+		return err
+	}
+	conf, err := newBoxInputConfig(rel)
+	if err != nil {
+		return err
+	}
+	// the name of the box is unique, but the alias is used as well so
+	// that `addTupleToBuffer` can use the same method as for UDSFs
+	conf.InputName = fmt.Sprintf("%s/%s", rel.Name, rel.Alias)
+	if err := subsequentBox.Input(rel.Name, conf); err != nil {
+		return err
+	}
+	bn := node.(core.BoxNode)
+	bn.StopOnDisconnect(core.Inbound | core.Outbound)
+	bn.RemoveOnStop()
+	return nil
+}
+
+// resolveCommonTableExpressions assigns temporary names to the common
+// table expressions in the WITH clause of the given statement and
+// replaces references to them with the temporary names. It returns the
+// common table expressions referred to directly or indirectly by the
+// rest of the statement, in the order of their definitions, and removes
+// the WITH clause from the statement. A common table expression can
+// only refer to the ones defined before it.
+func resolveCommonTableExpressions(sel *parser.SelectStmt) ([]parser.CommonTableExpressionAST, error) {
+	if len(sel.CommonTableExpressions) == 0 {
+		return nil, nil
+	}
+
+	names := map[string]string{}
+	ctes := make([]parser.CommonTableExpressionAST, len(sel.CommonTableExpressions))
+	for i, cte := range sel.CommonTableExpressions {
+		lowerName := strings.ToLower(string(cte.Name))
+		if _, ok := names[lowerName]; ok {
+			return nil, fmt.Errorf("WITH query name '%s' specified more than once", cte.Name)
+		}
+		cte.Select = replaceStreamNames(cte.Select, names)
+		cte.Name = parser.StreamIdentifier(fmt.Sprintf("sensorbee_tmp_cte_%v",
+			topologyBuilderNextTemporaryID()))
+		names[lowerName] = string(cte.Name)
+		ctes[i] = cte
+	}
+	sel.WithAST = parser.WithAST{}
+	*sel = replaceStreamNames(*sel, names)
+
+	// skip the common table expressions nobody refers to so that their
+	// boxes don't remain in the topology without any outbound connection
+	refs := map[string]bool{}
+	collectStreamNames(sel, refs)
+	for i := len(ctes) - 1; i >= 0; i-- {
+		if refs[strings.ToLower(string(ctes[i].Name))] {
+			collectStreamNames(&ctes[i].Select, refs)
+		}
+	}
+	var res []parser.CommonTableExpressionAST
+	for _, cte := range ctes {
+		if refs[strings.ToLower(string(cte.Name))] {
+			res = append(res, cte)
+		}
+	}
+	return res, nil
+}
+
+// replaceStreamNames returns a copy of the given statement in which the
+// names of the streams read by the statement, including the ones read
+// by its subqueries, are replaced according to names. The keys of names
+// must be in lower case. A stream whose name is replaced gets the
+// original name as its alias unless it already has one. Common table
+// expressions defined in the statement hide the names of the same name.
+func replaceStreamNames(sel parser.SelectStmt, names map[string]string) parser.SelectStmt {
+	if len(sel.CommonTableExpressions) > 0 {
+		inner := make(map[string]string, len(names))
+		for k, v := range names {
+			inner[k] = v
+		}
+		ctes := make([]parser.CommonTableExpressionAST, len(sel.CommonTableExpressions))
+		for i, cte := range sel.CommonTableExpressions {
+			cte.Select = replaceStreamNames(cte.Select, inner)
+			delete(inner, strings.ToLower(string(cte.Name)))
+			ctes[i] = cte
+		}
+		sel.CommonTableExpressions = ctes
+		names = inner
+	}
+
+	rels := make([]parser.AliasedStreamWindowAST, len(sel.Relations))
+	for i, rel := range sel.Relations {
+		switch rel.Type {
+		case parser.ActualStream:
+			if n, ok := names[strings.ToLower(rel.Name)]; ok {
+				if rel.Alias == "" {
+					rel.Alias = rel.Name
+				}
+				rel.Name = n
+			}
+		case parser.SubqueryStream:
+			sub := replaceStreamNames(*rel.Select, names)
+			rel.Select = &sub
+		}
+		rels[i] = rel
+	}
+	sel.Relations = rels
+	return sel
+}
+
+// collectStreamNames adds the lower-cased names of the streams read by
+// the given statement to names. Streams read by its subqueries and by
+// the common table expressions it refers to are also added, but the
+// names of the common table expressions themselves aren't.
+func collectStreamNames(sel *parser.SelectStmt, names map[string]bool) {
+	local := map[string]bool{}
+	for _, rel := range sel.Relations {
+		switch rel.Type {
+		case parser.ActualStream:
+			local[strings.ToLower(rel.Name)] = true
+		case parser.SubqueryStream:
+			collectStreamNames(rel.Select, local)
+		}
+	}
+	for i := len(sel.CommonTableExpressions) - 1; i >= 0; i-- {
+		cte := &sel.CommonTableExpressions[i]
+		name := strings.ToLower(string(cte.Name))
+		if local[name] {
+			delete(local, name)
+			collectStreamNames(&cte.Select, local)
+		}
+	}
+	for n := range local {
+		names[n] = true
+	}
+}
+
+// newBoxInputConfig returns a BoxInputConfig having the buffer capacity
+// and the shedding option of the given relation.
+func newBoxInputConfig(rel *parser.AliasedStreamWindowAST) (*core.BoxInputConfig, error) {
+	conf := &core.BoxInputConfig{}
+	// set capacity of input pipe
+	if rel.Capacity != parser.UnspecifiedCapacity {
+		if rel.Capacity > math.MaxInt32 {
+			return nil, fmt.Errorf("specified buffer capacity %d is too large", rel.Capacity)
+		} else if rel.Capacity < 0 {
+			// the parser should not allow this to happen, actually
+			return nil, fmt.Errorf("specified buffer capacity %d must not be negative", rel.Capacity)
+		}
+		conf.Capacity = int(rel.Capacity)
+	}
+	// set drop mode for box
+	if rel.Shedding == parser.DropOldest {
+		conf.DropMode = core.DropOldest
+	} else if rel.Shedding == parser.DropNewest {
+		conf.DropMode = core.DropLatest
+	} else if rel.Shedding == parser.Wait {
+		conf.DropMode = core.DropNone
+	}
+	return conf, nil
+}
+
 func (tb *TopologyBuilder) mkParamsMap(params []parser.SourceSinkParamAST) data.Map {
 	paramsMap := make(data.Map, len(params))
 	for _, kv := range params {
@@ -651,6 +889,66 @@ func (tb *TopologyBuilder) mkParamsMap(params []parser.SourceSinkParamAST) data.
 	return paramsMap
 }
 
+// popParallelism removes the "parallelism" parameter from params and
+// returns its value. It returns 0 when the parameter isn't given.
+func popParallelism(params data.Map) (int, error) {
+	v, ok := params["parallelism"]
+	if !ok {
+		return 0, nil
+	}
+	delete(params, "parallelism")
+	p, err := data.AsInt(v)
+	if err != nil {
+		return 0, fmt.Errorf("parallelism must be an integer: %v", v)
+	}
+	return int(p), nil
+}
+
+// popBatchParams removes the "max_batch_size" and "max_batch_latency"
+// parameters from params and returns their values. They're 0 when the
+// parameters aren't given. max_batch_latency can be a number of seconds or a
+// string such as "500ms".
+func popBatchParams(params data.Map) (int, time.Duration, error) {
+	var (
+		size    int64
+		latency time.Duration
+		err     error
+	)
+	if v, ok := params["max_batch_size"]; ok {
+		delete(params, "max_batch_size")
+		size, err = data.AsInt(v)
+		if err != nil {
+			return 0, 0, fmt.Errorf("max_batch_size must be an integer: %v", v)
+		}
+	}
+	if v, ok := params["max_batch_latency"]; ok {
+		delete(params, "max_batch_latency")
+		latency, err = data.ToDuration(v)
+		if err != nil {
+			return 0, 0, fmt.Errorf("max_batch_latency must be a duration: %v", v)
+		}
+	}
+	return int(size), latency, nil
+}
+
+// popShards removes the "shards" parameter from params and returns its
+// value. It returns 1 when the parameter isn't given.
+func popShards(params data.Map) (int, error) {
+	v, ok := params["shards"]
+	if !ok {
+		return 1, nil
+	}
+	delete(params, "shards")
+	n, err := data.AsInt(v)
+	if err != nil {
+		return 0, fmt.Errorf("shards must be an integer: %v", v)
+	}
+	if n <= 0 || n > int64(core.MaxParallelism) {
+		return 0, fmt.Errorf("shards must be in [1, %v]: %v", core.MaxParallelism, n)
+	}
+	return int(n), nil
+}
+
 type chanSink struct {
 	m      sync.RWMutex
 	ch     chan *core.Tuple
@@ -740,13 +1038,19 @@ func (tb *TopologyBuilder) AddSelectUnionStmt(stmts *parser.SelectUnionStmt) (co
 			tmpStmt := parser.CreateStreamAsSelectStmt{
 				parser.StreamIdentifier(tmpName),
 				parser.SelectStmt{
+					stmt.WithAST,
 					stmt.EmitterAST,
+					stmt.DistinctAST,
 					stmt.ProjectionsAST,
 					stmt.WindowedFromAST,
+					stmt.MatchRecognizeAST,
 					stmt.FilterAST,
 					stmt.GroupingAST,
 					stmt.HavingAST,
+					stmt.OrderingAST,
+					stmt.LimitAST,
 				},
+				parser.SourceSinkSpecsAST{},
 			}
 			box, err := tb.AddStmt(tmpStmt)
 			if err != nil {
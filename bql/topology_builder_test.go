@@ -318,6 +318,78 @@ func TestCreateStreamAsSelectStmt(t *testing.T) {
 			})
 		})
 
+		Convey("When running CREATE STREAM AS SELECT with parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
+                s [RANGE 2 SECONDS] WITH parallelism = 4`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the stream should still be processed by one goroutine", func() {
+				bn, err := tb.Topology().Box("t")
+				So(err, ShouldBeNil)
+				p, err := bn.Status().Get(data.MustCompilePath("behaviors.parallelism"))
+				So(err, ShouldBeNil)
+				So(p, ShouldEqual, data.Int(1))
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT from UDSFs with parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM a:int FROM
+                duplicate("s", 2) [RANGE 2 SECONDS] AS a,
+                duplicate("s", 2) [RANGE 2 SECONDS] AS b WITH parallelism = 4`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the UDSFs should still be processed by one goroutine", func() {
+				var ps []int64
+				for k, bn := range tb.Topology().Boxes() {
+					if strings.HasPrefix(k, "sensorbee_tmp_udsf_") {
+						p, err := bn.Status().Get(data.MustCompilePath("behaviors.parallelism"))
+						So(err, ShouldBeNil)
+						i, _ := data.AsInt(p)
+						ps = append(ps, i)
+					}
+				}
+				So(ps, ShouldResemble, []int64{1, 1})
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with shards", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int, count(*) AS c FROM
+                s [RANGE 2 SECONDS] GROUP BY int WITH shards = 4`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the box should have the shards", func() {
+				bn, err := tb.Topology().Box("t")
+				So(err, ShouldBeNil)
+				n, err := bn.Status().Get(data.MustCompilePath("box.shards"))
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, data.Int(4))
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with invalid parameters", func() {
+			for _, c := range []struct {
+				params string
+				err    string
+			}{
+				{`foo = "bar"`, "unknown stream parameter: foo"},
+				{`parallelism = "a"`, `parallelism must be an integer: "a"`},
+				{`parallelism = -1`, "specified parallelism -1 must not be negative"},
+				{`shards = "a"`, `shards must be an integer: "a"`},
+				{`shards = 0`, "shards must be in [1, 256]: 0"},
+				{`shards = 2`, "partitioning requires a GROUP BY clause"},
+			} {
+				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
+                s [RANGE 2 SECONDS] WITH `+c.params)
+
+				Convey("Then an error should be returned: "+c.params, func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, c.err)
+					_, err := tb.Topology().Box("t")
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+
 		Convey("When running CREATE STREAM AS SELECT on a non-existing stream", func() {
 			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
                 bar [RANGE 2 SECONDS] WHERE int=2`)
@@ -417,6 +489,178 @@ func TestCreateStreamAsSelectUnionStmt(t *testing.T) {
 	})
 }
 
+func TestCreateStreamAsSelectSubqueryStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		err = addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy`)
+		So(err, ShouldBeNil)
+
+		findSubqueryBox := func() string {
+			for k := range tb.Topology().Boxes() {
+				if strings.HasPrefix(k, "sensorbee_tmp_subquery_") {
+					return k
+				}
+			}
+			return ""
+		}
+
+		Convey("When running CREATE STREAM AS SELECT with a subquery", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM x:int
+				FROM (SELECT ISTREAM int FROM s [RANGE 2 SECONDS]) [RANGE 1 TUPLES] AS x`)
+			So(err, ShouldBeNil)
+
+			Convey("Then a temporary box computing the subquery should be created", func() {
+				So(findSubqueryBox(), ShouldStartWith, "sensorbee_tmp_subquery_")
+			})
+
+			Convey("And when dropping the stream", func() {
+				So(addBQLToTopology(tb, `DROP STREAM t`), ShouldBeNil)
+
+				Convey("Then the temporary box should eventually be removed", func() {
+					waitForExpectedCondition(func() bool {
+						return findSubqueryBox() == ""
+					})
+					So(findSubqueryBox(), ShouldEqual, "")
+				})
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with a subquery without an alias", func() {
+			prevNodes := len(tb.topology.Nodes())
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int
+				FROM (SELECT ISTREAM int FROM s [RANGE 2 SECONDS]) [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "must have an alias")
+				So(len(tb.topology.Nodes()), ShouldEqual, prevNodes)
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with a subquery on a non-existing stream", func() {
+			prevNodes := len(tb.topology.Nodes())
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM x:int
+				FROM (SELECT ISTREAM int FROM bar [RANGE 2 SECONDS]) [RANGE 1 TUPLES] AS x`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "was not found")
+				waitForExpectedCondition(func() bool {
+					return len(tb.topology.Nodes()) == prevNodes
+				})
+				So(len(tb.topology.Nodes()), ShouldEqual, prevNodes)
+			})
+		})
+	})
+}
+
+func TestCreateStreamAsSelectWithStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		err = addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy`)
+		So(err, ShouldBeNil)
+
+		findCTEBoxes := func() []string {
+			var names []string
+			for k := range tb.Topology().Boxes() {
+				if strings.HasPrefix(k, "sensorbee_tmp_cte_") {
+					names = append(names, k)
+				}
+			}
+			return names
+		}
+
+		Convey("When running CREATE STREAM AS SELECT with a WITH clause", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS
+				WITH a AS (SELECT ISTREAM int FROM s [RANGE 1 TUPLES]),
+				b AS (SELECT ISTREAM int * 2 AS int FROM a [RANGE 1 TUPLES]),
+				unused AS (SELECT ISTREAM int FROM a [RANGE 1 TUPLES])
+				SELECT ISTREAM b:int FROM b [RANGE 1 TUPLES]`)
+			So(err, ShouldBeNil)
+
+			Convey("Then temporary boxes computing the referenced queries should be created", func() {
+				So(len(findCTEBoxes()), ShouldEqual, 2)
+			})
+
+			Convey("And when dropping the stream", func() {
+				So(addBQLToTopology(tb, `DROP STREAM t`), ShouldBeNil)
+
+				Convey("Then the temporary boxes should eventually be removed", func() {
+					waitForExpectedCondition(func() bool {
+						return len(findCTEBoxes()) == 0
+					})
+					So(findCTEBoxes(), ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT having the same name as a WITH query", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS
+				WITH t AS (SELECT ISTREAM int FROM s [RANGE 1 TUPLES])
+				SELECT ISTREAM int FROM t [RANGE 1 TUPLES]`)
+
+			Convey("Then it should refer to the WITH query instead of itself", func() {
+				So(err, ShouldBeNil)
+				So(len(findCTEBoxes()), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with duplicate WITH query names", func() {
+			prevNodes := len(tb.topology.Nodes())
+			err := addBQLToTopology(tb, `CREATE STREAM t AS
+				WITH a AS (SELECT ISTREAM int FROM s [RANGE 1 TUPLES]),
+				A AS (SELECT ISTREAM int FROM s [RANGE 1 TUPLES])
+				SELECT ISTREAM int FROM a [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "WITH query name 'A' specified more than once")
+				So(len(tb.topology.Nodes()), ShouldEqual, prevNodes)
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with a WITH query referring to a later one", func() {
+			prevNodes := len(tb.topology.Nodes())
+			err := addBQLToTopology(tb, `CREATE STREAM t AS
+				WITH a AS (SELECT ISTREAM int FROM b [RANGE 1 TUPLES]),
+				b AS (SELECT ISTREAM int FROM s [RANGE 1 TUPLES])
+				SELECT ISTREAM int FROM a [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "was not found")
+				waitForExpectedCondition(func() bool {
+					return len(tb.topology.Nodes()) == prevNodes
+				})
+				So(len(tb.topology.Nodes()), ShouldEqual, prevNodes)
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT with a WITH query referring to the stream", func() {
+			prevNodes := len(tb.topology.Nodes())
+			err := addBQLToTopology(tb, `CREATE STREAM t AS
+				WITH a AS (SELECT ISTREAM int FROM t [RANGE 1 TUPLES])
+				SELECT ISTREAM int FROM a [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "selfloop")
+				So(len(tb.topology.Nodes()), ShouldEqual, prevNodes)
+			})
+		})
+	})
+}
+
 func TestCreateSinkStmt(t *testing.T) {
 	Convey("Given a BQL TopologyBuilder", t, func() {
 		dt := newTestTopology()
@@ -450,6 +694,66 @@ func TestCreateSinkStmt(t *testing.T) {
 				So(err.Error(), ShouldEqual, "unknown sink parameter: foo")
 			})
 		})
+		Convey("When running CREATE SINK with parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH parallelism=4`)
+
+			Convey("Then the parameter shouldn't be passed to the sink", func() {
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then the sink should still be written by one goroutine", func() {
+				sn, err := tb.Topology().Sink("hoge")
+				So(err, ShouldBeNil)
+				p, err := sn.Status().Get(data.MustCompilePath("behaviors.parallelism"))
+				So(err, ShouldBeNil)
+				So(p, ShouldEqual, data.Int(1))
+			})
+		})
+		Convey("When running CREATE SINK with batch parameters", func() {
+			err := addBQLToTopology(tb, `CREATE SINK hoge TYPE stdout WITH max_batch_size=10, max_batch_latency="500ms"`)
+
+			Convey("Then the parameters shouldn't be passed to the sink", func() {
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then the sink should have the parameters", func() {
+				sn, err := tb.Topology().Sink("hoge")
+				So(err, ShouldBeNil)
+				st := sn.Status()
+				s, err := st.Get(data.MustCompilePath("behaviors.max_batch_size"))
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, data.Int(10))
+				l, err := st.Get(data.MustCompilePath("behaviors.max_batch_latency"))
+				So(err, ShouldBeNil)
+				So(l, ShouldEqual, data.Float(0.5))
+			})
+		})
+		Convey("When running CREATE SINK with invalid batch parameters", func() {
+			for _, c := range []struct {
+				params string
+				err    string
+			}{
+				{`max_batch_size = "a"`, `max_batch_size must be an integer: "a"`},
+				{`max_batch_size = -1`, "specified max batch size -1 must not be negative"},
+				{`max_batch_latency = "a"`, `max_batch_latency must be a duration: "a"`},
+				{`max_batch_latency = -1`, "specified max batch latency -1s must not be negative"},
+			} {
+				err := addBQLToTopology(tb, `CREATE SINK hoge TYPE stdout WITH `+c.params)
+
+				Convey("Then an error should be returned: "+c.params, func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, c.err)
+				})
+			}
+		})
+		Convey("When running CREATE SINK with too large parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE SINK hoge TYPE collector WITH parallelism=100000`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "too large")
+			})
+		})
 		Convey("When running CREATE SINK with an unknown sink type", func() {
 			err := addBQLToTopology(tb, `CREATE SINK hoge TYPE foo`)
 
@@ -924,6 +1228,49 @@ func TestSelectStmt(t *testing.T) {
 			})
 		})
 
+		Convey("When issuing a SELECT stmt with a WITH clause", func() {
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt(`WITH a AS (SELECT ISTREAM int FROM s [RANGE 1 TUPLES]
+				WHERE int % 2 = 0) SELECT ISTREAM a:int * 10 AS x FROM a [RANGE 1 TUPLES];`)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.SelectStmt)
+			_, ch, err := tb.AddSelectStmt(&stmt)
+			So(err, ShouldBeNil)
+			So(addBQLToTopology(tb, `RESUME SOURCE s;`), ShouldBeNil)
+
+			Convey("Then the chan should receive the results of the WITH query", func() {
+				var xs []data.Value
+				for t := range ch {
+					xs = append(xs, t.Data["x"])
+				}
+				So(xs, ShouldResemble, []data.Value{data.Int(20), data.Int(40)})
+			})
+		})
+
+		Convey("When issuing a SELECT stmt on a sharded stream", func() {
+			So(addBQLToTopology(tb, `CREATE STREAM k AS SELECT ISTREAM int % 2 AS k FROM s [RANGE 1 TUPLES];
+				CREATE STREAM t AS SELECT ISTREAM k, count(*) AS c FROM k [RANGE 60 SECONDS]
+				GROUP BY k WITH shards = 4;`), ShouldBeNil)
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt(`SELECT ISTREAM * FROM t [RANGE 1 TUPLES];`)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.SelectStmt)
+			_, ch, err := tb.AddSelectStmt(&stmt)
+			So(err, ShouldBeNil)
+			So(addBQLToTopology(tb, `RESUME SOURCE s;`), ShouldBeNil)
+
+			Convey("Then the chan should receive the results of each group in order", func() {
+				cs := map[data.Value][]data.Value{}
+				for t := range ch {
+					cs[t.Data["k"]] = append(cs[t.Data["k"]], t.Data["c"])
+				}
+				So(cs, ShouldResemble, map[data.Value][]data.Value{
+					data.Int(0): {data.Int(1), data.Int(2)},
+					data.Int(1): {data.Int(1), data.Int(2)},
+				})
+			})
+		})
+
 		Convey("When issuing a SELECT stmt referencing an unknown source", func() {
 			bp := parser.New()
 			numNodes := len(tb.topology.Nodes())
@@ -2,18 +2,23 @@ package builtin
 
 import (
 	"bytes"
+	"container/heap"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math"
+	"math/big"
 	"sort"
+	"time"
 )
 
 // singleParamAggFunc is a template for aggregate functions that
 // have exactly one parameter
 type singleParamAggFunc struct {
 	aggFun func([]data.Value) (data.Value, error)
+	// newAccumulator is only used by incrementalAggFunc
+	newAccumulator func() udf.Accumulator
 }
 
 func (f *singleParamAggFunc) Accept(arity int) bool {
@@ -64,6 +69,27 @@ func (f *twoParamAggFunc) Call(ctx *core.Context, args ...data.Value) (data.Valu
 	return f.aggFun(arr1, arr2)
 }
 
+// incrementalAggFunc is a template for aggregate functions that
+// have exactly one parameter and can be computed incrementally
+// with an accumulator created by newAccumulator
+type incrementalAggFunc singleParamAggFunc
+
+func (f *incrementalAggFunc) Accept(arity int) bool {
+	return (*singleParamAggFunc)(f).Accept(arity)
+}
+
+func (f *incrementalAggFunc) IsAggregationParameter(k int) bool {
+	return (*singleParamAggFunc)(f).IsAggregationParameter(k)
+}
+
+func (f *incrementalAggFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	return (*singleParamAggFunc)(f).Call(ctx, args...)
+}
+
+func (f *incrementalAggFunc) NewAccumulator() udf.Accumulator {
+	return f.newAccumulator()
+}
+
 // countFunc is an aggregate function that counts the number
 // of non-null values passed in.
 //
@@ -71,7 +97,7 @@ func (f *twoParamAggFunc) Call(ctx *core.Context, args ...data.Value) (data.Valu
 //
 //  Input: anything (aggregated)
 //  Return Type: Int
-var countFunc udf.UDF = &singleParamAggFunc{
+var countFunc udf.UDF = &incrementalAggFunc{
 	aggFun: func(arr []data.Value) (data.Value, error) {
 		// count() is O(n) in the spirit of PostgreSQL
 		c := int64(0)
@@ -82,6 +108,7 @@ var countFunc udf.UDF = &singleParamAggFunc{
 		}
 		return data.Int(c), nil
 	},
+	newAccumulator: newCountAccumulator,
 }
 
 // arrayAggFunc is an aggregate function that concatenates
@@ -108,7 +135,7 @@ var arrayAggFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: Float (Null on empty input)
-var avgFunc udf.UDF = &singleParamAggFunc{
+var avgFunc udf.UDF = &incrementalAggFunc{
 	aggFun: func(arr []data.Value) (data.Value, error) {
 		if len(arr) == 0 {
 			return data.Null{}, nil
@@ -137,6 +164,7 @@ var avgFunc udf.UDF = &singleParamAggFunc{
 		}
 		return data.Float(sum / float64(count)), nil
 	},
+	newAccumulator: newAvgAccumulator,
 }
 
 // medianFunc is an aggregate function that computes the median
@@ -197,7 +225,7 @@ var medianFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Bool (aggregated)
 //  Return Type: Bool (Null on empty input)
-var boolAndFunc udf.UDF = &singleParamAggFunc{
+var boolAndFunc udf.UDF = &incrementalAggFunc{
 	aggFun: func(arr []data.Value) (data.Value, error) {
 		if len(arr) == 0 {
 			return data.Null{}, nil
@@ -228,6 +256,7 @@ var boolAndFunc udf.UDF = &singleParamAggFunc{
 		}
 		return data.Bool(result), nil
 	},
+	newAccumulator: newBoolAndAccumulator,
 }
 
 // boolOrFunc is an aggregate function that returns true if at least
@@ -238,7 +267,7 @@ var boolAndFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Bool (aggregated)
 //  Return Type: Bool (Null on empty input)
-var boolOrFunc udf.UDF = &singleParamAggFunc{
+var boolOrFunc udf.UDF = &incrementalAggFunc{
 	aggFun: func(arr []data.Value) (data.Value, error) {
 		if len(arr) == 0 {
 			return data.Null{}, nil
@@ -269,6 +298,7 @@ var boolOrFunc udf.UDF = &singleParamAggFunc{
 		}
 		return data.Bool(result), nil
 	},
+	newAccumulator: newBoolOrAccumulator,
 }
 
 // jsonObjectAggFunc is an aggregate functions that returns
@@ -322,7 +352,7 @@ var jsonObjectAggFunc udf.UDF = &twoParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: same as maximal input value (Null on empty input)
-var maxFunc udf.UDF = &singleParamAggFunc{
+var maxFunc udf.UDF = &incrementalAggFunc{
 	aggFun: func(arr []data.Value) (data.Value, error) {
 		if len(arr) == 0 {
 			return data.Null{}, nil
@@ -382,6 +412,7 @@ var maxFunc udf.UDF = &singleParamAggFunc{
 		}
 		return data.Float(maxFloat), nil
 	},
+	newAccumulator: newMaxAccumulator,
 }
 
 // minFunc is an aggregate function that computes the minimum
@@ -392,7 +423,7 @@ var maxFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: same as minimal input value (Null on empty input)
-var minFunc udf.UDF = &singleParamAggFunc{
+var minFunc udf.UDF = &incrementalAggFunc{
 	aggFun: func(arr []data.Value) (data.Value, error) {
 		if len(arr) == 0 {
 			return data.Null{}, nil
@@ -452,6 +483,7 @@ var minFunc udf.UDF = &singleParamAggFunc{
 		}
 		return data.Float(minFloat), nil
 	},
+	newAccumulator: newMinAccumulator,
 }
 
 type stringAggFuncTmpl struct {
@@ -513,7 +545,7 @@ var stringAggFunc udf.UDF = &stringAggFuncTmpl{}
 //  Input: Int or Float (aggregated)
 //  Return Type: Float if the input contains a Float, Int otherwise
 //   (Null on empty input)
-var sumFunc udf.UDF = &singleParamAggFunc{
+var sumFunc udf.UDF = &incrementalAggFunc{
 	aggFun: func(arr []data.Value) (data.Value, error) {
 		if len(arr) == 0 {
 			return data.Null{}, nil
@@ -557,6 +589,703 @@ var sumFunc udf.UDF = &singleParamAggFunc{
 		}
 		return data.Float(sum), nil
 	},
+	newAccumulator: newSumAccumulator,
 }
 
 // skipping xmlagg here since we have no XML data type
+
+// statistical aggregate functions
+
+// newVarianceFunc returns an aggregate function computing the variance
+// or the standard deviation of all input values with Welford's
+// algorithm. Null values are ignored, non-numeric values lead to an
+// error.
+func newVarianceFunc(sample, stddev bool) udf.UDF {
+	newAcc := func() udf.Accumulator {
+		return &varianceAccumulator{sample: sample, stddev: stddev}
+	}
+	return &incrementalAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			acc := newAcc()
+			for _, item := range arr {
+				if err := acc.Add(item); err != nil {
+					return nil, err
+				}
+			}
+			return acc.Result()
+		},
+		newAccumulator: newAcc,
+	}
+}
+
+// stddevSampFunc is an aggregate function that computes the
+// sample standard deviation of all input values. Null values
+// are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev_samp` or `stddev`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on less than two input values)
+var stddevSampFunc = newVarianceFunc(true, true)
+
+// stddevPopFunc is an aggregate function that computes the
+// population standard deviation of all input values. Null values
+// are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev_pop`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var stddevPopFunc = newVarianceFunc(false, true)
+
+// varSampFunc is an aggregate function that computes the
+// sample variance of all input values. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `var_samp` or `variance`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on less than two input values)
+var varSampFunc = newVarianceFunc(true, false)
+
+// varPopFunc is an aggregate function that computes the
+// population variance of all input values. Null values are
+// ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `var_pop`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var varPopFunc = newVarianceFunc(false, false)
+
+// newRegressionFunc returns an aggregate function computing a
+// statistic of pairs of a dependent variable Y and an independent
+// variable X. As in PostgreSQL, Y is the first parameter. Pairs in
+// which either value is Null are ignored, non-numeric values lead
+// to an error.
+func newRegressionFunc(stat func(s *regressionState) data.Value) udf.UDF {
+	return &twoParamAggFunc{
+		aggFun: func(ys []data.Value, xs []data.Value) (data.Value, error) {
+			if len(ys) != len(xs) {
+				return nil, fmt.Errorf("inputs must have same length (%d != %d)",
+					len(ys), len(xs))
+			}
+			s := &regressionState{}
+			for i := range ys {
+				if ys[i].Type() == data.TypeNull || xs[i].Type() == data.TypeNull {
+					continue
+				}
+				y, err := statNumber(ys[i])
+				if err != nil {
+					return nil, err
+				}
+				x, err := statNumber(xs[i])
+				if err != nil {
+					return nil, err
+				}
+				s.add(x, y)
+			}
+			if s.n == 0 {
+				return data.Null{}, nil
+			}
+			return stat(s), nil
+		},
+	}
+}
+
+// covarSampFunc is an aggregate function that computes the
+// sample covariance of pairs of input values.
+//
+// It can be used in BQL as `covar_samp`.
+//
+//  Input: 2 * Int or Float (aggregated)
+//  Return Type: Float (Null on less than two input pairs)
+var covarSampFunc = newRegressionFunc(func(s *regressionState) data.Value {
+	if s.n < 2 {
+		return data.Null{}
+	}
+	return data.Float(s.cxy / float64(s.n-1))
+})
+
+// covarPopFunc is an aggregate function that computes the
+// population covariance of pairs of input values.
+//
+// It can be used in BQL as `covar_pop`.
+//
+//  Input: 2 * Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var covarPopFunc = newRegressionFunc(func(s *regressionState) data.Value {
+	return data.Float(s.cxy / float64(s.n))
+})
+
+// corrFunc is an aggregate function that computes the
+// correlation coefficient of pairs of input values.
+//
+// It can be used in BQL as `corr`.
+//
+//  Input: 2 * Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or when either
+//   variable is constant)
+var corrFunc = newRegressionFunc(func(s *regressionState) data.Value {
+	if s.m2x == 0 || s.m2y == 0 {
+		return data.Null{}
+	}
+	return data.Float(s.cxy / math.Sqrt(s.m2x*s.m2y))
+})
+
+// regrSlopeFunc is an aggregate function that computes the
+// slope of the least-squares-fit linear equation determined by
+// pairs of input values.
+//
+// It can be used in BQL as `regr_slope`.
+//
+//  Input: 2 * Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or when X is constant)
+var regrSlopeFunc = newRegressionFunc(func(s *regressionState) data.Value {
+	if s.m2x == 0 {
+		return data.Null{}
+	}
+	return data.Float(s.cxy / s.m2x)
+})
+
+// regrInterceptFunc is an aggregate function that computes the
+// y-intercept of the least-squares-fit linear equation determined
+// by pairs of input values.
+//
+// It can be used in BQL as `regr_intercept`.
+//
+//  Input: 2 * Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or when X is constant)
+var regrInterceptFunc = newRegressionFunc(func(s *regressionState) data.Value {
+	if s.m2x == 0 {
+		return data.Null{}
+	}
+	return data.Float(s.meanY - s.cxy/s.m2x*s.meanX)
+})
+
+// regrR2Func is an aggregate function that computes the
+// square of the correlation coefficient of pairs of input values.
+// As in PostgreSQL, it returns 1 when only Y is constant.
+//
+// It can be used in BQL as `regr_r2`.
+//
+//  Input: 2 * Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or when X is constant)
+var regrR2Func = newRegressionFunc(func(s *regressionState) data.Value {
+	if s.m2x == 0 {
+		return data.Null{}
+	}
+	if s.m2y == 0 {
+		return data.Float(1)
+	}
+	return data.Float(s.cxy * s.cxy / (s.m2x * s.m2y))
+})
+
+// countAccumulator computes count incrementally.
+type countAccumulator struct {
+	c int64
+}
+
+func newCountAccumulator() udf.Accumulator {
+	return &countAccumulator{}
+}
+
+func (a *countAccumulator) Add(v data.Value) error {
+	if v.Type() != data.TypeNull {
+		a.c++
+	}
+	return nil
+}
+
+func (a *countAccumulator) Retract(v data.Value) error {
+	if v.Type() != data.TypeNull {
+		a.c--
+	}
+	return nil
+}
+
+func (a *countAccumulator) Result() (data.Value, error) {
+	return data.Int(a.c), nil
+}
+
+// exactSumPrec is the precision of a big.Float which can hold the sum of
+// float64 values without rounding. Finite float64 values range from 2^-1074
+// to 2^1024, and the remaining bits leave room for carries.
+const exactSumPrec = 2200
+
+// numericAccumulator holds the sum and the number of numeric values
+// and is used to compute sum and avg incrementally. Integers and floats
+// are summed up separately so that the integer sum stays exact. Floats
+// are summed up without rounding as well, so that retracting a value
+// doesn't lose the precision of the other values, e.g. retracting 1e20
+// from 1e20+1 results in 1. NaN and infinite values are only counted
+// because they cannot be retracted from a sum.
+type numericAccumulator struct {
+	avg      bool
+	intSum   int64
+	floatSum big.Float
+	numInts  int64
+	numFloat int64
+	nans     int64
+	posInfs  int64
+	negInfs  int64
+}
+
+func newNumericAccumulator(avg bool) *numericAccumulator {
+	a := &numericAccumulator{avg: avg}
+	a.floatSum.SetPrec(exactSumPrec)
+	return a
+}
+
+func newSumAccumulator() udf.Accumulator {
+	return newNumericAccumulator(false)
+}
+
+func newAvgAccumulator() udf.Accumulator {
+	return newNumericAccumulator(true)
+}
+
+func (a *numericAccumulator) update(v data.Value, sign int64) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		a.intSum += sign * i
+		a.numInts += sign
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		switch {
+		case math.IsNaN(f):
+			a.nans += sign
+		case math.IsInf(f, 1):
+			a.posInfs += sign
+		case math.IsInf(f, -1):
+			a.negInfs += sign
+		default:
+			var x big.Float
+			x.SetFloat64(float64(sign) * f)
+			a.floatSum.Add(&a.floatSum, &x)
+		}
+		a.numFloat += sign
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	return nil
+}
+
+func (a *numericAccumulator) Add(v data.Value) error {
+	return a.update(v, 1)
+}
+
+func (a *numericAccumulator) Retract(v data.Value) error {
+	return a.update(v, -1)
+}
+
+func (a *numericAccumulator) Result() (data.Value, error) {
+	count := a.numInts + a.numFloat
+	if count == 0 {
+		// empty or only null inputs
+		return data.Null{}, nil
+	}
+	if a.numFloat == 0 && !a.avg {
+		return data.Int(a.intSum), nil
+	}
+	var exact big.Float
+	exact.SetPrec(exactSumPrec).SetInt64(a.intSum).Add(&exact, &a.floatSum)
+	sum, _ := exact.Float64()
+	switch {
+	case a.nans > 0 || (a.posInfs > 0 && a.negInfs > 0):
+		sum = math.NaN()
+	case a.posInfs > 0:
+		sum = math.Inf(1)
+	case a.negInfs > 0:
+		sum = math.Inf(-1)
+	}
+	if a.avg {
+		return data.Float(sum / float64(count)), nil
+	}
+	return data.Float(sum), nil
+}
+
+// boolAccumulator counts true and false values and is used to compute
+// bool_and and bool_or incrementally.
+type boolAccumulator struct {
+	and    bool
+	trues  int64
+	falses int64
+}
+
+func newBoolAndAccumulator() udf.Accumulator {
+	return &boolAccumulator{and: true}
+}
+
+func newBoolOrAccumulator() udf.Accumulator {
+	return &boolAccumulator{}
+}
+
+func (a *boolAccumulator) update(v data.Value, sign int64) error {
+	switch v.Type() {
+	case data.TypeBool:
+		b, _ := data.AsBool(v)
+		if b {
+			a.trues += sign
+		} else {
+			a.falses += sign
+		}
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a bool", v, v)
+	}
+	return nil
+}
+
+func (a *boolAccumulator) Add(v data.Value) error {
+	return a.update(v, 1)
+}
+
+func (a *boolAccumulator) Retract(v data.Value) error {
+	return a.update(v, -1)
+}
+
+func (a *boolAccumulator) Result() (data.Value, error) {
+	if a.trues+a.falses == 0 {
+		// empty or only null inputs
+		return data.Null{}, nil
+	}
+	if a.and {
+		return data.Bool(a.falses == 0), nil
+	}
+	return data.Bool(a.trues > 0), nil
+}
+
+// extremeEntry is a distinct value held by an extremeAccumulator. Only
+// one of i, f, and t is used depending on the heap the entry is in.
+type extremeEntry struct {
+	i int64
+	f float64
+	t time.Time
+	// n is the number of occurrences of the value
+	n int
+	// index is the position of the entry in its heap
+	index int
+}
+
+// extremeHeap is a heap of distinct values whose top is the current
+// extreme value. Entries know their position so that a value can be
+// removed in O(log n) when its last occurrence is retracted.
+type extremeHeap struct {
+	entries []*extremeEntry
+	less    func(a, b *extremeEntry) bool
+}
+
+func (h *extremeHeap) Len() int {
+	return len(h.entries)
+}
+
+func (h *extremeHeap) Less(i, j int) bool {
+	return h.less(h.entries[i], h.entries[j])
+}
+
+func (h *extremeHeap) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.entries[i].index = i
+	h.entries[j].index = j
+}
+
+func (h *extremeHeap) Push(x interface{}) {
+	e := x.(*extremeEntry)
+	e.index = len(h.entries)
+	h.entries = append(h.entries, e)
+}
+
+func (h *extremeHeap) Pop() interface{} {
+	n := len(h.entries)
+	e := h.entries[n-1]
+	h.entries[n-1] = nil
+	h.entries = h.entries[:n-1]
+	return e
+}
+
+func (h *extremeHeap) top() *extremeEntry {
+	return h.entries[0]
+}
+
+// extremeAccumulator computes min and max incrementally. It counts the
+// occurrences of each value and keeps distinct values of each type in
+// a heap, so that adding or retracting a value takes O(log n) and the
+// current extreme value is always at the top of the heap. Retracted
+// values don't have to be the oldest ones, which is the case with
+// DISTINCT or a join.
+type extremeAccumulator struct {
+	max    bool
+	ints   map[int64]*extremeEntry
+	floats map[float64]*extremeEntry
+	times  map[int64]*extremeEntry
+	// nans counts NaN values, which are never an extreme value
+	nans int
+
+	intHeap   *extremeHeap
+	floatHeap *extremeHeap
+	timeHeap  *extremeHeap
+}
+
+func newMaxAccumulator() udf.Accumulator {
+	return newExtremeAccumulator(true)
+}
+
+func newMinAccumulator() udf.Accumulator {
+	return newExtremeAccumulator(false)
+}
+
+func newExtremeAccumulator(max bool) *extremeAccumulator {
+	a := &extremeAccumulator{
+		max:    max,
+		ints:   map[int64]*extremeEntry{},
+		floats: map[float64]*extremeEntry{},
+		times:  map[int64]*extremeEntry{},
+	}
+	if max {
+		a.intHeap = &extremeHeap{less: func(x, y *extremeEntry) bool { return x.i > y.i }}
+		a.floatHeap = &extremeHeap{less: func(x, y *extremeEntry) bool { return x.f > y.f }}
+		a.timeHeap = &extremeHeap{less: func(x, y *extremeEntry) bool { return x.t.After(y.t) }}
+	} else {
+		a.intHeap = &extremeHeap{less: func(x, y *extremeEntry) bool { return x.i < y.i }}
+		a.floatHeap = &extremeHeap{less: func(x, y *extremeEntry) bool { return x.f < y.f }}
+		a.timeHeap = &extremeHeap{less: func(x, y *extremeEntry) bool { return x.t.Before(y.t) }}
+	}
+	return a
+}
+
+func (a *extremeAccumulator) Add(v data.Value) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		if e, ok := a.ints[i]; ok {
+			e.n++
+			return nil
+		}
+		e := &extremeEntry{i: i, n: 1}
+		a.ints[i] = e
+		heap.Push(a.intHeap, e)
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		if math.IsNaN(f) {
+			a.nans++
+			return nil
+		}
+		if e, ok := a.floats[f]; ok {
+			e.n++
+			return nil
+		}
+		e := &extremeEntry{f: f, n: 1}
+		a.floats[f] = e
+		heap.Push(a.floatHeap, e)
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(v)
+		k := t.UnixNano()
+		if e, ok := a.times[k]; ok {
+			e.n++
+			return nil
+		}
+		e := &extremeEntry{t: t, n: 1}
+		a.times[k] = e
+		heap.Push(a.timeHeap, e)
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	return nil
+}
+
+func (a *extremeAccumulator) Retract(v data.Value) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		if e, ok := a.ints[i]; ok {
+			if e.n--; e.n <= 0 {
+				delete(a.ints, i)
+				heap.Remove(a.intHeap, e.index)
+			}
+		}
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		if math.IsNaN(f) {
+			a.nans--
+			return nil
+		}
+		if e, ok := a.floats[f]; ok {
+			if e.n--; e.n <= 0 {
+				delete(a.floats, f)
+				heap.Remove(a.floatHeap, e.index)
+			}
+		}
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(v)
+		k := t.UnixNano()
+		if e, ok := a.times[k]; ok {
+			if e.n--; e.n <= 0 {
+				delete(a.times, k)
+				heap.Remove(a.timeHeap, e.index)
+			}
+		}
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	return nil
+}
+
+func (a *extremeAccumulator) Result() (data.Value, error) {
+	numNumbers := len(a.ints) + len(a.floats) + a.nans
+	if len(a.times) > 0 {
+		if numNumbers > 0 {
+			return nil, fmt.Errorf("cannot compare timestamps and numbers")
+		}
+		return data.Timestamp(a.timeHeap.top().t), nil
+	}
+	if numNumbers == 0 {
+		// empty or only null inputs
+		return data.Null{}, nil
+	}
+
+	// the following is consistent with the computation in
+	// maxFunc and minFunc
+	if a.max {
+		maxInt := int64(math.MinInt64)
+		if len(a.ints) > 0 {
+			maxInt = a.intHeap.top().i
+		}
+		maxFloat := -float64(math.MaxFloat64)
+		if len(a.floats) > 0 {
+			maxFloat = a.floatHeap.top().f
+		}
+		if float64(maxInt) >= maxFloat {
+			return data.Int(maxInt), nil
+		}
+		return data.Float(maxFloat), nil
+	}
+	minInt := int64(math.MaxInt64)
+	if len(a.ints) > 0 {
+		minInt = a.intHeap.top().i
+	}
+	minFloat := float64(math.MaxFloat64)
+	if len(a.floats) > 0 {
+		minFloat = a.floatHeap.top().f
+	}
+	if float64(minInt) <= minFloat {
+		return data.Int(minInt), nil
+	}
+	return data.Float(minFloat), nil
+}
+
+// statNumber converts a numeric value for statistical aggregate
+// functions.
+func statNumber(v data.Value) (float64, error) {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return float64(i), nil
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		return f, nil
+	}
+	return 0, fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+}
+
+// varianceAccumulator computes variance and standard deviation
+// incrementally with Welford's algorithm, which is numerically stable
+// even when values are large compared to their deviation. Values are
+// retracted by reversing the update. As with numericAccumulator, NaN
+// and infinite values are only counted.
+type varianceAccumulator struct {
+	sample    bool
+	stddev    bool
+	n         int64
+	mean      float64
+	m2        float64
+	nonFinite int64
+}
+
+func (a *varianceAccumulator) update(v data.Value, sign int64) error {
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	x, err := statNumber(v)
+	if err != nil {
+		return err
+	}
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		a.nonFinite += sign
+		return nil
+	}
+	if sign > 0 {
+		a.n++
+		d := x - a.mean
+		a.mean += d / float64(a.n)
+		a.m2 += d * (x - a.mean)
+		return nil
+	}
+	if a.n <= 1 {
+		// avoid accumulating rounding errors
+		a.n, a.mean, a.m2 = 0, 0, 0
+		return nil
+	}
+	d := x - a.mean
+	a.n--
+	a.mean -= d / float64(a.n)
+	a.m2 -= d * (x - a.mean)
+	if a.m2 < 0 {
+		a.m2 = 0
+	}
+	return nil
+}
+
+func (a *varianceAccumulator) Add(v data.Value) error {
+	return a.update(v, 1)
+}
+
+func (a *varianceAccumulator) Retract(v data.Value) error {
+	return a.update(v, -1)
+}
+
+func (a *varianceAccumulator) Result() (data.Value, error) {
+	count := a.n + a.nonFinite
+	if count == 0 || (a.sample && count < 2) {
+		return data.Null{}, nil
+	}
+	if a.nonFinite > 0 {
+		return data.Float(math.NaN()), nil
+	}
+	div := float64(count)
+	if a.sample {
+		div--
+	}
+	res := a.m2 / div
+	if a.stddev {
+		res = math.Sqrt(res)
+	}
+	return data.Float(res), nil
+}
+
+// regressionState holds the means of X and Y, the sums of squared
+// deviations of X and Y and the sum of products of their deviations.
+// They're updated with the bivariate form of Welford's algorithm.
+type regressionState struct {
+	n     int64
+	meanX float64
+	meanY float64
+	m2x   float64
+	m2y   float64
+	cxy   float64
+}
+
+func (s *regressionState) add(x, y float64) {
+	s.n++
+	dx := x - s.meanX
+	s.meanX += dx / float64(s.n)
+	dy := y - s.meanY
+	s.meanY += dy / float64(s.n)
+	s.m2x += dx * (x - s.meanX)
+	s.m2y += dy * (y - s.meanY)
+	s.cxy += dx * (y - s.meanY)
+}
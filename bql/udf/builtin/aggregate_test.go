@@ -206,6 +206,44 @@ func TestUnaryAggregateFuncs(t *testing.T) {
 			// incompatible data
 			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
 		}},
+		{"var_pop", varPopFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// array with only Null
+			{data.Array{data.Null{}}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(3)}, data.Float(0)},
+			{data.Array{data.Int(2), data.Int(4), data.Null{}, data.Float(4), data.Int(4),
+				data.Int(5), data.Float(5), data.Int(7), data.Int(9)}, data.Float(4)},
+			// non-finite values
+			{data.Array{data.Int(2), data.Float(math.Inf(1))}, data.Float(math.NaN())},
+			// incompatible data
+			{data.Array{data.Int(7), data.String("8")}, nil},
+		}},
+		{"var_samp", varSampFunc, []udfUnaryTestCaseInput{
+			// empty array: Null
+			{data.Array{}, data.Null{}},
+			// less than two values: Null
+			{data.Array{data.Int(3), data.Null{}}, data.Null{}},
+			// normal inputs
+			{data.Array{data.Int(2), data.Int(4), data.Null{}, data.Float(4), data.Int(4),
+				data.Int(5), data.Float(5), data.Int(7), data.Int(9)}, data.Float(32. / 7)},
+			// large values with small deviation
+			{data.Array{data.Float(1e9 + 4), data.Float(1e9 + 7), data.Float(1e9 + 13),
+				data.Float(1e9 + 16)}, data.Float(30)},
+			// incompatible data
+			{data.Array{data.Int(7), data.Timestamp(someTime)}, nil},
+		}},
+		{"stddev_pop", stddevPopFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Int(2), data.Int(4), data.Null{}, data.Float(4), data.Int(4),
+				data.Int(5), data.Float(5), data.Int(7), data.Int(9)}, data.Float(2)},
+		}},
+		{"stddev_samp", stddevSampFunc, []udfUnaryTestCaseInput{
+			{data.Array{data.Int(1)}, data.Null{}},
+			{data.Array{data.Int(2), data.Int(4), data.Null{}, data.Float(4), data.Int(4),
+				data.Int(5), data.Float(5), data.Int(7), data.Int(9)}, data.Float(math.Sqrt(32. / 7))},
+		}},
 	}
 
 	for _, testCase := range udfUnaryTestCases {
@@ -279,6 +317,10 @@ func TestBinaryAggregateFuncs(t *testing.T) {
 		{data.Timestamp(someTime), data.Timestamp(someTime), nil},
 	}
 
+	// y = 2x + 1 with a pair having Null, which is ignored
+	xs := data.Array{data.Int(1), data.Float(2), data.Null{}, data.Int(3), data.Int(4)}
+	ys := data.Array{data.Int(3), data.Int(5), data.Int(100), data.Float(7), data.Int(9)}
+
 	udfBinaryTestCases := []udfBinaryTestCase{
 		{"json_object_agg", jsonObjectAggFunc, []udfBinaryTestCaseInput{
 			{data.Array{}, data.Array{}, data.Null{}},
@@ -307,6 +349,48 @@ func TestBinaryAggregateFuncs(t *testing.T) {
 			{data.Array{data.String("foo"), data.Int(17)},
 				data.Array{data.Int(7), data.Int(3)}, nil},
 		}},
+		{"covar_pop", covarPopFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			{data.Array{data.Null{}}, data.Array{data.Int(1)}, data.Null{}},
+			{data.Array{data.Int(3)}, data.Array{data.Int(1)}, data.Float(0)},
+			{ys, xs, data.Float(2.5)},
+			// different length
+			{data.Array{data.Int(3)}, data.Array{data.Int(1), data.Int(2)}, nil},
+			// incompatible data
+			{data.Array{data.String("3")}, data.Array{data.Int(1)}, nil},
+			{data.Array{data.Int(3)}, data.Array{data.Bool(true)}, nil},
+		}},
+		{"covar_samp", covarSampFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			{data.Array{data.Int(3)}, data.Array{data.Int(1)}, data.Null{}},
+			{ys, xs, data.Float(10. / 3)},
+		}},
+		{"corr", corrFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			{ys, xs, data.Float(1)},
+			{data.Array{data.Int(1), data.Int(0), data.Int(7), data.Int(1), data.Int(0)}, xs, data.Float(-0.4472135955)},
+			// constant variable
+			{data.Array{data.Int(1), data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, data.Null{}},
+		}},
+		{"regr_slope", regrSlopeFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			{ys, xs, data.Float(2)},
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.Int(1), data.Int(1)}, data.Null{}},
+		}},
+		{"regr_intercept", regrInterceptFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			{ys, xs, data.Float(1)},
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.Int(1), data.Int(1)}, data.Null{}},
+		}},
+		{"regr_r2", regrR2Func, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Null{}},
+			{ys, xs, data.Float(1)},
+			{data.Array{data.Int(1), data.Int(0), data.Int(7), data.Int(1), data.Int(0)}, xs, data.Float(0.2)},
+			// constant Y
+			{data.Array{data.Int(1), data.Int(1)}, data.Array{data.Int(1), data.Int(2)}, data.Float(1)},
+			// constant X
+			{data.Array{data.Int(1), data.Int(2)}, data.Array{data.Int(1), data.Int(1)}, data.Null{}},
+		}},
 		{"string_agg", stringAggFunc, []udfBinaryTestCaseInput{
 			{data.Array{}, data.String(", "), data.Null{}},
 			// normal cases
@@ -374,3 +458,216 @@ func TestBinaryAggregateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestIncrementalAggregateFuncs(t *testing.T) {
+	someTime := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+	someTimeLater := time.Date(2015, time.May, 1, 14, 28, 0, 0, time.UTC)
+
+	numbers := data.Array{data.Int(7), data.Null{}, data.Float(2.5), data.Int(-3),
+		data.Int(7), data.Float(math.NaN()), data.Int(10), data.Null{}, data.Float(-1.5),
+		data.Int(4), data.Int(4), data.Float(9.0)}
+	finiteNumbers := data.Array{data.Float(1004.5), data.Int(997), data.Null{}, data.Float(1013.25),
+		data.Int(984), data.Float(1002.5), data.Int(1016), data.Float(1000.25), data.Int(1007)}
+	bools := data.Array{data.Bool(true), data.Null{}, data.Bool(false), data.Bool(true),
+		data.Bool(true), data.Bool(true), data.Null{}, data.Bool(false), data.Bool(true)}
+	times := data.Array{data.Timestamp(someTime), data.Null{}, data.Timestamp(someTimeLater),
+		data.Timestamp(someTime), data.Timestamp(someTime), data.Null{}, data.Null{}}
+
+	testCases := []struct {
+		name   string
+		f      udf.UDF
+		inputs data.Array
+	}{
+		{"count", countFunc, numbers},
+		{"avg", avgFunc, numbers},
+		{"sum", sumFunc, numbers},
+		{"max", maxFunc, numbers},
+		{"min", minFunc, numbers},
+		{"max", maxFunc, times},
+		{"min", minFunc, times},
+		{"bool_and", boolAndFunc, bools},
+		{"bool_or", boolOrFunc, bools},
+		{"var_pop", varPopFunc, numbers},
+		{"var_samp", varSampFunc, numbers},
+		{"stddev_pop", stddevPopFunc, numbers},
+		{"stddev_samp", stddevSampFunc, numbers},
+		{"var_samp", varSampFunc, finiteNumbers},
+		{"stddev_pop", stddevPopFunc, finiteNumbers},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			f, ok := testCase.f.(udf.IncrementalAggregate)
+			So(ok, ShouldBeTrue)
+
+			for _, size := range []int{1, 3, 5} {
+				size := size
+
+				Convey(fmt.Sprintf("When sliding a window of %d values over the inputs", size), func() {
+					acc := f.NewAccumulator()
+
+					Convey("Then the result should equal the result of Call on the window", func() {
+						for i, v := range testCase.inputs {
+							So(acc.Add(v), ShouldBeNil)
+							if i >= size {
+								So(acc.Retract(testCase.inputs[i-size]), ShouldBeNil)
+							}
+							window := testCase.inputs[:i+1]
+							if i >= size {
+								window = testCase.inputs[i+1-size : i+1]
+							}
+							expected, err := f.Call(nil, window)
+							So(err, ShouldBeNil)
+							actual, err := acc.Result()
+							So(err, ShouldBeNil)
+							So(actual.Type(), ShouldEqual, expected.Type())
+							if expected.Type() == data.TypeFloat {
+								fExpected, _ := data.AsFloat(expected)
+								fActual, _ := data.AsFloat(actual)
+								if math.IsNaN(fExpected) {
+									So(math.IsNaN(fActual), ShouldBeTrue)
+								} else {
+									So(fActual, ShouldAlmostEqual, fExpected, 0.0000001)
+								}
+							} else {
+								So(actual, ShouldResemble, expected)
+							}
+						}
+					})
+
+					Convey("Then the result should equal the result of Call on no values after retracting all", func() {
+						for _, v := range testCase.inputs {
+							So(acc.Add(v), ShouldBeNil)
+						}
+						for _, v := range testCase.inputs {
+							So(acc.Retract(v), ShouldBeNil)
+						}
+						expected, err := f.Call(nil, data.Array{})
+						So(err, ShouldBeNil)
+						actual, err := acc.Result()
+						So(err, ShouldBeNil)
+						So(actual, ShouldResemble, expected)
+					})
+				})
+			}
+
+			// the order of retraction only matters for min and max,
+			// which keep values sorted
+			if testCase.name == "max" || testCase.name == "min" {
+				Convey("When retracting values in a different order from the one they were added", func() {
+					acc := f.NewAccumulator()
+					for _, v := range testCase.inputs {
+						So(acc.Add(v), ShouldBeNil)
+					}
+
+					Convey("Then the result should equal the result of Call on the remaining values", func() {
+						// retract every other value from the end, then the rest
+						order := []int{}
+						for i := len(testCase.inputs) - 1; i >= 0; i -= 2 {
+							order = append(order, i)
+						}
+						for i := len(testCase.inputs) - 2; i >= 0; i -= 2 {
+							order = append(order, i)
+						}
+						retracted := map[int]bool{}
+						for _, i := range order {
+							So(acc.Retract(testCase.inputs[i]), ShouldBeNil)
+							retracted[i] = true
+							window := data.Array{}
+							for j, v := range testCase.inputs {
+								if !retracted[j] {
+									window = append(window, v)
+								}
+							}
+							expected, err := f.Call(nil, window)
+							So(err, ShouldBeNil)
+							actual, err := acc.Result()
+							So(err, ShouldBeNil)
+							So(actual.Type(), ShouldEqual, expected.Type())
+							if expected.Type() == data.TypeFloat {
+								fExpected, _ := data.AsFloat(expected)
+								fActual, _ := data.AsFloat(actual)
+								if math.IsNaN(fExpected) {
+									So(math.IsNaN(fActual), ShouldBeTrue)
+								} else {
+									So(fActual, ShouldAlmostEqual, fExpected, 0.0000001)
+								}
+							} else {
+								So(actual, ShouldResemble, expected)
+							}
+						}
+					})
+				})
+			}
+
+			Convey("When adding an incompatible value", func() {
+				acc := f.NewAccumulator()
+				So(acc.Add(testCase.inputs[0]), ShouldBeNil)
+				err := acc.Add(data.String("hoge"))
+
+				Convey("Then it should fail unless the function only counts values", func() {
+					if testCase.name == "count" {
+						So(err, ShouldBeNil)
+					} else {
+						So(err, ShouldNotBeNil)
+
+						Convey("And the value should not be added", func() {
+							expected, err := f.Call(nil, testCase.inputs[:1])
+							So(err, ShouldBeNil)
+							actual, err := acc.Result()
+							So(err, ShouldBeNil)
+							So(actual, ShouldResemble, expected)
+						})
+					}
+				})
+			})
+		})
+	}
+}
+
+func TestNumericAccumulatorPrecision(t *testing.T) {
+	for _, testCase := range []struct {
+		name string
+		f    udf.UDF
+	}{
+		{"sum", sumFunc},
+		{"avg", avgFunc},
+	} {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given an accumulator of the %s function", testCase.name), t, func() {
+			acc := testCase.f.(udf.IncrementalAggregate).NewAccumulator()
+
+			Convey("When retracting a large value added before a small one", func() {
+				So(acc.Add(data.Float(1e20)), ShouldBeNil)
+				So(acc.Add(data.Float(1)), ShouldBeNil)
+				So(acc.Retract(data.Float(1e20)), ShouldBeNil)
+
+				Convey("Then the result should keep the small value", func() {
+					res, err := acc.Result()
+					So(err, ShouldBeNil)
+					So(res, ShouldEqual, data.Float(1))
+				})
+			})
+
+			Convey("When retracting a large value added before small integers and floats", func() {
+				So(acc.Add(data.Float(-1e300)), ShouldBeNil)
+				So(acc.Add(data.Int(2)), ShouldBeNil)
+				So(acc.Add(data.Float(0.5)), ShouldBeNil)
+				So(acc.Add(data.Float(1e-300)), ShouldBeNil)
+				So(acc.Retract(data.Float(-1e300)), ShouldBeNil)
+				So(acc.Retract(data.Float(1e-300)), ShouldBeNil)
+
+				Convey("Then the result should be computed from the remaining values", func() {
+					expected, err := testCase.f.Call(nil, data.Array{data.Int(2), data.Float(0.5)})
+					So(err, ShouldBeNil)
+					res, err := acc.Result()
+					So(err, ShouldBeNil)
+					So(res, ShouldEqual, expected)
+				})
+			})
+		})
+	}
+}
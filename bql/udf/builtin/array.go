@@ -2,11 +2,67 @@ package builtin
 
 import (
 	"fmt"
+	"sort"
+
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
 
+// arrayArg returns the given argument as an array. The second return
+// value is false when the argument is NULL.
+func arrayArg(arg data.Value) (data.Array, bool, error) {
+	if arg.Type() == data.TypeNull {
+		return nil, false, nil
+	} else if arg.Type() == data.TypeArray {
+		a, _ := data.AsArray(arg)
+		return a, true, nil
+	}
+	return nil, false, fmt.Errorf("%v is not an array", arg)
+}
+
+// singleParamArrayFunc is a template for functions that
+// have a single array parameter as input. NULL input
+// results in NULL.
+type singleParamArrayFunc struct {
+	singleParamFunc
+	arrFun func(data.Array) (data.Value, error)
+}
+
+func (f *singleParamArrayFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("function takes exactly one argument")
+	}
+	a, ok, err := arrayArg(args[0])
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return data.Null{}, nil
+	}
+	return f.arrFun(a)
+}
+
+// arrayElemFunc is a template for functions that have an array
+// and an arbitrary value as input. NULL array results in NULL
+// while NULL is a valid value for the second parameter.
+type arrayElemFunc struct {
+	twoParamFunc
+	arrFun func(data.Array, data.Value) (data.Value, error)
+}
+
+func (f *arrayElemFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	a, ok, err := arrayArg(args[0])
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return data.Null{}, nil
+	}
+	return f.arrFun(a, args[1])
+}
+
 // arrayLengthFunc returns the length of the given array.
 // NULL elements are counted as well.
 //
@@ -23,3 +79,391 @@ var arrayLengthFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Val
 	}
 	return nil, fmt.Errorf("%v is not an array", arg)
 })
+
+// arrayAppendFunc returns a new array having the given value
+// at the end of the given array. A NULL array is treated as
+// an empty array.
+//
+// It can be used in BQL as `array_append`.
+//
+//  Input: Array, Any
+//  Return Type: Array
+var arrayAppendFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr, v data.Value) (data.Value, error) {
+	a, _, err := arrayArg(arr)
+	if err != nil {
+		return nil, err
+	}
+	res := make(data.Array, 0, len(a)+1)
+	return append(append(res, a...), v), nil
+})
+
+// arrayPrependFunc returns a new array having the given value
+// at the beginning of the given array. A NULL array is treated
+// as an empty array. As in PostgreSQL, the value comes first.
+//
+// It can be used in BQL as `array_prepend`.
+//
+//  Input: Any, Array
+//  Return Type: Array
+var arrayPrependFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, v, arr data.Value) (data.Value, error) {
+	a, _, err := arrayArg(arr)
+	if err != nil {
+		return nil, err
+	}
+	res := make(data.Array, 0, len(a)+1)
+	return append(append(res, v), a...), nil
+})
+
+// arrayCatFunc concatenates two arrays. A NULL array is treated
+// as an empty array, but the result is NULL when both arrays
+// are NULL.
+//
+// It can be used in BQL as `array_cat`.
+//
+//  Input: Array, Array
+//  Return Type: Array
+var arrayCatFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr1, arr2 data.Value) (data.Value, error) {
+	a1, ok1, err := arrayArg(arr1)
+	if err != nil {
+		return nil, err
+	}
+	a2, ok2, err := arrayArg(arr2)
+	if err != nil {
+		return nil, err
+	}
+	if !ok1 && !ok2 {
+		return data.Null{}, nil
+	}
+	res := make(data.Array, 0, len(a1)+len(a2))
+	return append(append(res, a1...), a2...), nil
+})
+
+// arraySliceFunc returns the elements of the given array from
+// the given start index to the given end index (both inclusive).
+// As in PostgreSQL, indexes are 1-based. Indexes out of the range
+// of the array are clamped, so the result can be an empty array.
+//
+// It can be used in BQL as `array_slice`.
+//
+//  Input: Array, Int, Int
+//  Return Type: Array
+var arraySliceFunc udf.UDF = udf.TernaryFunc(func(ctx *core.Context, arr, from, to data.Value) (data.Value, error) {
+	a, ok, err := arrayArg(arr)
+	if err != nil {
+		return nil, err
+	} else if !ok || from.Type() == data.TypeNull || to.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	begin, err := data.AsInt(from)
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as an integer", from)
+	}
+	end, err := data.AsInt(to)
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as an integer", to)
+	}
+	if begin < 1 {
+		begin = 1
+	}
+	if end > int64(len(a)) {
+		end = int64(len(a))
+	}
+	if begin > end {
+		return data.Array{}, nil
+	}
+	res := make(data.Array, end-begin+1)
+	copy(res, a[begin-1:end])
+	return res, nil
+})
+
+// arrayPositionFunc returns the 1-based index of the first
+// occurrence of the given value in the given array, or NULL
+// if the value is not found. NULL can be searched as well.
+//
+// It can be used in BQL as `array_position`.
+//
+//  Input: Array, Any
+//  Return Type: Int
+var arrayPositionFunc udf.UDF = &arrayElemFunc{
+	arrFun: func(a data.Array, v data.Value) (data.Value, error) {
+		return arrayPosition(a, v, 1), nil
+	},
+}
+
+// arrayPositionFromFunc is like arrayPositionFunc, but the search
+// begins at the given 1-based index.
+//
+// It can be used in BQL as `array_position`.
+//
+//  Input: Array, Any, Int
+//  Return Type: Int
+var arrayPositionFromFunc udf.UDF = udf.TernaryFunc(func(ctx *core.Context, arr, v, from data.Value) (data.Value, error) {
+	a, ok, err := arrayArg(arr)
+	if err != nil {
+		return nil, err
+	} else if !ok || from.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	begin, err := data.AsInt(from)
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as an integer", from)
+	}
+	return arrayPosition(a, v, begin), nil
+})
+
+func arrayPosition(a data.Array, v data.Value, begin int64) data.Value {
+	if begin < 1 {
+		begin = 1
+	}
+	for i := begin - 1; i < int64(len(a)); i++ {
+		if data.Equal(a[i], v) {
+			return data.Int(i + 1)
+		}
+	}
+	return data.Null{}
+}
+
+// arrayRemoveFunc returns a new array in which all elements
+// equal to the given value are removed. NULL can be removed
+// as well.
+//
+// It can be used in BQL as `array_remove`.
+//
+//  Input: Array, Any
+//  Return Type: Array
+var arrayRemoveFunc udf.UDF = &arrayElemFunc{
+	arrFun: func(a data.Array, v data.Value) (data.Value, error) {
+		res := make(data.Array, 0, len(a))
+		for _, e := range a {
+			if !data.Equal(e, v) {
+				res = append(res, e)
+			}
+		}
+		return res, nil
+	},
+}
+
+// arrayContainsFunc returns true if the given array has an
+// element equal to the given value.
+//
+// It can be used in BQL as `array_contains`.
+//
+//  Input: Array, Any
+//  Return Type: Bool
+var arrayContainsFunc udf.UDF = &arrayElemFunc{
+	arrFun: func(a data.Array, v data.Value) (data.Value, error) {
+		return data.Bool(arrayPosition(a, v, 1).Type() != data.TypeNull), nil
+	},
+}
+
+// arrayDistinctFunc returns a new array having the elements
+// of the given array without duplicates. The first occurrence
+// of each value is kept.
+//
+// It can be used in BQL as `array_distinct`.
+//
+//  Input: Array
+//  Return Type: Array
+var arrayDistinctFunc udf.UDF = &singleParamArrayFunc{
+	arrFun: func(a data.Array) (data.Value, error) {
+		res := make(data.Array, 0, len(a))
+		seen := map[data.HashValue][]data.Value{}
+	loop:
+		for _, e := range a {
+			h := data.Hash(e)
+			for _, s := range seen[h] {
+				if data.Equal(s, e) {
+					continue loop
+				}
+			}
+			seen[h] = append(seen[h], e)
+			res = append(res, e)
+		}
+		return res, nil
+	},
+}
+
+// arraySortFunc returns a new array having the elements of the
+// given array in ascending order. Values of different types are
+// ordered in the same way as ORDER BY does.
+//
+// It can be used in BQL as `array_sort`.
+//
+//  Input: Array
+//  Return Type: Array
+var arraySortFunc udf.UDF = &singleParamArrayFunc{
+	arrFun: func(a data.Array) (data.Value, error) {
+		res := a.Copy()
+		sort.Stable(valueSlice(res))
+		return res, nil
+	},
+}
+
+// valueSlice sorts values by data.Less.
+type valueSlice []data.Value
+
+func (s valueSlice) Len() int           { return len(s) }
+func (s valueSlice) Less(i, j int) bool { return data.Less(s[i], s[j]) }
+func (s valueSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// arrayReverseFunc returns a new array having the elements of
+// the given array in reverse order.
+//
+// It can be used in BQL as `array_reverse`.
+//
+//  Input: Array
+//  Return Type: Array
+var arrayReverseFunc udf.UDF = &singleParamArrayFunc{
+	arrFun: func(a data.Array) (data.Value, error) {
+		res := make(data.Array, len(a))
+		for i, e := range a {
+			res[len(a)-1-i] = e
+		}
+		return res, nil
+	},
+}
+
+// arrayAggregateFunc creates a function which applies the given
+// aggregate function to the elements of an array so that both
+// return the same result for the same values.
+func arrayAggregateFunc(agg udf.UDF) udf.UDF {
+	return &singleParamArrayFunc{
+		arrFun: func(a data.Array) (data.Value, error) {
+			return agg.Call(nil, a)
+		},
+	}
+}
+
+// arrayMaxFunc returns the maximum element of the given array.
+// Null values are ignored.
+//
+// It can be used in BQL as `array_max`.
+//
+//  Input: Array of Int, Float or Timestamp
+//  Return Type: same as the maximum element (Null on empty array)
+var arrayMaxFunc = arrayAggregateFunc(maxFunc)
+
+// arrayMinFunc returns the minimum element of the given array.
+// Null values are ignored.
+//
+// It can be used in BQL as `array_min`.
+//
+//  Input: Array of Int, Float or Timestamp
+//  Return Type: same as the minimum element (Null on empty array)
+var arrayMinFunc = arrayAggregateFunc(minFunc)
+
+// arraySumFunc returns the sum of the elements of the given
+// array. Null values are ignored.
+//
+// It can be used in BQL as `array_sum`.
+//
+//  Input: Array of Int or Float
+//  Return Type: Int if all elements are Int, Float otherwise
+//   (Null on empty array)
+var arraySumFunc = arrayAggregateFunc(sumFunc)
+
+// arrayAvgFunc returns the average of the elements of the given
+// array. Null values are ignored.
+//
+// It can be used in BQL as `array_avg`.
+//
+//  Input: Array of Int or Float
+//  Return Type: Float (Null on empty array)
+var arrayAvgFunc = arrayAggregateFunc(avgFunc)
+
+// arrayZipFunc combines the given arrays into an array of arrays
+// where the i-th array has the i-th elements of all input arrays.
+// The result is as long as the longest input array and missing
+// elements are filled with NULL. A NULL array is treated as an
+// empty array.
+//
+// It can be used in BQL as `array_zip`.
+//
+//  Input: n * Array
+//  Return Type: Array
+var arrayZipFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		arrs := make([]data.Array, len(args))
+		n := 0
+		for i, arg := range args {
+			a, _, err := arrayArg(arg)
+			if err != nil {
+				return nil, err
+			}
+			arrs[i] = a
+			if len(a) > n {
+				n = len(a)
+			}
+		}
+		res := make(data.Array, n)
+		for i := range res {
+			elems := make(data.Array, len(arrs))
+			for j, a := range arrs {
+				if i < len(a) {
+					elems[j] = a[i]
+				} else {
+					elems[j] = data.Null{}
+				}
+			}
+			res[i] = elems
+		}
+		return res, nil
+	},
+}
+
+// unnestUDSF emits one tuple for each element of an array in an
+// input tuple. The emitted tuple is a copy of the input tuple in
+// which the array is replaced with the element.
+type unnestUDSF struct {
+	path data.Path
+}
+
+// createUnnestUDSF creates a UDSF flattening an array addressed by
+// the given path in tuples coming from the given stream.
+//
+// It can be used in BQL as `array_unnest` since UNNEST is a reserved
+// word:
+//
+//  SELECT RSTREAM * FROM array_unnest("stream", "readings") [RANGE 1 TUPLES];
+//
+// A tuple is dropped when the value at the path is an empty array,
+// NULL or missing. Other non-array values result in an error.
+func createUnnestUDSF(decl udf.UDSFDeclarer, stream, path string) (udf.UDSF, error) {
+	p, err := data.CompilePath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path for unnest: %v", err)
+	}
+	if err := decl.Input(stream, nil); err != nil {
+		return nil, err
+	}
+	return &unnestUDSF{
+		path: p,
+	}, nil
+}
+
+func (u *unnestUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	v, err := t.Data.Get(u.path)
+	if err != nil {
+		// a missing field is treated as NULL
+		return nil
+	}
+	a, _, err := arrayArg(v)
+	if err != nil {
+		return err
+	}
+	for _, e := range a {
+		out := t.Copy()
+		if err := out.Data.Set(u.path, e); err != nil {
+			return err
+		}
+		if err := w.Write(ctx, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *unnestUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
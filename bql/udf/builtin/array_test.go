@@ -4,6 +4,7 @@ import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math"
 	"testing"
@@ -33,6 +34,50 @@ func TestUnaryArrayFuncs(t *testing.T) {
 			{data.Array{data.Null{}}, data.Int(1)},
 			{data.Array{data.Int(2), data.Float(3)}, data.Int(2)},
 		}},
+		{"array_distinct", arrayDistinctFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Array{}},
+			{data.Array{data.Int(2), data.Int(1), data.Int(2)}, data.Array{data.Int(2), data.Int(1)}},
+			{data.Array{data.Int(2), data.Float(2), data.String("2")}, data.Array{data.Int(2), data.String("2")}},
+			{data.Array{data.Null{}, data.Null{}}, data.Array{data.Null{}}},
+			{data.Array{data.Array{data.Int(1)}, data.Array{data.Int(1)}}, data.Array{data.Array{data.Int(1)}}},
+		}},
+		{"array_sort", arraySortFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Array{}},
+			{data.Array{data.Int(3), data.Float(1.5), data.Int(2)}, data.Array{data.Float(1.5), data.Int(2), data.Int(3)}},
+			{data.Array{data.String("b"), data.Int(1), data.Null{}}, data.Array{data.Null{}, data.Int(1), data.String("b")}},
+		}},
+		{"array_reverse", arrayReverseFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Array{}},
+			{data.Array{data.Int(1)}, data.Array{data.Int(1)}},
+			{data.Array{data.Int(1), data.Null{}, data.String("a")}, data.Array{data.String("a"), data.Null{}, data.Int(1)}},
+		}},
+		{"array_max", arrayMaxFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Null{}}, data.Null{}},
+			{data.Array{data.Int(2), data.Null{}, data.Int(5), data.Int(3)}, data.Int(5)},
+			{data.Array{data.Int(2), data.Float(2.5)}, data.Float(2.5)},
+			{data.Array{data.Timestamp(someTime), data.Timestamp(someTime.Add(time.Second))}, data.Timestamp(someTime.Add(time.Second))},
+			{data.Array{data.String("a")}, nil},
+		}},
+		{"array_min", arrayMinFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Int(2), data.Null{}, data.Int(5), data.Int(3)}, data.Int(2)},
+			{data.Array{data.Int(2), data.Float(1.5)}, data.Float(1.5)},
+			{data.Array{data.String("a")}, nil},
+		}},
+		{"array_sum", arraySumFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Null{}}, data.Null{}},
+			{data.Array{data.Int(2), data.Null{}, data.Int(5)}, data.Int(7)},
+			{data.Array{data.Int(2), data.Float(0.5)}, data.Float(2.5)},
+			{data.Array{data.String("a")}, nil},
+		}},
+		{"array_avg", arrayAvgFunc, []udfUnaryTestCaseInput{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Null{}}, data.Null{}},
+			{data.Array{data.Int(2), data.Null{}, data.Int(5)}, data.Float(3.5)},
+			{data.Array{data.String("a")}, nil},
+		}},
 	}
 
 	for _, testCase := range udfUnaryTestCases {
@@ -80,3 +125,267 @@ func TestUnaryArrayFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestBinaryArrayFuncs(t *testing.T) {
+	arr := data.Array{data.Int(1), data.String("a"), data.Null{}, data.Int(1)}
+
+	udfBinaryTestCases := []udfBinaryTestCase{
+		{"array_append", arrayAppendFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Int(1), data.Array{data.Int(1)}},
+			{data.Array{data.Int(1)}, data.Null{}, data.Array{data.Int(1), data.Null{}}},
+			{data.Null{}, data.Int(1), data.Array{data.Int(1)}},
+			{data.Int(1), data.Int(1), nil},
+		}},
+		{"array_prepend", arrayPrependFunc, []udfBinaryTestCaseInput{
+			{data.Int(1), data.Array{}, data.Array{data.Int(1)}},
+			{data.Int(1), data.Array{data.Int(2)}, data.Array{data.Int(1), data.Int(2)}},
+			{data.Int(1), data.Null{}, data.Array{data.Int(1)}},
+			{data.Int(1), data.Int(1), nil},
+		}},
+		{"array_cat", arrayCatFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Array{}},
+			{data.Array{data.Int(1)}, data.Array{data.Int(2), data.Int(3)}, data.Array{data.Int(1), data.Int(2), data.Int(3)}},
+			{data.Null{}, data.Array{data.Int(2)}, data.Array{data.Int(2)}},
+			{data.Array{data.Int(1)}, data.Null{}, data.Array{data.Int(1)}},
+			{data.Null{}, data.Null{}, data.Null{}},
+			{data.Array{data.Int(1)}, data.Int(2), nil},
+			{data.String("a"), data.Array{}, nil},
+		}},
+		{"array_position", arrayPositionFunc, []udfBinaryTestCaseInput{
+			{arr, data.Int(1), data.Int(1)},
+			{arr, data.Float(1), data.Int(1)},
+			{arr, data.String("a"), data.Int(2)},
+			{arr, data.Null{}, data.Int(3)},
+			{arr, data.String("b"), data.Null{}},
+			{data.Array{}, data.Int(1), data.Null{}},
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Map{}, data.Int(1), nil},
+		}},
+		{"array_remove", arrayRemoveFunc, []udfBinaryTestCaseInput{
+			{arr, data.Int(1), data.Array{data.String("a"), data.Null{}}},
+			{arr, data.Null{}, data.Array{data.Int(1), data.String("a"), data.Int(1)}},
+			{arr, data.String("b"), arr},
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Map{}, data.Int(1), nil},
+		}},
+		{"array_contains", arrayContainsFunc, []udfBinaryTestCaseInput{
+			{arr, data.Int(1), data.Bool(true)},
+			{arr, data.Null{}, data.Bool(true)},
+			{arr, data.String("b"), data.Bool(false)},
+			{data.Array{}, data.Int(1), data.Bool(false)},
+			{data.Null{}, data.Int(1), data.Null{}},
+			{data.Map{}, data.Int(1), nil},
+		}},
+	}
+
+	for _, testCase := range udfBinaryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %s (%T) and %s (%T)",
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				if dispatcher, ok := regFun.(*arityDispatcher); ok {
+					regFun = dispatcher.binary
+				}
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestTernaryArrayFuncs(t *testing.T) {
+	arr := data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(1)}
+
+	udf3aryTestCases := []udf3aryTestCase{
+		{"array_slice", arraySliceFunc, []udf3aryTestCaseInput{
+			{arr, data.Int(2), data.Int(3), data.Array{data.Int(2), data.Int(3)}},
+			{arr, data.Int(1), data.Int(1), data.Array{data.Int(1)}},
+			{arr, data.Int(-5), data.Int(2), data.Array{data.Int(1), data.Int(2)}},
+			{arr, data.Int(3), data.Int(10), data.Array{data.Int(3), data.Int(1)}},
+			{arr, data.Int(3), data.Int(2), data.Array{}},
+			{arr, data.Int(5), data.Int(6), data.Array{}},
+			{data.Null{}, data.Int(1), data.Int(2), data.Null{}},
+			{arr, data.Null{}, data.Int(2), data.Null{}},
+			{arr, data.Int(1), data.Null{}, data.Null{}},
+			{arr, data.String("1"), data.Int(2), nil},
+			{data.Int(1), data.Int(1), data.Int(2), nil},
+		}},
+		{"array_position", arrayPositionFromFunc, []udf3aryTestCaseInput{
+			{arr, data.Int(1), data.Int(1), data.Int(1)},
+			{arr, data.Int(1), data.Int(2), data.Int(4)},
+			{arr, data.Int(1), data.Int(-1), data.Int(1)},
+			{arr, data.Int(2), data.Int(3), data.Null{}},
+			{arr, data.Int(1), data.Null{}, data.Null{}},
+			{data.Null{}, data.Int(1), data.Int(1), data.Null{}},
+			{arr, data.Int(1), data.Float(1.5), nil},
+		}},
+	}
+
+	for _, testCase := range udf3aryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %#v",
+					[]data.Value{tc.input1, tc.input2, tc.input3}), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2, tc.input3)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 3)
+				if dispatcher, ok := regFun.(*arityDispatcher); ok {
+					regFun = dispatcher.ternary
+				}
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestArrayZipFunc(t *testing.T) {
+	Convey("Given the array_zip function", t, func() {
+		f := arrayZipFunc
+
+		Convey("When zipping arrays of the same length", func() {
+			val, err := f.Call(nil, data.Array{data.Int(1), data.Int(2)},
+				data.Array{data.String("a"), data.String("b")})
+
+			Convey("Then the result should have pairs of elements", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Array{
+					data.Array{data.Int(1), data.String("a")},
+					data.Array{data.Int(2), data.String("b")},
+				})
+			})
+		})
+
+		Convey("When zipping arrays of different lengths", func() {
+			val, err := f.Call(nil, data.Array{data.Int(1), data.Int(2)},
+				data.Array{data.String("a")}, data.Null{})
+
+			Convey("Then missing elements should be filled with NULL", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Array{
+					data.Array{data.Int(1), data.String("a"), data.Null{}},
+					data.Array{data.Int(2), data.Null{}, data.Null{}},
+				})
+			})
+		})
+
+		Convey("When zipping a non-array value", func() {
+			_, err := f.Call(nil, data.Array{data.Int(1)}, data.Int(2))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then it should be registered in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("array_zip", 3)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
+
+func TestUnnestUDSF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given the array_unnest UDSF in the default registry", t, func() {
+		r, err := udf.CopyGlobalUDSFCreatorRegistry()
+		So(err, ShouldBeNil)
+		c, err := r.Lookup("array_unnest", 2)
+		So(err, ShouldBeNil)
+
+		Convey("When creating it with an invalid path", func() {
+			_, err := c.CreateUDSF(ctx, udf.NewUDSFDeclarer(), data.String("s"), data.String("a["))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating it with a valid path", func() {
+			decl := udf.NewUDSFDeclarer()
+			f, err := c.CreateUDSF(ctx, decl, data.String("s"), data.String("sensor.values"))
+			So(err, ShouldBeNil)
+			Reset(func() {
+				f.Terminate(ctx)
+			})
+
+			var res []data.Map
+			w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+				res = append(res, t.Data)
+				return nil
+			})
+
+			Convey("Then it should read the given stream", func() {
+				So(decl.ListInputs(), ShouldContainKey, "s")
+			})
+
+			Convey("Then it should emit a tuple for each element", func() {
+				in := data.Map{
+					"id": data.Int(1),
+					"sensor": data.Map{
+						"values": data.Array{data.Int(3), data.Int(4)},
+					},
+				}
+				So(f.Process(ctx, core.NewTuple(in), w), ShouldBeNil)
+				So(res, ShouldResemble, []data.Map{
+					{"id": data.Int(1), "sensor": data.Map{"values": data.Int(3)}},
+					{"id": data.Int(1), "sensor": data.Map{"values": data.Int(4)}},
+				})
+				So(in["sensor"], ShouldResemble, data.Map{
+					"values": data.Array{data.Int(3), data.Int(4)},
+				})
+			})
+
+			Convey("Then it should drop a tuple without elements", func() {
+				So(f.Process(ctx, core.NewTuple(data.Map{"sensor": data.Map{"values": data.Array{}}}), w), ShouldBeNil)
+				So(f.Process(ctx, core.NewTuple(data.Map{"sensor": data.Map{"values": data.Null{}}}), w), ShouldBeNil)
+				So(f.Process(ctx, core.NewTuple(data.Map{"id": data.Int(1)}), w), ShouldBeNil)
+				So(res, ShouldBeEmpty)
+			})
+
+			Convey("Then it should fail on a non-array value", func() {
+				So(f.Process(ctx, core.NewTuple(data.Map{"sensor": data.Map{"values": data.Int(1)}}), w), ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,370 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"math"
+	"math/bits"
+	"sync"
+)
+
+const (
+	hllMinPrecision     = 4
+	hllMaxPrecision     = 18
+	hllDefaultPrecision = 14
+)
+
+// hyperLogLog estimates the number of distinct values with the
+// HyperLogLog algorithm by Flajolet et al. It uses 2^precision
+// registers of one byte each and its standard error is about
+// 1.04 / sqrt(2^precision), i.e., 0.81% with the default precision.
+// Small cardinalities are estimated by linear counting.
+type hyperLogLog struct {
+	precision uint
+	registers []uint8
+}
+
+func newHyperLogLog(precision uint) *hyperLogLog {
+	return &hyperLogLog{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+// mixHash improves the distribution of the bits of a data.HashValue
+// with the finalizer of MurmurHash3. HyperLogLog relies on the bits
+// of hash values being uniformly distributed.
+func mixHash(h data.HashValue) uint64 {
+	x := uint64(h)
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+func (h *hyperLogLog) add(v data.Value) {
+	idx, rank := h.register(mixHash(data.Hash(v)))
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+// register returns the index of the register that the mixed hash value
+// belongs to and its rank. The first bits choose the register and the
+// register keeps the maximum position of the leftmost 1-bit in the
+// remaining bits.
+func (h *hyperLogLog) register(x uint64) (uint64, uint8) {
+	idx := x >> (64 - h.precision)
+	w := x<<h.precision | 1<<(h.precision-1)
+	return idx, uint8(bits.LeadingZeros64(w)) + 1
+}
+
+func (h *hyperLogLog) count() int64 {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	e := alpha * m * m / sum
+	if e <= 2.5*m && zeros > 0 {
+		e = m * math.Log(m/float64(zeros))
+	}
+	return int64(e + 0.5)
+}
+
+// approxCountDistinctFunc is an aggregate function that estimates
+// the number of distinct non-null values with HyperLogLog. Its standard
+// error is about 0.81%. Values are distinguished as in
+// `count(DISTINCT x)`, e.g., 1 and 1.0 are regarded as the same value.
+//
+// When the function is computed incrementally, added values are merged
+// into the registers right away, but the hash values of all distinct
+// values in the window are kept as well because a register cannot be
+// decremented. When the last occurrence of a value determining a
+// register leaves the window, the register is recomputed from those
+// hash values on the next evaluation, which takes O(n) time for n
+// distinct values. Therefore, this function doesn't use less memory
+// than `count(DISTINCT x)` on a window.
+//
+// It can be used in BQL as `approx_count_distinct`.
+//
+//  Input: anything (aggregated)
+//  Return Type: Int
+var approxCountDistinctFunc udf.UDF = &incrementalAggFunc{
+	aggFun: func(arr []data.Value) (data.Value, error) {
+		h := newHyperLogLog(hllDefaultPrecision)
+		for _, item := range arr {
+			if item.Type() != data.TypeNull {
+				h.add(item)
+			}
+		}
+		return data.Int(h.count()), nil
+	},
+	newAccumulator: newHyperLogLogAccumulator,
+}
+
+// hyperLogLogAccumulator computes approxCountDistinctFunc
+// incrementally. It counts the occurrences of each mixed hash value so
+// that registers whose maximum rank has been retracted can be
+// recomputed.
+type hyperLogLogAccumulator struct {
+	hll    *hyperLogLog
+	hashes map[uint64]int
+	// dirty holds the indices of registers that have to be recomputed
+	dirty map[uint64]bool
+}
+
+func newHyperLogLogAccumulator() udf.Accumulator {
+	return &hyperLogLogAccumulator{
+		hll:    newHyperLogLog(hllDefaultPrecision),
+		hashes: map[uint64]int{},
+		dirty:  map[uint64]bool{},
+	}
+}
+
+func (a *hyperLogLogAccumulator) Add(v data.Value) error {
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	x := mixHash(data.Hash(v))
+	if a.hashes[x]++; a.hashes[x] > 1 {
+		return nil
+	}
+	idx, rank := a.hll.register(x)
+	if rank > a.hll.registers[idx] {
+		a.hll.registers[idx] = rank
+	}
+	return nil
+}
+
+func (a *hyperLogLogAccumulator) Retract(v data.Value) error {
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	x := mixHash(data.Hash(v))
+	n, ok := a.hashes[x]
+	if !ok {
+		return nil
+	}
+	if n > 1 {
+		a.hashes[x] = n - 1
+		return nil
+	}
+	delete(a.hashes, x)
+	// the register only changes when its maximum rank was retracted
+	idx, rank := a.hll.register(x)
+	if rank == a.hll.registers[idx] {
+		a.dirty[idx] = true
+	}
+	return nil
+}
+
+func (a *hyperLogLogAccumulator) Result() (data.Value, error) {
+	if len(a.dirty) > 0 {
+		for idx := range a.dirty {
+			a.hll.registers[idx] = 0
+		}
+		for x := range a.hashes {
+			idx, rank := a.hll.register(x)
+			if a.dirty[idx] && rank > a.hll.registers[idx] {
+				a.hll.registers[idx] = rank
+			}
+		}
+		a.dirty = map[uint64]bool{}
+	}
+	return data.Int(a.hll.count()), nil
+}
+
+// hyperLogLogState is a UDS which estimates the number of distinct
+// values written to it with HyperLogLog. Since the state isn't bound
+// to a window, it can keep counting distinct values over a long
+// period. The value to be counted is taken from the 'field' of each
+// tuple written to the state and null values are ignored.
+//
+// The state can be created in BQL as follows:
+//  CREATE STATE devices TYPE hyperloglog WITH field = "device_id";
+// An optional 'precision' parameter between 4 and 18 specifies the
+// number of registers (2^precision). The default precision is 14.
+// The estimation can be obtained by `hyperloglog_count("devices")`.
+type hyperLogLogState struct {
+	m     sync.RWMutex
+	field string
+	path  data.Path
+
+	// hll is nil after the state is terminated.
+	hll *hyperLogLog
+}
+
+var (
+	_ core.LoadableSharedState = &hyperLogLogState{}
+	_ core.Writer              = &hyperLogLogState{}
+)
+
+func (s *hyperLogLogState) Write(ctx *core.Context, t *core.Tuple) error {
+	v, err := t.Data.Get(s.path)
+	if err != nil {
+		return fmt.Errorf("a tuple written to the state doesn't have the field: %v", err)
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.hll == nil {
+		return errors.New("the state has already been terminated")
+	}
+	s.hll.add(v)
+	return nil
+}
+
+// count returns the estimated number of distinct values.
+func (s *hyperLogLogState) count() (int64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.hll == nil {
+		return 0, errors.New("the state has already been terminated")
+	}
+	return s.hll.count(), nil
+}
+
+func (s *hyperLogLogState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.hll = nil
+	return nil
+}
+
+func (s *hyperLogLogState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.hll == nil {
+		return errors.New("the state has already been terminated")
+	}
+	return saveSketch(w, data.Map{
+		"field":     data.String(s.field),
+		"precision": data.Int(s.hll.precision),
+		"registers": data.Blob(s.hll.registers),
+	})
+}
+
+func (s *hyperLogLogState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	loaded, err := loadHyperLogLogState(r)
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.field = loaded.field
+	s.path = loaded.path
+	s.hll = loaded.hll
+	return nil
+}
+
+func loadHyperLogLogState(r io.Reader) (*hyperLogLogState, error) {
+	m, err := loadSketch(r)
+	if err != nil {
+		return nil, err
+	}
+	s, err := createHyperLogLogState(m)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := m["registers"]
+	if !ok {
+		return nil, fmt.Errorf("the saved data doesn't have registers")
+	}
+	var registers []byte
+	switch v.Type() {
+	case data.TypeBlob:
+		registers, _ = data.AsBlob(v)
+	case data.TypeString:
+		// msgpack decodes a blob as a string
+		str, _ := data.AsString(v)
+		registers = []byte(str)
+	default:
+		return nil, fmt.Errorf("the saved registers must be a blob: %v", v.Type())
+	}
+	if len(registers) != len(s.hll.registers) {
+		return nil, fmt.Errorf("the number of saved registers doesn't match the precision")
+	}
+	copy(s.hll.registers, registers)
+	return s, nil
+}
+
+func createHyperLogLogState(params data.Map) (*hyperLogLogState, error) {
+	field, path, err := sketchFieldParam(params)
+	if err != nil {
+		return nil, err
+	}
+	p, err := sketchIntParam(params, "precision", hllDefaultPrecision,
+		hllMinPrecision, hllMaxPrecision)
+	if err != nil {
+		return nil, err
+	}
+	return &hyperLogLogState{
+		field: field,
+		path:  path,
+		hll:   newHyperLogLog(uint(p)),
+	}, nil
+}
+
+type hyperLogLogStateCreator struct {
+}
+
+var (
+	_ udf.UDSLoader = &hyperLogLogStateCreator{}
+)
+
+func (c *hyperLogLogStateCreator) CreateState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	return createHyperLogLogState(params)
+}
+
+func (c *hyperLogLogStateCreator) LoadState(ctx *core.Context, r io.Reader, params data.Map) (core.SharedState, error) {
+	return loadHyperLogLogState(r)
+}
+
+// hyperLogLogCountFunc returns the estimated number of distinct values
+// written to the hyperloglog state having the given name.
+//
+// It can be used in BQL as `hyperloglog_count`.
+//
+//  Input: String (state name)
+//  Return Type: Int
+var hyperLogLogCountFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, name data.Value) (data.Value, error) {
+	st, n, err := lookupSharedState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*hyperLogLogState)
+	if !ok {
+		return nil, fmt.Errorf("the state '%v' is not a hyperloglog state", n)
+	}
+	c, err := s.count()
+	if err != nil {
+		return nil, err
+	}
+	return data.Int(c), nil
+})
@@ -0,0 +1,229 @@
+package builtin
+
+import (
+	"bytes"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestApproxCountDistinctFunc(t *testing.T) {
+	Convey("Given the approx_count_distinct function", t, func() {
+		f := approxCountDistinctFunc
+
+		Convey("When evaluating it on an empty array", func() {
+			val, err := f.Call(nil, data.Array{})
+
+			Convey("Then the result should be 0", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("When evaluating it on a few values", func() {
+			val, err := f.Call(nil, data.Array{data.Int(1), data.Float(1), data.Null{},
+				data.String("a"), data.Int(2), data.String("a"), data.Null{}})
+
+			Convey("Then the result should be exact", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldEqual, data.Int(3))
+			})
+		})
+
+		for _, n := range []int{1000, 30000, 200000} {
+			n := n
+			Convey(fmt.Sprintf("When evaluating it on %d distinct values appearing twice", n), func() {
+				arr := make(data.Array, 0, 2*n)
+				for i := 0; i < n; i++ {
+					arr = append(arr, data.Int(i), data.String(fmt.Sprint("device", i)))
+				}
+				for i := 0; i < n/2; i++ {
+					arr = append(arr, data.Int(i), data.String(fmt.Sprint("device", i)))
+				}
+				val, err := f.Call(nil, arr)
+
+				Convey("Then the result should be close to the actual number", func() {
+					So(err, ShouldBeNil)
+					c, err := data.AsInt(val)
+					So(err, ShouldBeNil)
+					So(c, ShouldAlmostEqual, 2*n, 0.03*float64(2*n))
+				})
+			})
+		}
+
+		Convey("When computing it incrementally on a sliding window", func() {
+			inc, ok := f.(udf.IncrementalAggregate)
+			So(ok, ShouldBeTrue)
+			acc := inc.NewAccumulator()
+
+			arr := make(data.Array, 0, 3000)
+			for i := 0; i < cap(arr); i++ {
+				if i%7 == 0 {
+					arr = append(arr, data.Null{})
+					continue
+				}
+				// values repeat so that some of them are retracted
+				// while another occurrence is still in the window
+				arr = append(arr, data.Int(i%1100))
+			}
+
+			Convey("Then the result should equal the result of Call on the window", func() {
+				const size = 500
+				for i, v := range arr {
+					So(acc.Add(v), ShouldBeNil)
+					if i >= size {
+						So(acc.Retract(arr[i-size]), ShouldBeNil)
+					}
+					if i%50 != 0 {
+						continue
+					}
+					window := arr[:i+1]
+					if i >= size {
+						window = arr[i+1-size : i+1]
+					}
+					expected, err := f.Call(nil, window)
+					So(err, ShouldBeNil)
+					actual, err := acc.Result()
+					So(err, ShouldBeNil)
+					So(actual, ShouldEqual, expected)
+				}
+			})
+		})
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("approx_count_distinct", 1)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
+
+func TestHyperLogLogState(t *testing.T) {
+	ctx := core.NewContext(nil)
+	reg := udf.CopyGlobalUDFRegistry(ctx)
+	countFunc, err := reg.Lookup("hyperloglog_count", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Given a hyperloglog state", t, func() {
+		c, err := udf.CopyGlobalUDSCreatorRegistry()
+		So(err, ShouldBeNil)
+		creator, err := c.Lookup("hyperloglog")
+		So(err, ShouldBeNil)
+		s, err := creator.CreateState(ctx, data.Map{"field": data.String("device.id")})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("devices", "hyperloglog", s), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("devices")
+		})
+		w := s.(core.Writer)
+
+		Convey("When writing tuples to it", func() {
+			for i := 0; i < 10000; i++ {
+				So(w.Write(ctx, core.NewTuple(data.Map{
+					"device": data.Map{"id": data.Int(i % 5000)}})), ShouldBeNil)
+			}
+			So(w.Write(ctx, core.NewTuple(data.Map{
+				"device": data.Map{"id": data.Null{}}})), ShouldBeNil)
+
+			Convey("Then hyperloglog_count should estimate the number of distinct values", func() {
+				v, err := countFunc.Call(ctx, data.String("devices"))
+				So(err, ShouldBeNil)
+				n, err := data.AsInt(v)
+				So(err, ShouldBeNil)
+				So(n, ShouldAlmostEqual, 5000, 150)
+			})
+
+			Convey("And saving it", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+				saved := buf.Bytes()
+				expected, err := countFunc.Call(ctx, data.String("devices"))
+				So(err, ShouldBeNil)
+
+				Convey("Then it should be loaded as a new state", func() {
+					loaded, err := creator.(udf.UDSLoader).LoadState(ctx, bytes.NewReader(saved), data.Map{})
+					So(err, ShouldBeNil)
+					_, err = ctx.SharedStates.Replace("devices", "hyperloglog", loaded)
+					So(err, ShouldBeNil)
+					v, err := countFunc.Call(ctx, data.String("devices"))
+					So(err, ShouldBeNil)
+					So(v, ShouldEqual, expected)
+
+					Convey("And the field should be restored", func() {
+						So(loaded.(core.Writer).Write(ctx, core.NewTuple(data.Map{
+							"device": data.Map{"id": data.String("new")}})), ShouldBeNil)
+						v, err := countFunc.Call(ctx, data.String("devices"))
+						So(err, ShouldBeNil)
+						So(v, ShouldNotEqual, expected)
+					})
+				})
+
+				Convey("Then it should be loaded into an existing state", func() {
+					other, err := creator.CreateState(ctx, data.Map{
+						"field": data.String("x"), "precision": data.Int(4)})
+					So(err, ShouldBeNil)
+					So(other.(core.LoadableSharedState).Load(ctx, bytes.NewReader(saved), data.Map{}), ShouldBeNil)
+					n, err := other.(*hyperLogLogState).count()
+					So(err, ShouldBeNil)
+					So(data.Int(n), ShouldEqual, expected)
+				})
+
+				Convey("Then broken data should not be loaded", func() {
+					_, err := creator.(udf.UDSLoader).LoadState(ctx, bytes.NewReader(saved[:len(saved)/2]), data.Map{})
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+
+		Convey("When writing a tuple without the field", func() {
+			err := w.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the state is terminated", func() {
+			So(s.Terminate(ctx), ShouldBeNil)
+
+			Convey("Then writing and counting should fail", func() {
+				So(w.Write(ctx, core.NewTuple(data.Map{"device": data.Map{"id": data.Int(1)}})), ShouldNotBeNil)
+				_, err := countFunc.Call(ctx, data.String("devices"))
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When counting a state which doesn't exist", func() {
+			_, err := countFunc.Call(ctx, data.String("no_such_state"))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters", t, func() {
+		for _, params := range []data.Map{
+			{},
+			{"field": data.Int(1)},
+			{"field": data.String("a[")},
+			{"field": data.String("a"), "precision": data.Int(3)},
+			{"field": data.String("a"), "precision": data.Int(19)},
+			{"field": data.String("a"), "precision": data.String("a")},
+		} {
+			params := params
+			Convey(fmt.Sprintf("When creating a hyperloglog state with %v", params), func() {
+				_, err := (&hyperLogLogStateCreator{}).CreateState(ctx, params)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
@@ -51,6 +51,9 @@ func init() {
 	udf.RegisterGlobalUDF("octet_length", octetLengthFunc)
 	udf.RegisterGlobalUDF("overlay", &arityDispatcher{
 		ternary: overlayFunc, quaternary: overlayFunc})
+	udf.RegisterGlobalUDF("regexp_matches", regexpMatchesFunc)
+	udf.RegisterGlobalUDF("regexp_replace", regexpReplaceFunc)
+	udf.RegisterGlobalUDF("regexp_split_to_array", regexpSplitToArrayFunc)
 	udf.RegisterGlobalUDF("rtrim", &arityDispatcher{
 		unary: rtrimSpaceFunc, binary: rtrimFunc})
 	udf.RegisterGlobalUDF("sha1", sha1Func)
@@ -64,8 +67,42 @@ func init() {
 	// time functions
 	udf.RegisterGlobalUDF("distance_us", diffUsFunc)
 	udf.RegisterGlobalUDF("clock_timestamp", clockTimestampFunc)
+	udf.RegisterGlobalUDF("date_part", datePartFunc)
+	udf.RegisterGlobalUDF("date_trunc", dateTruncFunc)
+	udf.RegisterGlobalUDF("extract", datePartFunc)
+	udf.RegisterGlobalUDF("make_timestamp", makeTimestampFunc)
+	udf.RegisterGlobalUDF("timestamp_add", timestampAddFunc)
+	udf.RegisterGlobalUDF("timezone", timezoneFunc)
+	udf.RegisterGlobalUDF("to_char", toCharFunc)
+	udf.RegisterGlobalUDF("to_timestamp", toTimestampFunc)
 	// array functions
+	udf.RegisterGlobalUDF("array_append", arrayAppendFunc)
+	udf.RegisterGlobalUDF("array_avg", arrayAvgFunc)
+	udf.RegisterGlobalUDF("array_cat", arrayCatFunc)
+	udf.RegisterGlobalUDF("array_contains", arrayContainsFunc)
+	udf.RegisterGlobalUDF("array_distinct", arrayDistinctFunc)
 	udf.RegisterGlobalUDF("array_length", arrayLengthFunc)
+	udf.RegisterGlobalUDF("array_max", arrayMaxFunc)
+	udf.RegisterGlobalUDF("array_min", arrayMinFunc)
+	udf.RegisterGlobalUDF("array_position", &arityDispatcher{
+		binary: arrayPositionFunc, ternary: arrayPositionFromFunc})
+	udf.RegisterGlobalUDF("array_prepend", arrayPrependFunc)
+	udf.RegisterGlobalUDF("array_remove", arrayRemoveFunc)
+	udf.RegisterGlobalUDF("array_reverse", arrayReverseFunc)
+	udf.RegisterGlobalUDF("array_slice", arraySliceFunc)
+	udf.RegisterGlobalUDF("array_sort", arraySortFunc)
+	udf.RegisterGlobalUDF("array_sum", arraySumFunc)
+	udf.RegisterGlobalUDF("array_zip", arrayZipFunc)
+	// map functions
+	udf.RegisterGlobalUDF("map_deep_merge", mapDeepMergeFunc)
+	udf.RegisterGlobalUDF("map_delete", mapDeleteFunc)
+	udf.RegisterGlobalUDF("map_entries", mapEntriesFunc)
+	udf.RegisterGlobalUDF("map_from_arrays", mapFromArraysFunc)
+	udf.RegisterGlobalUDF("map_has_key", mapHasKeyFunc)
+	udf.RegisterGlobalUDF("map_keys", mapKeysFunc)
+	udf.RegisterGlobalUDF("map_merge", mapMergeFunc)
+	udf.RegisterGlobalUDF("map_set", mapSetFunc)
+	udf.RegisterGlobalUDF("map_values", mapValuesFunc)
 	// aggregate functions
 	udf.RegisterGlobalUDF("array_agg", arrayAggFunc)
 	udf.RegisterGlobalUDF("avg", avgFunc)
@@ -78,8 +115,37 @@ func init() {
 	udf.RegisterGlobalUDF("min", minFunc)
 	udf.RegisterGlobalUDF("string_agg", stringAggFunc)
 	udf.RegisterGlobalUDF("sum", sumFunc)
+	// statistical aggregate functions
+	udf.RegisterGlobalUDF("corr", corrFunc)
+	udf.RegisterGlobalUDF("covar_pop", covarPopFunc)
+	udf.RegisterGlobalUDF("covar_samp", covarSampFunc)
+	udf.RegisterGlobalUDF("regr_intercept", regrInterceptFunc)
+	udf.RegisterGlobalUDF("regr_r2", regrR2Func)
+	udf.RegisterGlobalUDF("regr_slope", regrSlopeFunc)
+	udf.RegisterGlobalUDF("stddev", stddevSampFunc)
+	udf.RegisterGlobalUDF("stddev_pop", stddevPopFunc)
+	udf.RegisterGlobalUDF("stddev_samp", stddevSampFunc)
+	udf.RegisterGlobalUDF("var_pop", varPopFunc)
+	udf.RegisterGlobalUDF("var_samp", varSampFunc)
+	udf.RegisterGlobalUDF("variance", varSampFunc)
+	// sketch functions
+	udf.RegisterGlobalUDF("approx_count_distinct", approxCountDistinctFunc)
+	udf.RegisterGlobalUDF("approx_top_k", approxTopKFunc)
+	udf.RegisterGlobalUDF("hyperloglog_count", hyperLogLogCountFunc)
+	udf.RegisterGlobalUDF("space_saving_top_k", spaceSavingTopKFunc)
+	// percentile functions
+	udf.RegisterGlobalUDF("approx_percentile", approxPercentileFunc)
+	udf.RegisterGlobalUDF("percentile_cont", percentileContFunc)
+	udf.RegisterGlobalUDF("percentile_disc", percentileDiscFunc)
 	// conversion functions
 	udf.RegisterGlobalUDF("blob_to_raw_string", udf.MustConvertGeneric(blobToRawString))
 	// other functions
 	udf.RegisterGlobalUDF("coalesce", coalesceFunc)
+
+	// stream-generating functions
+	udf.MustRegisterGlobalUDSFCreator("array_unnest", udf.MustConvertToUDSFCreator(createUnnestUDSF))
+
+	// user-defined states
+	udf.MustRegisterGlobalUDSCreator("hyperloglog", &hyperLogLogStateCreator{})
+	udf.MustRegisterGlobalUDSCreator("space_saving", &spaceSavingStateCreator{})
 }
@@ -0,0 +1,299 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// mapArg returns the given argument as a map. The second return
+// value is false when the argument is NULL.
+func mapArg(arg data.Value) (data.Map, bool, error) {
+	if arg.Type() == data.TypeNull {
+		return nil, false, nil
+	} else if arg.Type() == data.TypeMap {
+		m, _ := data.AsMap(arg)
+		return m, true, nil
+	}
+	return nil, false, fmt.Errorf("%v is not a map", arg)
+}
+
+// sortedKeys returns the keys of the given map in ascending order
+// so that functions returning arrays have a deterministic result.
+func sortedKeys(m data.Map) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// singleParamMapFunc is a template for functions that
+// have a single map parameter as input. NULL input
+// results in NULL.
+type singleParamMapFunc struct {
+	singleParamFunc
+	mapFun func(data.Map) (data.Value, error)
+}
+
+func (f *singleParamMapFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("function takes exactly one argument")
+	}
+	m, ok, err := mapArg(args[0])
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return data.Null{}, nil
+	}
+	return f.mapFun(m)
+}
+
+// mapKeysFunc returns the keys of the given map in ascending
+// order.
+//
+// It can be used in BQL as `map_keys`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapKeysFunc udf.UDF = &singleParamMapFunc{
+	mapFun: func(m data.Map) (data.Value, error) {
+		res := make(data.Array, 0, len(m))
+		for _, k := range sortedKeys(m) {
+			res = append(res, data.String(k))
+		}
+		return res, nil
+	},
+}
+
+// mapValuesFunc returns the values of the given map in ascending
+// order of their keys.
+//
+// It can be used in BQL as `map_values`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapValuesFunc udf.UDF = &singleParamMapFunc{
+	mapFun: func(m data.Map) (data.Value, error) {
+		res := make(data.Array, 0, len(m))
+		for _, k := range sortedKeys(m) {
+			res = append(res, m[k])
+		}
+		return res, nil
+	},
+}
+
+// mapEntriesFunc returns the entries of the given map as an array
+// of maps having "key" and "value" fields in ascending order of
+// their keys.
+//
+// It can be used in BQL as `map_entries`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapEntriesFunc udf.UDF = &singleParamMapFunc{
+	mapFun: func(m data.Map) (data.Value, error) {
+		res := make(data.Array, 0, len(m))
+		for _, k := range sortedKeys(m) {
+			res = append(res, data.Map{
+				"key":   data.String(k),
+				"value": m[k],
+			})
+		}
+		return res, nil
+	},
+}
+
+// mapMergeFunc merges all given maps into a new map. When more than
+// one map has the same key, the value in the last map is used.
+// NULL arguments are ignored, but the result is NULL when all
+// arguments are NULL.
+//
+// It can be used in BQL as `map_merge`.
+//
+//  Input: n * Map
+//  Return Type: Map
+var mapMergeFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		return mergeMaps(args, false)
+	},
+}
+
+// mapDeepMergeFunc is like mapMergeFunc, but values of the same key
+// are merged recursively when both of them are maps.
+//
+// It can be used in BQL as `map_deep_merge`.
+//
+//  Input: n * Map
+//  Return Type: Map
+var mapDeepMergeFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		return mergeMaps(args, true)
+	},
+}
+
+func mergeMaps(args []data.Value, deep bool) (data.Value, error) {
+	var res data.Map
+	for _, arg := range args {
+		m, ok, err := mapArg(arg)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		if res == nil {
+			res = data.Map{}
+		}
+		mergeMap(res, m, deep)
+	}
+	if res == nil {
+		return data.Null{}, nil
+	}
+	return res, nil
+}
+
+// mergeMap copies entries of src to dst. dst must not share any map
+// with src or other arguments since it might be modified when deep is
+// true.
+func mergeMap(dst, src data.Map, deep bool) {
+	for k, v := range src {
+		if deep && v.Type() == data.TypeMap {
+			if cur, ok := dst[k]; ok && cur.Type() == data.TypeMap {
+				curMap, _ := data.AsMap(cur)
+				srcMap, _ := data.AsMap(v)
+				mergeMap(curMap, srcMap, deep)
+				continue
+			}
+			// copy the map so that later arguments don't modify src
+			m, _ := data.AsMap(v)
+			dst[k] = m.Copy()
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+// mapDeleteFunc returns a new map in which the given keys are
+// removed. Keys which don't exist in the map are ignored.
+//
+// It can be used in BQL as `map_delete`.
+//
+//  Input: Map, n * String
+//  Return Type: Map
+var mapDeleteFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		m, ok, err := mapArg(args[0])
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			return data.Null{}, nil
+		}
+		res := make(data.Map, len(m))
+		for k, v := range m {
+			res[k] = v
+		}
+		for _, arg := range args[1:] {
+			if arg.Type() == data.TypeNull {
+				continue
+			}
+			k, err := data.AsString(arg)
+			if err != nil {
+				return nil, fmt.Errorf("cannot interpret %s as a string", arg)
+			}
+			delete(res, k)
+		}
+		return res, nil
+	},
+}
+
+// mapHasKeyFunc returns true if the given map has the given key.
+//
+// It can be used in BQL as `map_has_key`.
+//
+//  Input: Map, String
+//  Return Type: Bool
+var mapHasKeyFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arg, key data.Value) (data.Value, error) {
+	m, ok, err := mapArg(arg)
+	if err != nil {
+		return nil, err
+	} else if !ok || key.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	k, err := data.AsString(key)
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a string", key)
+	}
+	_, ok = m[k]
+	return data.Bool(ok), nil
+})
+
+// mapSetFunc returns a new map in which the given value is set at
+// the location addressed by the given path, e.g. "a.b[0]". As with
+// data.Map.Set, intermediate maps and arrays are created when they
+// don't exist. A NULL map is treated as an empty map.
+//
+// It can be used in BQL as `map_set`.
+//
+//  Input: Map, String, Any
+//  Return Type: Map
+var mapSetFunc udf.UDF = udf.TernaryFunc(func(ctx *core.Context, arg, path, v data.Value) (data.Value, error) {
+	m, ok, err := mapArg(arg)
+	if err != nil {
+		return nil, err
+	}
+	if path.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	s, err := data.AsString(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a string", path)
+	}
+	p, err := data.CompilePath(s)
+	if err != nil {
+		return nil, err
+	}
+	var res data.Map
+	if ok {
+		res = m.Copy()
+	} else {
+		res = data.Map{}
+	}
+	if err := res.Set(p, v); err != nil {
+		return nil, err
+	}
+	return res, nil
+})
+
+// mapFromArraysFunc creates a map from an array of keys and an
+// array of values having the same length. Keys must be strings and
+// must not be duplicated. As with json_object_agg, a pair of NULL
+// key and NULL value is ignored.
+//
+// It can be used in BQL as `map_from_arrays`.
+//
+//  Input: Array, Array
+//  Return Type: Map
+var mapFromArraysFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, keys, values data.Value) (data.Value, error) {
+	ks, ok1, err := arrayArg(keys)
+	if err != nil {
+		return nil, err
+	}
+	vs, ok2, err := arrayArg(values)
+	if err != nil {
+		return nil, err
+	}
+	if !ok1 || !ok2 {
+		return data.Null{}, nil
+	}
+	if len(ks) == 0 && len(vs) == 0 {
+		return data.Map{}, nil
+	}
+	return jsonObjectAggFunc.Call(ctx, ks, vs)
+})
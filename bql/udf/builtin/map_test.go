@@ -0,0 +1,263 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestUnaryMapFuncs(t *testing.T) {
+	m := data.Map{"b": data.Int(2), "a": data.Null{}, "c": data.Array{data.Int(3)}}
+
+	invalidInputs := []udfUnaryTestCaseInput{
+		// NULL input -> NULL output
+		{data.Null{}, data.Null{}},
+		// cannot process the following
+		{data.Array{}, nil},
+		{data.Int(1), nil},
+		{data.String("hoge"), nil},
+	}
+
+	udfUnaryTestCases := []udfUnaryTestCase{
+		{"map_keys", mapKeysFunc, []udfUnaryTestCaseInput{
+			{data.Map{}, data.Array{}},
+			{m, data.Array{data.String("a"), data.String("b"), data.String("c")}},
+		}},
+		{"map_values", mapValuesFunc, []udfUnaryTestCaseInput{
+			{data.Map{}, data.Array{}},
+			{m, data.Array{data.Null{}, data.Int(2), data.Array{data.Int(3)}}},
+		}},
+		{"map_entries", mapEntriesFunc, []udfUnaryTestCaseInput{
+			{data.Map{}, data.Array{}},
+			{m, data.Array{
+				data.Map{"key": data.String("a"), "value": data.Null{}},
+				data.Map{"key": data.String("b"), "value": data.Int(2)},
+				data.Map{"key": data.String("c"), "value": data.Array{data.Int(3)}},
+			}},
+		}},
+	}
+
+	for _, testCase := range udfUnaryTestCases {
+		f := testCase.f
+		allInputs := append(testCase.inputs, invalidInputs...)
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %s (%T)", tc.input, tc.input), func() {
+					val, err := f.Call(nil, tc.input)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 1)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestBinaryMapFuncs(t *testing.T) {
+	m := data.Map{"a": data.Int(1), "b": data.Null{}}
+
+	udfBinaryTestCases := []udfBinaryTestCase{
+		{"map_has_key", mapHasKeyFunc, []udfBinaryTestCaseInput{
+			{m, data.String("a"), data.Bool(true)},
+			{m, data.String("b"), data.Bool(true)},
+			{m, data.String("c"), data.Bool(false)},
+			{data.Map{}, data.String("a"), data.Bool(false)},
+			{m, data.Null{}, data.Null{}},
+			{data.Null{}, data.String("a"), data.Null{}},
+			{m, data.Int(1), nil},
+			{data.Array{}, data.String("a"), nil},
+		}},
+		{"map_from_arrays", mapFromArraysFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Array{}, data.Map{}},
+			{data.Array{data.String("a"), data.String("b")}, data.Array{data.Int(1), data.Null{}}, m},
+			{data.Array{data.String("a"), data.Null{}}, data.Array{data.Int(1), data.Null{}},
+				data.Map{"a": data.Int(1)}},
+			{data.Null{}, data.Array{}, data.Null{}},
+			{data.Array{}, data.Null{}, data.Null{}},
+			// different length
+			{data.Array{data.String("a")}, data.Array{}, nil},
+			// duplicate keys
+			{data.Array{data.String("a"), data.String("a")}, data.Array{data.Int(1), data.Int(2)}, nil},
+			// non-string key
+			{data.Array{data.Int(1)}, data.Array{data.Int(1)}, nil},
+			{data.Map{}, data.Array{}, nil},
+		}},
+	}
+
+	for _, testCase := range udfBinaryTestCases {
+		f := testCase.f
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %s (%T) and %s (%T)",
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := f.Call(nil, tc.input1, tc.input2)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
+
+func TestMapSetFunc(t *testing.T) {
+	Convey("Given the map_set function", t, func() {
+		f := mapSetFunc
+		m := data.Map{"a": data.Map{"b": data.Int(1)}}
+
+		Convey("When setting a value at a nested path", func() {
+			val, err := f.Call(nil, m, data.String("a.c[1]"), data.String("x"))
+
+			Convey("Then the value should be set in a new map", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Map{"a": data.Map{
+					"b": data.Int(1),
+					"c": data.Array{data.Null{}, data.String("x")},
+				}})
+			})
+
+			Convey("Then the original map should not be modified", func() {
+				So(m, ShouldResemble, data.Map{"a": data.Map{"b": data.Int(1)}})
+			})
+		})
+
+		Convey("When setting a value to a NULL map", func() {
+			val, err := f.Call(nil, data.Null{}, data.String("a"), data.Int(1))
+
+			Convey("Then it should be treated as an empty map", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Map{"a": data.Int(1)})
+			})
+		})
+
+		Convey("When the path is NULL", func() {
+			val, err := f.Call(nil, m, data.Null{}, data.Int(1))
+
+			Convey("Then the result should be NULL", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When the path is invalid", func() {
+			_, err := f.Call(nil, m, data.String("a["), data.Int(1))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When an intermediate value is not a map", func() {
+			_, err := f.Call(nil, m, data.String("a.b.c"), data.Int(1))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("map_set", 3)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
+
+func TestVariadicMapFuncs(t *testing.T) {
+	m1 := data.Map{"a": data.Int(1), "n": data.Map{"x": data.Int(1), "y": data.Int(2)}}
+	m2 := data.Map{"b": data.Int(2), "n": data.Map{"y": data.Int(3)}}
+
+	testCases := []struct {
+		name     string
+		f        udf.UDF
+		inputs   []data.Value
+		expected data.Value
+	}{
+		{"map_merge", mapMergeFunc, []data.Value{m1, m2}, data.Map{
+			"a": data.Int(1), "b": data.Int(2), "n": data.Map{"y": data.Int(3)},
+		}},
+		{"map_merge", mapMergeFunc, []data.Value{m1, data.Null{}}, m1},
+		{"map_merge", mapMergeFunc, []data.Value{data.Null{}, data.Null{}}, data.Null{}},
+		{"map_merge", mapMergeFunc, []data.Value{m1, data.Int(1)}, nil},
+		{"map_deep_merge", mapDeepMergeFunc, []data.Value{m1, m2}, data.Map{
+			"a": data.Int(1), "b": data.Int(2), "n": data.Map{"x": data.Int(1), "y": data.Int(3)},
+		}},
+		{"map_deep_merge", mapDeepMergeFunc, []data.Value{m1, data.Map{"n": data.Int(0)}, m2}, data.Map{
+			"a": data.Int(1), "b": data.Int(2), "n": data.Map{"y": data.Int(3)},
+		}},
+		{"map_deep_merge", mapDeepMergeFunc, []data.Value{data.Array{}}, nil},
+		{"map_delete", mapDeleteFunc, []data.Value{m1, data.String("n")}, data.Map{"a": data.Int(1)}},
+		{"map_delete", mapDeleteFunc, []data.Value{m1, data.String("a"), data.String("n"), data.String("z")}, data.Map{}},
+		{"map_delete", mapDeleteFunc, []data.Value{m1, data.Null{}}, m1},
+		{"map_delete", mapDeleteFunc, []data.Value{data.Null{}, data.String("a")}, data.Null{}},
+		{"map_delete", mapDeleteFunc, []data.Value{m1, data.Int(1)}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		Convey(fmt.Sprintf("Given the %s function", tc.name), t, func() {
+			Convey(fmt.Sprintf("When evaluating it on %v", tc.inputs), func() {
+				val, err := tc.f.Call(nil, tc.inputs...)
+
+				if tc.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+						So(err, ShouldBeNil)
+						So(val, ShouldResemble, tc.expected)
+					})
+				}
+
+				Convey("Then the input maps should not be modified", func() {
+					So(m1, ShouldResemble, data.Map{"a": data.Int(1), "n": data.Map{"x": data.Int(1), "y": data.Int(2)}})
+					So(m2, ShouldResemble, data.Map{"b": data.Int(2), "n": data.Map{"y": data.Int(3)}})
+				})
+			})
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(tc.name, len(tc.inputs))
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, tc.f)
+			})
+		})
+	}
+}
@@ -0,0 +1,380 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"sort"
+)
+
+// percentileFuncTmpl is a template for aggregate functions computing
+// percentiles. One parameter is the fraction, or an array of
+// fractions, and the other one is the aggregated values.
+type percentileFuncTmpl struct {
+	// aggParam is the index of the aggregation parameter. The other
+	// parameter is the fraction.
+	aggParam int
+
+	// prepare converts the aggregated values into a state from which
+	// percentile computes percentiles. Null values are already removed.
+	prepare func(arr []data.Value) (interface{}, error)
+
+	// percentile computes the percentile for the given fraction.
+	percentile func(state interface{}, fraction float64) data.Value
+}
+
+func (f *percentileFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *percentileFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == f.aggParam
+}
+
+func (f *percentileFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[f.aggParam])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[f.aggParam])
+	}
+	fracArg := args[1-f.aggParam]
+	if fracArg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	fractions, isArray, err := percentileFractions(fracArg)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]data.Value, 0, len(arr))
+	for _, v := range arr {
+		if v.Type() != data.TypeNull {
+			values = append(values, v)
+		}
+	}
+	var state interface{}
+	if len(values) > 0 {
+		state, err = f.prepare(values)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := make(data.Array, len(fractions))
+	for i, frac := range fractions {
+		if state == nil {
+			res[i] = data.Null{}
+		} else {
+			res[i] = f.percentile(state, frac)
+		}
+	}
+	if !isArray {
+		return res[0], nil
+	}
+	return res, nil
+}
+
+// percentileFractions returns the fractions given as a number or an
+// array of numbers. The second return value is true when an array was
+// given.
+func percentileFractions(v data.Value) ([]float64, bool, error) {
+	isArray := v.Type() == data.TypeArray
+	var arr data.Array
+	if isArray {
+		arr, _ = data.AsArray(v)
+	} else {
+		arr = data.Array{v}
+	}
+	fractions := make([]float64, len(arr))
+	for i, elem := range arr {
+		f, err := statNumber(elem)
+		if err != nil {
+			return nil, false, fmt.Errorf("fraction must be a number: %v", err)
+		}
+		if !(f >= 0 && f <= 1) {
+			return nil, false, fmt.Errorf("fraction %v is not between 0 and 1", elem)
+		}
+		fractions[i] = f
+	}
+	return fractions, isArray, nil
+}
+
+// checkSorted returns an error if the values are neither in ascending
+// nor in descending order. The percentile functions rely on the order
+// given by `WITHIN GROUP (ORDER BY ...)` instead of sorting by
+// themselves so that the order can be reversed with DESC.
+func checkSorted(values []data.Value) error {
+	dir := 0
+	for i := 1; i < len(values); i++ {
+		d := 0
+		if data.Less(values[i-1], values[i]) {
+			d = 1
+		} else if data.Less(values[i], values[i-1]) {
+			d = -1
+		}
+		if d == 0 {
+			continue
+		}
+		if dir == 0 {
+			dir = d
+		} else if d != dir {
+			return fmt.Errorf("input values must be sorted, " +
+				"use WITHIN GROUP (ORDER BY ...)")
+		}
+	}
+	return nil
+}
+
+// percentileContFunc is an aggregate function that computes a
+// percentile of the input values with linear interpolation between
+// adjacent values. The fraction can also be an array of fractions,
+// in which case an array of percentiles is returned. Null values are
+// ignored, non-numeric values lead to an error.
+//
+// The input values must be sorted, so the function is used in BQL as
+//  percentile_cont(0.95) WITHIN GROUP (ORDER BY x)
+// which is the same as `percentile_cont(0.95, x ORDER BY x)`.
+//
+//  Input: Float or Array of Float (fraction), Int or Float (aggregated)
+//  Return Type: Float or Array of Float (Null on empty input)
+var percentileContFunc udf.UDF = &percentileFuncTmpl{
+	aggParam: 1,
+	prepare: func(arr []data.Value) (interface{}, error) {
+		if err := checkSorted(arr); err != nil {
+			return nil, err
+		}
+		floatVals := make([]float64, len(arr))
+		for i, v := range arr {
+			f, err := statNumber(v)
+			if err != nil {
+				return nil, err
+			}
+			floatVals[i] = f
+		}
+		return floatVals, nil
+	},
+	percentile: func(state interface{}, fraction float64) data.Value {
+		floatVals := state.([]float64)
+		pos := fraction * float64(len(floatVals)-1)
+		lo := math.Floor(pos)
+		hi := math.Ceil(pos)
+		result := floatVals[int(lo)]
+		if hi != lo {
+			result += (floatVals[int(hi)] - result) * (pos - lo)
+		}
+		return data.Float(result)
+	},
+}
+
+// percentileDiscFunc is an aggregate function that returns the first
+// input value whose position in the input is at or above the given
+// fraction. Unlike percentileContFunc, it can be used with any type
+// of values that can be sorted. The fraction can also be an array of
+// fractions, in which case an array of values is returned. Null values
+// are ignored.
+//
+// The input values must be sorted, so the function is used in BQL as
+//  percentile_disc(0.95) WITHIN GROUP (ORDER BY x)
+// which is the same as `percentile_disc(0.95, x ORDER BY x)`.
+//
+//  Input: Float or Array of Float (fraction), any (aggregated)
+//  Return Type: same as input or Array (Null on empty input)
+var percentileDiscFunc udf.UDF = &percentileFuncTmpl{
+	aggParam: 1,
+	prepare: func(arr []data.Value) (interface{}, error) {
+		if err := checkSorted(arr); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	},
+	percentile: func(state interface{}, fraction float64) data.Value {
+		values := state.([]data.Value)
+		idx := int(math.Ceil(fraction*float64(len(values)))) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		return values[idx]
+	},
+}
+
+// approxPercentileCompression is the compression parameter of the
+// t-digest used by approxPercentileFunc. The digest holds at most
+// about approxPercentileCompression centroids.
+const approxPercentileCompression = 200
+
+// approxPercentileFunc(expr, fraction) is an aggregate function that
+// estimates a percentile of the input values with a t-digest. Unlike
+// percentileContFunc, it doesn't require sorted input. Estimates are
+// most accurate for fractions close to 0 or 1, e.g. for p99 latencies,
+// and are exact when there are only a few values. The fraction can
+// also be an array of fractions, in which case an array of percentiles
+// is returned. Null and NaN values are ignored, non-numeric values lead
+// to an error.
+//
+// Because values cannot be removed from a t-digest, the digest is
+// rebuilt from all values in the window every time the function is
+// evaluated, which takes O(n) time for n values in the window. The
+// digest has a bounded size, but the window itself still has to be
+// kept as with percentileContFunc.
+//
+// It can be used in BQL as `approx_percentile`.
+//
+//  Input: Int or Float (aggregated), Float or Array of Float (fraction)
+//  Return Type: Float or Array of Float (Null on empty input)
+var approxPercentileFunc udf.UDF = &percentileFuncTmpl{
+	aggParam: 0,
+	prepare: func(arr []data.Value) (interface{}, error) {
+		t := newTDigest(approxPercentileCompression)
+		for _, v := range arr {
+			f, err := statNumber(v)
+			if err != nil {
+				return nil, err
+			}
+			if math.IsNaN(f) {
+				continue
+			}
+			t.add(f)
+		}
+		if t.count == 0 {
+			return nil, nil
+		}
+		t.compress()
+		return t, nil
+	},
+	percentile: func(state interface{}, fraction float64) data.Value {
+		t := state.(*tDigest)
+		return data.Float(t.quantile(fraction))
+	},
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+type centroidSlice []centroid
+
+func (s centroidSlice) Len() int {
+	return len(s)
+}
+
+func (s centroidSlice) Less(i, j int) bool {
+	return s[i].mean < s[j].mean
+}
+
+func (s centroidSlice) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// tDigest is a merging t-digest as described by Dunning and Ertl in
+// "Computing Extremely Accurate Quantiles Using t-Digests". Values are
+// buffered and merged into a sorted list of centroids whose sizes are
+// limited by the scale function, so that quantiles near the tails are
+// accurate.
+type tDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min         float64
+	max         float64
+}
+
+func newTDigest(compression float64) *tDigest {
+	return &tDigest{
+		compression: compression,
+		buffer:      make([]centroid, 0, int(5*compression)),
+	}
+}
+
+// add adds a value to the digest. It must not be NaN.
+func (t *tDigest) add(x float64) {
+	if t.count == 0 || x < t.min {
+		t.min = x
+	}
+	if t.count == 0 || x > t.max {
+		t.max = x
+	}
+	t.count++
+	t.buffer = append(t.buffer, centroid{x, 1})
+	if len(t.buffer) == cap(t.buffer) {
+		t.compress()
+	}
+}
+
+// compress merges buffered values into the centroids.
+func (t *tDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := make(centroidSlice, 0, len(t.centroids)+len(t.buffer))
+	all = append(all, t.centroids...)
+	all = append(all, t.buffer...)
+	sort.Sort(all)
+
+	merged := make([]centroid, 0, len(t.centroids)+1)
+	cur := all[0]
+	soFar := 0.0
+	for _, c := range all[1:] {
+		proposed := cur.weight + c.weight
+		if t.scale((soFar+proposed)/t.count)-t.scale(soFar/t.count) <= 1 {
+			cur.mean += (c.mean - cur.mean) * c.weight / proposed
+			cur.weight = proposed
+			continue
+		}
+		soFar += cur.weight
+		merged = append(merged, cur)
+		cur = c
+	}
+	t.centroids = append(merged, cur)
+	t.buffer = t.buffer[:0]
+}
+
+// scale is the scale function k1 of the t-digest, which maps a
+// quantile to the range [-compression/4, compression/4]. A centroid
+// may only span a range of quantiles which is mapped to an interval
+// of length 1 or less. Since the function is steep near the tails,
+// centroids there stay small.
+func (t *tDigest) scale(q float64) float64 {
+	return t.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// quantile estimates the q-quantile of the values added to the digest.
+// The values are ranked from 0 to count-1 like in percentileContFunc
+// and the center of each centroid is placed at the average rank of
+// the values it holds. Between the centers, as well as between the
+// minimum or maximum and the outermost centers, values are linearly
+// interpolated. Therefore, the result is exact while no centroids
+// have been merged. The digest must not be empty.
+func (t *tDigest) quantile(q float64) float64 {
+	t.compress()
+	// the minimum and the maximum can be part of larger centroids
+	if q == 0 {
+		return t.min
+	} else if q == 1 {
+		return t.max
+	}
+	target := q * (t.count - 1)
+	left, leftRank := t.min, 0.0
+	soFar := 0.0
+	for _, c := range t.centroids {
+		rank := soFar + (c.weight-1)/2
+		if target <= rank {
+			if rank == leftRank {
+				return c.mean
+			}
+			return left + (c.mean-left)*(target-leftRank)/(rank-leftRank)
+		}
+		left, leftRank = c.mean, rank
+		soFar += c.weight
+	}
+	maxRank := t.count - 1
+	if maxRank == leftRank {
+		return left
+	}
+	return left + (t.max-left)*(target-leftRank)/(maxRank-leftRank)
+}
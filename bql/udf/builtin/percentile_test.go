@@ -0,0 +1,193 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPercentileFuncs(t *testing.T) {
+	someTime := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+	someTimeLater := time.Date(2015, time.May, 1, 14, 28, 0, 0, time.UTC)
+
+	sorted := data.Array{data.Int(1), data.Null{}, data.Int(2), data.Float(3.5), data.Int(4), data.Int(10)}
+	reversed := data.Array{data.Int(10), data.Int(4), data.Float(3.5), data.Int(2), data.Int(1)}
+	unsorted := data.Array{data.Int(4), data.Int(1), data.Int(10), data.Float(3.5), data.Int(2)}
+
+	// percentile_cont and percentile_disc take the fraction first,
+	// approx_percentile takes the aggregated values first
+	testCases := []udfBinaryTestCase{
+		{"percentile_cont", percentileContFunc, []udfBinaryTestCaseInput{
+			{data.Float(0.5), data.Array{}, data.Null{}},
+			{data.Float(0.5), data.Array{data.Null{}}, data.Null{}},
+			{data.Float(0.5), data.Array{data.Int(3)}, data.Float(3)},
+			{data.Float(0.5), sorted, data.Float(3.5)},
+			{data.Float(0.75), sorted, data.Float(4)},
+			{data.Float(0.9), sorted, data.Float(7.6)},
+			{data.Int(0), sorted, data.Float(1)},
+			{data.Int(1), sorted, data.Float(10)},
+			// descending order gives the percentile from the top
+			{data.Float(0.9), reversed, data.Float(1.4)},
+			{data.Array{data.Float(0.25), data.Float(0.5)}, sorted,
+				data.Array{data.Float(2), data.Float(3.5)}},
+			{data.Array{}, sorted, data.Array{}},
+			{data.Array{data.Float(0.5)}, data.Array{}, data.Array{data.Null{}}},
+			{data.Null{}, sorted, data.Null{}},
+			// fail cases
+			{data.Float(0.5), unsorted, nil},
+			{data.Float(1.5), sorted, nil},
+			{data.Float(-0.1), sorted, nil},
+			{data.Float(math.NaN()), sorted, nil},
+			{data.String("0.5"), sorted, nil},
+			{data.Array{data.Float(0.5), data.Null{}}, sorted, nil},
+			{data.Float(0.5), data.Array{data.String("a"), data.String("b")}, nil},
+			{data.Float(0.5), data.Int(1), nil},
+		}},
+		{"percentile_disc", percentileDiscFunc, []udfBinaryTestCaseInput{
+			{data.Float(0.5), data.Array{}, data.Null{}},
+			{data.Float(0.5), sorted, data.Float(3.5)},
+			{data.Float(0.41), sorted, data.Float(3.5)},
+			{data.Float(0.61), sorted, data.Int(4)},
+			{data.Int(0), sorted, data.Int(1)},
+			{data.Int(1), sorted, data.Int(10)},
+			{data.Float(0.9), reversed, data.Int(1)},
+			{data.Float(0.5), data.Array{data.String("a"), data.String("b"), data.String("c")},
+				data.String("b")},
+			{data.Float(0.5), data.Array{data.Timestamp(someTime), data.Timestamp(someTimeLater)},
+				data.Timestamp(someTime)},
+			{data.Array{data.Float(0.1), data.Float(1)}, sorted,
+				data.Array{data.Int(1), data.Int(10)}},
+			// fail cases
+			{data.Float(0.5), unsorted, nil},
+			{data.Float(2), sorted, nil},
+		}},
+		{"approx_percentile", approxPercentileFunc, []udfBinaryTestCaseInput{
+			{data.Array{}, data.Float(0.5), data.Null{}},
+			{data.Array{data.Null{}, data.Float(math.NaN())}, data.Float(0.5), data.Null{}},
+			// the result is exact for small inputs and doesn't
+			// require sorted input
+			{unsorted, data.Float(0.5), data.Float(3.5)},
+			{unsorted, data.Float(0.9), data.Float(7.6)},
+			{unsorted, data.Int(0), data.Float(1)},
+			{unsorted, data.Int(1), data.Float(10)},
+			{unsorted, data.Array{data.Float(0.25), data.Float(0.5)},
+				data.Array{data.Float(2), data.Float(3.5)}},
+			{unsorted, data.Null{}, data.Null{}},
+			// fail cases
+			{unsorted, data.Float(1.5), nil},
+			{data.Array{data.Int(1), data.String("a")}, data.Float(0.5), nil},
+			{data.Int(1), data.Float(0.5), nil},
+		}},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range testCase.inputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %s (%T) and %s (%T)",
+					tc.input1, tc.input1, tc.input2, tc.input2), func() {
+					val, err := testCase.f.Call(nil, tc.input1, tc.input2)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							if val.Type() == data.TypeFloat && tc.expected.Type() == data.TypeFloat {
+								So(val, ShouldAlmostEqual, tc.expected, 0.0000001)
+							} else {
+								So(val, ShouldResemble, tc.expected)
+							}
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, testCase.f)
+			})
+		})
+	}
+
+	Convey("Given the approx_percentile function", t, func() {
+		Convey("When evaluating it on many values", func() {
+			// 0, ..., 99999 in a scrambled order
+			n := 100000
+			arr := make(data.Array, n)
+			for i := range arr {
+				arr[i] = data.Int((i * 7919) % n)
+			}
+			fractions := data.Array{data.Float(0.01), data.Float(0.5), data.Float(0.99), data.Float(0.999)}
+			val, err := approxPercentileFunc.Call(nil, arr, fractions)
+
+			Convey("Then the results should be close to the exact percentiles", func() {
+				So(err, ShouldBeNil)
+				res, err := data.AsArray(val)
+				So(err, ShouldBeNil)
+				So(len(res), ShouldEqual, len(fractions))
+				for i, frac := range fractions {
+					f, _ := data.AsFloat(frac)
+					actual, err := data.AsFloat(res[i])
+					So(err, ShouldBeNil)
+					// the error is much smaller near the tails
+					tolerance := 0.001 * float64(n)
+					if f < 0.05 || f > 0.95 {
+						tolerance = 0.0002 * float64(n)
+					}
+					So(actual, ShouldAlmostEqual, f*float64(n-1), tolerance)
+				}
+			})
+		})
+
+		Convey("When evaluating it on the same value many times", func() {
+			arr := make(data.Array, 10000)
+			for i := range arr {
+				arr[i] = data.Float(2.5)
+			}
+			val, err := approxPercentileFunc.Call(nil, arr, data.Float(0.99))
+
+			Convey("Then the result should be the value", func() {
+				So(err, ShouldBeNil)
+				So(val, ShouldAlmostEqual, data.Float(2.5), 0.0000001)
+			})
+		})
+	})
+}
+
+func TestTDigest(t *testing.T) {
+	Convey("Given a t-digest", t, func() {
+		td := newTDigest(100)
+
+		Convey("When adding many values", func() {
+			for i := 0; i < 100000; i++ {
+				td.add(float64((i * 7919) % 100000))
+			}
+
+			Convey("Then the number of centroids should be bounded", func() {
+				td.compress()
+				So(len(td.centroids), ShouldBeLessThanOrEqualTo, 101)
+				w := 0.0
+				for _, c := range td.centroids {
+					w += c.weight
+				}
+				So(w, ShouldEqual, 100000)
+			})
+
+			Convey("Then the minimum and maximum should be exact", func() {
+				So(td.quantile(0), ShouldEqual, 0)
+				So(td.quantile(1), ShouldEqual, 99999)
+			})
+		})
+	})
+}
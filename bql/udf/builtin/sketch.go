@@ -0,0 +1,98 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"io/ioutil"
+)
+
+// sketchFormatVersion is the version of the format in which sketch
+// states are saved. Saved data having a different version cannot be
+// loaded.
+const sketchFormatVersion = 1
+
+// sketchFieldParam returns the 'field' parameter of a sketch state and
+// the compiled path. Tuples written to the state are summarized by the
+// value at the path.
+func sketchFieldParam(params data.Map) (string, data.Path, error) {
+	v, ok := params["field"]
+	if !ok {
+		return "", nil, fmt.Errorf("cannot find 'field' parameter")
+	}
+	field, err := data.AsString(v)
+	if err != nil {
+		return "", nil, fmt.Errorf("'field' parameter must be a string: %v", err)
+	}
+	path, err := data.CompilePath(field)
+	if err != nil {
+		return "", nil, fmt.Errorf("'field' parameter has an invalid path: %v", err)
+	}
+	return field, path, nil
+}
+
+// sketchIntParam returns the integer parameter having the name. When
+// the parameter isn't given, def is returned.
+func sketchIntParam(params data.Map, name string, def, min, max int64) (int64, error) {
+	v, ok := params[name]
+	if !ok {
+		return def, nil
+	}
+	i, err := data.AsInt(v)
+	if err != nil {
+		return 0, fmt.Errorf("'%v' parameter must be an integer: %v", name, err)
+	}
+	if i < min || i > max {
+		return 0, fmt.Errorf("'%v' parameter must be between %v and %v", name, min, max)
+	}
+	return i, nil
+}
+
+// saveSketch writes a sketch state as a msgpack-encoded map. The map
+// must contain the 'field' parameter so that the state can be loaded
+// without any parameter.
+func saveSketch(w io.Writer, m data.Map) error {
+	m["version"] = data.Int(sketchFormatVersion)
+	b, err := data.MarshalMsgpack(m)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// loadSketch reads a sketch state written by saveSketch.
+func loadSketch(r io.Reader) (data.Map, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	m, err := data.UnmarshalMsgpack(b)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := m["version"]; !ok {
+		return nil, fmt.Errorf("the saved data doesn't have the version")
+	} else if ver, err := data.AsInt(v); err != nil || ver != sketchFormatVersion {
+		return nil, fmt.Errorf("unsupported format version of the saved data: %v", v)
+	}
+	return m, nil
+}
+
+// lookupSharedState returns the shared state having the given name.
+// The name is also returned as a string for error messages.
+func lookupSharedState(ctx *core.Context, name data.Value) (core.SharedState, string, error) {
+	if ctx == nil {
+		return nil, "", fmt.Errorf("shared states cannot be used without a context")
+	}
+	n, err := data.AsString(name)
+	if err != nil {
+		return nil, "", fmt.Errorf("the state name must be a string: %v", err)
+	}
+	s, err := ctx.SharedStates.Get(n)
+	if err != nil {
+		return nil, "", err
+	}
+	return s, n, nil
+}
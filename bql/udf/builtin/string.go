@@ -13,6 +13,7 @@ import (
 	"io"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 // singleParamStringFunc is a template for functions that
@@ -645,3 +646,218 @@ func decodeJSON(ctx *core.Context, v data.Value) (data.Value, error) {
 		return nil, fmt.Errorf("ill-formed JSON (starting with %c)", first)
 	}
 }
+
+// maxCachedRegexps is the maximum number of compiled regular expressions
+// held by a regexpCache.
+const maxCachedRegexps = 64
+
+// regexpCache holds compiled regular expressions so that patterns, which
+// are constant in most calls, don't have to be compiled on every call.
+type regexpCache struct {
+	m   sync.Mutex
+	res map[string]*regexp.Regexp
+}
+
+func (c *regexpCache) compile(expr string) (*regexp.Regexp, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if re, ok := c.res[expr]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	if c.res == nil || len(c.res) >= maxCachedRegexps {
+		c.res = map[string]*regexp.Regexp{}
+	}
+	c.res[expr] = re
+	return re, nil
+}
+
+// regexpFuncTmpl is a template for functions that apply a regular
+// expression to a string. All parameters must be strings. The last
+// parameter is an optional string of flags: `i` for case-insensitive
+// matching, `c` for case-sensitive matching (the default) and, if
+// allowed, `g` for processing all matches instead of only the first one.
+type regexpFuncTmpl struct {
+	// numParams is the number of parameters excluding the flags,
+	// starting with the string and the pattern
+	numParams  int
+	globalFlag bool
+	cache      regexpCache
+	reFun      func(str string, re *regexp.Regexp, global bool, args []string) (data.Value, error)
+}
+
+func (f *regexpFuncTmpl) Accept(arity int) bool {
+	return arity == f.numParams || arity == f.numParams+1
+}
+
+func (f *regexpFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *regexpFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if !f.Accept(len(args)) {
+		return nil, fmt.Errorf("function takes %d or %d arguments", f.numParams, f.numParams+1)
+	}
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+		s, err := data.AsString(arg)
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", arg)
+		}
+		strs[i] = s
+	}
+
+	expr := strs[1]
+	global := false
+	if len(strs) > f.numParams {
+		caseInsensitive := false
+		for _, flag := range strs[f.numParams] {
+			switch {
+			case flag == 'i':
+				caseInsensitive = true
+			case flag == 'c':
+				caseInsensitive = false
+			case flag == 'g' && f.globalFlag:
+				global = true
+			default:
+				return nil, fmt.Errorf("invalid regular expression flag: %c", flag)
+			}
+		}
+		if caseInsensitive {
+			expr = "(?i)" + expr
+		}
+	}
+	re, err := f.cache.compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return f.reFun(strs[0], re, global, strs[2:f.numParams])
+}
+
+// regexpMatchesFunc returns the substrings captured by the parenthesized
+// subexpressions of the first match of a regular expression as an array.
+// When the regular expression doesn't have any subexpressions, the array
+// only contains the whole match. When there is no match, NULL is returned.
+// With the `g` flag, an array of such arrays is returned for all matches.
+// See also: PostgreSQL's `regexp_matches`
+//
+// It can be used in BQL as `regexp_matches`.
+//
+//  Input: String, String, [String]
+//  Return Type: Array
+var regexpMatchesFunc udf.UDF = &regexpFuncTmpl{
+	numParams:  2,
+	globalFlag: true,
+	reFun: func(str string, re *regexp.Regexp, global bool, args []string) (data.Value, error) {
+		toArray := func(match []string) data.Array {
+			if len(match) > 1 {
+				match = match[1:]
+			}
+			a := make(data.Array, len(match))
+			for i, m := range match {
+				a[i] = data.String(m)
+			}
+			return a
+		}
+		if !global {
+			match := re.FindStringSubmatch(str)
+			if match == nil {
+				return data.Null{}, nil
+			}
+			return toArray(match), nil
+		}
+		matches := re.FindAllStringSubmatch(str, -1)
+		res := make(data.Array, len(matches))
+		for i, match := range matches {
+			res[i] = toArray(match)
+		}
+		return res, nil
+	},
+}
+
+// regexpReplaceFunc replaces the first match of a regular expression with
+// a replacement string, or all matches with the `g` flag. In the
+// replacement, `\n` (for n = 1, ..., 9) refers to the substring captured
+// by the n-th parenthesized subexpression and `\&` to the whole match.
+// See also: PostgreSQL's `regexp_replace`
+//
+// It can be used in BQL as `regexp_replace`.
+//
+//  Input: String, String, String, [String]
+//  Return Type: String
+var regexpReplaceFunc udf.UDF = &regexpFuncTmpl{
+	numParams:  3,
+	globalFlag: true,
+	reFun: func(str string, re *regexp.Regexp, global bool, args []string) (data.Value, error) {
+		template := regexpReplacementTemplate(args[0])
+		if global {
+			return data.String(re.ReplaceAllString(str, template)), nil
+		}
+		loc := re.FindStringSubmatchIndex(str)
+		if loc == nil {
+			return data.String(str), nil
+		}
+		res := str[:loc[0]]
+		res = string(re.ExpandString([]byte(res), template, str, loc))
+		return data.String(res + str[loc[1]:]), nil
+	},
+}
+
+// regexpReplacementTemplate converts a replacement string using `\n`
+// and `\&` into a template for regexp.Regexp.Expand.
+func regexpReplacementTemplate(repl string) string {
+	b := bytes.NewBuffer(nil)
+	escaped := false
+	for _, r := range repl {
+		if escaped {
+			switch {
+			case r >= '1' && r <= '9':
+				fmt.Fprintf(b, "${%c}", r)
+			case r == '&':
+				b.WriteString("${0}")
+			default:
+				b.WriteRune(r)
+			}
+			escaped = false
+			continue
+		}
+		switch r {
+		case '\\':
+			escaped = true
+		case '$':
+			b.WriteString("$$")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if escaped {
+		b.WriteRune('\\')
+	}
+	return b.String()
+}
+
+// regexpSplitToArrayFunc splits a string using a regular expression
+// as the delimiter.
+// See also: PostgreSQL's `regexp_split_to_array`
+//
+// It can be used in BQL as `regexp_split_to_array`.
+//
+//  Input: String, String, [String]
+//  Return Type: Array
+var regexpSplitToArrayFunc udf.UDF = &regexpFuncTmpl{
+	numParams: 2,
+	reFun: func(str string, re *regexp.Regexp, global bool, args []string) (data.Value, error) {
+		parts := re.Split(str, -1)
+		res := make(data.Array, len(parts))
+		for i, p := range parts {
+			res[i] = data.String(p)
+		}
+		return res, nil
+	},
+}
@@ -163,6 +163,21 @@ func TestBinaryStringFuncs(t *testing.T) {
 			{data.String("Thomas"), data.Int(7), data.String("")},
 			{data.String("日本語"), data.Int(2), data.String("語")},
 		}},
+		{"regexp_matches", regexpMatchesFunc, []udfBinaryTestCaseInput{
+			{data.String("foobarbequebaz"), data.String("ba."), data.Array{data.String("bar")}},
+			{data.String("foobarbequebaz"), data.String("(bar)(beque)"), data.Array{data.String("bar"), data.String("beque")}},
+			{data.String("foobarbequebaz"), data.String("(x)?bar"), data.Array{data.String("")}},
+			{data.String("foobarbequebaz"), data.String("qux"), data.Null{}},
+			{data.String("foo"), data.String("("), nil},
+		}},
+		{"regexp_split_to_array", regexpSplitToArrayFunc, []udfBinaryTestCaseInput{
+			{data.String("hello world  again"), data.String(`\s+`),
+				data.Array{data.String("hello"), data.String("world"), data.String("again")}},
+			{data.String("abc"), data.String(""),
+				data.Array{data.String("a"), data.String("b"), data.String("c")}},
+			{data.String("abc"), data.String("x"), data.Array{data.String("abc")}},
+			{data.String("foo"), data.String("("), nil},
+		}},
 		{"ltrim", ltrimFunc, []udfBinaryTestCaseInput{
 			{data.String("zzzytrim"), data.String("xyz"), data.String("trim")},
 			{data.String("zzzytrimz"), data.String("xyz"), data.String("trimz")},
@@ -246,6 +261,31 @@ func Test3aryStringFuncs(t *testing.T) {
 	}
 
 	udf3aryTestCases := []udf3aryTestCase{
+		{"regexp_matches", regexpMatchesFunc, []udf3aryTestCaseInput{
+			{data.String("foobarbequebazilbarfbonk"), data.String("(b[^b]+)(b[^b]+)"), data.String("g"),
+				data.Array{
+					data.Array{data.String("bar"), data.String("beque")},
+					data.Array{data.String("bazil"), data.String("barf")},
+				}},
+			{data.String("FOO"), data.String("o+"), data.String("i"), data.Array{data.String("OO")}},
+			{data.String("FOO"), data.String("o+"), data.String("ic"), data.Null{}},
+			{data.String("FOO"), data.String("o+"), data.String("g"), data.Array{}},
+			{data.String("FOO"), data.String("o+"), data.String("x"), nil},
+		}},
+		{"regexp_replace", regexpReplaceFunc, []udf3aryTestCaseInput{
+			{data.String("foobarbaz"), data.String("b.."), data.String("X"), data.String("fooXbaz")},
+			{data.String("foobarbaz"), data.String("b(..)"), data.String(`X\1Y`), data.String("fooXarYbaz")},
+			{data.String("foobarbaz"), data.String("b.."), data.String(`<\&>`), data.String("foo<bar>baz")},
+			{data.String("foobarbaz"), data.String("b.."), data.String("$1"), data.String("foo$1baz")},
+			{data.String("foobarbaz"), data.String("x"), data.String("X"), data.String("foobarbaz")},
+			{data.String("foo"), data.String("("), data.String("X"), nil},
+		}},
+		{"regexp_split_to_array", regexpSplitToArrayFunc, []udf3aryTestCaseInput{
+			{data.String("aXbxc"), data.String("x"), data.String("i"),
+				data.Array{data.String("a"), data.String("b"), data.String("c")}},
+			// `g` is not a valid flag for regexp_split_to_array
+			{data.String("aXbxc"), data.String("x"), data.String("g"), nil},
+		}},
 		{"overlay", overlayFunc, []udf3aryTestCaseInput{
 			{data.String("Txxxxas"), data.String("hom"), data.Int(1), data.String("Thomxas")},
 			{data.String("Txxxxas"), data.String("hom"), data.Int(0), data.String("homxxas")},
@@ -333,6 +373,11 @@ func Test4aryStringFuncs(t *testing.T) {
 	}
 
 	udf4aryTestCases := []udf4aryTestCase{
+		{"regexp_replace", regexpReplaceFunc, []udf4aryTestCaseInput{
+			{data.String("foobarbaz"), data.String("b(.)."), data.String(`\1`), data.String("g"), data.String("fooaa")},
+			{data.String("fooBARbaz"), data.String("b.."), data.String("X"), data.String("gi"), data.String("fooXX")},
+			{data.String("fooBARbaz"), data.String("b.."), data.String("X"), data.String("q"), nil},
+		}},
 		{"overlay", overlayFunc, []udf4aryTestCaseInput{
 			// these are the same cases as in the ternary case:
 			{data.String("Txxxxas"), data.String("hom"), data.Int(1), data.Int(3), data.String("Thomxas")},
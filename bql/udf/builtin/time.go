@@ -1,10 +1,14 @@
 package builtin
 
 import (
+	"bytes"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -54,3 +58,627 @@ var diffUsFunc udf.UDF = &diffUsFuncTmpl{}
 var clockTimestampFunc = udf.MustConvertGeneric(func() time.Time {
 	return time.Now().In(time.UTC)
 })
+
+// locationCache holds time zones loaded by name because loading a
+// time zone reads the time zone database every time.
+type locationCache struct {
+	m    sync.RWMutex
+	locs map[string]*time.Location
+}
+
+var locations = &locationCache{
+	locs: map[string]*time.Location{},
+}
+
+// load returns the time zone having the given IANA name such as
+// "Asia/Tokyo". "UTC" and "Local" are also accepted.
+func (c *locationCache) load(name string) (*time.Location, error) {
+	c.m.RLock()
+	loc, ok := c.locs[name]
+	c.m.RUnlock()
+	if ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone '%s'", name)
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.locs[name] = loc
+	return loc, nil
+}
+
+// timeArgs converts arguments of date/time functions. strIdx and
+// tsIdx have the indexes of String and Timestamp arguments and the
+// converted values are returned in the same order. The returned bool
+// is false if any of the arguments is NULL.
+func timeArgs(args []data.Value, strIdx []int, tsIdx []int) ([]string, []time.Time, bool, error) {
+	strs := make([]string, len(strIdx))
+	tss := make([]time.Time, len(tsIdx))
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return nil, nil, false, nil
+		}
+	}
+	for i, idx := range strIdx {
+		s, err := data.AsString(args[idx])
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("cannot interpret %s as a string", args[idx])
+		}
+		strs[i] = s
+	}
+	for i, idx := range tsIdx {
+		t, err := data.AsTimestamp(args[idx])
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("cannot interpret %s as a timestamp", args[idx])
+		}
+		tss[i] = t
+	}
+	return strs, tss, true, nil
+}
+
+// timeFieldAliases maps plural and abbreviated names of date/time
+// fields to their canonical names.
+var timeFieldAliases = map[string]string{
+	"microseconds": "microsecond",
+	"us":           "microsecond",
+	"milliseconds": "millisecond",
+	"ms":           "millisecond",
+	"seconds":      "second",
+	"minutes":      "minute",
+	"hours":        "hour",
+	"days":         "day",
+	"weeks":        "week",
+	"months":       "month",
+	"quarters":     "quarter",
+	"years":        "year",
+	"decades":      "decade",
+	"centuries":    "century",
+	"millennia":    "millennium",
+	"millenniums":  "millennium",
+}
+
+func normalizeTimeField(field string) string {
+	f := strings.ToLower(strings.TrimSpace(field))
+	if a, ok := timeFieldAliases[f]; ok {
+		return a
+	}
+	return f
+}
+
+// truncateTime truncates the given time to the precision of the
+// given field in the time's location.
+func truncateTime(field string, t time.Time) (time.Time, error) {
+	y, mon, d := t.Date()
+	h, min, s := t.Clock()
+	ns := t.Nanosecond()
+	loc := t.Location()
+	switch normalizeTimeField(field) {
+	case "microsecond":
+		return time.Date(y, mon, d, h, min, s, ns/1000*1000, loc), nil
+	case "millisecond":
+		return time.Date(y, mon, d, h, min, s, ns/1000000*1000000, loc), nil
+	case "second":
+		return time.Date(y, mon, d, h, min, s, 0, loc), nil
+	case "minute":
+		return time.Date(y, mon, d, h, min, 0, 0, loc), nil
+	case "hour":
+		return time.Date(y, mon, d, h, 0, 0, 0, loc), nil
+	case "day":
+		return time.Date(y, mon, d, 0, 0, 0, 0, loc), nil
+	case "week":
+		// weeks begin on Monday as in ISO 8601
+		return time.Date(y, mon, d-(int(t.Weekday())+6)%7, 0, 0, 0, 0, loc), nil
+	case "month":
+		return time.Date(y, mon, 1, 0, 0, 0, 0, loc), nil
+	case "quarter":
+		return time.Date(y, mon-(mon-1)%3, 1, 0, 0, 0, 0, loc), nil
+	case "year":
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, loc), nil
+	case "decade":
+		return time.Date(y-y%10, time.January, 1, 0, 0, 0, 0, loc), nil
+	case "century":
+		return time.Date((y-1)/100*100+1, time.January, 1, 0, 0, 0, 0, loc), nil
+	case "millennium":
+		return time.Date((y-1)/1000*1000+1, time.January, 1, 0, 0, 0, 0, loc), nil
+	}
+	return time.Time{}, fmt.Errorf("unsupported field for date_trunc: %s", field)
+}
+
+// timePart extracts the given field from the given time in the
+// time's location.
+func timePart(field string, t time.Time) (data.Value, error) {
+	switch normalizeTimeField(field) {
+	case "microsecond":
+		return data.Int(t.Second()*1000000 + t.Nanosecond()/1000), nil
+	case "millisecond":
+		return data.Float(float64(t.Second())*1000 + float64(t.Nanosecond())/1e6), nil
+	case "second":
+		return data.Float(float64(t.Second()) + float64(t.Nanosecond())/1e9), nil
+	case "minute":
+		return data.Int(t.Minute()), nil
+	case "hour":
+		return data.Int(t.Hour()), nil
+	case "day":
+		return data.Int(t.Day()), nil
+	case "dow":
+		// Sunday is 0
+		return data.Int(t.Weekday()), nil
+	case "isodow":
+		// Monday is 1 and Sunday is 7
+		return data.Int((int(t.Weekday())+6)%7 + 1), nil
+	case "doy":
+		return data.Int(t.YearDay()), nil
+	case "week":
+		_, w := t.ISOWeek()
+		return data.Int(w), nil
+	case "isoyear":
+		y, _ := t.ISOWeek()
+		return data.Int(y), nil
+	case "month":
+		return data.Int(t.Month()), nil
+	case "quarter":
+		return data.Int((t.Month()-1)/3 + 1), nil
+	case "year":
+		return data.Int(t.Year()), nil
+	case "decade":
+		return data.Int(t.Year() / 10), nil
+	case "century":
+		return data.Int((t.Year()-1)/100 + 1), nil
+	case "millennium":
+		return data.Int((t.Year()-1)/1000 + 1), nil
+	case "epoch":
+		return data.Float(float64(t.Unix()) + float64(t.Nanosecond())/1e9), nil
+	case "timezone":
+		// the offset from UTC in seconds
+		_, offset := t.Zone()
+		return data.Int(offset), nil
+	}
+	return nil, fmt.Errorf("unsupported field for date_part: %s", field)
+}
+
+// dateTruncFunc truncates a timestamp to the precision of the given
+// field, e.g. 'hour' or 'day'. Supported fields are microsecond,
+// millisecond, second, minute, hour, day, week (starting on Monday),
+// month, quarter, year, decade, century and millennium. Fields are
+// computed in the time zone of the timestamp unless the name of a
+// time zone is given as the third argument.
+//
+// It can be used in BQL as `date_trunc`.
+//
+//  Input: String, Timestamp[, String]
+//  Return Type: Timestamp
+var dateTruncFunc udf.UDF = &arityDispatcher{
+	binary: udf.BinaryFunc(func(ctx *core.Context, field, ts data.Value) (data.Value, error) {
+		return dateTrunc(field, ts, nil)
+	}),
+	ternary: udf.TernaryFunc(func(ctx *core.Context, field, ts, zone data.Value) (data.Value, error) {
+		return dateTrunc(field, ts, zone)
+	}),
+}
+
+func dateTrunc(field, ts, zone data.Value) (data.Value, error) {
+	args, strIdx := []data.Value{field, ts}, []int{0}
+	if zone != nil {
+		args, strIdx = append(args, zone), append(strIdx, 2)
+	}
+	strs, tss, ok, err := timeArgs(args, strIdx, []int{1})
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	t := tss[0]
+	if zone != nil {
+		loc, err := locations.load(strs[1])
+		if err != nil {
+			return nil, err
+		}
+		t = t.In(loc)
+	}
+	res, err := truncateTime(strs[0], t)
+	if err != nil {
+		return nil, err
+	}
+	return data.Timestamp(res), nil
+}
+
+// datePartFunc extracts a field from a timestamp. Supported fields
+// are the ones supported by date_trunc as well as dow (the day of
+// the week with Sunday as 0), isodow (with Monday as 1 and Sunday as
+// 7), doy (the day of the year), isoyear, epoch (seconds since
+// 1970-01-01 00:00:00 UTC) and timezone (the offset from UTC in
+// seconds). week returns the ISO 8601 week number. second,
+// millisecond and epoch include fractional parts and their results
+// are Float, while other fields result in Int. Fields are computed in
+// the time zone of the timestamp.
+//
+// It can be used in BQL as `date_part` or `extract`.
+//
+//  Input: String, Timestamp
+//  Return Type: Int or Float
+var datePartFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, field, ts data.Value) (data.Value, error) {
+	strs, tss, ok, err := timeArgs([]data.Value{field, ts}, []int{0}, []int{1})
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	return timePart(strs[0], tss[0])
+})
+
+// timezoneFunc converts a timestamp to the time zone having the given
+// IANA name such as "Asia/Tokyo". The converted timestamp represents
+// the same instant but date/time functions use the local time in the
+// time zone. This is the same as `ts AT TIME ZONE zone`.
+//
+// It can be used in BQL as `timezone`.
+//
+//  Input: String, Timestamp
+//  Return Type: Timestamp
+var timezoneFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, zone, ts data.Value) (data.Value, error) {
+	strs, tss, ok, err := timeArgs([]data.Value{zone, ts}, []int{0}, []int{1})
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	loc, err := locations.load(strs[0])
+	if err != nil {
+		return nil, err
+	}
+	return data.Timestamp(tss[0].In(loc)), nil
+})
+
+// timestampAddFunc adds the given amount of the given unit to a
+// timestamp. Supported units are the ones supported by date_trunc.
+// The amount can be a Float for units up to week, but must be an
+// Int for larger units. Adding months keeps the day of the month
+// when possible, so that adding a month to January 31 results in
+// March 2 or 3.
+//
+// It can be used in BQL as `timestamp_add`.
+//
+//  Input: Timestamp, Int or Float, String
+//  Return Type: Timestamp
+var timestampAddFunc udf.UDF = udf.TernaryFunc(func(ctx *core.Context, ts, amount, unit data.Value) (data.Value, error) {
+	strs, tss, ok, err := timeArgs([]data.Value{ts, amount, unit}, []int{2}, []int{0})
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	t := tss[0]
+	var d time.Duration
+	months := 0
+	switch normalizeTimeField(strs[0]) {
+	case "microsecond":
+		d = time.Microsecond
+	case "millisecond":
+		d = time.Millisecond
+	case "second":
+		d = time.Second
+	case "minute":
+		d = time.Minute
+	case "hour":
+		d = time.Hour
+	case "day":
+		d = 24 * time.Hour
+	case "week":
+		d = 7 * 24 * time.Hour
+	case "month":
+		months = 1
+	case "quarter":
+		months = 3
+	case "year":
+		months = 12
+	case "decade":
+		months = 120
+	case "century":
+		months = 1200
+	case "millennium":
+		months = 12000
+	default:
+		return nil, fmt.Errorf("unsupported unit for timestamp_add: %s", strs[0])
+	}
+
+	if months > 0 {
+		n, err := data.AsInt(amount)
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as an integer", amount)
+		}
+		return data.Timestamp(t.AddDate(0, int(n)*months, 0)), nil
+	}
+	switch amount.Type() {
+	case data.TypeInt:
+		n, _ := data.AsInt(amount)
+		return data.Timestamp(t.Add(time.Duration(n) * d)), nil
+	case data.TypeFloat:
+		f, _ := data.AsFloat(amount)
+		return data.Timestamp(t.Add(time.Duration(f * float64(d)))), nil
+	}
+	return nil, fmt.Errorf("cannot interpret %s as a number", amount)
+})
+
+// makeTimestampFuncTmpl creates a timestamp from its fields.
+type makeTimestampFuncTmpl struct {
+}
+
+func (f *makeTimestampFuncTmpl) Accept(arity int) bool {
+	return arity == 6 || arity == 7
+}
+
+func (f *makeTimestampFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *makeTimestampFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if !f.Accept(len(args)) {
+		return nil, fmt.Errorf("function takes six or seven arguments")
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	var fields [5]int
+	for i := range fields {
+		v, err := data.AsInt(args[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as an integer", args[i])
+		}
+		fields[i] = int(v)
+	}
+	var sec float64
+	switch args[5].Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(args[5])
+		sec = float64(i)
+	case data.TypeFloat:
+		sec, _ = data.AsFloat(args[5])
+	default:
+		return nil, fmt.Errorf("cannot interpret %s as a number", args[5])
+	}
+	loc := time.UTC
+	if len(args) == 7 {
+		zone, err := data.AsString(args[6])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", args[6])
+		}
+		if loc, err = locations.load(zone); err != nil {
+			return nil, err
+		}
+	}
+
+	year, month, day, hour, min := fields[0], fields[1], fields[2], fields[3], fields[4]
+	if month < 1 || month > 12 {
+		return nil, fmt.Errorf("month out of range: %d", month)
+	}
+	// the day after the last day of the month is the first day of the
+	// next month
+	if day < 1 || day > time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return nil, fmt.Errorf("day out of range: %d", day)
+	}
+	if hour < 0 || hour > 23 {
+		return nil, fmt.Errorf("hour out of range: %d", hour)
+	}
+	if min < 0 || min > 59 {
+		return nil, fmt.Errorf("minute out of range: %d", min)
+	}
+	if sec < 0 || sec >= 60 {
+		return nil, fmt.Errorf("second out of range: %v", sec)
+	}
+	intSec := int(sec)
+	nsec := int((sec - float64(intSec)) * 1e9)
+	return data.Timestamp(time.Date(year, time.Month(month), day, hour, min, intSec, nsec, loc)), nil
+}
+
+// makeTimestampFunc creates a timestamp from year, month, day, hour,
+// minute and second, which can have a fractional part. The fields are
+// interpreted in UTC unless the name of a time zone is given as the
+// seventh argument.
+//
+// It can be used in BQL as `make_timestamp`.
+//
+//  Input: 5 * Int, Int or Float[, String]
+//  Return Type: Timestamp
+var makeTimestampFunc udf.UDF = &makeTimestampFuncTmpl{}
+
+// toCharFunc formats a timestamp according to the given template.
+// See timeTemplate for the template patterns. The timestamp is
+// formatted in its time zone, so use AT TIME ZONE to render it as
+// a local time.
+//
+// It can be used in BQL as `to_char`.
+//
+//  Input: Timestamp, String
+//  Return Type: String
+var toCharFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, ts, tmpl data.Value) (data.Value, error) {
+	strs, tss, ok, err := timeArgs([]data.Value{ts, tmpl}, []int{1}, []int{0})
+	if err != nil || !ok {
+		return data.Null{}, err
+	}
+	tokens, err := parseTimeTemplate(strs[0])
+	if err != nil {
+		return nil, err
+	}
+	b := bytes.NewBuffer(nil)
+	for _, tok := range tokens {
+		b.WriteString(tok.format(tss[0]))
+	}
+	return data.String(b.String()), nil
+})
+
+// toTimestampFunc parses a string according to the given template.
+// See timeTemplate for the template patterns. The time is interpreted
+// in UTC unless the template has a time zone pattern. When only one
+// numeric argument is given, it is interpreted as seconds since
+// 1970-01-01 00:00:00 UTC.
+//
+// It can be used in BQL as `to_timestamp`.
+//
+//  Input: String, String or Int or Float
+//  Return Type: Timestamp
+var toTimestampFunc udf.UDF = &arityDispatcher{
+	unary: udf.UnaryFunc(func(ctx *core.Context, epoch data.Value) (data.Value, error) {
+		switch epoch.Type() {
+		case data.TypeNull:
+			return data.Null{}, nil
+		case data.TypeInt:
+			i, _ := data.AsInt(epoch)
+			return data.Timestamp(time.Unix(i, 0).In(time.UTC)), nil
+		case data.TypeFloat:
+			f, _ := data.AsFloat(epoch)
+			sec := math.Floor(f)
+			return data.Timestamp(time.Unix(int64(sec), int64((f-sec)*1e9)).In(time.UTC)), nil
+		}
+		return nil, fmt.Errorf("cannot interpret %s as a number", epoch)
+	}),
+	binary: udf.BinaryFunc(func(ctx *core.Context, str, tmpl data.Value) (data.Value, error) {
+		strs, _, ok, err := timeArgs([]data.Value{str, tmpl}, []int{0, 1}, nil)
+		if err != nil || !ok {
+			return data.Null{}, err
+		}
+		tokens, err := parseTimeTemplate(strs[1])
+		if err != nil {
+			return nil, err
+		}
+		b := bytes.NewBuffer(nil)
+		for _, tok := range tokens {
+			if tok.timeTemplate == nil {
+				b.WriteString(tok.literal)
+				continue
+			}
+			if tok.fraction && !strings.HasSuffix(b.String(), ".") {
+				return nil, fmt.Errorf("MS and US must follow a period in to_timestamp")
+			}
+			b.WriteString(tok.layout)
+		}
+		t, err := time.Parse(b.String(), strs[0])
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse '%s' with template '%s': %v", strs[0], strs[1], err)
+		}
+		return data.Timestamp(t), nil
+	}),
+}
+
+// timeTemplate is a pattern in templates of to_char and to_timestamp.
+// As in PostgreSQL, the following patterns are supported:
+//
+//  YYYY: year (4 digits)         YY: year (2 digits)
+//  MM: month (01-12)             DD: day of month (01-31)
+//  DDD: day of year (001-366)    HH24: hour of day (00-23)
+//  HH12, HH: hour (01-12)        MI: minute (00-59)
+//  SS: second (00-59)            MS: millisecond (000-999)
+//  US: microsecond (000000-999999)
+//  AM, PM, am, pm: meridiem indicator
+//  MONTH, Month, month: full month name
+//  MON, Mon, mon: abbreviated month name
+//  DAY, Day, day: full day name
+//  DY, Dy, dy: abbreviated day name
+//  TZ: time zone abbreviation    OF: time zone offset (e.g. +09:00)
+//
+// Text in double quotes is copied as it is and other characters are
+// copied as well. to_timestamp ignores day names and requires a
+// period before MS and US. Since it's implemented with time.Parse,
+// literal text must not contain elements of layouts in the time
+// package such as "Jan" or "2".
+type timeTemplate struct {
+	pattern string
+	// layout is the layout of the time package for this pattern
+	layout string
+	// fraction is true if layout is the fractional part of seconds
+	// which needs a period before it
+	fraction bool
+	upper    bool
+	lower    bool
+}
+
+// timeTemplates is ordered so that longer patterns are matched first.
+var timeTemplates = []timeTemplate{
+	{pattern: "YYYY", layout: "2006"},
+	{pattern: "HH24", layout: "15"},
+	{pattern: "HH12", layout: "03"},
+	{pattern: "MONTH", layout: "January", upper: true},
+	{pattern: "Month", layout: "January"},
+	{pattern: "month", layout: "January", lower: true},
+	{pattern: "DDD", layout: "002"},
+	{pattern: "MON", layout: "Jan", upper: true},
+	{pattern: "Mon", layout: "Jan"},
+	{pattern: "mon", layout: "Jan", lower: true},
+	{pattern: "DAY", layout: "Monday", upper: true},
+	{pattern: "Day", layout: "Monday"},
+	{pattern: "day", layout: "Monday", lower: true},
+	{pattern: "YY", layout: "06"},
+	{pattern: "MM", layout: "01"},
+	{pattern: "DD", layout: "02"},
+	{pattern: "DY", layout: "Mon", upper: true},
+	{pattern: "Dy", layout: "Mon"},
+	{pattern: "dy", layout: "Mon", lower: true},
+	{pattern: "HH", layout: "03"},
+	{pattern: "MI", layout: "04"},
+	{pattern: "SS", layout: "05"},
+	{pattern: "MS", layout: "000", fraction: true},
+	{pattern: "US", layout: "000000", fraction: true},
+	{pattern: "AM", layout: "PM"},
+	{pattern: "PM", layout: "PM"},
+	{pattern: "am", layout: "pm"},
+	{pattern: "pm", layout: "pm"},
+	{pattern: "TZ", layout: "MST"},
+	{pattern: "OF", layout: "-07:00"},
+}
+
+// timeTemplateToken is either a pattern or literal text in a template.
+type timeTemplateToken struct {
+	*timeTemplate
+	literal string
+}
+
+func (t timeTemplateToken) format(ts time.Time) string {
+	if t.timeTemplate == nil {
+		return t.literal
+	}
+	if t.fraction {
+		// the time package only formats fractional seconds after
+		// a period
+		return ts.Format("." + t.layout)[1:]
+	}
+	s := ts.Format(t.layout)
+	if t.upper {
+		return strings.ToUpper(s)
+	} else if t.lower {
+		return strings.ToLower(s)
+	}
+	return s
+}
+
+func parseTimeTemplate(tmpl string) ([]timeTemplateToken, error) {
+	var tokens []timeTemplateToken
+	literal := bytes.NewBuffer(nil)
+	flush := func() {
+		if literal.Len() > 0 {
+			tokens = append(tokens, timeTemplateToken{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	rest := tmpl
+loop:
+	for len(rest) > 0 {
+		if rest[0] == '"' {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quoted text in template '%s'", tmpl)
+			}
+			literal.WriteString(rest[1 : end+1])
+			rest = rest[end+2:]
+			continue
+		}
+		for i := range timeTemplates {
+			t := &timeTemplates[i]
+			if strings.HasPrefix(rest, t.pattern) {
+				flush()
+				tokens = append(tokens, timeTemplateToken{timeTemplate: t})
+				rest = rest[len(t.pattern):]
+				continue loop
+			}
+		}
+		literal.WriteByte(rest[0])
+		rest = rest[1:]
+	}
+	flush()
+	return tokens, nil
+}
@@ -123,3 +123,184 @@ func TestBinaryDateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestDateTimeFuncs(t *testing.T) {
+	// Friday
+	ts := time.Date(2015, time.May, 1, 14, 27, 3, 123456789, time.UTC)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		inputs   []data.Value
+		expected data.Value
+	}{
+		// date_trunc
+		{"date_trunc", []data.Value{data.String("microsecond"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 123456000, time.UTC))},
+		{"date_trunc", []data.Value{data.String("milliseconds"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 123000000, time.UTC))},
+		{"date_trunc", []data.Value{data.String("second"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("minute"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("HOUR"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("day"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("week"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.April, 27, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("month"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.May, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("quarter"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.April, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("year"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("decade"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("century"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("millennium"), data.Timestamp(ts)},
+			data.Timestamp(time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC))},
+		{"date_trunc", []data.Value{data.String("day"), data.Timestamp(ts), data.String("Asia/Tokyo")},
+			data.Timestamp(time.Date(2015, time.May, 1, 0, 0, 0, 0, tokyo))},
+		{"date_trunc", []data.Value{data.String("day"), data.Timestamp(ts.In(tokyo))},
+			data.Timestamp(time.Date(2015, time.May, 1, 0, 0, 0, 0, tokyo))},
+		{"date_trunc", []data.Value{data.String("fortnight"), data.Timestamp(ts)}, nil},
+		{"date_trunc", []data.Value{data.String("day"), data.Timestamp(ts), data.String("Mars/Olympus")}, nil},
+		{"date_trunc", []data.Value{data.String("day"), data.String("2015-05-01")}, nil},
+		{"date_trunc", []data.Value{data.Null{}, data.Timestamp(ts)}, data.Null{}},
+		{"date_trunc", []data.Value{data.String("day"), data.Null{}}, data.Null{}},
+		// date_part
+		{"date_part", []data.Value{data.String("microseconds"), data.Timestamp(ts)}, data.Int(3123456)},
+		{"date_part", []data.Value{data.String("millisecond"), data.Timestamp(ts)}, data.Float(3123.456789)},
+		{"date_part", []data.Value{data.String("second"), data.Timestamp(ts)}, data.Float(3.123456789)},
+		{"date_part", []data.Value{data.String("minute"), data.Timestamp(ts)}, data.Int(27)},
+		{"date_part", []data.Value{data.String("hour"), data.Timestamp(ts)}, data.Int(14)},
+		{"date_part", []data.Value{data.String("hour"), data.Timestamp(ts.In(tokyo))}, data.Int(23)},
+		{"date_part", []data.Value{data.String("day"), data.Timestamp(ts)}, data.Int(1)},
+		{"date_part", []data.Value{data.String("dow"), data.Timestamp(ts)}, data.Int(5)},
+		{"date_part", []data.Value{data.String("dow"), data.Timestamp(ts.AddDate(0, 0, 2))}, data.Int(0)},
+		{"date_part", []data.Value{data.String("isodow"), data.Timestamp(ts.AddDate(0, 0, 2))}, data.Int(7)},
+		{"date_part", []data.Value{data.String("doy"), data.Timestamp(ts)}, data.Int(121)},
+		{"date_part", []data.Value{data.String("week"), data.Timestamp(ts)}, data.Int(18)},
+		{"date_part", []data.Value{data.String("isoyear"), data.Timestamp(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))}, data.Int(2015)},
+		{"date_part", []data.Value{data.String("month"), data.Timestamp(ts)}, data.Int(5)},
+		{"date_part", []data.Value{data.String("quarter"), data.Timestamp(ts)}, data.Int(2)},
+		{"date_part", []data.Value{data.String("year"), data.Timestamp(ts)}, data.Int(2015)},
+		{"date_part", []data.Value{data.String("decade"), data.Timestamp(ts)}, data.Int(201)},
+		{"date_part", []data.Value{data.String("century"), data.Timestamp(ts)}, data.Int(21)},
+		{"date_part", []data.Value{data.String("century"), data.Timestamp(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))}, data.Int(20)},
+		{"date_part", []data.Value{data.String("millennium"), data.Timestamp(ts)}, data.Int(3)},
+		{"date_part", []data.Value{data.String("epoch"), data.Timestamp(ts)}, data.Float(1430490423.123456789)},
+		{"date_part", []data.Value{data.String("timezone"), data.Timestamp(ts.In(tokyo))}, data.Int(9 * 3600)},
+		{"date_part", []data.Value{data.String("fortnight"), data.Timestamp(ts)}, nil},
+		{"date_part", []data.Value{data.Int(1), data.Timestamp(ts)}, nil},
+		{"date_part", []data.Value{data.String("hour"), data.Null{}}, data.Null{}},
+		{"extract", []data.Value{data.String("hour"), data.Timestamp(ts)}, data.Int(14)},
+		// timezone
+		{"timezone", []data.Value{data.String("Asia/Tokyo"), data.Timestamp(ts)}, data.Timestamp(ts.In(tokyo))},
+		{"timezone", []data.Value{data.String("Mars/Olympus"), data.Timestamp(ts)}, nil},
+		{"timezone", []data.Value{data.Null{}, data.Timestamp(ts)}, data.Null{}},
+		// timestamp_add
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.Int(-3), data.String("seconds")},
+			data.Timestamp(ts.Add(-3 * time.Second))},
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.Float(1.5), data.String("hour")},
+			data.Timestamp(ts.Add(90 * time.Minute))},
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.Int(2), data.String("week")},
+			data.Timestamp(ts.AddDate(0, 0, 14))},
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.Int(1), data.String("quarter")},
+			data.Timestamp(ts.AddDate(0, 3, 0))},
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.Int(1), data.String("century")},
+			data.Timestamp(ts.AddDate(100, 0, 0))},
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.Float(1.5), data.String("month")}, nil},
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.String("1"), data.String("day")}, nil},
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.Int(1), data.String("fortnight")}, nil},
+		{"timestamp_add", []data.Value{data.Timestamp(ts), data.Null{}, data.String("day")}, data.Null{}},
+		// make_timestamp
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(5), data.Int(1), data.Int(14), data.Int(27), data.Float(3.5)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 500000000, time.UTC))},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(5), data.Int(1), data.Int(23), data.Int(27), data.Int(3), data.String("Asia/Tokyo")},
+			data.Timestamp(time.Date(2015, time.May, 1, 23, 27, 3, 0, tokyo))},
+		{"make_timestamp", []data.Value{data.Int(2016), data.Int(2), data.Int(29), data.Int(0), data.Int(0), data.Int(0)},
+			data.Timestamp(time.Date(2016, time.February, 29, 0, 0, 0, 0, time.UTC))},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(2), data.Int(29), data.Int(0), data.Int(0), data.Int(0)}, nil},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(13), data.Int(1), data.Int(0), data.Int(0), data.Int(0)}, nil},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(1), data.Int(1), data.Int(24), data.Int(0), data.Int(0)}, nil},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(1), data.Int(1), data.Int(0), data.Int(60), data.Int(0)}, nil},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(1), data.Int(1), data.Int(0), data.Int(0), data.Float(60)}, nil},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(1), data.Int(1), data.Int(0), data.Int(0), data.String("0")}, nil},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Int(1), data.Int(1), data.Int(0), data.Int(0), data.Int(0), data.String("Mars/Olympus")}, nil},
+		{"make_timestamp", []data.Value{data.Int(2015), data.Null{}, data.Int(1), data.Int(0), data.Int(0), data.Int(0)}, data.Null{}},
+		// to_char
+		{"to_char", []data.Value{data.Timestamp(ts), data.String("YYYY-MM-DD HH24:MI:SS.MS")},
+			data.String("2015-05-01 14:27:03.123")},
+		{"to_char", []data.Value{data.Timestamp(ts), data.String("YY/DDD HH12:MI:SSUS PM")},
+			data.String("15/121 02:27:03123456 PM")},
+		{"to_char", []data.Value{data.Timestamp(ts), data.String("Day, DD Month YYYY")},
+			data.String("Friday, 01 May 2015")},
+		{"to_char", []data.Value{data.Timestamp(ts), data.String("DY MON dy mon am")},
+			data.String("FRI MAY fri may pm")},
+		{"to_char", []data.Value{data.Timestamp(ts.In(tokyo)), data.String(`HH24:MI TZ OF "DD" "" 1`)},
+			data.String("23:27 JST +09:00 DD  1")},
+		{"to_char", []data.Value{data.Timestamp(ts), data.String(`"YYYY`)}, nil},
+		{"to_char", []data.Value{data.String("2015"), data.String("YYYY")}, nil},
+		{"to_char", []data.Value{data.Timestamp(ts), data.Null{}}, data.Null{}},
+		// to_timestamp
+		{"to_timestamp", []data.Value{data.String("2015-05-01 14:27:03.123"), data.String("YYYY-MM-DD HH24:MI:SS.MS")},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 123000000, time.UTC))},
+		{"to_timestamp", []data.Value{data.String("01 MAY 15 02:27 pm +09:00"), data.String("DD MON YY HH12:MI am OF")},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 0, 0, time.FixedZone("", 9*3600)))},
+		{"to_timestamp", []data.Value{data.String("20150501T142703"), data.String(`YYYYMMDD"T"HH24MISS`)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 0, time.UTC))},
+		{"to_timestamp", []data.Value{data.String("2015-05-01"), data.String("YYYY-MM-DD HH24")}, nil},
+		{"to_timestamp", []data.Value{data.String("03123"), data.String("SSMS")}, nil},
+		{"to_timestamp", []data.Value{data.Null{}, data.String("YYYY")}, data.Null{}},
+		{"to_timestamp", []data.Value{data.Int(1430490423)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 0, time.UTC))},
+		{"to_timestamp", []data.Value{data.Float(1430490423.5)},
+			data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 500000000, time.UTC))},
+		{"to_timestamp", []data.Value{data.String("1430490423")}, nil},
+		{"to_timestamp", []data.Value{data.Null{}}, data.Null{}},
+	}
+
+	reg := udf.CopyGlobalUDFRegistry(nil)
+	for _, tc := range testCases {
+		tc := tc
+
+		Convey(fmt.Sprintf("Given the %s function", tc.name), t, func() {
+			f, err := reg.Lookup(tc.name, len(tc.inputs))
+			So(err, ShouldBeNil)
+
+			Convey(fmt.Sprintf("When evaluating it on %v", tc.inputs), func() {
+				val, err := f.Call(nil, tc.inputs...)
+
+				if tc.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+						So(err, ShouldBeNil)
+						switch tc.expected.Type() {
+						case data.TypeTimestamp:
+							// compare instants and time zones separately because
+							// loaded time zones have internal caches
+							So(val.Type(), ShouldEqual, data.TypeTimestamp)
+							actual, _ := data.AsTimestamp(val)
+							expected, _ := data.AsTimestamp(tc.expected)
+							So(actual.Equal(expected), ShouldBeTrue)
+							So(actual.Location().String(), ShouldEqual, expected.Location().String())
+						case data.TypeFloat:
+							So(val, ShouldAlmostEqual, tc.expected, 0.0000001)
+						default:
+							So(val, ShouldResemble, tc.expected)
+						}
+					})
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,418 @@
+package builtin
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"sort"
+	"sync"
+)
+
+const (
+	spaceSavingDefaultCapacity = 1000
+	spaceSavingMaxCapacity     = 1000000
+)
+
+// topKCounter is a counter of the Space-Saving algorithm. count is an
+// upper bound of the actual number of occurrences of value and maxError
+// is the maximum overestimation.
+type topKCounter struct {
+	value    data.Value
+	count    int64
+	maxError int64
+
+	// index is the position of the counter in the heap
+	index int
+}
+
+// topKHeap is a min-heap of counters ordered by their counts.
+type topKHeap []*topKCounter
+
+func (h topKHeap) Len() int {
+	return len(h)
+}
+
+func (h topKHeap) Less(i, j int) bool {
+	return h[i].count < h[j].count
+}
+
+func (h topKHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *topKHeap) Push(x interface{}) {
+	c := x.(*topKCounter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *topKHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	c := old[n-1]
+	*h = old[:n-1]
+	return c
+}
+
+// spaceSaving finds the most frequent values with the Space-Saving
+// algorithm by Metwally et al. It monitors at most capacity values.
+// When a value which isn't monitored arrives and all counters are in
+// use, the counter having the smallest count is taken over by the new
+// value and the count is incremented. The counts are exact as long as
+// the number of distinct values doesn't exceed the capacity. Otherwise,
+// every value occurring more than n / capacity times in n values is
+// guaranteed to be monitored.
+type spaceSaving struct {
+	capacity int
+	counters map[data.HashValue][]*topKCounter
+	heap     topKHeap
+}
+
+func newSpaceSaving(capacity int) *spaceSaving {
+	return &spaceSaving{
+		capacity: capacity,
+		counters: map[data.HashValue][]*topKCounter{},
+	}
+}
+
+func (s *spaceSaving) add(v data.Value) {
+	h := data.Hash(v)
+	for _, c := range s.counters[h] {
+		if data.Equal(c.value, v) {
+			c.count++
+			heap.Fix(&s.heap, c.index)
+			return
+		}
+	}
+
+	// values are copied because they're kept beyond the lifetime of tuples
+	v = data.Array{v}.Copy()[0]
+	if len(s.heap) < s.capacity {
+		c := &topKCounter{value: v, count: 1}
+		heap.Push(&s.heap, c)
+		s.counters[h] = append(s.counters[h], c)
+		return
+	}
+	c := s.heap[0]
+	s.removeCounter(c)
+	c.value = v
+	c.maxError = c.count
+	c.count++
+	s.counters[h] = append(s.counters[h], c)
+	heap.Fix(&s.heap, 0)
+}
+
+// removeCounter removes the counter from its hash bucket.
+func (s *spaceSaving) removeCounter(c *topKCounter) {
+	h := data.Hash(c.value)
+	bucket := s.counters[h]
+	for i, bc := range bucket {
+		if bc == c {
+			bucket = append(bucket[:i], bucket[i+1:]...)
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		delete(s.counters, h)
+	} else {
+		s.counters[h] = bucket
+	}
+}
+
+// top returns at most k counters in descending order of their counts.
+// Counters having the same count are ordered by their values.
+func (s *spaceSaving) top(k int) data.Array {
+	cs := make([]*topKCounter, len(s.heap))
+	copy(cs, s.heap)
+	sort.Sort(topKCounterSlice(cs))
+	if len(cs) > k {
+		cs = cs[:k]
+	}
+	res := make(data.Array, len(cs))
+	for i, c := range cs {
+		res[i] = data.Map{
+			"value": c.value,
+			"count": data.Int(c.count),
+			"error": data.Int(c.maxError),
+		}
+	}
+	return res
+}
+
+type topKCounterSlice []*topKCounter
+
+func (s topKCounterSlice) Len() int {
+	return len(s)
+}
+
+func (s topKCounterSlice) Less(i, j int) bool {
+	if s[i].count != s[j].count {
+		return s[i].count > s[j].count
+	}
+	return data.Less(s[i].value, s[j].value)
+}
+
+func (s topKCounterSlice) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// topKParam returns the number of values to be returned.
+func topKParam(v data.Value) (int, error) {
+	k, err := data.AsInt(v)
+	if err != nil {
+		return 0, fmt.Errorf("k must be an integer: %v", err)
+	}
+	if k <= 0 || k > spaceSavingMaxCapacity {
+		return 0, fmt.Errorf("k must be between 1 and %v", spaceSavingMaxCapacity)
+	}
+	return int(k), nil
+}
+
+type approxTopKFuncTmpl struct {
+}
+
+func (f *approxTopKFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *approxTopKFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *approxTopKFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	k, err := topKParam(args[1])
+	if err != nil {
+		return nil, err
+	}
+	capacity := 10 * k
+	if capacity < 100 {
+		capacity = 100
+	}
+	s := newSpaceSaving(capacity)
+	for _, item := range arr {
+		if item.Type() != data.TypeNull {
+			s.add(item)
+		}
+	}
+	if len(s.heap) == 0 {
+		return data.Null{}, nil
+	}
+	return s.top(k), nil
+}
+
+// approxTopKFunc(expr, k) is an aggregate function that returns the k
+// most frequent non-null values with the Space-Saving algorithm, which
+// monitors at most max(10 * k, 100) distinct values. Each element of
+// the result is a map having the value in "value", the estimated
+// number of its occurrences in "count" and the maximum overestimation
+// of the count in "error". The result is sorted by the count in
+// descending order. Counts are exact when there are no more distinct
+// values than the number of monitored values.
+//
+// It can be used in BQL as `approx_top_k`.
+//
+//  Input: anything (aggregated), Int (k)
+//  Return Type: Array of Map (Null on empty input)
+var approxTopKFunc udf.UDF = &approxTopKFuncTmpl{}
+
+// spaceSavingState is a UDS which finds the most frequent values
+// written to it with the Space-Saving algorithm. Since the state isn't
+// bound to a window, it can keep finding heavy hitters over a long
+// period. The value to be counted is taken from the 'field' of each
+// tuple written to the state and null values are ignored.
+//
+// The state can be created in BQL as follows:
+//  CREATE STATE hot_devices TYPE space_saving WITH field = "device_id";
+// An optional 'capacity' parameter specifies the number of monitored
+// values. The default capacity is 1000. The k most frequent values can
+// be obtained by `space_saving_top_k("hot_devices", k)` in the same
+// format as approx_top_k.
+type spaceSavingState struct {
+	m     sync.RWMutex
+	field string
+	path  data.Path
+
+	// ss is nil after the state is terminated.
+	ss *spaceSaving
+}
+
+var (
+	_ core.LoadableSharedState = &spaceSavingState{}
+	_ core.Writer              = &spaceSavingState{}
+)
+
+func (s *spaceSavingState) Write(ctx *core.Context, t *core.Tuple) error {
+	v, err := t.Data.Get(s.path)
+	if err != nil {
+		return fmt.Errorf("a tuple written to the state doesn't have the field: %v", err)
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.ss == nil {
+		return errors.New("the state has already been terminated")
+	}
+	s.ss.add(v)
+	return nil
+}
+
+// top returns the k most frequent values.
+func (s *spaceSavingState) top(k int) (data.Array, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.ss == nil {
+		return nil, errors.New("the state has already been terminated")
+	}
+	// values are copied so that they can't be modified by the caller
+	return s.ss.top(k).Copy(), nil
+}
+
+func (s *spaceSavingState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.ss = nil
+	return nil
+}
+
+func (s *spaceSavingState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.ss == nil {
+		return errors.New("the state has already been terminated")
+	}
+	return saveSketch(w, data.Map{
+		"field":    data.String(s.field),
+		"capacity": data.Int(s.ss.capacity),
+		"counters": s.ss.top(s.ss.capacity),
+	})
+}
+
+func (s *spaceSavingState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	loaded, err := loadSpaceSavingState(r)
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.field = loaded.field
+	s.path = loaded.path
+	s.ss = loaded.ss
+	return nil
+}
+
+func loadSpaceSavingState(r io.Reader) (*spaceSavingState, error) {
+	m, err := loadSketch(r)
+	if err != nil {
+		return nil, err
+	}
+	s, err := createSpaceSavingState(m)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := m["counters"]
+	if !ok {
+		return nil, fmt.Errorf("the saved data doesn't have counters")
+	}
+	counters, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("the saved counters must be an array: %v", err)
+	}
+	if len(counters) > s.ss.capacity {
+		return nil, fmt.Errorf("the number of saved counters exceeds the capacity")
+	}
+	for _, cv := range counters {
+		cm, err := data.AsMap(cv)
+		if err != nil {
+			return nil, fmt.Errorf("a saved counter must be a map: %v", err)
+		}
+		c := &topKCounter{}
+		var ok bool
+		if c.value, ok = cm["value"]; !ok {
+			return nil, fmt.Errorf("a saved counter doesn't have the value")
+		}
+		if c.count, err = data.AsInt(cm["count"]); err != nil {
+			return nil, fmt.Errorf("the count of a saved counter must be an integer: %v", err)
+		}
+		if c.maxError, err = data.AsInt(cm["error"]); err != nil {
+			return nil, fmt.Errorf("the error of a saved counter must be an integer: %v", err)
+		}
+		heap.Push(&s.ss.heap, c)
+		h := data.Hash(c.value)
+		s.ss.counters[h] = append(s.ss.counters[h], c)
+	}
+	return s, nil
+}
+
+func createSpaceSavingState(params data.Map) (*spaceSavingState, error) {
+	field, path, err := sketchFieldParam(params)
+	if err != nil {
+		return nil, err
+	}
+	c, err := sketchIntParam(params, "capacity", spaceSavingDefaultCapacity,
+		1, spaceSavingMaxCapacity)
+	if err != nil {
+		return nil, err
+	}
+	return &spaceSavingState{
+		field: field,
+		path:  path,
+		ss:    newSpaceSaving(int(c)),
+	}, nil
+}
+
+type spaceSavingStateCreator struct {
+}
+
+var (
+	_ udf.UDSLoader = &spaceSavingStateCreator{}
+)
+
+func (c *spaceSavingStateCreator) CreateState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	return createSpaceSavingState(params)
+}
+
+func (c *spaceSavingStateCreator) LoadState(ctx *core.Context, r io.Reader, params data.Map) (core.SharedState, error) {
+	return loadSpaceSavingState(r)
+}
+
+// spaceSavingTopKFunc returns the k most frequent values written to the
+// space_saving state having the given name in the same format as
+// approxTopKFunc. The result is an empty array when no value has been
+// written to the state.
+//
+// It can be used in BQL as `space_saving_top_k`.
+//
+//  Input: String (state name), Int (k)
+//  Return Type: Array of Map
+var spaceSavingTopKFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, kv data.Value) (data.Value, error) {
+	st, n, err := lookupSharedState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*spaceSavingState)
+	if !ok {
+		return nil, fmt.Errorf("the state '%v' is not a space_saving state", n)
+	}
+	k, err := topKParam(kv)
+	if err != nil {
+		return nil, err
+	}
+	return s.top(k)
+})
@@ -0,0 +1,228 @@
+package builtin
+
+import (
+	"bytes"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func topKEntry(v data.Value, count, maxError int64) data.Map {
+	return data.Map{"value": v, "count": data.Int(count), "error": data.Int(maxError)}
+}
+
+func TestApproxTopKFunc(t *testing.T) {
+	Convey("Given the approx_top_k function", t, func() {
+		f := approxTopKFunc
+
+		testCases := []udfBinaryTestCaseInput{
+			{data.Array{}, data.Int(3), data.Null{}},
+			{data.Array{data.Null{}}, data.Int(3), data.Null{}},
+			{data.Array{data.String("a"), data.String("b"), data.Null{}, data.String("a"),
+				data.Int(1), data.Float(1), data.String("a"), data.Int(1)}, data.Int(2),
+				data.Array{topKEntry(data.Int(1), 3, 0), topKEntry(data.String("a"), 3, 0)}},
+			// ties are ordered by values
+			{data.Array{data.String("b"), data.String("a"), data.String("c")}, data.Int(5),
+				data.Array{topKEntry(data.String("a"), 1, 0), topKEntry(data.String("b"), 1, 0),
+					topKEntry(data.String("c"), 1, 0)}},
+			{data.Array{data.Map{"a": data.Int(1)}, data.Map{"a": data.Int(1)}}, data.Int(1),
+				data.Array{topKEntry(data.Map{"a": data.Int(1)}, 2, 0)}},
+			// fail cases
+			{data.Array{data.Int(1)}, data.Int(0), nil},
+			{data.Array{data.Int(1)}, data.Float(1.5), nil},
+			{data.Array{data.Int(1)}, data.Null{}, nil},
+			{data.Int(1), data.Int(1), nil},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When evaluating it on %s and %s", tc.input1, tc.input2), func() {
+				val, err := f.Call(nil, tc.input1, tc.input2)
+
+				if tc.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+						So(err, ShouldBeNil)
+						So(val, ShouldResemble, tc.expected)
+					})
+				}
+			})
+		}
+
+		Convey("When evaluating it on more distinct values than it monitors", func() {
+			// value i (1 <= i <= 10) appears 1000 * i times and 5000
+			// other values appear once in a mixed order
+			arr := data.Array{}
+			for j := 0; j < 1000; j++ {
+				for i := 1; i <= 10; i++ {
+					for k := 0; k < i; k++ {
+						arr = append(arr, data.Int(i))
+					}
+				}
+				for k := 0; k < 5; k++ {
+					arr = append(arr, data.String(fmt.Sprint("noise", j*5+k)))
+				}
+			}
+			val, err := f.Call(nil, arr, data.Int(3))
+
+			Convey("Then the most frequent values should be found", func() {
+				So(err, ShouldBeNil)
+				res, err := data.AsArray(val)
+				So(err, ShouldBeNil)
+				So(len(res), ShouldEqual, 3)
+				for i, expected := range []int64{10, 9, 8} {
+					m, err := data.AsMap(res[i])
+					So(err, ShouldBeNil)
+					So(m["value"], ShouldEqual, data.Int(expected))
+					count, _ := data.AsInt(m["count"])
+					maxError, _ := data.AsInt(m["error"])
+					So(count, ShouldBeGreaterThanOrEqualTo, 1000*expected)
+					So(count-maxError, ShouldBeLessThanOrEqualTo, 1000*expected)
+				}
+			})
+		})
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("approx_top_k", 2)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldHaveSameTypeAs, f)
+		})
+	})
+}
+
+func TestSpaceSavingState(t *testing.T) {
+	ctx := core.NewContext(nil)
+	reg := udf.CopyGlobalUDFRegistry(ctx)
+	topKFunc, err := reg.Lookup("space_saving_top_k", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Given a space_saving state", t, func() {
+		c, err := udf.CopyGlobalUDSCreatorRegistry()
+		So(err, ShouldBeNil)
+		creator, err := c.Lookup("space_saving")
+		So(err, ShouldBeNil)
+		s, err := creator.CreateState(ctx, data.Map{"field": data.String("id"), "capacity": data.Int(3)})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("hot", "space_saving", s), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("hot")
+		})
+		w := s.(core.Writer)
+
+		Convey("When no tuple is written to it", func() {
+			Convey("Then space_saving_top_k should return an empty array", func() {
+				v, err := topKFunc.Call(ctx, data.String("hot"), data.Int(2))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{})
+			})
+		})
+
+		Convey("When writing tuples to it", func() {
+			for _, id := range []string{"a", "b", "a", "c", "a", "b", "d"} {
+				So(w.Write(ctx, core.NewTuple(data.Map{"id": data.String(id)})), ShouldBeNil)
+			}
+			So(w.Write(ctx, core.NewTuple(data.Map{"id": data.Null{}})), ShouldBeNil)
+			expected := data.Array{topKEntry(data.String("a"), 3, 0),
+				topKEntry(data.String("b"), 2, 0), topKEntry(data.String("d"), 2, 1)}
+
+			Convey("Then space_saving_top_k should return the most frequent values", func() {
+				v, err := topKFunc.Call(ctx, data.String("hot"), data.Int(5))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, expected)
+
+				v, err = topKFunc.Call(ctx, data.String("hot"), data.Int(1))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, expected[:1])
+			})
+
+			Convey("And saving it", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.(core.SavableSharedState).Save(ctx, buf, data.Map{}), ShouldBeNil)
+				saved := buf.Bytes()
+
+				Convey("Then it should be loaded as a new state", func() {
+					loaded, err := creator.(udf.UDSLoader).LoadState(ctx, bytes.NewReader(saved), data.Map{})
+					So(err, ShouldBeNil)
+					_, err = ctx.SharedStates.Replace("hot", "space_saving", loaded)
+					So(err, ShouldBeNil)
+					v, err := topKFunc.Call(ctx, data.String("hot"), data.Int(5))
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, expected)
+
+					Convey("And it should keep counting", func() {
+						So(loaded.(core.Writer).Write(ctx, core.NewTuple(data.Map{"id": data.String("b")})), ShouldBeNil)
+						So(loaded.(core.Writer).Write(ctx, core.NewTuple(data.Map{"id": data.String("b")})), ShouldBeNil)
+						So(loaded.(core.Writer).Write(ctx, core.NewTuple(data.Map{"id": data.String("e")})), ShouldBeNil)
+						v, err := topKFunc.Call(ctx, data.String("hot"), data.Int(5))
+						So(err, ShouldBeNil)
+						So(v, ShouldResemble, data.Array{topKEntry(data.String("b"), 4, 0),
+							topKEntry(data.String("a"), 3, 0), topKEntry(data.String("e"), 3, 2)})
+					})
+				})
+
+				Convey("Then it should be loaded into an existing state", func() {
+					other, err := creator.CreateState(ctx, data.Map{"field": data.String("x")})
+					So(err, ShouldBeNil)
+					So(other.(core.LoadableSharedState).Load(ctx, bytes.NewReader(saved), data.Map{}), ShouldBeNil)
+					v, err := other.(*spaceSavingState).top(5)
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, expected)
+				})
+			})
+		})
+
+		Convey("When the state is terminated", func() {
+			So(s.Terminate(ctx), ShouldBeNil)
+
+			Convey("Then writing and reading should fail", func() {
+				So(w.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1)})), ShouldNotBeNil)
+				_, err := topKFunc.Call(ctx, data.String("hot"), data.Int(1))
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When reading it with an invalid k", func() {
+			_, err := topKFunc.Call(ctx, data.String("hot"), data.Int(0))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When reading it as a hyperloglog state", func() {
+			countFunc, err := reg.Lookup("hyperloglog_count", 1)
+			So(err, ShouldBeNil)
+			_, err = countFunc.Call(ctx, data.String("hot"))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "not a hyperloglog state")
+			})
+		})
+	})
+
+	Convey("Given invalid parameters", t, func() {
+		for _, params := range []data.Map{
+			{},
+			{"field": data.String("a"), "capacity": data.Int(0)},
+			{"field": data.String("a"), "capacity": data.Float(1.5)},
+		} {
+			params := params
+			Convey(fmt.Sprintf("When creating a space_saving state with %v", params), func() {
+				_, err := (&spaceSavingStateCreator{}).CreateState(ctx, params)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
@@ -25,6 +25,39 @@ type UDF interface {
 	IsAggregationParameter(k int) bool
 }
 
+// IncrementalAggregate is an aggregate function having exactly one
+// aggregation parameter whose result can be maintained incrementally.
+// When a window slides, values entering the window are added to an
+// Accumulator and values leaving the window are retracted from it, so
+// that the result doesn't have to be recomputed from all values in the
+// window. Call must still compute the same result from an array of
+// values because it's used when the function cannot be computed
+// incrementally, e.g. when it's used with ORDER BY.
+type IncrementalAggregate interface {
+	UDF
+
+	// NewAccumulator returns an Accumulator holding no values.
+	NewAccumulator() Accumulator
+}
+
+// Accumulator holds the intermediate state of an IncrementalAggregate.
+type Accumulator interface {
+	// Add adds a value to the accumulator. When Add returns an error,
+	// the value isn't added and it will not be retracted later. The
+	// error is reported as the result of the aggregate function as long
+	// as the value is in the window.
+	Add(v data.Value) error
+
+	// Retract removes a value that has previously been added by Add.
+	Retract(v data.Value) error
+
+	// Result returns the aggregate of the values currently held by the
+	// accumulator. It must return the same value as UDF.Call with an
+	// array of those values. A float result may differ from it by
+	// rounding errors of the values held.
+	Result() (data.Value, error)
+}
+
 type function struct {
 	f     func(*core.Context, ...data.Value) (data.Value, error)
 	arity int
@@ -178,7 +211,7 @@ func (fr *defaultFunctionRegistry) Register(name string, f UDF) error {
 	switch lowerName {
 	case "count", "avg", "max", "min", "sum",
 		"coalesce", "lower", "upper", "octet_length",
-		"substring":
+		"substring", "extract":
 		// skip check
 	default:
 		if err := core.ValidateSymbol(name); err != nil {
@@ -0,0 +1,168 @@
+package udf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+const (
+	// checkpointStatePrefix is the prefix of names of states saved in
+	// checkpoints. The rest of the name is the name of the node.
+	checkpointStatePrefix = "sensorbee_checkpoint_"
+
+	// checkpointManifest is the name of the state having the ID of the
+	// latest complete checkpoint.
+	checkpointManifest = "sensorbee_checkpoint"
+	checkpointLatest   = "latest"
+)
+
+type udsCheckpointStorage struct {
+	s UDSStorage
+}
+
+// NewUDSCheckpointStorage creates a core.CheckpointStorage which saves
+// checkpoints to the UDSStorage. A state of a node is saved as a UDS named
+// "sensorbee_checkpoint_<node name>". The storage only keeps the latest
+// complete checkpoint and the one being taken, which are saved with tags
+// "checkpoint_0" and "checkpoint_1" alternately. The ID of the latest
+// complete checkpoint is saved as "sensorbee_checkpoint" with the tag
+// "latest". Because it's committed after all states of the checkpoint are
+// committed, the latest complete checkpoint is never overwritten by a
+// checkpoint which fails. Each state starts with the ID of the checkpoint
+// it belongs to so that a state left in a slot by an older checkpoint isn't
+// loaded as a part of a newer one which doesn't have the node.
+func NewUDSCheckpointStorage(s UDSStorage) core.CheckpointStorage {
+	return &udsCheckpointStorage{
+		s: s,
+	}
+}
+
+func (c *udsCheckpointStorage) Save(topology string, id int64, node string) (core.CheckpointStorageWriter, error) {
+	slot, err := c.slot(topology, id)
+	if err != nil {
+		return nil, err
+	}
+	w, err := c.s.Save(topology, checkpointStatePrefix+node, slotTag(slot))
+	if err != nil {
+		return nil, err
+	}
+	var header [8]byte
+	binary.BigEndian.PutUint64(header[:], uint64(id))
+	if _, err := w.Write(header[:]); err != nil {
+		w.Abort()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (c *udsCheckpointStorage) Load(topology string, id int64, node string) (io.ReadCloser, error) {
+	latest, slot, err := c.latest(topology)
+	if err != nil {
+		return nil, err
+	}
+	if latest != id {
+		return nil, core.NotExistError(fmt.Errorf("checkpoint %v of topology '%v' was not found", id, topology))
+	}
+	r, err := c.s.Load(topology, checkpointStatePrefix+node, slotTag(slot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, core.NotExistError(err)
+		}
+		return nil, err
+	}
+
+	// the slot can have a state saved by an older checkpoint when the
+	// node didn't exist at the time of the latest checkpoint
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		r.Close()
+		return nil, fmt.Errorf("the state of node '%v' in checkpoint %v is broken: %v", node, id, err)
+	}
+	if saved := int64(binary.BigEndian.Uint64(header[:])); saved != id {
+		r.Close()
+		return nil, core.NotExistError(fmt.Errorf("node '%v' was not found in checkpoint %v of topology '%v'", node, id, topology))
+	}
+	return r, nil
+}
+
+func (c *udsCheckpointStorage) Complete(topology string, id int64) error {
+	slot, err := c.slot(topology, id)
+	if err != nil {
+		return err
+	}
+	b, err := data.MarshalMsgpack(data.Map{
+		"id":   data.Int(id),
+		"slot": data.Int(slot),
+	})
+	if err != nil {
+		return err
+	}
+	w, err := c.s.Save(topology, checkpointManifest, checkpointLatest)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(b); err != nil {
+		w.Abort()
+		return err
+	}
+	return w.Commit()
+}
+
+func (c *udsCheckpointStorage) Latest(topology string) (int64, error) {
+	id, _, err := c.latest(topology)
+	return id, err
+}
+
+// latest returns the ID and the slot of the latest complete checkpoint.
+func (c *udsCheckpointStorage) latest(topology string) (int64, int64, error) {
+	r, err := c.s.Load(topology, checkpointManifest, checkpointLatest)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, 0, core.NotExistError(err)
+		}
+		return 0, 0, err
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	m, err := data.UnmarshalMsgpack(b)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := data.AsInt(m["id"])
+	if err != nil {
+		return 0, 0, fmt.Errorf("the checkpoint manifest doesn't have a valid id: %v", err)
+	}
+	slot, err := data.AsInt(m["slot"])
+	if err != nil {
+		return 0, 0, fmt.Errorf("the checkpoint manifest doesn't have a valid slot: %v", err)
+	}
+	return id, slot, nil
+}
+
+// slot returns the slot in which states of the checkpoint are saved. It's
+// the one which isn't used by the latest complete checkpoint.
+func (c *udsCheckpointStorage) slot(topology string, id int64) (int64, error) {
+	latest, slot, err := c.latest(topology)
+	if err != nil {
+		if core.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if latest == id {
+		return slot, nil
+	}
+	return 1 - slot, nil
+}
+
+func slotTag(slot int64) string {
+	return fmt.Sprintf("checkpoint_%v", slot)
+}
@@ -0,0 +1,97 @@
+package udf
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+func TestUDSCheckpointStorage(t *testing.T) {
+	Convey("Given a checkpoint storage on a UDSStorage", t, func() {
+		s := NewUDSCheckpointStorage(NewInMemoryUDSStorage())
+
+		save := func(id int64, node, content string) {
+			w, err := s.Save("test_topology", id, node)
+			So(err, ShouldBeNil)
+			_, err = io.WriteString(w, content)
+			So(err, ShouldBeNil)
+			So(w.Commit(), ShouldBeNil)
+		}
+		load := func(id int64, node string) (string, error) {
+			r, err := s.Load("test_topology", id, node)
+			if err != nil {
+				return "", err
+			}
+			defer r.Close()
+			b, err := ioutil.ReadAll(r)
+			return string(b), err
+		}
+
+		Convey("When it doesn't have any checkpoint", func() {
+			Convey("Then Latest should fail", func() {
+				_, err := s.Latest("test_topology")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When completing a checkpoint", func() {
+			save(1, "box", "state1")
+			So(s.Complete("test_topology", 1), ShouldBeNil)
+
+			Convey("Then it should be the latest checkpoint", func() {
+				id, err := s.Latest("test_topology")
+				So(err, ShouldBeNil)
+				So(id, ShouldEqual, 1)
+
+				st, err := load(1, "box")
+				So(err, ShouldBeNil)
+				So(st, ShouldEqual, "state1")
+			})
+
+			Convey("Then loading a missing node should fail", func() {
+				_, err := load(1, "box2")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then a failed checkpoint should not overwrite it", func() {
+				save(2, "box", "state2")
+				save(3, "box", "state3")
+
+				id, err := s.Latest("test_topology")
+				So(err, ShouldBeNil)
+				So(id, ShouldEqual, 1)
+				st, err := load(1, "box")
+				So(err, ShouldBeNil)
+				So(st, ShouldEqual, "state1")
+
+				Convey("And a node only saved in an older checkpoint should not be loaded", func() {
+					// checkpoint 4 uses the same slot as checkpoint 2
+					save(2, "box2", "state2")
+					So(s.Complete("test_topology", 2), ShouldBeNil)
+					save(3, "box", "state3")
+					So(s.Complete("test_topology", 3), ShouldBeNil)
+					save(4, "box", "state4")
+					So(s.Complete("test_topology", 4), ShouldBeNil)
+
+					_, err := load(4, "box2")
+					So(core.IsNotExist(err), ShouldBeTrue)
+					st, err := load(4, "box")
+					So(err, ShouldBeNil)
+					So(st, ShouldEqual, "state4")
+				})
+
+				Convey("And the next checkpoint should replace it", func() {
+					So(s.Complete("test_topology", 3), ShouldBeNil)
+					st, err := load(3, "box")
+					So(err, ShouldBeNil)
+					So(st, ShouldEqual, "state3")
+
+					_, err = load(1, "box")
+					So(core.IsNotExist(err), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
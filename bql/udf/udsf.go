@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
@@ -234,6 +235,16 @@ type UDSFDeclarer interface {
 	// ListInputs returns all inputs declared by a UDSF. The caller can safely
 	// modify the map returned from this method.
 	ListInputs() map[string]*UDSFInputConfig
+
+	// SetWatermarkDelay declares the allowed lateness of tuples emitted by
+	// a UDSF running in the source mode. When the delay is greater than or
+	// equal to 0, each emitted tuple gets a watermark which is the latest
+	// timestamp emitted so far minus the delay. A negative delay, which is
+	// the default, disables watermarks.
+	SetWatermarkDelay(d time.Duration)
+
+	// WatermarkDelay returns the delay set by SetWatermarkDelay.
+	WatermarkDelay() time.Duration
 }
 
 // UDSFInputConfig has input configuration parameters for UDSF.
@@ -255,12 +266,14 @@ type UDSFInputConfig struct {
 }
 
 type udsfDeclarer struct {
-	inputs map[string]*UDSFInputConfig
+	inputs         map[string]*UDSFInputConfig
+	watermarkDelay time.Duration
 }
 
 func newUDSFDeclarer() *udsfDeclarer {
 	return &udsfDeclarer{
-		inputs: map[string]*UDSFInputConfig{},
+		inputs:         map[string]*UDSFInputConfig{},
+		watermarkDelay: -1,
 	}
 }
 
@@ -287,3 +300,11 @@ func (d *udsfDeclarer) ListInputs() map[string]*UDSFInputConfig {
 	}
 	return m
 }
+
+func (d *udsfDeclarer) SetWatermarkDelay(delay time.Duration) {
+	d.watermarkDelay = delay
+}
+
+func (d *udsfDeclarer) WatermarkDelay() time.Duration {
+	return d.watermarkDelay
+}
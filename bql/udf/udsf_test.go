@@ -66,6 +66,10 @@ func TestDuplicateUDSF(t *testing.T) {
 				So(decl.inputs["test_stream"].DropMode, ShouldEqual, core.DropNone)
 			})
 
+			Convey("Then the declarer shouldn't have a watermark delay", func() {
+				So(decl.WatermarkDelay(), ShouldBeLessThan, 0)
+			})
+
 			Convey("Then it should duplicates tuples", func() {
 				cnt := 0
 				w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
@@ -8,6 +8,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"sort"
+	"strings"
 )
 
 // Statement is a wrapper of BQL statement structs.
@@ -101,7 +102,37 @@ func inputFromSelect(stmt *parser.SelectStmt) ([]string, error) {
 			for n := range decl.ListInputs() {
 				names = append(names, n)
 			}
+
+		case parser.SubqueryStream:
+			ns, err := inputFromSelect(rel.Select)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, ns...)
+		}
+	}
+
+	// A common table expression isn't an input of the statement. The
+	// inputs of it are used instead if the statement refers to it.
+	for i := len(stmt.CommonTableExpressions) - 1; i >= 0; i-- {
+		cte := &stmt.CommonTableExpressions[i]
+		var rest []string
+		referred := false
+		for _, n := range names {
+			if strings.ToLower(n) == strings.ToLower(string(cte.Name)) {
+				referred = true
+				continue
+			}
+			rest = append(rest, n)
+		}
+		if !referred {
+			continue
+		}
+		ns, err := inputFromSelect(&cte.Select)
+		if err != nil {
+			return nil, err
 		}
+		names = append(rest, ns...)
 	}
 	return names, nil
 }
@@ -0,0 +1,246 @@
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxBatchSize    = 100
+	defaultMaxBatchLatency = 100 * time.Millisecond
+)
+
+// batchWriter buffers tuples written to a BatchSink and writes them at once
+// with WriteBatch. Because it doesn't close the sink, Close method only
+// writes tuples it still has.
+type batchWriter struct {
+	ctx        *Context
+	nodeName   string
+	sink       BatchSink
+	maxSize    int
+	maxLatency time.Duration
+	concurrent bool
+
+	m     sync.Mutex
+	batch []*Tuple
+	timer *time.Timer
+	// gen is incremented every time tuples are taken from batch so that
+	// a timer started for old tuples doesn't flush new ones.
+	gen      int64
+	fatalErr error
+	closed   bool
+
+	// inflight is the number of calls to WriteBatch in progress. cond is
+	// signaled when it's decremented.
+	inflight int
+	cond     *sync.Cond
+
+	// writeMutex serializes calls to WriteBatch when the sink isn't
+	// concurrent. It also keeps the order of tuples in that case.
+	writeMutex sync.Mutex
+}
+
+func newBatchWriter(ctx *Context, nodeName string, s BatchSink, config *SinkConfig) *batchWriter {
+	w := &batchWriter{
+		ctx:        ctx,
+		nodeName:   nodeName,
+		sink:       s,
+		maxSize:    config.MaxBatchSize,
+		maxLatency: config.MaxBatchLatency,
+	}
+	w.cond = sync.NewCond(&w.m)
+	if w.maxSize == 0 {
+		w.maxSize = defaultMaxBatchSize
+	}
+	if w.maxLatency == 0 {
+		w.maxLatency = defaultMaxBatchLatency
+	}
+	if cs, ok := s.(ConcurrentSink); ok {
+		w.concurrent = cs.Concurrent()
+	}
+	return w
+}
+
+func (w *batchWriter) Write(ctx *Context, t *Tuple) error {
+	w.m.Lock()
+	if w.fatalErr != nil {
+		err := w.fatalErr
+		w.m.Unlock()
+		return err
+	}
+
+	// The tuple is still referenced after this method returns.
+	t.Flags.Set(TFShared)
+	w.batch = append(w.batch, t)
+	if len(w.batch) == 1 {
+		w.startTimer()
+	}
+	full := len(w.batch) >= w.maxSize
+	w.m.Unlock()
+
+	if !full {
+		return nil
+	}
+	return w.flush(ctx, t)
+}
+
+// startTimer starts a timer which flushes the current batch after maxLatency.
+// The caller must lock w.m.
+func (w *batchWriter) startTimer() {
+	if w.closed {
+		return
+	}
+	gen := w.gen
+	w.timer = time.AfterFunc(w.maxLatency, func() {
+		w.m.Lock()
+		stale := gen != w.gen || w.closed
+		w.m.Unlock()
+		if stale {
+			return
+		}
+		// A fatal error is returned from the next call of Write.
+		w.flush(w.ctx, nil)
+	})
+}
+
+// take removes up to maxSize tuples from the batch and returns them. The
+// caller must lock w.m.
+func (w *batchWriter) take() []*Tuple {
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	w.gen++
+
+	n := len(w.batch)
+	if n > w.maxSize {
+		n = w.maxSize
+	}
+	ts := w.batch[:n:n]
+	if n == len(w.batch) {
+		w.batch = nil
+	} else {
+		w.batch = append([]*Tuple(nil), w.batch[n:]...)
+		w.startTimer()
+	}
+	return ts
+}
+
+// flush writes tuples in the batch. current is the tuple being written by
+// the caller and can be nil. It returns a fatal error only when current is
+// included in the tuples which couldn't be written due to the error. Other
+// tuples are reported as dropped tuples by this method.
+func (w *batchWriter) flush(ctx *Context, current *Tuple) error {
+	if !w.concurrent {
+		w.writeMutex.Lock()
+		defer w.writeMutex.Unlock()
+	}
+
+	w.m.Lock()
+	if w.fatalErr != nil {
+		// The sink cannot write tuples anymore.
+		err := w.fatalErr
+		ts := w.batch
+		w.batch = nil
+		w.m.Unlock()
+		return w.drop(ctx, ts, current, err)
+	}
+	ts := w.take()
+	if len(ts) == 0 {
+		w.m.Unlock()
+		return nil
+	}
+	w.inflight++
+	w.m.Unlock()
+
+	err := w.sink.WriteBatch(ctx, ts)
+	w.m.Lock()
+	w.inflight--
+	w.cond.Broadcast()
+	w.m.Unlock()
+	if err == nil {
+		return nil
+	}
+
+	if be, ok := err.(*BatchError); ok {
+		for i, e := range be.Errors {
+			if e == nil || i >= len(ts) {
+				continue
+			}
+			if !IsTemporaryError(e) {
+				e = TemporaryError(e)
+			}
+			w.report(ctx, ts[i], e)
+		}
+		return nil
+	}
+
+	if !IsFatalError(err) {
+		for _, t := range ts {
+			w.report(ctx, t, err)
+		}
+		return nil
+	}
+
+	w.m.Lock()
+	if w.fatalErr == nil {
+		w.fatalErr = err
+	}
+	w.m.Unlock()
+	return w.drop(ctx, ts, current, err)
+}
+
+// drop reports tuples which couldn't be written due to a fatal error. It
+// doesn't report current but returns the error when ts has it so that the
+// caller can report it.
+func (w *batchWriter) drop(ctx *Context, ts []*Tuple, current *Tuple, err error) error {
+	found := false
+	for _, t := range ts {
+		if t == current {
+			found = true
+			continue
+		}
+		w.report(ctx, t, err)
+	}
+	if found {
+		return err
+	}
+	return nil
+}
+
+func (w *batchWriter) report(ctx *Context, t *Tuple, err error) {
+	ctx.droppedTuple(t, NTSink, w.nodeName, ETInput, err)
+}
+
+// flushAll writes all tuples in the batch and waits until all calls to
+// WriteBatch in progress finish. It returns a fatal error when the sink failed
+// with it.
+func (w *batchWriter) flushAll(ctx *Context) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	for {
+		if len(w.batch) > 0 {
+			w.m.Unlock()
+			w.flush(ctx, nil)
+			w.m.Lock()
+			continue
+		}
+		if w.inflight == 0 {
+			return w.fatalErr
+		}
+		w.cond.Wait()
+	}
+}
+
+// Close writes all tuples in the batch and stops the timer. Write must not
+// be called after calling this method.
+func (w *batchWriter) Close(ctx *Context) error {
+	w.m.Lock()
+	w.closed = true
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	w.m.Unlock()
+	return w.flushAll(ctx)
+}
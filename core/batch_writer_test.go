@@ -0,0 +1,193 @@
+package core
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// batchCollectorSink records sizes of batches it received. It fails to write
+// tuples whose "fail" field is true.
+type batchCollectorSink struct {
+	TupleCollectorSink
+	batches []int
+	fatal   bool
+}
+
+func newBatchCollectorSink() *batchCollectorSink {
+	s := &batchCollectorSink{}
+	s.c = sync.NewCond(&s.m)
+	return s
+}
+
+func (s *batchCollectorSink) WriteBatch(ctx *Context, ts []*Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.fatal {
+		return FatalError(errors.New("the sink is broken"))
+	}
+	s.batches = append(s.batches, len(ts))
+	errs := make([]error, len(ts))
+	failed := false
+	for i, t := range ts {
+		if f, _ := t.Data["fail"].(data.Bool); f {
+			errs[i] = errors.New("cannot write the tuple")
+			failed = true
+			continue
+		}
+		s.Tuples = append(s.Tuples, t)
+	}
+	s.c.Broadcast()
+	if failed {
+		return &BatchError{Errors: errs}
+	}
+	return nil
+}
+
+func (s *batchCollectorSink) batchSizes() []int {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return append([]int(nil), s.batches...)
+}
+
+func TestDefaultSinkNodeBatch(t *testing.T) {
+	tuples := make([]*Tuple, 7)
+	for i := range tuples {
+		tuples[i] = NewTuple(data.Map{"i": data.Int(i)})
+	}
+	tuples[4].Data["fail"] = data.True
+
+	Convey("Given a topology having a batch sink", t, func() {
+		ctx := NewContext(nil)
+		t, err := NewDefaultTopology(ctx, "test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			t.Stop()
+		})
+
+		so := NewTupleIncrementalEmitterSource(tuples)
+		_, err = t.AddSource("source", so, nil)
+		So(err, ShouldBeNil)
+		si := newBatchCollectorSink()
+
+		Convey("When tuples reach the max batch size", func() {
+			sin, err := t.AddSink("sink", si, &SinkConfig{
+				MaxBatchSize:    3,
+				MaxBatchLatency: time.Hour,
+			})
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+			so.EmitTuples(4)
+			si.Wait(3)
+
+			Convey("Then they should be written at once", func() {
+				So(si.batchSizes(), ShouldResemble, []int{3})
+			})
+
+			Convey("Then stopping the sink should write the rest", func() {
+				So(sin.Stop(), ShouldBeNil)
+				So(si.batchSizes(), ShouldResemble, []int{3, 1})
+				So(si.len(), ShouldEqual, 4)
+			})
+
+			Convey("Then its status should have batch parameters", func() {
+				st := sin.Status()
+				s, err := st.Get(data.MustCompilePath("behaviors.max_batch_size"))
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, data.Int(3))
+				l, err := st.Get(data.MustCompilePath("behaviors.max_batch_latency"))
+				So(err, ShouldBeNil)
+				So(l, ShouldEqual, data.Float(3600))
+			})
+		})
+
+		Convey("When the max batch latency passes", func() {
+			sin, err := t.AddSink("sink", si, &SinkConfig{
+				MaxBatchSize:    100,
+				MaxBatchLatency: 10 * time.Millisecond,
+			})
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+			so.EmitTuples(2)
+
+			Convey("Then buffered tuples should be written", func() {
+				si.Wait(2)
+				So(si.batchSizes(), ShouldResemble, []int{2})
+			})
+		})
+
+		Convey("When a checkpoint is taken", func() {
+			sin, err := t.AddSink("sink", si, &SinkConfig{
+				MaxBatchSize:    100,
+				MaxBatchLatency: time.Hour,
+			})
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+			so.EmitTuples(2)
+			_, err = t.(CheckpointableTopology).Checkpoint(NewInMemoryCheckpointStorage(), 10*time.Second)
+			So(err, ShouldBeNil)
+
+			Convey("Then buffered tuples should be written", func() {
+				So(si.len(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When a part of a batch fails", func() {
+			dtso := NewDroppedTupleCollectorSource()
+			_, err := t.AddSource("dropped_tuples", dtso, nil)
+			So(err, ShouldBeNil)
+			dsi := NewTupleCollectorSink()
+			dsin, err := t.AddSink("dropped", dsi, nil)
+			So(err, ShouldBeNil)
+			So(dsin.Input("dropped_tuples", nil), ShouldBeNil)
+
+			sin, err := t.AddSink("sink", si, &SinkConfig{
+				MaxBatchSize:    6,
+				MaxBatchLatency: time.Hour,
+			})
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+			so.EmitTuples(6)
+			si.Wait(5)
+
+			Convey("Then only the failed tuple should be dropped", func() {
+				dsi.Wait(1)
+				So(dsi.len(), ShouldEqual, 1)
+				d := dsi.get(0).Data
+				So(d["node_name"], ShouldEqual, "sink")
+				So(d["data"], ShouldResemble, tuples[4].Data)
+				So(si.len(), ShouldEqual, 5)
+			})
+		})
+
+		Convey("When a batch fails with a fatal error", func() {
+			si.fatal = true
+			sin, err := t.AddSink("sink", si, &SinkConfig{
+				MaxBatchSize:    2,
+				MaxBatchLatency: time.Hour,
+			})
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+			so.EmitTuples(2)
+
+			Convey("Then the sink should stop", func() {
+				sin.State().Wait(TSStopped)
+				st := sin.Status()
+				So(st["error"], ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a sink config with invalid batch parameters", t, func() {
+		Convey("When validating it", func() {
+			Convey("Then it should fail", func() {
+				So((&SinkConfig{MaxBatchSize: -1}).Validate(), ShouldNotBeNil)
+				So((&SinkConfig{MaxBatchLatency: -time.Second}).Validate(), ShouldNotBeNil)
+			})
+		})
+	})
+}
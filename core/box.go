@@ -97,6 +97,21 @@ type StatefulBox interface {
 	Terminate(ctx *Context) error
 }
 
+// ConcurrentBox is a Box which can opt in to concurrent processing. When
+// Concurrent method returns true, a topology may call Process method from
+// multiple goroutines at the same time, up to BoxConfig.Parallelism, and
+// tuples may be written to the Writer in a different order from the one in
+// which they arrived. A Box which doesn't implement this interface, or whose
+// Concurrent method returns false, is always processed by a single goroutine
+// regardless of BoxConfig.Parallelism.
+type ConcurrentBox interface {
+	Box
+
+	// Concurrent returns true when Process method can safely be called
+	// concurrently. It must always return the same value.
+	Concurrent() bool
+}
+
 // TODO: Support input constraints such as an acceptable frequency of tuples.
 
 // NamedInputBox is a box whose inputs have custom input names.
@@ -0,0 +1,450 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// CheckpointableBox is a Box whose state can be saved in a checkpoint and
+// restored from it.
+//
+// CheckpointableTopology.Checkpoint takes a checkpoint by sending barriers
+// from all Sources. A Box saves its state when barriers have arrived from all
+// of its inputs, so the saved state reflects exactly those tuples that were
+// written before the barriers.
+type CheckpointableBox interface {
+	Box
+
+	// SaveCheckpoint writes the current state of the Box to w. It can be
+	// called concurrently with Process.
+	SaveCheckpoint(ctx *Context, w io.Writer) error
+
+	// LoadCheckpoint restores the state of the Box from the data written by
+	// SaveCheckpoint. It's called after Init and before the Box processes
+	// any tuple.
+	LoadCheckpoint(ctx *Context, r io.Reader) error
+}
+
+// CheckpointableSource is a Source which can generate its stream again from
+// a position recorded in a checkpoint, e.g. an offset in a file or a message
+// queue.
+type CheckpointableSource interface {
+	Source
+
+	// Offset returns the position of the tuple being written. It's called
+	// from inside Writer.Write which GenerateStream calls, and the returned
+	// value must make Seek regenerate the stream starting from that tuple.
+	// Therefore, Write must not be called concurrently by GenerateStream.
+	Offset(ctx *Context) (data.Value, error)
+
+	// Seek sets the position from which GenerateStream starts generating
+	// tuples. It's called before GenerateStream.
+	Seek(ctx *Context, offset data.Value) error
+}
+
+// CheckpointStorage stores states of nodes saved in checkpoints. A
+// checkpoint is identified by the name of the topology and its ID, which
+// increases every time a checkpoint is taken.
+type CheckpointStorage interface {
+	// Save returns a writer to save the state of the node in the checkpoint.
+	// Either Commit or Abort of the writer has to be called.
+	Save(topology string, id int64, node string) (CheckpointStorageWriter, error)
+
+	// Load loads the state of the node saved in the checkpoint. It returns
+	// NotExistError when the state doesn't exist. io.ReadCloser.Close has to
+	// be called when it gets unnecessary.
+	Load(topology string, id int64, node string) (io.ReadCloser, error)
+
+	// Complete marks the checkpoint as the latest complete checkpoint of the
+	// topology. It's called after all states in the checkpoint have been
+	// committed. States of previous checkpoints can be discarded after that.
+	Complete(topology string, id int64) error
+
+	// Latest returns the ID of the latest complete checkpoint of the
+	// topology. It returns NotExistError when the topology doesn't have any
+	// complete checkpoint.
+	Latest(topology string) (int64, error)
+}
+
+// CheckpointStorageWriter is used to save a state in a checkpoint.
+type CheckpointStorageWriter interface {
+	io.Writer
+
+	// Commit persists the data written to the writer so far and closes it.
+	Commit() error
+
+	// Abort discards the data written to the writer.
+	Abort() error
+}
+
+type inMemoryCheckpointStorage struct {
+	m      sync.RWMutex
+	states map[string]map[int64]map[string][]byte
+	latest map[string]int64
+}
+
+// NewInMemoryCheckpointStorage creates a new CheckpointStorage which stores
+// all data in memory. This storage should only be used for experiment or
+// test purpose.
+func NewInMemoryCheckpointStorage() CheckpointStorage {
+	return &inMemoryCheckpointStorage{
+		states: map[string]map[int64]map[string][]byte{},
+		latest: map[string]int64{},
+	}
+}
+
+func (s *inMemoryCheckpointStorage) Save(topology string, id int64, node string) (CheckpointStorageWriter, error) {
+	return &inMemoryCheckpointStorageWriter{
+		s:        s,
+		buf:      bytes.NewBuffer(nil),
+		topology: topology,
+		id:       id,
+		node:     node,
+	}, nil
+}
+
+func (s *inMemoryCheckpointStorage) Load(topology string, id int64, node string) (io.ReadCloser, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	b, ok := s.states[topology][id][node]
+	if !ok {
+		return nil, NotExistError(fmt.Errorf("the state of node '%v' was not found in checkpoint %v", node, id))
+	}
+	return ioutil.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (s *inMemoryCheckpointStorage) Complete(topology string, id int64) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.latest[topology] = id
+	for i := range s.states[topology] {
+		if i < id {
+			delete(s.states[topology], i)
+		}
+	}
+	return nil
+}
+
+func (s *inMemoryCheckpointStorage) Latest(topology string) (int64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	id, ok := s.latest[topology]
+	if !ok {
+		return 0, NotExistError(fmt.Errorf("topology '%v' doesn't have a complete checkpoint", topology))
+	}
+	return id, nil
+}
+
+type inMemoryCheckpointStorageWriter struct {
+	s        *inMemoryCheckpointStorage
+	buf      *bytes.Buffer
+	topology string
+	id       int64
+	node     string
+}
+
+func (w *inMemoryCheckpointStorageWriter) Write(data []byte) (int, error) {
+	if w.buf == nil {
+		return 0, errors.New("writer is already closed")
+	}
+	return w.buf.Write(data)
+}
+
+func (w *inMemoryCheckpointStorageWriter) Commit() error {
+	if w.buf == nil {
+		return errors.New("writer is already closed")
+	}
+	w.s.m.Lock()
+	defer w.s.m.Unlock()
+	t := w.s.states[w.topology]
+	if t == nil {
+		t = map[int64]map[string][]byte{}
+		w.s.states[w.topology] = t
+	}
+	if t[w.id] == nil {
+		t[w.id] = map[string][]byte{}
+	}
+	t[w.id][w.node] = w.buf.Bytes()
+	w.buf = nil
+	return nil
+}
+
+func (w *inMemoryCheckpointStorageWriter) Abort() error {
+	if w.buf == nil {
+		return errors.New("writer is already closed")
+	}
+	w.buf = nil
+	return nil
+}
+
+// checkpointBarrier is sent from Sources to downstream nodes to take a
+// checkpoint. It's carried by a Tuple having only the barrier.
+type checkpointBarrier struct {
+	id       int64
+	topology string
+	storage  CheckpointStorage
+	progress *checkpointProgress
+}
+
+// checkpointProgress tracks nodes which haven't saved their states yet.
+type checkpointProgress struct {
+	m       sync.Mutex
+	waiting map[string]bool
+	err     error
+	done    chan struct{}
+}
+
+func newCheckpointProgress(nodes []string) *checkpointProgress {
+	p := &checkpointProgress{
+		waiting: make(map[string]bool, len(nodes)),
+		done:    make(chan struct{}),
+	}
+	for _, n := range nodes {
+		p.waiting[n] = true
+	}
+	if len(nodes) == 0 {
+		close(p.done)
+	}
+	return p
+}
+
+// ack records that the node has saved its state.
+func (p *checkpointProgress) ack(node string, err error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	if !p.waiting[node] {
+		return
+	}
+	delete(p.waiting, node)
+	if err != nil && p.err == nil {
+		p.err = fmt.Errorf("node '%v' cannot save its state: %v", node, err)
+	}
+	if len(p.waiting) == 0 {
+		close(p.done)
+	}
+}
+
+func (p *checkpointProgress) error() error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.err
+}
+
+// saveBoxCheckpoint saves the state of the Box if it's a CheckpointableBox.
+func saveBoxCheckpoint(ctx *Context, b *checkpointBarrier, node string, box Box) error {
+	cb, ok := box.(CheckpointableBox)
+	if !ok {
+		return nil
+	}
+	w, err := b.storage.Save(b.topology, b.id, node)
+	if err != nil {
+		return err
+	}
+	if err := cb.SaveCheckpoint(ctx, w); err != nil {
+		if e := w.Abort(); e != nil {
+			ctx.ErrLog(e).WithFields(nodeLogFields(NTBox, node)).
+				Error("Cannot abort saving the state of the box")
+		}
+		return err
+	}
+	return w.Commit()
+}
+
+// loadBoxCheckpoint restores the state of the Box from the checkpoint if it's
+// a CheckpointableBox and the checkpoint has its state.
+func loadBoxCheckpoint(ctx *Context, s CheckpointStorage, topology string, id int64, node string, box Box) error {
+	cb, ok := box.(CheckpointableBox)
+	if !ok {
+		return nil
+	}
+	r, err := s.Load(topology, id, node)
+	if err != nil {
+		if IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer r.Close()
+	return cb.LoadCheckpoint(ctx, r)
+}
+
+// saveSourceOffset saves the offset of a CheckpointableSource.
+func saveSourceOffset(b *checkpointBarrier, node string, offset data.Value) error {
+	d, err := data.MarshalMsgpack(data.Map{"offset": offset})
+	if err != nil {
+		return err
+	}
+	w, err := b.storage.Save(b.topology, b.id, node)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(d); err != nil {
+		w.Abort()
+		return err
+	}
+	return w.Commit()
+}
+
+// loadSourceOffset loads the offset of a CheckpointableSource. It returns
+// NotExistError when the checkpoint doesn't have the offset.
+func loadSourceOffset(s CheckpointStorage, topology string, id int64, node string) (data.Value, error) {
+	r, err := s.Load(topology, id, node)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	m, err := data.UnmarshalMsgpack(b)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := m["offset"]
+	if !ok {
+		return nil, fmt.Errorf("the saved state of source '%v' doesn't have an offset", node)
+	}
+	return v, nil
+}
+
+// checkpointWriter is a Writer passed to Source.GenerateStream. It injects
+// barriers between tuples written by the Source.
+type checkpointWriter struct {
+	node   string
+	source Source
+	dsts   *dataDestinations
+
+	m       sync.Mutex
+	pending *checkpointBarrier
+}
+
+// inject sends the barrier to destinations. When the Source supports
+// offsets, the barrier is sent when the Source writes the next tuple so that
+// the offset of the tuple can be recorded.
+func (w *checkpointWriter) inject(ctx *Context, b *checkpointBarrier) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if _, ok := w.source.(CheckpointableSource); ok {
+		w.pending = b
+		return
+	}
+	w.dsts.writeBarrier(ctx, b)
+	b.progress.ack(w.node, nil)
+}
+
+func (w *checkpointWriter) Write(ctx *Context, t *Tuple) error {
+	w.m.Lock()
+	if b := w.pending; b != nil {
+		w.pending = nil
+		offset, err := w.source.(CheckpointableSource).Offset(ctx)
+		if err == nil {
+			err = saveSourceOffset(b, w.node, offset)
+		}
+		w.dsts.writeBarrier(ctx, b)
+		b.progress.ack(w.node, err)
+	}
+	w.m.Unlock()
+	return w.dsts.Write(ctx, t)
+}
+
+func (w *checkpointWriter) Close(ctx *Context) error {
+	return w.dsts.Close(ctx)
+}
+
+// alignedTuple is a tuple received from the input channel ch.
+type alignedTuple struct {
+	ch uintptr
+	t  *Tuple
+}
+
+// checkpointAligner aligns barriers received from inputs of a node. Once a
+// barrier arrives from an input, tuples from the input are held back until
+// barriers of the same checkpoint arrive from all inputs.
+type checkpointAligner struct {
+	m        sync.Mutex
+	barrier  *checkpointBarrier
+	arrived  map[uintptr]bool
+	buffered []alignedTuple
+	lastID   int64
+}
+
+// receive handles a tuple received from the input channel ch. It returns
+// true when the tuple should be processed now. When barriers have arrived
+// from all numInputs inputs, the barrier is returned as completed. Tuples
+// held back are returned as replay, and they have to be passed to receive
+// again before tuples received after this call.
+func (a *checkpointAligner) receive(ch uintptr, t *Tuple, numInputs int) (process bool, completed *checkpointBarrier, replay []alignedTuple) {
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	b := t.barrier
+	if b == nil {
+		if a.barrier != nil && a.arrived[ch] {
+			a.buffered = append(a.buffered, alignedTuple{ch, t})
+			return false, nil, nil
+		}
+		return true, nil, nil
+	}
+
+	if a.barrier != nil {
+		if a.arrived[ch] {
+			// this barrier follows the barrier of the current checkpoint
+			a.buffered = append(a.buffered, alignedTuple{ch, t})
+			return false, nil, nil
+		}
+		if b.id < a.barrier.id {
+			return false, nil, nil // outdated
+		}
+		if b.id > a.barrier.id {
+			// the current checkpoint was abandoned because a new one started
+			// before all barriers arrived.
+			replay = a.reset()
+		}
+	}
+	if a.barrier == nil {
+		if b.id <= a.lastID {
+			return false, nil, replay
+		}
+		a.barrier = b
+		a.arrived = map[uintptr]bool{}
+	}
+	a.arrived[ch] = true
+	if len(a.arrived) >= numInputs {
+		completed = a.barrier
+		a.lastID = completed.id
+		replay = append(replay, a.reset()...)
+	}
+	return false, completed, replay
+}
+
+// inputClosed handles an input channel closed while aligning barriers. It
+// returns values in the same way as receive.
+func (a *checkpointAligner) inputClosed(ch uintptr, numInputs int) (completed *checkpointBarrier, replay []alignedTuple) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if a.barrier == nil {
+		return nil, nil
+	}
+	delete(a.arrived, ch)
+	if len(a.arrived) < numInputs {
+		return nil, nil
+	}
+	completed = a.barrier
+	a.lastID = completed.id
+	return completed, a.reset()
+}
+
+func (a *checkpointAligner) reset() []alignedTuple {
+	buffered := a.buffered
+	a.barrier = nil
+	a.arrived = nil
+	a.buffered = nil
+	return buffered
+}
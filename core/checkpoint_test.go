@@ -0,0 +1,427 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// countingBox counts tuples it processed and saves the count in checkpoints.
+type countingBox struct {
+	m   sync.Mutex
+	cnt int
+}
+
+func (b *countingBox) Process(ctx *Context, t *Tuple, w Writer) error {
+	b.m.Lock()
+	b.cnt++
+	b.m.Unlock()
+	return w.Write(ctx, t)
+}
+
+func (b *countingBox) count() int {
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.cnt
+}
+
+func (b *countingBox) SaveCheckpoint(ctx *Context, w io.Writer) error {
+	b.m.Lock()
+	defer b.m.Unlock()
+	_, err := fmt.Fprint(w, b.cnt)
+	return err
+}
+
+func (b *countingBox) LoadCheckpoint(ctx *Context, r io.Reader) error {
+	d, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.cnt, err = strconv.Atoi(string(d))
+	return err
+}
+
+// concurrentCountingBox is a countingBox processing tuples concurrently.
+// It takes some time to process a tuple so that other tuples are processed
+// at the same time.
+type concurrentCountingBox struct {
+	countingBox
+}
+
+func (b *concurrentCountingBox) Process(ctx *Context, t *Tuple, w Writer) error {
+	time.Sleep(time.Millisecond)
+	return b.countingBox.Process(ctx, t, w)
+}
+
+func (b *concurrentCountingBox) Concurrent() bool {
+	return true
+}
+
+// offsetSource emits a tuple every time a value is sent to next. Its offset
+// is the index of the tuple.
+type offsetSource struct {
+	m    sync.Mutex
+	pos  int
+	next chan struct{}
+	stop chan struct{}
+}
+
+func newOffsetSource() *offsetSource {
+	return &offsetSource{
+		next: make(chan struct{}),
+		stop: make(chan struct{}),
+	}
+}
+
+func (s *offsetSource) GenerateStream(ctx *Context, w Writer) error {
+	for {
+		select {
+		case <-s.next:
+		case <-s.stop:
+			return nil
+		}
+		if err := w.Write(ctx, NewTuple(data.Map{"pos": data.Int(s.position())})); err != nil {
+			return err
+		}
+		s.m.Lock()
+		s.pos++
+		s.m.Unlock()
+	}
+}
+
+func (s *offsetSource) Stop(ctx *Context) error {
+	close(s.stop)
+	return nil
+}
+
+func (s *offsetSource) position() int {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.pos
+}
+
+func (s *offsetSource) Offset(ctx *Context) (data.Value, error) {
+	return data.Int(s.position()), nil
+}
+
+func (s *offsetSource) Seek(ctx *Context, offset data.Value) error {
+	p, err := data.AsInt(offset)
+	if err != nil {
+		return err
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.pos = int(p)
+	return nil
+}
+
+func newCheckpointableTopology(ctx *Context, name string) (CheckpointableTopology, error) {
+	t, err := NewDefaultTopology(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return t.(CheckpointableTopology), nil
+}
+
+func TestCheckpointAligner(t *testing.T) {
+	Convey("Given a checkpoint aligner with two inputs", t, func() {
+		a := &checkpointAligner{}
+		b1 := &checkpointBarrier{id: 1}
+		t1 := NewTuple(data.Map{"v": data.Int(1)})
+		t2 := NewTuple(data.Map{"v": data.Int(2)})
+
+		Convey("When a barrier arrives from one input", func() {
+			ok, completed, _ := a.receive(1, &Tuple{barrier: b1}, 2)
+			So(ok, ShouldBeFalse)
+			So(completed, ShouldBeNil)
+
+			Convey("Then tuples from the input should be held back until alignment completes", func() {
+				ok, _, _ := a.receive(1, t1, 2)
+				So(ok, ShouldBeFalse)
+				ok, _, _ = a.receive(2, t2, 2)
+				So(ok, ShouldBeTrue)
+
+				ok, completed, replay := a.receive(2, &Tuple{barrier: b1}, 2)
+				So(ok, ShouldBeFalse)
+				So(completed, ShouldEqual, b1)
+				So(replay, ShouldResemble, []alignedTuple{{1, t1}})
+
+				ok, _, _ = a.receive(1, t1, 2)
+				So(ok, ShouldBeTrue)
+			})
+
+			Convey("Then closing the other input should complete alignment", func() {
+				a.receive(1, t1, 2)
+				completed, replay := a.inputClosed(2, 1)
+				So(completed, ShouldEqual, b1)
+				So(replay, ShouldResemble, []alignedTuple{{1, t1}})
+			})
+
+			Convey("Then a barrier of a newer checkpoint should abandon the current one", func() {
+				a.receive(2, t2, 2)
+				a.receive(1, t1, 2)
+				b2 := &checkpointBarrier{id: 2}
+				ok, completed, replay := a.receive(2, &Tuple{barrier: b2}, 2)
+				So(ok, ShouldBeFalse)
+				So(completed, ShouldBeNil)
+				So(replay, ShouldResemble, []alignedTuple{{1, t1}})
+
+				Convey("And an outdated barrier should be ignored", func() {
+					_, completed, _ := a.receive(1, &Tuple{barrier: b1}, 2)
+					So(completed, ShouldBeNil)
+					_, completed, _ = a.receive(1, &Tuple{barrier: b2}, 2)
+					So(completed, ShouldEqual, b2)
+				})
+			})
+		})
+	})
+}
+
+func TestDefaultTopologyCheckpoint(t *testing.T) {
+	tuples := make([]*Tuple, 10)
+	for i := range tuples {
+		tuples[i] = NewTuple(data.Map{"i": data.Int(i)})
+	}
+
+	Convey("Given a topology having a checkpointable box", t, func() {
+		ctx := NewContext(nil)
+		s := NewInMemoryCheckpointStorage()
+		t, err := newCheckpointableTopology(ctx, "test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			t.Stop()
+		})
+
+		so := NewTupleIncrementalEmitterSource(tuples)
+		_, err = t.AddSource("source", so, nil)
+		So(err, ShouldBeNil)
+		b := &countingBox{}
+		bn, err := t.AddBox("box", b, nil)
+		So(err, ShouldBeNil)
+		So(bn.Input("source", nil), ShouldBeNil)
+		si := NewTupleCollectorSink()
+		sin, err := t.AddSink("sink", si, nil)
+		So(err, ShouldBeNil)
+		So(sin.Input("box", nil), ShouldBeNil)
+
+		Convey("When restoring it without any checkpoint", func() {
+			_, err := t.Restore(s)
+
+			Convey("Then it should fail", func() {
+				So(IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When taking a checkpoint", func() {
+			so.EmitTuples(3)
+			si.Wait(3)
+			id, err := t.Checkpoint(s, 10*time.Second)
+			So(err, ShouldBeNil)
+			so.EmitTuples(2)
+			si.Wait(5)
+
+			Convey("Then it should be the latest checkpoint", func() {
+				So(id, ShouldEqual, 1)
+				l, err := s.Latest("test")
+				So(err, ShouldBeNil)
+				So(l, ShouldEqual, id)
+			})
+
+			Convey("Then the next checkpoint should have a new ID", func() {
+				id2, err := t.Checkpoint(s, 10*time.Second)
+				So(err, ShouldBeNil)
+				So(id2, ShouldEqual, 2)
+			})
+
+			Convey("Then a new topology should restore the state of the box", func() {
+				t2, err := newCheckpointableTopology(NewContext(nil), "test")
+				So(err, ShouldBeNil)
+				defer t2.Stop()
+				rid, err := t2.Restore(s)
+				So(err, ShouldBeNil)
+				So(rid, ShouldEqual, id)
+
+				b2 := &countingBox{}
+				_, err = t2.AddBox("box", b2, nil)
+				So(err, ShouldBeNil)
+				So(b2.count(), ShouldEqual, 3)
+
+				Convey("And a box which isn't in the checkpoint should start with an empty state", func() {
+					b3 := &countingBox{}
+					_, err := t2.AddBox("box2", b3, nil)
+					So(err, ShouldBeNil)
+					So(b3.count(), ShouldEqual, 0)
+				})
+			})
+		})
+	})
+
+	Convey("Given a topology having a checkpointable source", t, func() {
+		ctx := NewContext(nil)
+		s := NewInMemoryCheckpointStorage()
+		t, err := newCheckpointableTopology(ctx, "test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			t.Stop()
+		})
+
+		so := newOffsetSource()
+		_, err = t.AddSource("source", so, nil)
+		So(err, ShouldBeNil)
+		si := NewTupleCollectorSink()
+		sin, err := t.AddSink("sink", si, nil)
+		So(err, ShouldBeNil)
+		So(sin.Input("source", nil), ShouldBeNil)
+		so.next <- struct{}{}
+		so.next <- struct{}{}
+		si.Wait(2)
+
+		Convey("When taking a checkpoint while the source is idle", func() {
+			_, err := t.Checkpoint(s, 10*time.Millisecond)
+
+			Convey("Then it should time out", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When taking a checkpoint", func() {
+			ch := make(chan error, 1)
+			go func() {
+				_, err := t.Checkpoint(s, 10*time.Second)
+				ch <- err
+			}()
+			// The offset is recorded when the source writes the next tuple.
+			cw := t.(*defaultTopology).sources["source"].cw
+			for {
+				cw.m.Lock()
+				injected := cw.pending != nil
+				cw.m.Unlock()
+				if injected {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			so.next <- struct{}{}
+			So(<-ch, ShouldBeNil)
+
+			Convey("Then a new topology should restore the offset of the source", func() {
+				t2, err := newCheckpointableTopology(NewContext(nil), "test")
+				So(err, ShouldBeNil)
+				defer t2.Stop()
+				_, err = t2.Restore(s)
+				So(err, ShouldBeNil)
+
+				so2 := newOffsetSource()
+				_, err = t2.AddSource("source", so2, nil)
+				So(err, ShouldBeNil)
+				So(so2.position(), ShouldEqual, 2)
+			})
+		})
+	})
+
+	Convey("Given a topology having a checkpointable box with parallelism", t, func() {
+		ctx := NewContext(nil)
+		s := NewInMemoryCheckpointStorage()
+		t, err := newCheckpointableTopology(ctx, "test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			t.Stop()
+		})
+
+		so := newOffsetSource()
+		_, err = t.AddSource("source", so, nil)
+		So(err, ShouldBeNil)
+		b := &concurrentCountingBox{}
+		bn, err := t.AddBox("box", b, &BoxConfig{Parallelism: 4})
+		So(err, ShouldBeNil)
+		So(bn.Input("source", nil), ShouldBeNil)
+		si := NewTupleCollectorSink()
+		sin, err := t.AddSink("sink", si, nil)
+		So(err, ShouldBeNil)
+		So(sin.Input("box", nil), ShouldBeNil)
+
+		const n = 100
+		emit := func(so *offsetSource, num int) {
+			for i := 0; i < num; i++ {
+				so.next <- struct{}{}
+			}
+		}
+
+		Convey("When taking a checkpoint while tuples are being processed", func() {
+			emit(so, n/2)
+			ch := make(chan error, 1)
+			go func() {
+				_, err := t.Checkpoint(s, 10*time.Second)
+				ch <- err
+			}()
+			cw := t.(*defaultTopology).sources["source"].cw
+			for {
+				cw.m.Lock()
+				injected := cw.pending != nil
+				cw.m.Unlock()
+				if injected {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			emit(so, n/2)
+			So(<-ch, ShouldBeNil)
+			si.Wait(n)
+
+			Convey("Then a new topology restored from it should emit the rest of the output", func() {
+				t2, err := newCheckpointableTopology(NewContext(nil), "test")
+				So(err, ShouldBeNil)
+				defer t2.Stop()
+				_, err = t2.Restore(s)
+				So(err, ShouldBeNil)
+
+				so2 := newOffsetSource()
+				_, err = t2.AddSource("source", so2, nil)
+				So(err, ShouldBeNil)
+				b2 := &concurrentCountingBox{}
+				bn2, err := t2.AddBox("box", b2, &BoxConfig{Parallelism: 4})
+				So(err, ShouldBeNil)
+				So(bn2.Input("source", nil), ShouldBeNil)
+				si2 := NewTupleCollectorSink()
+				sin2, err := t2.AddSink("sink", si2, nil)
+				So(err, ShouldBeNil)
+				So(sin2.Input("box", nil), ShouldBeNil)
+
+				// the state of the box must reflect exactly the tuples
+				// before the offset of the source
+				offset := so2.position()
+				So(b2.count(), ShouldEqual, offset)
+
+				emit(so2, n-offset)
+				si2.Wait(n - offset)
+				So(b2.count(), ShouldEqual, n)
+
+				// the output of both topologies must be the same as the
+				// output of the topology which isn't restored
+				restored := map[int64]bool{}
+				for i := 0; i < si2.len(); i++ {
+					p, _ := data.AsInt(si2.get(i).Data["pos"])
+					restored[p] = true
+				}
+				original := map[int64]bool{}
+				for i := 0; i < si.len(); i++ {
+					p, _ := data.AsInt(si.get(i).Data["pos"])
+					if p >= int64(offset) {
+						original[p] = true
+					}
+				}
+				So(si2.len(), ShouldEqual, n-offset)
+				So(restored, ShouldResemble, original)
+			})
+		})
+	})
+}
@@ -86,10 +86,30 @@ func (db *defaultBoxNode) run() (runErr error) {
 	}()
 	db.state.Set(TSRunning)
 	w := newBoxWriterAdapter(db.box, db.name, db.dsts)
-	db.runErr = db.srcs.pour(db.topology.ctx, w, 1) // TODO: make parallelism configurable
+	db.runErr = db.srcs.pour(db.topology.ctx, w, db.parallelism())
 	return
 }
 
+// parallelism returns the number of goroutines processing tuples. It's
+// always 1 unless the box supports concurrent processing.
+func (db *defaultBoxNode) parallelism() int {
+	if cb, ok := db.box.(ConcurrentBox); !ok || !cb.Concurrent() {
+		return 1
+	}
+	if db.config.Parallelism == 0 {
+		return 1
+	}
+	return db.config.Parallelism
+}
+
+// checkpoint saves the state of the box and forwards the barrier to
+// destinations. It's called when barriers have arrived from all inputs.
+func (db *defaultBoxNode) checkpoint(ctx *Context, b *checkpointBarrier) {
+	err := saveBoxCheckpoint(ctx, b, db.name, db.box)
+	db.dsts.writeBarrier(ctx, b)
+	b.progress.ack(db.name, err)
+}
+
 func (db *defaultBoxNode) Stop() error {
 	db.stop()
 	return nil
@@ -145,6 +165,7 @@ func (db *defaultBoxNode) Status() data.Map {
 			"stop_on_outbound_disconnect": data.Bool((connDir & Outbound) != 0),
 			"graceful_stop":               data.Bool(gstop),
 			"remove_on_stop":              data.Bool(removeOnStop),
+			"parallelism":                 data.Int(db.parallelism()),
 		},
 	}
 	if st == TSStopped && db.runErr != nil {
@@ -10,6 +10,8 @@ type defaultSinkNode struct {
 	config *SinkConfig
 	srcs   *dataSources
 	sink   Sink
+	// batch is used instead of sink when the sink implements BatchSink.
+	batch *batchWriter
 
 	gracefulStopEnabled     bool
 	stopOnDisconnectEnabled bool
@@ -82,10 +84,30 @@ func (ds *defaultSinkNode) run() (runErr error) {
 		}
 	}()
 	ds.state.Set(TSRunning)
-	ds.runErr = ds.srcs.pour(ds.topology.ctx, newTraceWriter(ds.sink, ETInput, ds.name), 1)
+	if ds.batch == nil {
+		ds.runErr = ds.srcs.pour(ds.topology.ctx, newTraceWriter(ds.sink, ETInput, ds.name), ds.parallelism())
+		return
+	}
+
+	ds.runErr = ds.srcs.pour(ds.topology.ctx, newTraceWriter(ds.batch, ETInput, ds.name), ds.parallelism())
+	// Tuples still in the batch have to be written before closing the sink.
+	if err := ds.batch.Close(ds.topology.ctx); err != nil && ds.runErr == nil {
+		ds.runErr = err
+	}
 	return
 }
 
+// checkpoint is called when barriers have arrived from all inputs. A Sink
+// doesn't have a state to be saved, but buffered tuples are written so that
+// they're included in the checkpoint.
+func (ds *defaultSinkNode) checkpoint(ctx *Context, b *checkpointBarrier) {
+	var err error
+	if ds.batch != nil {
+		err = ds.batch.flushAll(ctx)
+	}
+	b.progress.ack(ds.name, err)
+}
+
 func (ds *defaultSinkNode) Stop() error {
 	ds.stop()
 	return nil
@@ -113,6 +135,18 @@ func (ds *defaultSinkNode) stop() {
 	ds.state.Wait(TSStopped)
 }
 
+// parallelism returns the number of goroutines writing tuples. It's
+// always 1 unless the sink supports concurrent writes.
+func (ds *defaultSinkNode) parallelism() int {
+	if cs, ok := ds.sink.(ConcurrentSink); !ok || !cs.Concurrent() {
+		return 1
+	}
+	if ds.config.Parallelism == 0 {
+		return 1
+	}
+	return ds.config.Parallelism
+}
+
 func (ds *defaultSinkNode) Status() data.Map {
 	ds.stateMutex.Lock()
 	st := ds.state.getWithoutLock()
@@ -121,14 +155,20 @@ func (ds *defaultSinkNode) Status() data.Map {
 	removeOnStop := ds.config.RemoveOnStop
 	ds.stateMutex.Unlock()
 
+	behaviors := data.Map{
+		"stop_on_disconnect": data.Bool(stopOnDisconnect),
+		"graceful_stop":      data.Bool(gstop),
+		"remove_on_stop":     data.Bool(removeOnStop),
+		"parallelism":        data.Int(ds.parallelism()),
+	}
+	if ds.batch != nil {
+		behaviors["max_batch_size"] = data.Int(ds.batch.maxSize)
+		behaviors["max_batch_latency"] = data.Float(ds.batch.maxLatency.Seconds())
+	}
 	m := data.Map{
 		"state":       data.String(st.String()),
 		"input_stats": ds.srcs.status(),
-		"behaviors": data.Map{
-			"stop_on_disconnect": data.Bool(stopOnDisconnect),
-			"graceful_stop":      data.Bool(gstop),
-			"remove_on_stop":     data.Bool(removeOnStop),
-		},
+		"behaviors":   behaviors,
 	}
 	if st == TSStopped && ds.runErr != nil {
 		m["error"] = data.String(ds.runErr.Error())
@@ -11,6 +11,7 @@ type defaultSourceNode struct {
 	config                  *SourceConfig
 	source                  Source
 	dsts                    *dataDestinations
+	cw                      *checkpointWriter
 	pausedOnStartup         bool
 	stopOnDisconnectEnabled bool
 	runErr                  error
@@ -56,7 +57,7 @@ func (ds *defaultSourceNode) run() (runErr error) {
 		return
 	}
 
-	ds.runErr = ds.source.GenerateStream(ds.topology.ctx, newTraceWriter(ds.dsts, ETOutput, ds.name))
+	ds.runErr = ds.source.GenerateStream(ds.topology.ctx, newTraceWriter(ds.cw, ETOutput, ds.name))
 	return
 }
 
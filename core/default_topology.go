@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 )
 
 type defaultTopology struct {
@@ -21,13 +22,24 @@ type defaultTopology struct {
 	state      *topologyStateHolder
 	stateMutex sync.Mutex
 
+	// checkpointMutex serializes Checkpoint and Restore. It protects
+	// lastCheckpointID.
+	checkpointMutex  sync.Mutex
+	lastCheckpointID int64
+
+	// restoring is the checkpoint from which nodes restore their states when
+	// they're added to the topology. It's protected by nodeMutex.
+	restoring *restoringCheckpoint
+
 	// TODO: support lazy invocation of GenerateStream (call it when the first
 	// destination is added or a Sink is indirectly connected). Maybe graph
 	// management is required.
 }
 
+var _ CheckpointableTopology = &defaultTopology{}
+
 // NewDefaultTopology creates a topology having a simple graph
-// structure.
+// structure. The returned Topology also implements CheckpointableTopology.
 func NewDefaultTopology(ctx *Context, name string) (Topology, error) {
 	if err := ValidateSymbol(name); err != nil {
 		return nil, err
@@ -90,11 +102,20 @@ func (t *defaultTopology) AddSource(name string, s Source, config *SourceConfig)
 	ds.config = &SourceConfig{}
 	*ds.config = *config
 	ds.dsts.callback = ds.dstCallback
+	ds.cw = &checkpointWriter{
+		node:   name,
+		source: s,
+		dsts:   ds.dsts,
+	}
 	if err := t.checkNodeNameDuplication(name); err != nil {
 		// Because the source isn't started yet, it doesn't return an error.
 		ds.Stop()
 		return nil, err
 	}
+	if err := t.restoreSource(name, s); err != nil {
+		ds.Stop()
+		return nil, err
+	}
 	t.sources[strings.ToLower(name)] = ds
 
 	go func() {
@@ -149,6 +170,9 @@ func (t *defaultTopology) AddBox(name string, b Box, config *BoxConfig) (BoxNode
 	if config == nil {
 		config = &BoxConfig{}
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 
 	t.nodeMutex.Lock()
 	defer t.nodeMutex.Unlock()
@@ -177,6 +201,15 @@ func (t *defaultTopology) AddBox(name string, b Box, config *BoxConfig) (BoxNode
 			return nil, err
 		}
 	}
+	if err := t.restoreBox(name, b); err != nil {
+		if sb, ok := b.(StatefulBox); ok {
+			if err := sb.Terminate(t.ctx); err != nil {
+				t.ctx.ErrLog(err).WithFields(nodeLogFields(NTBox, name)).
+					Error("Cannot terminate the box")
+			}
+		}
+		return nil, err
+	}
 
 	db := &defaultBoxNode{
 		defaultNode: newDefaultNode(t, name, config.Meta),
@@ -187,6 +220,7 @@ func (t *defaultTopology) AddBox(name string, b Box, config *BoxConfig) (BoxNode
 	db.config = &BoxConfig{}
 	*db.config = *config
 	db.dsts.callback = db.dstCallback
+	db.srcs.onBarrier = db.checkpoint
 	t.boxes[strings.ToLower(name)] = db
 
 	go func() {
@@ -238,6 +272,10 @@ func (t *defaultTopology) AddSink(name string, s Sink, config *SinkConfig) (Sink
 	if config == nil {
 		config = &SinkConfig{}
 	}
+	if err := config.Validate(); err != nil {
+		closeSinkFlag = true
+		return nil, err
+	}
 
 	t.nodeMutex.Lock()
 	defer t.nodeMutex.Unlock()
@@ -258,6 +296,10 @@ func (t *defaultTopology) AddSink(name string, s Sink, config *SinkConfig) (Sink
 	}
 	ds.config = &SinkConfig{}
 	*ds.config = *config
+	if bs, ok := s.(BatchSink); ok {
+		ds.batch = newBatchWriter(t.ctx, name, bs, ds.config)
+	}
+	ds.srcs.onBarrier = ds.checkpoint
 	t.sinks[strings.ToLower(name)] = ds
 
 	go func() {
@@ -333,6 +375,155 @@ func (t *defaultTopology) Stop() error {
 	return lastErr
 }
 
+func (t *defaultTopology) Checkpoint(s CheckpointStorage, timeout time.Duration) (int64, error) {
+	t.checkpointMutex.Lock()
+	defer t.checkpointMutex.Unlock()
+
+	id := t.lastCheckpointID
+	if l, err := s.Latest(t.name); err == nil {
+		if l > id {
+			id = l
+		}
+	} else if !IsNotExist(err) {
+		return 0, err
+	}
+	id++
+	t.lastCheckpointID = id // IDs aren't reused even if this checkpoint fails
+
+	var (
+		sources []*defaultSourceNode
+		boxes   []*defaultBoxNode // boxes which don't have inputs
+		nodes   []string
+	)
+	err := func() error {
+		t.nodeMutex.Lock()
+		defer t.nodeMutex.Unlock()
+		if t.state.Get() >= TSStopping {
+			return fmt.Errorf("the topology is already stopped")
+		}
+		t.restoring = nil
+
+		for _, src := range t.sources {
+			if src.state.Get() < TSStopping {
+				sources = append(sources, src)
+				nodes = append(nodes, src.name)
+			}
+		}
+		for _, b := range t.boxes {
+			if b.state.Get() >= TSStopping {
+				continue
+			}
+			if b.srcs.numInputs() == 0 {
+				boxes = append(boxes, b)
+			}
+			nodes = append(nodes, b.name)
+		}
+		for _, sink := range t.sinks {
+			if sink.state.Get() < TSStopping && sink.srcs.numInputs() > 0 {
+				nodes = append(nodes, sink.name)
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		return 0, err
+	}
+
+	b := &checkpointBarrier{
+		id:       id,
+		topology: t.name,
+		storage:  s,
+		progress: newCheckpointProgress(nodes),
+	}
+	// Barriers are sent asynchronously because sending them blocks while
+	// queues of destinations are full.
+	for _, db := range boxes {
+		go db.checkpoint(t.ctx, b)
+	}
+	for _, src := range sources {
+		go src.cw.inject(t.ctx, b)
+	}
+
+	if timeout > 0 {
+		select {
+		case <-b.progress.done:
+		case <-time.After(timeout):
+			return 0, fmt.Errorf("checkpoint %v timed out", id)
+		}
+	} else {
+		<-b.progress.done
+	}
+	if err := b.progress.error(); err != nil {
+		return 0, err
+	}
+	if err := s.Complete(t.name, id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (t *defaultTopology) Restore(s CheckpointStorage) (int64, error) {
+	t.checkpointMutex.Lock()
+	defer t.checkpointMutex.Unlock()
+
+	id, err := s.Latest(t.name)
+	if err != nil {
+		return 0, err
+	}
+	if id > t.lastCheckpointID {
+		t.lastCheckpointID = id
+	}
+
+	t.nodeMutex.Lock()
+	defer t.nodeMutex.Unlock()
+	t.restoring = &restoringCheckpoint{
+		storage: s,
+		id:      id,
+	}
+	return id, nil
+}
+
+type restoringCheckpoint struct {
+	storage CheckpointStorage
+	id      int64
+}
+
+// restoreSource seeks the source to the offset saved in the checkpoint
+// being restored. It doesn't acquire the lock and it's the caller's
+// responsibility to do it before calling this method.
+func (t *defaultTopology) restoreSource(name string, s Source) error {
+	r := t.restoring
+	cs, ok := s.(CheckpointableSource)
+	if r == nil || !ok {
+		return nil
+	}
+	offset, err := loadSourceOffset(r.storage, t.name, r.id, name)
+	if err != nil {
+		if IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("cannot restore the offset of source '%v': %v", name, err)
+	}
+	if err := cs.Seek(t.ctx, offset); err != nil {
+		return fmt.Errorf("cannot restore the offset of source '%v': %v", name, err)
+	}
+	return nil
+}
+
+// restoreBox restores the state of the box from the checkpoint being
+// restored. It doesn't acquire the lock and it's the caller's responsibility
+// to do it before calling this method.
+func (t *defaultTopology) restoreBox(name string, b Box) error {
+	r := t.restoring
+	if r == nil {
+		return nil
+	}
+	if err := loadBoxCheckpoint(t.ctx, r.storage, t.name, r.id, name, b); err != nil {
+		return fmt.Errorf("cannot restore the state of box '%v': %v", name, err)
+	}
+	return nil
+}
+
 func (t *defaultTopology) State() TopologyStateHolder {
 	return t.state
 }
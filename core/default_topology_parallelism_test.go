@@ -0,0 +1,207 @@
+package core
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
+	"testing"
+	"time"
+)
+
+// concurrencyCounter records the maximum number of goroutines which
+// entered it at the same time.
+type concurrencyCounter struct {
+	m      sync.Mutex
+	active int
+	max    int
+
+	// release blocks goroutines in enter until it's closed.
+	release chan struct{}
+}
+
+func newConcurrencyCounter() *concurrencyCounter {
+	return &concurrencyCounter{
+		release: make(chan struct{}),
+	}
+}
+
+func (c *concurrencyCounter) enter() {
+	c.m.Lock()
+	c.active++
+	if c.active > c.max {
+		c.max = c.active
+	}
+	c.m.Unlock()
+	<-c.release
+	time.Sleep(time.Millisecond)
+	c.m.Lock()
+	c.active--
+	c.m.Unlock()
+}
+
+func (c *concurrencyCounter) maxActive() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.max
+}
+
+// waitMaxActive waits until n goroutines enter the counter at the same
+// time. It gives up after a while.
+func (c *concurrencyCounter) waitMaxActive(n int) {
+	for i := 0; i < 1000 && c.maxActive() < n; i++ {
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+type concurrencyCountingBox struct {
+	*concurrencyCounter
+	concurrent bool
+}
+
+func (b *concurrencyCountingBox) Process(ctx *Context, t *Tuple, w Writer) error {
+	b.enter()
+	return w.Write(ctx, t)
+}
+
+func (b *concurrencyCountingBox) Concurrent() bool {
+	return b.concurrent
+}
+
+type concurrencyCountingSink struct {
+	*concurrencyCounter
+	TupleCollectorSink
+	concurrent bool
+}
+
+func (s *concurrencyCountingSink) Write(ctx *Context, t *Tuple) error {
+	s.enter()
+	return s.TupleCollectorSink.Write(ctx, t)
+}
+
+func (s *concurrencyCountingSink) Concurrent() bool {
+	return s.concurrent
+}
+
+func TestDefaultTopologyParallelism(t *testing.T) {
+	tuples := make([]*Tuple, 4)
+	for i := range tuples {
+		tuples[i] = NewTuple(data.Map{"i": data.Int(i)})
+	}
+
+	Convey("Given a default topology", t, func() {
+		ctx := NewContext(nil)
+		t, err := NewDefaultTopology(ctx, "test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			t.Stop()
+		})
+
+		so := NewTupleEmitterSource(tuples)
+		son, err := t.AddSource("source", so, &SourceConfig{
+			PausedOnStartup: true,
+		})
+		So(err, ShouldBeNil)
+
+		Convey("When adding a concurrent box with parallelism", func() {
+			b := &concurrencyCountingBox{newConcurrencyCounter(), true}
+			bn, err := t.AddBox("box", b, &BoxConfig{Parallelism: 4})
+			So(err, ShouldBeNil)
+			So(bn.Input("source", nil), ShouldBeNil)
+			si := NewTupleCollectorSink()
+			sin, err := t.AddSink("sink", si, nil)
+			So(err, ShouldBeNil)
+			So(sin.Input("box", nil), ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+
+			Convey("Then tuples should be processed concurrently", func() {
+				b.waitMaxActive(4)
+				close(b.release)
+				si.Wait(4)
+				So(b.maxActive(), ShouldEqual, 4)
+			})
+
+			Convey("Then its status should have the parallelism", func() {
+				close(b.release)
+				v, err := bn.Status().Get(data.MustCompilePath("behaviors.parallelism"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(4))
+			})
+		})
+
+		Convey("When adding a non-concurrent box with parallelism", func() {
+			b := &concurrencyCountingBox{newConcurrencyCounter(), false}
+			close(b.release)
+			bn, err := t.AddBox("box", b, &BoxConfig{Parallelism: 4})
+			So(err, ShouldBeNil)
+			So(bn.Input("source", nil), ShouldBeNil)
+			si := NewTupleCollectorSink()
+			sin, err := t.AddSink("sink", si, nil)
+			So(err, ShouldBeNil)
+			So(sin.Input("box", nil), ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+
+			Convey("Then tuples should be processed one by one in order", func() {
+				si.Wait(4)
+				So(b.maxActive(), ShouldEqual, 1)
+				for i, t := range si.Tuples {
+					So(t.Data["i"], ShouldEqual, data.Int(i))
+				}
+			})
+
+			Convey("Then its status should have the effective parallelism", func() {
+				v, err := bn.Status().Get(data.MustCompilePath("behaviors.parallelism"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(1))
+			})
+		})
+
+		Convey("When adding a concurrent sink with parallelism", func() {
+			si := &concurrencyCountingSink{concurrencyCounter: newConcurrencyCounter(), concurrent: true}
+			si.c = sync.NewCond(&si.TupleCollectorSink.m)
+			sin, err := t.AddSink("sink", si, &SinkConfig{Parallelism: 4})
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+
+			Convey("Then tuples should be written concurrently", func() {
+				si.waitMaxActive(4)
+				close(si.release)
+				si.Wait(4)
+				So(si.maxActive(), ShouldEqual, 4)
+			})
+		})
+
+		Convey("When adding a non-concurrent sink with parallelism", func() {
+			si := &concurrencyCountingSink{concurrencyCounter: newConcurrencyCounter()}
+			si.c = sync.NewCond(&si.TupleCollectorSink.m)
+			close(si.release)
+			sin, err := t.AddSink("sink", si, &SinkConfig{Parallelism: 4})
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+
+			Convey("Then tuples should be written one by one", func() {
+				si.Wait(4)
+				So(si.maxActive(), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When adding a box with invalid parallelism", func() {
+			Convey("Then it should fail", func() {
+				for _, p := range []int{-1, MaxParallelism + 1} {
+					_, err := t.AddBox("box", &DoesNothingBox{}, &BoxConfig{Parallelism: p})
+					So(err, ShouldNotBeNil)
+				}
+			})
+		})
+
+		Convey("When adding a sink with invalid parallelism", func() {
+			Convey("Then it should fail", func() {
+				for _, p := range []int{-1, MaxParallelism + 1} {
+					_, err := t.AddSink("sink", &DoesNothingSink{}, &SinkConfig{Parallelism: p})
+					So(err, ShouldNotBeNil)
+				}
+			})
+		})
+	})
+}
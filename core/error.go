@@ -150,6 +150,32 @@ func TemporaryError(err error) error {
 	return &temporaryError{err: err}
 }
 
+// BatchError is returned from BatchSink.WriteBatch when some of the tuples in
+// a batch couldn't be written. Errors must have the same length as the batch
+// and its i-th element is the error of the i-th tuple, or nil when the tuple
+// was written successfully.
+type BatchError struct {
+	Errors []error
+}
+
+func (b *BatchError) Error() string {
+	n := 0
+	var first error
+	for _, e := range b.Errors {
+		if e == nil {
+			continue
+		}
+		if first == nil {
+			first = e
+		}
+		n++
+	}
+	if first == nil {
+		return "no tuple in the batch failed"
+	}
+	return fmt.Sprintf("%v of %v tuples in the batch couldn't be written: %v", n, len(b.Errors), first)
+}
+
 // TODO: add a hybrid error interface having all possible methods which can
 // customize behavior by setting flags.
 
@@ -200,3 +226,47 @@ func NotExistError(err error) error {
 		err: err,
 	}
 }
+
+// IsLateTupleError returns true when the error was caused by a tuple that
+// arrived after the watermark of the receiver had passed its timestamp.
+// Such tuples are reported as dropped tuples with ETLate event type.
+//
+// If the error implements following interface, IsLateTupleError returns
+// the return value of LateTuple method:
+//
+//	interface {
+//		LateTuple() bool
+//	}
+func IsLateTupleError(err error) bool {
+	type lateTuple interface {
+		LateTuple() bool
+	}
+	l, ok := err.(lateTuple)
+	if !ok {
+		return false
+	}
+	return l.LateTuple()
+}
+
+type lateTupleError struct {
+	err error
+}
+
+func (l *lateTupleError) Error() string {
+	return l.err.Error()
+}
+
+func (l *lateTupleError) LateTuple() bool {
+	return true
+}
+
+// LateTupleError decorates the given error so that IsLateTupleError returns
+// true even if err doesn't have LateTuple method. It will panic if err is nil.
+func LateTupleError(err error) error {
+	if err == nil {
+		panic(fmt.Errorf("the error cannot be nil"))
+	}
+	return &lateTupleError{
+		err: err,
+	}
+}
@@ -225,3 +225,58 @@ func TestNotExistError(t *testing.T) {
 		})
 	})
 }
+
+type fakeLateTupleError struct {
+	late bool
+}
+
+func (f *fakeLateTupleError) Error() string {
+	return "fake error message"
+}
+
+func (f *fakeLateTupleError) LateTuple() bool {
+	return f.late
+}
+
+func TestLateTupleError(t *testing.T) {
+	Convey("Given an error", t, func() {
+		Convey("When the error is nil", func() {
+			Convey("Then it shouldn't be a late tuple error", func() {
+				So(IsLateTupleError(nil), ShouldBeFalse)
+			})
+
+			Convey("Then LateTupleError should panic", func() {
+				So(func() {
+					LateTupleError(nil)
+				}, ShouldPanic)
+			})
+		})
+
+		Convey("When the error implements LateTuple method", func() {
+			Convey("Then it can be a late tuple error", func() {
+				So(IsLateTupleError(&fakeLateTupleError{true}), ShouldBeTrue)
+			})
+
+			Convey("Then it can also be a non-late tuple error by configuration", func() {
+				So(IsLateTupleError(&fakeLateTupleError{false}), ShouldBeFalse)
+			})
+		})
+
+		Convey("When the error doesn't implement LateTuple method", func() {
+			err := errors.New("test failure")
+
+			Convey("Then it shouldn't be a late tuple error", func() {
+				So(IsLateTupleError(err), ShouldBeFalse)
+			})
+
+			Convey("Then it can be wrapped as a late tuple error", func() {
+				e := LateTupleError(err)
+				So(IsLateTupleError(e), ShouldBeTrue)
+
+				Convey("And the original error message shouldn't be changed", func() {
+					So(e.Error(), ShouldEqual, "test failure")
+				})
+			})
+		})
+	})
+}
@@ -267,6 +267,9 @@ const (
 const (
 	// MaxCapacity is the maximum capacity or buffer size of pipes.
 	MaxCapacity int = 1<<17 - 1
+
+	// MaxParallelism is the maximum parallelism of a Box or a Sink.
+	MaxParallelism int = 256
 )
 
 func validateCapacity(c int) error {
@@ -279,6 +282,16 @@ func validateCapacity(c int) error {
 	return nil
 }
 
+func validateParallelism(p int) error {
+	if p > MaxParallelism {
+		return fmt.Errorf("specified parallelism %d is too large (must be <= %d)",
+			p, MaxParallelism)
+	} else if p < 0 {
+		return fmt.Errorf("specified parallelism %d must not be negative", p)
+	}
+	return nil
+}
+
 // BoxInputConfig has parameters to customize input behavior of a Box on each
 // input pipe.
 type BoxInputConfig struct {
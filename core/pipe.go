@@ -145,6 +145,22 @@ func (s *pipeSender) write(ctx *Context, in *Tuple, droppedTuple func(*Tuple)) e
 	return nil
 }
 
+// writeBarrier sends a barrier of a checkpoint to the pipe. The barrier is
+// never dropped regardless of dropMode. It only returns errPipeClosed.
+func (s *pipeSender) writeBarrier(ctx *Context, b *checkpointBarrier) error {
+	s.rwm.RLock()
+	defer s.rwm.RUnlock()
+
+	if s.closed {
+		return errPipeClosed
+	}
+	s.out <- &Tuple{
+		InputName: s.inputName,
+		barrier:   b,
+	}
+	return nil
+}
+
 // Close closes a channel. When multiple goroutines try to close the channel,
 // only one goroutine can actually close it. Other goroutines don't wait until
 // the channel is actually closed. Close never fails.
@@ -228,6 +244,14 @@ type dataSources struct {
 	// msgChs is a slice of channels which are connected to goroutines
 	// pouring tuples. They receive controlling messages through this channel.
 	msgChs []chan<- *dataSourcesMessage
+
+	// aligner aligns barriers of checkpoints received from inputs.
+	aligner checkpointAligner
+
+	// onBarrier is called when barriers of a checkpoint have arrived from all
+	// inputs. It must be set before pour is called. Barriers are discarded
+	// when it's nil.
+	onBarrier func(ctx *Context, b *checkpointBarrier)
 }
 
 func newDataSources(nodeType NodeType, nodeName string) *dataSources {
@@ -281,6 +305,19 @@ func (s *dataSources) add(name string, r *pipeReceiver) error {
 	return nil
 }
 
+// numInputs returns the number of inputs which are still open.
+func (s *dataSources) numInputs() int {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	n := 0
+	for _, r := range s.recvs {
+		if !r.sender.isClosed() {
+			n++
+		}
+	}
+	return n
+}
+
 func (s *dataSources) sendMessage(msg *dataSourcesMessage) {
 	s.m.Lock()
 	defer s.m.Unlock()
@@ -375,54 +412,57 @@ func (s *dataSources) pour(ctx *Context, w Writer, parallelism int) error {
 		// ensureLocked ensures proper lock for s. Removing this introduces
 		// race conditions because genCases requires locked s and genCases is
 		// called in goroutines.
+		//
+		// Only one pouringThread receives tuples even when parallelism is
+		// greater than 1 so that tuples and barriers of checkpoints from
+		// each input are received in the order they were sent. Instead,
+		// the pouringThread writes tuples with multiple goroutines.
 		var ensureLocked sync.WaitGroup
-		for i := 0; i < parallelism; i++ {
-			msgCh := make(chan *dataSourcesMessage)
-			s.msgChs = append(s.msgChs, msgCh)
-
-			wg.Add(1)
-			ensureLocked.Add(1)
-			go func() {
-				defer wg.Done()
-				needDone := true
-				defer func() {
-					if needDone {
-						ensureLocked.Done()
-					}
-				}()
-				cs := genCases(msgCh)
-				ensureLocked.Done()
-				needDone = false
-				ins, err := s.pouringThread(ctx, w, cs)
-				collectInputs.Do(func() {
-					// It's sufficient to collect input only once. The only
-					// problem which might happen is that ins has old receivers.
-					// However, they will simply be removed when calling
-					// reflect.Select. There might be a case that only one
-					// pouringThread has a newly added receiver but it isn't
-					// assigned to inputs. To solve that problem, pour method
-					// also reads tuples from s.recvs.
-					//
-					// In addition, when pouringThread panics while remove
-					// method is called, s.recvs might not have receivers
-					// which pour method should read tuples. However, inputs
-					// returned from pouringThread has them. If the returned
-					// inputs doesn't have them, that means they were already
-					// removed successfully.
-					//
-					// In conclusion, by combining s.recvs and inputs, all
-					// inputs can be drained and no sender will be blocked.
-					inputs = ins
-				})
-				if err != nil {
-					logOnce.Do(func() {
-						threadErr = err // return only one error
-						ctx.ErrLog(err).WithFields(nodeLogFields(s.nodeType, s.nodeName)).
-							Error("the node stopped with a fatal error")
-					})
+		msgCh := make(chan *dataSourcesMessage)
+		s.msgChs = append(s.msgChs, msgCh)
+
+		wg.Add(1)
+		ensureLocked.Add(1)
+		go func() {
+			defer wg.Done()
+			needDone := true
+			defer func() {
+				if needDone {
+					ensureLocked.Done()
 				}
 			}()
-		}
+			cs := genCases(msgCh)
+			ensureLocked.Done()
+			needDone = false
+			ins, err := s.pouringThread(ctx, w, parallelism, cs)
+			collectInputs.Do(func() {
+				// It's sufficient to collect input only once. The only
+				// problem which might happen is that ins has old receivers.
+				// However, they will simply be removed when calling
+				// reflect.Select. There might be a case that only one
+				// pouringThread has a newly added receiver but it isn't
+				// assigned to inputs. To solve that problem, pour method
+				// also reads tuples from s.recvs.
+				//
+				// In addition, when pouringThread panics while remove
+				// method is called, s.recvs might not have receivers
+				// which pour method should read tuples. However, inputs
+				// returned from pouringThread has them. If the returned
+				// inputs doesn't have them, that means they were already
+				// removed successfully.
+				//
+				// In conclusion, by combining s.recvs and inputs, all
+				// inputs can be drained and no sender will be blocked.
+				inputs = ins
+			})
+			if err != nil {
+				logOnce.Do(func() {
+					threadErr = err // return only one error
+					ctx.ErrLog(err).WithFields(nodeLogFields(s.nodeType, s.nodeName)).
+						Error("the node stopped with a fatal error")
+				})
+			}
+		}()
 		ensureLocked.Wait()
 		return nil
 	}()
@@ -470,7 +510,133 @@ func (s *dataSources) pour(ctx *Context, w Writer, parallelism int) error {
 	return threadErr
 }
 
-func (s *dataSources) pouringThread(ctx *Context, w Writer, cs []reflect.SelectCase) (inputs []reflect.SelectCase, retErr error) {
+// writeTuple writes a tuple received from an input to w. It returns an error
+// when the node has to stop.
+func (s *dataSources) writeTuple(ctx *Context, w Writer, t *Tuple) error {
+	atomic.AddInt64(&s.numReceived, 1)
+	err := w.Write(ctx, t)
+	if err == nil {
+		return nil
+	}
+
+	atomic.AddInt64(&s.numErrors, 1)
+	switch {
+	case IsFatalError(err):
+		// logging is done by pour method
+		s.reportDroppedTuple(ctx, t, err)
+		return err
+
+	case IsTemporaryError(err):
+		// TODO: retry
+		s.reportDroppedTuple(ctx, t, err) // TODO: don't write a tuple until retry fails
+
+	default:
+		// Skip this tuple
+		s.reportDroppedTuple(ctx, t, err)
+	}
+	return nil
+}
+
+// parallelWriter writes tuples received by a pouringThread with multiple
+// goroutines. Because the pouringThread is the only goroutine receiving
+// tuples from inputs, wait can make sure that all tuples received before a
+// barrier have been written when the barrier is handled.
+type parallelWriter struct {
+	s *dataSources
+	w Writer
+
+	ch       chan *Tuple
+	workers  sync.WaitGroup
+	inflight sync.WaitGroup
+
+	// m protects err, which is the first fatal error returned from w.
+	m   sync.Mutex
+	err error
+}
+
+func newParallelWriter(ctx *Context, s *dataSources, w Writer, parallelism int) *parallelWriter {
+	p := &parallelWriter{
+		s:  s,
+		w:  w,
+		ch: make(chan *Tuple),
+	}
+	for i := 0; i < parallelism; i++ {
+		p.workers.Add(1)
+		go func() {
+			defer p.workers.Done()
+			for t := range p.ch {
+				p.write(ctx, t)
+			}
+		}()
+	}
+	return p
+}
+
+func (p *parallelWriter) write(ctx *Context, t *Tuple) {
+	defer p.inflight.Done()
+	var err error
+	defer func() {
+		if e := recover(); e != nil {
+			if er, ok := e.(error); ok {
+				err = er
+			} else {
+				err = fmt.Errorf("'%v' got an unknown error through panic: %v", p.s.nodeName, e)
+			}
+			if !IsFatalError(err) {
+				err = FatalError(err)
+			}
+		}
+		if err != nil {
+			p.m.Lock()
+			if p.err == nil {
+				p.err = err
+			}
+			p.m.Unlock()
+		}
+	}()
+	err = p.s.writeTuple(ctx, p.w, t)
+}
+
+// Write passes the tuple to one of the goroutines. It returns a fatal error
+// when one of the previous tuples failed with it.
+func (p *parallelWriter) Write(ctx *Context, t *Tuple) error {
+	if err := p.fatalError(); err != nil {
+		p.s.reportDroppedTuple(ctx, t, err)
+		return err
+	}
+	p.inflight.Add(1)
+	p.ch <- t
+	return nil
+}
+
+func (p *parallelWriter) fatalError() error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.err
+}
+
+// wait waits until all tuples passed to Write have been written.
+func (p *parallelWriter) wait() {
+	p.inflight.Wait()
+}
+
+// close stops all goroutines after they write all tuples. It returns the
+// fatal error if any.
+func (p *parallelWriter) close() error {
+	close(p.ch)
+	p.workers.Wait()
+	return p.fatalError()
+}
+
+func (s *dataSources) reportDroppedTuple(ctx *Context, t *Tuple, err error) {
+	et := ETInput
+	if IsLateTupleError(err) {
+		et = ETLate
+	}
+	ctx.droppedTuple(t, s.nodeType, s.nodeName, et, err)
+}
+
+func (s *dataSources) pouringThread(ctx *Context, w Writer, parallelism int, cs []reflect.SelectCase) (inputs []reflect.SelectCase, retErr error) {
 	const (
 		message = iota
 		defaultCase
@@ -515,12 +681,66 @@ func (s *dataSources) pouringThread(ctx *Context, w Writer, cs []reflect.SelectC
 	gracefulStopEnabled := false
 	stopOnDisconnect := false
 
-	reportDT := func(t *Tuple, err error) {
-		ctx.droppedTuple(t, s.nodeType, s.nodeName, ETInput, err)
+	write := func(t *Tuple) error {
+		return s.writeTuple(ctx, w, t)
+	}
+	var pw *parallelWriter
+	if parallelism > 1 {
+		pw = newParallelWriter(ctx, s, w, parallelism)
+		write = func(t *Tuple) error {
+			return pw.Write(ctx, t)
+		}
+		defer func() {
+			// This is called before the deferred function above so that
+			// the fatal error of a writer goroutine is returned.
+			if err := pw.close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+	}
+
+	// pending has tuples held back while aligning barriers. They have to be
+	// processed before receiving new tuples from inputs.
+	var pending []alignedTuple
+	numInputs := func() int {
+		return len(cs) - maxControlIndex - 1
+	}
+	barrierCompleted := func(b *checkpointBarrier, replay []alignedTuple) {
+		// Tuples held back are older than the ones still in pending.
+		pending = append(replay, pending...)
+		if b != nil && s.onBarrier != nil {
+			if pw != nil {
+				// The state saved by onBarrier must reflect all tuples
+				// received before the barrier.
+				pw.wait()
+			}
+			s.onBarrier(ctx, b)
+		}
+	}
+
+	// process processes a tuple received from the input channel ch. It
+	// returns an error when the node has to stop.
+	process := func(ch uintptr, t *Tuple) error {
+		ok, completed, replay := s.aligner.receive(ch, t, numInputs())
+		barrierCompleted(completed, replay)
+		if !ok {
+			return nil
+		}
+		return write(t)
 	}
 
 receiveLoop:
 	for {
+		if len(pending) > 0 {
+			p := pending[0]
+			pending = pending[1:]
+			if err := process(p.ch, p.t); err != nil {
+				retErr = err
+				return
+			}
+			continue
+		}
+
 		if stopOnDisconnect && len(cs) == maxControlIndex+1 {
 			// When stopOnDisconnect is enabled, this loop breaks if the data
 			// source doesn't have any input channel. Otherwise, it keeps
@@ -536,8 +756,10 @@ receiveLoop:
 			}
 
 			// remove the closed channel by swapping it with the last element.
+			ch := cs[i].Chan.Pointer()
 			cs[i], cs[len(cs)-1] = cs[len(cs)-1], cs[i]
 			cs = cs[:len(cs)-1]
+			barrierCompleted(s.aligner.inputClosed(ch, numInputs()))
 			continue
 		}
 
@@ -581,35 +803,17 @@ receiveLoop:
 			break receiveLoop
 
 		default:
-			atomic.AddInt64(&s.numReceived, 1)
 			t, ok := v.Interface().(*Tuple)
 			if !ok {
+				atomic.AddInt64(&s.numReceived, 1)
 				atomic.AddInt64(&s.numErrors, 1)
 				ctx.Log().WithFields(nodeLogFields(s.nodeType, s.nodeName)).
 					Error("Cannot receive a tuple from a receiver due to a type error")
 				break
 			}
-
-			err := w.Write(ctx, t)
-			if err == nil {
-				break
-			}
-
-			atomic.AddInt64(&s.numErrors, 1)
-			switch {
-			case IsFatalError(err):
-				// logging is done by pour method
+			if err := process(cs[i].Chan.Pointer(), t); err != nil {
 				retErr = err
-				reportDT(t, err)
 				return
-
-			case IsTemporaryError(err):
-				// TODO: retry
-				reportDT(t, err) // TODO: don't write a tuple until retry fails
-
-			default:
-				// Skip this tuple
-				reportDT(t, err)
 			}
 		}
 	}
@@ -866,6 +1070,18 @@ func (d *dataDestinations) Write(ctx *Context, t *Tuple) error {
 	return nil
 }
 
+// writeBarrier sends a barrier of a checkpoint to all destinations. Unlike
+// Write, it doesn't wait while the destinations are paused so that a
+// checkpoint can be taken with paused nodes.
+func (d *dataDestinations) writeBarrier(ctx *Context, b *checkpointBarrier) {
+	d.rwm.RLock()
+	defer d.rwm.RUnlock()
+	for _, dst := range d.dsts {
+		// Closed destinations will be removed by Write.
+		dst.writeBarrier(ctx, b)
+	}
+}
+
 func (d *dataDestinations) pause() {
 	d.rwm.Lock()
 	defer d.rwm.Unlock()
@@ -917,3 +1133,164 @@ func (d *dataDestinations) status() data.Map {
 	st["outputs"] = m
 	return st
 }
+
+// partitioningWriter routes each tuple to one of its partitions. Each
+// partition has its own queue and goroutine writing tuples to the Writer of
+// the partition.
+type partitioningWriter struct {
+	nodeName string
+	key      func(t *Tuple) (data.Value, error)
+	outs     []chan partitionedTuple
+	wg       sync.WaitGroup
+
+	// progress has the indices of partitions whose Writers implement
+	// ProgressWriter.
+	progress []int
+
+	// rwm protects outs from write-close conflicts.
+	rwm    sync.RWMutex
+	closed bool
+
+	// errm protects err. rwm cannot be used for it because a partition
+	// would be dead-locked when Write blocks on its full queue.
+	errm sync.Mutex
+	err  error
+}
+
+// partitionedTuple is a tuple queued for a partition. When progress is true,
+// the tuple was routed to another partition and is passed to Progress of the
+// partition's ProgressWriter.
+type partitionedTuple struct {
+	t        *Tuple
+	progress bool
+}
+
+// NewPartitioningWriter creates a WriteCloser which routes each tuple to one
+// of the given Writers. The Writer is chosen by data.Hash of the partition key
+// computed by key, so tuples having the same key are always written to the
+// same Writer.
+//
+// Each Writer has its own queue having the given capacity and is written by
+// its own goroutine. Therefore, Writers run concurrently while tuples routed
+// to the same Writer are written in the order in which they were written to
+// the partitioning writer. When a Writer implements ProgressWriter, a copy of
+// each tuple routed to other Writers is passed to its Progress method in the
+// same order, so that the Writer sees the progress of time of all tuples.
+// Write blocks while the queue of one of the partitions is full.
+//
+// A tuple for which a Writer returns an error is reported as a tuple dropped
+// by the Box having nodeName. Once a Writer returns a fatal error, tuples
+// routed to it are discarded and Write returns the error. Errors returned
+// from Progress are logged. Close waits until all queued tuples are written.
+// Write fails after Close is called.
+func NewPartitioningWriter(ctx *Context, nodeName string, key func(t *Tuple) (data.Value, error), ws []Writer, capacity int) WriteCloser {
+	w := &partitioningWriter{
+		nodeName: nodeName,
+		key:      key,
+		outs:     make([]chan partitionedTuple, len(ws)),
+	}
+	for i, dst := range ws {
+		if _, ok := dst.(ProgressWriter); ok {
+			w.progress = append(w.progress, i)
+		}
+		ch := make(chan partitionedTuple, capacity)
+		w.outs[i] = ch
+		w.wg.Add(1)
+		go w.drain(ctx, i, ch, dst)
+	}
+	return w
+}
+
+func (w *partitioningWriter) drain(ctx *Context, i int, in <-chan partitionedTuple, dst Writer) {
+	defer w.wg.Done()
+	var fatal error
+	for pt := range in {
+		if fatal != nil {
+			if !pt.progress {
+				w.reportDroppedTuple(ctx, pt.t, fatal)
+			}
+			continue
+		}
+
+		var err error
+		if pt.progress {
+			err = dst.(ProgressWriter).Progress(ctx, pt.t)
+			if err != nil {
+				ctx.ErrLog(err).WithFields(nodeLogFields(NTBox, w.nodeName)).
+					WithField("partition", i).Error("Cannot notify a partition of a tuple")
+			}
+		} else if err = dst.Write(ctx, pt.t); err != nil {
+			w.reportDroppedTuple(ctx, pt.t, err)
+		}
+		if err != nil && IsFatalError(err) {
+			ctx.ErrLog(err).WithFields(nodeLogFields(NTBox, w.nodeName)).
+				WithField("partition", i).Error("The partition stopped with a fatal error")
+			fatal = err
+			w.errm.Lock()
+			if w.err == nil {
+				w.err = err
+			}
+			w.errm.Unlock()
+		}
+	}
+}
+
+func (w *partitioningWriter) reportDroppedTuple(ctx *Context, t *Tuple, err error) {
+	et := ETInput
+	if IsLateTupleError(err) {
+		et = ETLate
+	}
+	ctx.droppedTuple(t, NTBox, w.nodeName, et, err)
+}
+
+func (w *partitioningWriter) Write(ctx *Context, t *Tuple) error {
+	w.errm.Lock()
+	err := w.err
+	w.errm.Unlock()
+	if err != nil {
+		return err
+	}
+
+	k, err := w.key(t)
+	if err != nil {
+		return err
+	}
+
+	w.rwm.RLock()
+	defer w.rwm.RUnlock()
+	if w.closed {
+		return errPipeClosed
+	}
+	p := int(data.Hash(k) % data.HashValue(len(w.outs)))
+
+	// Copies have to be created before t is passed to the partition
+	// because ShallowCopy modifies flags of t.
+	progress := make([]partitionedTuple, 0, len(w.progress))
+	for _, i := range w.progress {
+		if i != p {
+			progress = append(progress, partitionedTuple{t: t.ShallowCopy(), progress: true})
+		}
+	}
+	w.outs[p] <- partitionedTuple{t: t}
+	j := 0
+	for _, i := range w.progress {
+		if i != p {
+			w.outs[i] <- progress[j]
+			j++
+		}
+	}
+	return nil
+}
+
+func (w *partitioningWriter) Close(ctx *Context) error {
+	w.rwm.Lock()
+	if !w.closed {
+		w.closed = true
+		for _, ch := range w.outs {
+			close(ch)
+		}
+	}
+	w.rwm.Unlock()
+	w.wg.Wait()
+	return nil
+}
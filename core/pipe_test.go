@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
@@ -631,3 +632,127 @@ func (d *dataDestinations) has(name string) bool {
 	_, ok := d.dsts[name]
 	return ok
 }
+
+func TestPartitioningWriter(t *testing.T) {
+	Convey("Given a partitioning writer having 4 partitions", t, func() {
+		ctx := NewContext(nil)
+		sinks := make([]*TupleCollectorSink, 4)
+		ws := make([]Writer, len(sinks))
+		for i := range sinks {
+			sinks[i] = NewTupleCollectorSink()
+			ws[i] = sinks[i]
+		}
+		key := func(t *Tuple) (data.Value, error) {
+			return t.Data.Get(data.MustCompilePath("k"))
+		}
+		w := NewPartitioningWriter(ctx, "box", key, ws, 2)
+
+		Convey("When writing tuples having some keys", func() {
+			for i := 0; i < 40; i++ {
+				So(w.Write(ctx, NewTuple(data.Map{
+					"k": data.Int(i % 5),
+					"i": data.Int(i),
+				})), ShouldBeNil)
+			}
+			So(w.Close(ctx), ShouldBeNil)
+
+			Convey("Then all tuples should be written after closing it", func() {
+				n := 0
+				for _, s := range sinks {
+					n += len(s.Tuples)
+				}
+				So(n, ShouldEqual, 40)
+			})
+
+			Convey("Then tuples having the same key should be written to the same partition in order", func() {
+				partitions := map[data.Value]int{}
+				for i, s := range sinks {
+					prev := map[data.Value]int64{}
+					for _, t := range s.Tuples {
+						k := t.Data["k"]
+						if p, ok := partitions[k]; ok {
+							So(p, ShouldEqual, i)
+						}
+						partitions[k] = i
+
+						v, err := data.AsInt(t.Data["i"])
+						So(err, ShouldBeNil)
+						if p, ok := prev[k]; ok {
+							So(v, ShouldBeGreaterThan, p)
+						}
+						prev[k] = v
+					}
+				}
+				So(len(partitions), ShouldEqual, 5)
+			})
+
+			Convey("Then writing a tuple after closing it should fail", func() {
+				So(w.Write(ctx, NewTuple(data.Map{"k": data.Int(1)})), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing a tuple not having the key", func() {
+			err := w.Write(ctx, NewTuple(data.Map{}))
+			So(w.Close(ctx), ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a partitioning writer having a partition returning an error", t, func() {
+		ctx := NewContext(nil)
+		dsi := NewTupleCollectorSink()
+		ctx.addDroppedTupleSource(&droppedTupleCollectorSource{w: dsi})
+		w := NewPartitioningWriter(ctx, "box", func(t *Tuple) (data.Value, error) {
+			return data.Int(0), nil
+		}, []Writer{WriterFunc(func(ctx *Context, t *Tuple) error {
+			return errors.New("error")
+		})}, 1)
+
+		Convey("When writing a tuple", func() {
+			So(w.Write(ctx, NewTuple(data.Map{"v": data.Int(1)})), ShouldBeNil)
+			So(w.Close(ctx), ShouldBeNil)
+
+			Convey("Then it should be reported as a tuple dropped by the box", func() {
+				So(dsi.len(), ShouldEqual, 1)
+				d := dsi.get(0).Data
+				So(d["node_type"], ShouldEqual, "box")
+				So(d["node_name"], ShouldEqual, "box")
+				So(d["event_type"], ShouldEqual, ETInput.String())
+				So(d["error"], ShouldEqual, "error")
+				So(d["data"], ShouldResemble, data.Map{"v": data.Int(1)})
+			})
+		})
+	})
+
+	Convey("Given a partitioning writer having a partition returning a fatal error", t, func() {
+		ctx := NewContext(nil)
+		fatal := FatalError(errors.New("fatal"))
+		written := make(chan struct{}, 1)
+		w := NewPartitioningWriter(ctx, "box", func(t *Tuple) (data.Value, error) {
+			return data.Int(0), nil
+		}, []Writer{WriterFunc(func(ctx *Context, t *Tuple) error {
+			written <- struct{}{}
+			return fatal
+		})}, 1)
+		Reset(func() {
+			w.Close(ctx)
+		})
+
+		Convey("When writing tuples after the error", func() {
+			So(w.Write(ctx, NewTuple(data.Map{})), ShouldBeNil)
+			<-written
+			var err error
+			for i := 0; i < 1000 && err == nil; i++ {
+				err = w.Write(ctx, NewTuple(data.Map{}))
+				time.Sleep(time.Millisecond)
+			}
+
+			Convey("Then Write should return the error", func() {
+				So(err, ShouldEqual, fatal)
+			})
+		})
+	})
+}
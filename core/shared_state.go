@@ -68,6 +68,21 @@ type LoadableSharedState interface {
 	Load(ctx *Context, r io.Reader, params data.Map) error
 }
 
+// KeyedSharedState is a SharedState whose data can be looked up by a key
+// like a table. A stream can be joined with a KeyedSharedState using the
+// JOIN TABLE clause of a BQL SELECT statement. If the state also implements
+// Writer, the table can be maintained by a topology via SharedStateSink.
+type KeyedSharedState interface {
+	SharedState
+
+	// Lookup returns the row stored with the given key. It returns
+	// NotExistError when the state doesn't have a row for the key.
+	// The caller must not modify the returned map.
+	//
+	// Lookup and other methods including Write can be called concurrently.
+	Lookup(ctx *Context, key data.Value) (data.Map, error)
+}
+
 // TODO: Add MixiableSharedState interface
 
 // SharedStateRegistry manages SharedState with names assigned to each state.
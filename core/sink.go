@@ -9,3 +9,39 @@ package core
 type Sink interface {
 	WriteCloser
 }
+
+// ConcurrentSink is a Sink which can opt in to concurrent writes. When
+// Concurrent method returns true, a topology may call Write method from
+// multiple goroutines at the same time, up to SinkConfig.Parallelism. See
+// ConcurrentBox for details.
+type ConcurrentSink interface {
+	Sink
+
+	// Concurrent returns true when Write method can safely be called
+	// concurrently. It must always return the same value.
+	Concurrent() bool
+}
+
+// BatchSink is a Sink which can write multiple tuples at once. When a Sink
+// implements this interface, a topology buffers tuples and calls WriteBatch
+// with up to SinkConfig.MaxBatchSize tuples instead of calling Write for each
+// tuple. Buffered tuples are also written when SinkConfig.MaxBatchLatency has
+// passed since the first of them was buffered, when a checkpoint is taken, or
+// when the sink is being stopped. Write method is never called by a topology,
+// but it should still be implemented to support other uses of the sink.
+//
+// WriteBatch may return fatal or temporary errors as Write does. Such an error
+// is considered to be the error of all tuples in the batch. When only some of
+// the tuples couldn't be written, WriteBatch should return a *BatchError so
+// that only those tuples are reported as dropped ones. They're reported with
+// temporary errors.
+//
+// WriteBatch must not keep the slice after it returns. When the sink is also a
+// ConcurrentSink and its Concurrent method returns true, WriteBatch may be
+// called concurrently.
+type BatchSink interface {
+	Sink
+
+	// WriteBatch writes tuples at once.
+	WriteBatch(ctx *Context, ts []*Tuple) error
+}
@@ -1,5 +1,10 @@
 package core
 
+import (
+	"fmt"
+	"time"
+)
+
 // Topology is a topology which can add Sources, Boxes, and Sinks
 // dynamically. Boxes and Sinks can also add inputs dynamically from running
 // Sources or Boxes.
@@ -86,6 +91,44 @@ type Topology interface {
 	Sinks() map[string]SinkNode
 }
 
+// CheckpointableTopology is a Topology which can save states of its nodes in
+// a checkpoint and restore them from it. Callers can check whether a Topology
+// supports checkpoints by type assertion.
+type CheckpointableTopology interface {
+	Topology
+
+	// Checkpoint takes a checkpoint of the topology and saves it to the
+	// storage. It returns the ID of the checkpoint after all states in it are
+	// saved and the checkpoint is marked as complete.
+	//
+	// Each Source sends a barrier to its destinations. When a Box has received
+	// barriers from all of its inputs, it saves its state if it implements
+	// CheckpointableBox, and then forwards the barrier. Tuples received from
+	// inputs which have already sent the barrier are held back until then, so
+	// that the saved states reflect all tuples written before the barriers
+	// and nothing after them. A CheckpointableSource records its offset
+	// when it writes the next tuple. Therefore, a checkpoint cannot be
+	// completed while such a Source is paused or doesn't generate tuples.
+	// A Box having a parallelism greater than 1 saves its state after all
+	// tuples received before the barriers have been processed.
+	//
+	// Checkpoint fails when it doesn't complete within the timeout. When the
+	// timeout is 0, it waits until the checkpoint completes.
+	Checkpoint(s CheckpointStorage, timeout time.Duration) (int64, error)
+
+	// Restore makes the topology restore states of nodes from the latest
+	// complete checkpoint in the storage and returns its ID. States are
+	// restored when nodes are added to the topology: a CheckpointableBox
+	// loads its state after its Init is called and a CheckpointableSource
+	// seeks to its offset before its GenerateStream is called. Nodes added
+	// before calling Restore aren't affected. Nodes stop restoring their
+	// states once the next checkpoint is taken.
+	//
+	// It returns NotExistError when the storage doesn't have a complete
+	// checkpoint of the topology.
+	Restore(s CheckpointStorage) (int64, error)
+}
+
 // SourceConfig has configuration parameters of a Source node.
 type SourceConfig struct {
 	// PausedOnStartup is a flag which indicates the initial state of the
@@ -105,7 +148,11 @@ type SourceConfig struct {
 
 // BoxConfig has configuration parameters of a Box node.
 type BoxConfig struct {
-	// TODO: parallelism
+	// Parallelism is the maximum number of goroutines concurrently calling
+	// Process method of the box. It's only effective when the Box implements
+	// ConcurrentBox and its Concurrent method returns true. Other Boxes are
+	// always processed by a single goroutine. When it's 0, 1 is used.
+	Parallelism int
 
 	// RemoveOnStop is a flag which indicates the stop state of the topology.
 	// If it is true, the box is removed.
@@ -117,8 +164,29 @@ type BoxConfig struct {
 	Meta interface{}
 }
 
+// Validate validates values of BoxConfig.
+func (c *BoxConfig) Validate() error {
+	return validateParallelism(c.Parallelism)
+}
+
 // SinkConfig has configuration parameters of a Sink node.
 type SinkConfig struct {
+	// Parallelism is the maximum number of goroutines concurrently calling
+	// Write method of the sink. Like BoxConfig.Parallelism, it's only
+	// effective when the Sink implements ConcurrentSink and its Concurrent
+	// method returns true. When it's 0, 1 is used.
+	Parallelism int
+
+	// MaxBatchSize is the maximum number of tuples passed to WriteBatch
+	// method of the sink at once. It's only effective when the Sink
+	// implements BatchSink. When it's 0, 100 is used.
+	MaxBatchSize int
+
+	// MaxBatchLatency is the maximum duration a tuple is buffered before it's
+	// written to the sink. Like MaxBatchSize, it's only effective when the
+	// Sink implements BatchSink. When it's 0, 100 milliseconds is used.
+	MaxBatchLatency time.Duration
+
 	// RemoveOnStop is a flag which indicates the stop state of the topology.
 	// If it is true, the sink is removed.
 	RemoveOnStop bool
@@ -128,3 +196,17 @@ type SinkConfig struct {
 	// related to the sink.
 	Meta interface{}
 }
+
+// Validate validates values of SinkConfig.
+func (c *SinkConfig) Validate() error {
+	if err := validateParallelism(c.Parallelism); err != nil {
+		return err
+	}
+	if c.MaxBatchSize < 0 {
+		return fmt.Errorf("specified max batch size %d must not be negative", c.MaxBatchSize)
+	}
+	if c.MaxBatchLatency < 0 {
+		return fmt.Errorf("specified max batch latency %v must not be negative", c.MaxBatchLatency)
+	}
+	return nil
+}
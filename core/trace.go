@@ -16,6 +16,9 @@ const (
 	ETOutput
 	// ETOther represents any other event
 	ETOther
+	// ETLate represents an event where a tuple arrived at some processing
+	// unit after the watermark had already passed its timestamp
+	ETLate
 )
 
 // A TraceEvent represents an event in the processing lifecycle of a
@@ -45,6 +48,8 @@ func (t EventType) String() string {
 		return "output"
 	case ETOther:
 		return "other"
+	case ETLate:
+		return "late"
 	default:
 		return "unknown"
 	}
@@ -30,6 +30,12 @@ type Tuple struct {
 	// Tuple.
 	ProcTimestamp time.Time
 
+	// Watermark is a promise from the sender that no further tuple having
+	// a Timestamp earlier than this value will follow. Processing units
+	// that buffer tuples by Timestamp can use it to decide when results are
+	// complete. The zero value means that no watermark is available.
+	Watermark time.Time
+
 	// BatchID is reserved for future use.
 	BatchID int64
 
@@ -42,6 +48,10 @@ type Tuple struct {
 	// Trace is used during debugging to trace to way of a Tuple through
 	// a topology. See the documentation for TraceEvent.
 	Trace []TraceEvent
+
+	// barrier is set when the tuple carries a barrier of a checkpoint instead
+	// of data. Such tuples are never passed to Boxes or Sinks.
+	barrier *checkpointBarrier
 }
 
 // AddEvent adds a TraceEvent to this Tuple's trace. This is not
@@ -1,5 +1,10 @@
 package core
 
+import (
+	"sync"
+	"time"
+)
+
 // Writer describes an object that tuples can be written to
 // as the output for a Box. Note that this interface was chosen
 // because it also allows a Box to write multiple (or none)
@@ -20,6 +25,18 @@ type WriteCloser interface {
 	Close(ctx *Context) error
 }
 
+// ProgressWriter is a Writer which can also be notified of tuples written to
+// other Writers working together with it, e.g. other partitions of a
+// partitioning writer. Such a Writer can advance its time to the Timestamp
+// and the Watermark of the tuple, e.g. to expire tuples or close windows, as
+// if it had received the tuple.
+type ProgressWriter interface {
+	Writer
+
+	// Progress notifies the Writer of a tuple written to another Writer.
+	Progress(ctx *Context, t *Tuple) error
+}
+
 type writerFunc func(ctx *Context, t *Tuple) error
 
 // WriterFunc creates a Writer from a function.
@@ -30,3 +47,38 @@ func WriterFunc(f func(ctx *Context, t *Tuple) error) Writer {
 func (w writerFunc) Write(ctx *Context, t *Tuple) error {
 	return w(ctx, t)
 }
+
+type watermarkWriter struct {
+	w     Writer
+	delay time.Duration
+
+	m   sync.Mutex
+	max time.Time
+}
+
+// NewWatermarkWriter creates a Writer which assigns a watermark to each tuple
+// written through it before passing it to w. The watermark is the largest
+// Timestamp seen so far minus delay, i.e. tuples are allowed to arrive at
+// most delay later than the latest tuple. A tuple having the TFShared flag is
+// shallow-copied before its Watermark field is updated.
+func NewWatermarkWriter(w Writer, delay time.Duration) Writer {
+	return &watermarkWriter{
+		w:     w,
+		delay: delay,
+	}
+}
+
+func (w *watermarkWriter) Write(ctx *Context, t *Tuple) error {
+	w.m.Lock()
+	if t.Timestamp.After(w.max) {
+		w.max = t.Timestamp
+	}
+	wm := w.max.Add(-w.delay)
+	w.m.Unlock()
+
+	if t.Flags.IsSet(TFShared) {
+		t = t.ShallowCopy()
+	}
+	t.Watermark = wm
+	return w.w.Write(ctx, t)
+}
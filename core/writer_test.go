@@ -0,0 +1,50 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestWatermarkWriter(t *testing.T) {
+	Convey("Given a watermark writer with a delay of 2 seconds", t, func() {
+		ctx := NewContext(nil)
+		sink := NewTupleCollectorSink()
+		w := NewWatermarkWriter(sink, 2*time.Second)
+		base := time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)
+		write := func(sec int) {
+			tup := NewTuple(data.Map{})
+			tup.Timestamp = base.Add(time.Duration(sec) * time.Second)
+			So(w.Write(ctx, tup), ShouldBeNil)
+		}
+
+		Convey("When writing tuples out of order", func() {
+			write(5)
+			write(3)
+			write(8)
+			write(7)
+
+			Convey("Then the watermark should follow the latest timestamp", func() {
+				So(len(sink.Tuples), ShouldEqual, 4)
+				So(sink.Tuples[0].Watermark, ShouldResemble, base.Add(3*time.Second))
+				So(sink.Tuples[1].Watermark, ShouldResemble, base.Add(3*time.Second))
+				So(sink.Tuples[2].Watermark, ShouldResemble, base.Add(6*time.Second))
+				So(sink.Tuples[3].Watermark, ShouldResemble, base.Add(6*time.Second))
+			})
+		})
+
+		Convey("When writing a shared tuple", func() {
+			tup := NewTuple(data.Map{})
+			tup.Timestamp = base
+			tup.Flags.Set(TFShared)
+			So(w.Write(ctx, tup), ShouldBeNil)
+
+			Convey("Then the original tuple shouldn't be modified", func() {
+				So(tup.Watermark.IsZero(), ShouldBeTrue)
+				So(sink.Tuples[0].Watermark, ShouldResemble, base.Add(-2*time.Second))
+			})
+		})
+	})
+}
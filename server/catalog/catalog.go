@@ -0,0 +1,197 @@
+package catalog
+
+import (
+	"bytes"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Catalog records BQL statements applied to topologies so that the topologies
+// can be rebuilt when the server restarts.
+type Catalog interface {
+	// Create creates an empty catalog of the topology. If the catalog already
+	// has the topology, its statements are discarded.
+	Create(topology string) error
+
+	// Add appends statements to the catalog of the topology. The catalog of
+	// the topology is created if it doesn't exist. Statements must be ones
+	// returned from the BQL parser. Statements which aren't Recordable are
+	// ignored. Statements which no longer have to be replayed, such as ones
+	// of a node dropped by the added statements, are removed from the
+	// catalog so that it doesn't grow as nodes are created and dropped.
+	Add(topology string, stmts ...interface{}) error
+
+	// Load returns statements recorded in the catalog of the topology as a
+	// BQL string. It returns core.NotExistError if the catalog doesn't have
+	// the topology.
+	Load(topology string) (string, error)
+
+	// List returns names of all topologies in the catalog.
+	List() ([]string, error)
+
+	// Remove removes the topology from the catalog. It doesn't return an
+	// error when the catalog doesn't have the topology.
+	Remove(topology string) error
+}
+
+// Recordable returns true if the statement changes the definition of a
+// topology and should be recorded in a catalog. Statements which only return
+// data such as SELECT or EVAL aren't recordable. Statements having side effects
+// which shouldn't be repeated on restart such as SAVE STATE or REWIND SOURCE
+// aren't recordable, either.
+func Recordable(stmt interface{}) bool {
+	switch stmt.(type) {
+	case parser.SelectStmt, parser.SelectUnionStmt, parser.EvalStmt,
+		parser.SaveStateStmt, parser.RewindSourceStmt:
+		return false
+	}
+	return true
+}
+
+// fsCatalog is a Catalog which stores statements of each topology to a BQL
+// file named "<topology>.bql" in a directory. Each file is rewritten
+// atomically on every change so that a crash doesn't leave a broken catalog.
+type fsCatalog struct {
+	m       sync.Mutex
+	dirPath string
+}
+
+// NewFS creates a Catalog storing BQL files in the directory.
+func NewFS(dir string) (Catalog, error) {
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("dir (%v) isn't valid: %v", dir, err)
+	}
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("dir (%v) isn't valid: it isn't a directory", dir)
+	}
+	return &fsCatalog{
+		dirPath: dir,
+	}, nil
+}
+
+func (c *fsCatalog) Create(topology string) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.write(topology, nil)
+}
+
+func (c *fsCatalog) Add(topology string, stmts ...interface{}) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	b, err := ioutil.ReadFile(c.filepath(topology))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	recorded, err := parser.New().ParseStmts(string(b))
+	if err != nil {
+		return fmt.Errorf("the catalog of topology '%v' is broken: %v", topology, err)
+	}
+	for _, stmt := range stmts {
+		if Recordable(stmt) {
+			recorded = append(recorded, stmt)
+		}
+	}
+
+	buf := bytes.NewBuffer(nil)
+	for _, stmt := range compact(recorded) {
+		fmt.Fprintf(buf, "%v;\n", stmt)
+	}
+	return c.write(topology, buf.Bytes())
+}
+
+func (c *fsCatalog) Load(topology string) (string, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	b, err := ioutil.ReadFile(c.filepath(topology))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", core.NotExistError(fmt.Errorf("topology '%v' is not in the catalog", topology))
+		}
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (c *fsCatalog) List() ([]string, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	fs, err := ioutil.ReadDir(c.dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	for _, f := range fs {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".bql") {
+			continue
+		}
+		name := strings.TrimSuffix(f.Name(), ".bql")
+		if core.ValidateSymbol(name) != nil {
+			continue
+		}
+		res = append(res, name)
+	}
+	return res, nil
+}
+
+func (c *fsCatalog) Remove(topology string) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if err := os.Remove(c.filepath(topology)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// write replaces the BQL file of the topology. The caller must lock c.m.
+func (c *fsCatalog) write(topology string, b []byte) error {
+	if err := core.ValidateSymbol(topology); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(c.dirPath, c.filename(topology)+".")
+	if err != nil {
+		return err
+	}
+	fn := f.Name()
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(fn)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(fn)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(fn)
+		return err
+	}
+
+	// This Rename might not always be atomic on some platforms.
+	if err := os.Rename(fn, c.filepath(topology)); err != nil {
+		os.Remove(fn)
+		return err
+	}
+	return nil
+}
+
+func (c *fsCatalog) filename(topology string) string {
+	return topology + ".bql"
+}
+
+func (c *fsCatalog) filepath(topology string) string {
+	return filepath.Join(c.dirPath, c.filename(topology))
+}
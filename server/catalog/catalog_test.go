@@ -0,0 +1,137 @@
+package catalog
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFSCatalog(t *testing.T) {
+	Convey("Given a filesystem catalog", t, func() {
+		dir, err := ioutil.TempDir("", "sensorbee_catalog_test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(dir)
+		})
+		c, err := NewFS(dir)
+		So(err, ShouldBeNil)
+
+		stmts, err := parser.New().ParseStmts(`
+			CREATE SOURCE s TYPE dummy WITH num=4;
+			SELECT ISTREAM * FROM s [RANGE 1 TUPLES];
+			CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES];
+			SAVE STATE st;
+			DROP SOURCE s;`)
+		So(err, ShouldBeNil)
+
+		Convey("When the catalog doesn't have the topology", func() {
+			Convey("Then Load should fail", func() {
+				_, err := c.Load("test_topology")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then Remove should succeed", func() {
+				So(c.Remove("test_topology"), ShouldBeNil)
+			})
+		})
+
+		Convey("When creating a topology", func() {
+			So(c.Create("test_topology"), ShouldBeNil)
+
+			Convey("Then it should be listed", func() {
+				ts, err := c.List()
+				So(err, ShouldBeNil)
+				So(ts, ShouldResemble, []string{"test_topology"})
+			})
+
+			Convey("Then it should have no statement", func() {
+				s, err := c.Load("test_topology")
+				So(err, ShouldBeNil)
+				So(s, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When adding statements", func() {
+			So(c.Add("test_topology", stmts[0], stmts[1]), ShouldBeNil)
+			So(c.Add("test_topology", stmts[2:]...), ShouldBeNil)
+
+			Convey("Then it should only have recordable statements", func() {
+				s, err := c.Load("test_topology")
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, stmts[0].(parser.CreateSourceStmt).String()+";\n"+
+					stmts[2].(parser.CreateStreamAsSelectStmt).String()+";\n"+
+					stmts[4].(parser.DropSourceStmt).String()+";\n")
+
+				Convey("And the statements should be parsed again", func() {
+					ss, err := parser.New().ParseStmts(s)
+					So(err, ShouldBeNil)
+					So(ss, ShouldResemble, []interface{}{stmts[0], stmts[2], stmts[4]})
+				})
+			})
+
+			Convey("Then dropping the stream reading from the dropped source should remove all statements", func() {
+				drop, _, err := parser.New().ParseStmt("DROP STREAM t")
+				So(err, ShouldBeNil)
+				So(c.Add("test_topology", drop), ShouldBeNil)
+				s, err := c.Load("test_topology")
+				So(err, ShouldBeNil)
+				So(s, ShouldBeEmpty)
+			})
+
+			Convey("Then creating the topology again should discard the statements", func() {
+				So(c.Create("test_topology"), ShouldBeNil)
+				s, err := c.Load("test_topology")
+				So(err, ShouldBeNil)
+				So(s, ShouldBeEmpty)
+			})
+
+			Convey("Then removing the topology should remove it from the list", func() {
+				So(c.Remove("test_topology"), ShouldBeNil)
+				ts, err := c.List()
+				So(err, ShouldBeNil)
+				So(ts, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the directory has other files", func() {
+			So(c.Create("test_topology"), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(dir, "test_topology-state-default.state"), nil, 0644), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(dir, "invalid-name.bql"), nil, 0644), ShouldBeNil)
+
+			Convey("Then List should ignore them", func() {
+				ts, err := c.List()
+				So(err, ShouldBeNil)
+				So(ts, ShouldResemble, []string{"test_topology"})
+			})
+		})
+
+		Convey("When creating a topology having an invalid name", func() {
+			err := c.Create("../test_topology")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a path which isn't a directory", t, func() {
+		f, err := ioutil.TempFile("", "sensorbee_catalog_test")
+		So(err, ShouldBeNil)
+		f.Close()
+		Reset(func() {
+			os.Remove(f.Name())
+		})
+
+		Convey("When creating a filesystem catalog", func() {
+			_, err := NewFS(f.Name())
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,261 @@
+package catalog
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"strings"
+	"unicode"
+)
+
+// target is a node or a shared state which statements are applied to. Names
+// of nodes are case-insensitive and stored in lower case. Names of states are
+// case-sensitive.
+type target struct {
+	state bool
+	name  string
+}
+
+func nodeTarget(name parser.StreamIdentifier) target {
+	return target{name: strings.ToLower(string(name))}
+}
+
+func stateTarget(name parser.StreamIdentifier) target {
+	return target{state: true, name: string(name)}
+}
+
+// createdTarget returns the node or the state created by the statement.
+// LOAD STATE is regarded as a creation because it can create a new state.
+func createdTarget(stmt interface{}) (target, bool) {
+	switch s := stmt.(type) {
+	case parser.CreateSourceStmt:
+		return nodeTarget(s.Name), true
+	case parser.CreateStreamAsSelectStmt:
+		return nodeTarget(s.Name), true
+	case parser.CreateStreamAsSelectUnionStmt:
+		return nodeTarget(s.Name), true
+	case parser.CreateSinkStmt:
+		return nodeTarget(s.Name), true
+	case parser.CreateStateStmt:
+		return stateTarget(s.Name), true
+	case parser.LoadStateStmt:
+		return stateTarget(s.Name), true
+	case parser.LoadStateOrCreateStmt:
+		return stateTarget(s.Name), true
+	}
+	return target{}, false
+}
+
+// droppedTarget returns the node or the state dropped by the statement.
+func droppedTarget(stmt interface{}) (target, bool) {
+	switch s := stmt.(type) {
+	case parser.DropSourceStmt:
+		return nodeTarget(s.Source), true
+	case parser.DropStreamStmt:
+		return nodeTarget(s.Stream), true
+	case parser.DropSinkStmt:
+		return nodeTarget(s.Sink), true
+	case parser.DropStateStmt:
+		return stateTarget(s.State), true
+	}
+	return target{}, false
+}
+
+// modifiedTarget returns the node or the state modified by the statement
+// without creating or dropping it. It also returns the keys of parameters
+// set by UPDATE statements, which are nil for PAUSE and RESUME SOURCE.
+func modifiedTarget(stmt interface{}) (target, []parser.SourceSinkParamKey, bool) {
+	switch s := stmt.(type) {
+	case parser.UpdateSourceStmt:
+		return nodeTarget(s.Name), paramKeys(s.Params), true
+	case parser.UpdateSinkStmt:
+		return nodeTarget(s.Name), paramKeys(s.Params), true
+	case parser.UpdateStateStmt:
+		return stateTarget(s.Name), paramKeys(s.Params), true
+	case parser.PauseSourceStmt:
+		return nodeTarget(s.Source), nil, true
+	case parser.ResumeSourceStmt:
+		return nodeTarget(s.Source), nil, true
+	}
+	return target{}, nil, false
+}
+
+func paramKeys(params []parser.SourceSinkParamAST) []parser.SourceSinkParamKey {
+	// keys must not be nil
+	keys := make([]parser.SourceSinkParamKey, len(params))
+	for i, p := range params {
+		keys[i] = p.Key
+	}
+	return keys
+}
+
+// concerns returns true if the statement creates, drops, or modifies the
+// target. INSERT INTO concerns both the sink and the input because replaying
+// it requires both of them.
+func concerns(stmt interface{}, t target) bool {
+	if c, ok := createdTarget(stmt); ok {
+		return c == t
+	}
+	if d, ok := droppedTarget(stmt); ok {
+		return d == t
+	}
+	if m, _, ok := modifiedTarget(stmt); ok {
+		return m == t
+	}
+	if s, ok := stmt.(parser.InsertIntoFromStmt); ok {
+		return nodeTarget(s.Sink) == t || nodeTarget(s.Input) == t
+	}
+	return false
+}
+
+// mentions returns true if the statement has an identifier or a word in a
+// string which is the same as the name of the target. It's used to find
+// statements which might refer to the target, e.g. a stream reading from it
+// or a UDF using it, so it can return true for statements which don't.
+func mentions(stmt interface{}, t target) bool {
+	words := strings.FieldsFunc(fmt.Sprint(stmt), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	for _, w := range words {
+		if strings.EqualFold(w, t.name) {
+			return true
+		}
+	}
+	return false
+}
+
+// compact removes statements which don't have to be replayed to rebuild the
+// topology:
+//
+//  1. When a node or a state is dropped, the statements creating, modifying,
+//     and dropping it are removed unless another remaining statement which
+//     was applied while it existed might refer to it. For example, CREATE
+//     STREAM of a stream reading from a dropped source keeps the source in
+//     the catalog until the stream is dropped as well.
+//  2. UPDATE statements are removed when a later UPDATE statement of the
+//     same node or state sets all of their parameters again, and PAUSE or
+//     RESUME SOURCE statements are removed when the source is paused or
+//     resumed again.
+//
+// Replaying the remaining statements results in the same nodes and states.
+func compact(stmts []interface{}) []interface{} {
+	for {
+		res, ok := removeDropped(stmts)
+		if !ok {
+			break
+		}
+		stmts = res
+	}
+	return removeOverridden(stmts)
+}
+
+// removeDropped removes the statements of the first dropped node or state
+// which can be removed. It returns false when nothing is removed.
+func removeDropped(stmts []interface{}) ([]interface{}, bool) {
+	for i, stmt := range stmts {
+		t, ok := droppedTarget(stmt)
+		if !ok {
+			continue
+		}
+
+		// Statements after the previous DROP of the target are applied to
+		// the instance dropped by this statement.
+		group := map[int]bool{i: true}
+		first := i
+		created := false
+		for j := i - 1; j >= 0; j-- {
+			if d, ok := droppedTarget(stmts[j]); ok && d == t {
+				break
+			}
+			if !concerns(stmts[j], t) {
+				continue
+			}
+			group[j] = true
+			first = j
+			if c, ok := createdTarget(stmts[j]); ok && c == t {
+				created = true
+			}
+		}
+		if !created {
+			// The DROP has to be replayed because the target was created
+			// before the catalog started recording or by another DROP
+			// which couldn't be removed.
+			continue
+		}
+
+		referred := false
+		for j := first + 1; j < i; j++ {
+			if !group[j] && mentions(stmts[j], t) {
+				referred = true
+				break
+			}
+		}
+		if referred {
+			continue
+		}
+
+		res := make([]interface{}, 0, len(stmts)-len(group))
+		for j, s := range stmts {
+			if !group[j] {
+				res = append(res, s)
+			}
+		}
+		return res, true
+	}
+	return stmts, false
+}
+
+// removeOverridden removes UPDATE, PAUSE, and RESUME statements overridden by
+// later statements of the same node or state.
+func removeOverridden(stmts []interface{}) []interface{} {
+	res := make([]interface{}, 0, len(stmts))
+	for i, stmt := range stmts {
+		if !overridden(stmt, stmts[i+1:]) {
+			res = append(res, stmt)
+		}
+	}
+	return res
+}
+
+func overridden(stmt interface{}, later []interface{}) bool {
+	t, keys, ok := modifiedTarget(stmt)
+	if !ok {
+		return false
+	}
+	for _, s := range later {
+		if c, ok := createdTarget(s); ok && c == t {
+			return false
+		}
+		if d, ok := droppedTarget(s); ok && d == t {
+			return false
+		}
+		lt, lkeys, ok := modifiedTarget(s)
+		if !ok || lt != t {
+			continue
+		}
+		if keys == nil {
+			if lkeys == nil {
+				return true
+			}
+		} else if lkeys != nil && containsKeys(lkeys, keys) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsKeys returns true if keys has all of subset.
+func containsKeys(keys, subset []parser.SourceSinkParamKey) bool {
+	for _, k := range subset {
+		found := false
+		for _, l := range keys {
+			if k == l {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+package catalog
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"testing"
+)
+
+func TestCompact(t *testing.T) {
+	cases := []struct {
+		title    string
+		input    string
+		expected string
+	}{
+		{
+			title: "a dropped stream",
+			input: `CREATE SOURCE s TYPE dummy;
+				CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES];
+				DROP STREAM t;`,
+			expected: `CREATE SOURCE s TYPE dummy;`,
+		},
+		{
+			title: "a dropped sink having inputs",
+			input: `CREATE SOURCE s TYPE dummy;
+				CREATE SINK k TYPE stdout;
+				INSERT INTO k FROM s;
+				UPDATE SINK k SET a = 1;
+				DROP SINK k;`,
+			expected: `CREATE SOURCE s TYPE dummy;`,
+		},
+		{
+			title: "a dropped input of a sink",
+			input: `CREATE SOURCE s TYPE dummy;
+				CREATE SINK k TYPE stdout;
+				INSERT INTO k FROM s;
+				DROP SOURCE S;`,
+			expected: `CREATE SINK k TYPE stdout;`,
+		},
+		{
+			title: "a dropped source read by a stream",
+			input: `CREATE SOURCE s TYPE dummy;
+				CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES];
+				DROP SOURCE s;`,
+			expected: `CREATE SOURCE s TYPE dummy;
+				CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES];
+				DROP SOURCE s;`,
+		},
+		{
+			title: "a dropped source read by a dropped stream",
+			input: `CREATE SOURCE s TYPE dummy;
+				CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES];
+				DROP SOURCE s;
+				DROP STREAM t;`,
+			expected: ``,
+		},
+		{
+			title: "a dropped state used by a stream",
+			input: `CREATE STATE st TYPE counter;
+				CREATE SOURCE s TYPE dummy;
+				CREATE STREAM t AS SELECT ISTREAM udf("st") FROM s [RANGE 1 TUPLES];
+				DROP STATE st;`,
+			expected: `CREATE STATE st TYPE counter;
+				CREATE SOURCE s TYPE dummy;
+				CREATE STREAM t AS SELECT ISTREAM udf("st") FROM s [RANGE 1 TUPLES];
+				DROP STATE st;`,
+		},
+		{
+			title: "a loaded and dropped state",
+			input: `CREATE STATE st TYPE counter;
+				UPDATE STATE st SET a = 1;
+				LOAD STATE st TYPE counter;
+				DROP STATE st;`,
+			expected: ``,
+		},
+		{
+			title: "a state having the name of a dropped node",
+			input: `CREATE STATE s TYPE counter;
+				CREATE SOURCE s TYPE dummy;
+				DROP SOURCE s;`,
+			expected: `CREATE STATE s TYPE counter;`,
+		},
+		{
+			title: "a source created again after dropped",
+			input: `CREATE SOURCE s TYPE dummy WITH a = 1;
+				DROP SOURCE s;
+				CREATE SOURCE s TYPE dummy WITH a = 2;`,
+			expected: `CREATE SOURCE s TYPE dummy WITH a = 2;`,
+		},
+		{
+			title: "a dropped source which wasn't recorded",
+			input: `DROP SOURCE s;`,
+			expected: `DROP SOURCE s;`,
+		},
+		{
+			title: "repeated updates",
+			input: `CREATE SOURCE s TYPE dummy;
+				UPDATE SOURCE s SET a = 1, b = 1;
+				UPDATE SOURCE s SET a = 2;
+				UPDATE SOURCE s SET b = 2, a = 3;
+				UPDATE SOURCE s SET a = 4;`,
+			expected: `CREATE SOURCE s TYPE dummy;
+				UPDATE SOURCE s SET b = 2, a = 3;
+				UPDATE SOURCE s SET a = 4;`,
+		},
+		{
+			title: "repeated pauses and resumes",
+			input: `CREATE PAUSED SOURCE s TYPE dummy;
+				RESUME SOURCE s;
+				UPDATE SOURCE s SET a = 1;
+				PAUSE SOURCE s;
+				RESUME SOURCE s;`,
+			expected: `CREATE PAUSED SOURCE s TYPE dummy;
+				UPDATE SOURCE s SET a = 1;
+				RESUME SOURCE s;`,
+		},
+		{
+			title: "an update of a dropped source followed by the one of a new source",
+			input: `CREATE SOURCE s TYPE dummy;
+				CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES];
+				UPDATE SOURCE s SET a = 1;
+				DROP SOURCE s;
+				CREATE SOURCE s TYPE dummy;
+				UPDATE SOURCE s SET a = 2;`,
+			expected: `CREATE SOURCE s TYPE dummy;
+				CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES];
+				UPDATE SOURCE s SET a = 1;
+				DROP SOURCE s;
+				CREATE SOURCE s TYPE dummy;
+				UPDATE SOURCE s SET a = 2;`,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		Convey("Given statements of "+c.title, t, func() {
+			input, err := parser.New().ParseStmts(c.input)
+			So(err, ShouldBeNil)
+
+			Convey("When compacting them", func() {
+				res := compact(input)
+
+				Convey("Then only statements which have to be replayed should remain", func() {
+					expected, err := parser.New().ParseStmts(c.expected)
+					So(err, ShouldBeNil)
+					So(res, ShouldResemble, expected)
+				})
+			})
+		})
+	}
+}
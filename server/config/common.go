@@ -28,6 +28,14 @@ func mustAsString(v data.Value) string {
 	return s
 }
 
+func mustAsInt(v data.Value) int {
+	i, err := data.ToInt(v)
+	if err != nil {
+		panic(err)
+	}
+	return int(i)
+}
+
 func mustAsMap(v data.Value) data.Map {
 	m, err := data.AsMap(v)
 	if err != nil {
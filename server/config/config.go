@@ -13,8 +13,8 @@ type Config struct {
 	Network *Network
 
 	// Topologies section has information of topologies created on startup.
-	// This section might be going to be removed when SensorBee supports
-	// persisting topologies.
+	// Topologies created through the API can be persisted by enabling the
+	// catalog in Storage section.
 	Topologies Topologies
 
 	// Storage section has information of storage of components in SensorBee.
@@ -62,7 +62,8 @@ func TestConfigToMap(t *testing.T) {
 					BQLFile: "t1.bql",
 				},
 				"t2": &Topology{
-					BQLFile: "t2.bql",
+					BQLFile:            "t2.bql",
+					CheckpointInterval: 60,
 				},
 			},
 			Storage: &Storage{
@@ -72,6 +73,9 @@ func TestConfigToMap(t *testing.T) {
 						"dir": data.String("uds"),
 					},
 				},
+				Catalog: Catalog{
+					Enabled: true,
+				},
 			},
 			Logging: &Logging{
 				Target:                   "stderr",
@@ -90,10 +94,12 @@ func TestConfigToMap(t *testing.T) {
 					},
 					"topologies": data.Map{
 						"t1": data.Map{
-							"bql_file": data.String("t1.bql"),
+							"bql_file":            data.String("t1.bql"),
+							"checkpoint_interval": data.Int(0),
 						},
 						"t2": data.Map{
-							"bql_file": data.String("t2.bql"),
+							"bql_file":            data.String("t2.bql"),
+							"checkpoint_interval": data.Int(60),
 						},
 					},
 					"storage": data.Map{
@@ -103,6 +109,10 @@ func TestConfigToMap(t *testing.T) {
 								"dir": data.String("uds"),
 							},
 						},
+						"catalog": data.Map{
+							"enabled": data.True,
+							"dir":     data.String(""),
+						},
 					},
 					"logging": data.Map{
 						"target":                     data.String("stderr"),
@@ -8,6 +8,8 @@ import (
 // Storage has storage configuration parameters for components in SensorBee.
 type Storage struct {
 	UDS UDSStorage `json:"uds" yaml:"uds"`
+
+	Catalog Catalog `json:"catalog" yaml:"catalog"`
 }
 
 // UDSStorage has configuration parameters for the storage of UDSs.
@@ -16,6 +18,18 @@ type UDSStorage struct {
 	Params data.Map `json:"params" yaml:"params"`
 }
 
+// Catalog has configuration parameters for the catalog of topologies. When
+// it's enabled, BQL statements applied to topologies through the API are
+// recorded in the catalog and topologies are rebuilt from it on startup.
+type Catalog struct {
+	// Enabled is true when the catalog is used.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// Dir is the directory where the catalog is stored. When it's empty, the
+	// directory of the fs UDS storage is used.
+	Dir string `json:"dir" yaml:"dir"`
+}
+
 // Because data.Map doesn't support YAML encoding, UDSStorage.Params has type
 // map[string]interface{} instead of data.Map.
 
@@ -77,6 +91,18 @@ var (
 					"additionalProperties": false
 				}
 			]
+		},
+		"catalog": {
+			"type": "object",
+			"properties": {
+				"enabled": {
+					"type": "boolean"
+				},
+				"dir": {
+					"type": "string"
+				}
+			},
+			"additionalProperties": false
 		}
 	},
 	"additionalProperties": false
@@ -115,6 +141,10 @@ func newStorage(m data.Map) *Storage {
 			Type:   mustAsString(getWithDefault(m, "uds.type", data.String("in_memory"))),
 			Params: mustAsMap(udsParams),
 		},
+		Catalog: Catalog{
+			Enabled: mustToBool(getWithDefault(m, "catalog.enabled", data.False)),
+			Dir:     mustAsString(getWithDefault(m, "catalog.dir", data.String(""))),
+		},
 	}
 }
 
@@ -125,6 +155,10 @@ func (s *Storage) ToMap() data.Map {
 			"params": s.UDS.Params,
 			"type":   data.String(s.UDS.Type),
 		},
+		"catalog": data.Map{
+			"enabled": data.Bool(s.Catalog.Enabled),
+			"dir":     data.String(s.Catalog.Dir),
+		},
 	}
 
 }
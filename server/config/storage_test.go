@@ -165,3 +165,43 @@ func TestUDSStorageFS(t *testing.T) {
 		})
 	})
 }
+
+func TestStorageCatalog(t *testing.T) {
+	Convey("Given a JSON config for storage.catalog section", t, func() {
+		Convey("When the config is valid", func() {
+			s, err := NewStorage(toMap(`{"catalog":{"enabled":true,"dir":"/path/to/catalog"}}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have given parameters", func() {
+				So(s.Catalog.Enabled, ShouldBeTrue)
+				So(s.Catalog.Dir, ShouldEqual, "/path/to/catalog")
+			})
+		})
+
+		Convey("When the config doesn't have the section", func() {
+			s, err := NewStorage(toMap(`{}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then the catalog should be disabled", func() {
+				So(s.Catalog.Enabled, ShouldBeFalse)
+				So(s.Catalog.Dir, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the config has an undefined field", func() {
+			_, err := NewStorage(toMap(`{"catalog":{"enabled":true,"unknown":"invalid"}}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When enabled isn't a bool", func() {
+			_, err := NewStorage(toMap(`{"catalog":{"enabled":"true"}}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
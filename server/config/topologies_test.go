@@ -57,5 +57,21 @@ func TestTopologies(t *testing.T) {
 				})
 			}
 		})
+
+		Convey("When validating checkpoint_interval", func() {
+			Convey("Then it should accept a non-negative integer", func() {
+				ts, err := NewTopologies(toMap(`{"test":{"checkpoint_interval":60},"test2":{}}`))
+				So(err, ShouldBeNil)
+				So(ts["test"].CheckpointInterval, ShouldEqual, 60)
+				So(ts["test2"].CheckpointInterval, ShouldEqual, 0)
+			})
+
+			for _, c := range []string{"-1", "1.5", `"60"`} {
+				Convey(fmt.Sprint("Then it should reject ", c), func() {
+					_, err := NewTopologies(toMap(fmt.Sprintf(`{"test":{"checkpoint_interval":%v}}`, c)))
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/gocraft/web"
@@ -13,6 +14,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"gopkg.in/sensorbee/sensorbee.v0/server/catalog"
 	"gopkg.in/sensorbee/sensorbee.v0/server/config"
 	"gopkg.in/sensorbee/sensorbee.v0/server/udsstorage"
 )
@@ -22,6 +24,8 @@ type Context struct {
 	*jasco.Context
 
 	udsStorage udf.UDSStorage
+	// catalog is nil when the catalog of topologies is disabled.
+	catalog    catalog.Catalog
 	topologies TopologyRegistry
 	config     *config.Config
 	// logger is used by core.Context, not for the server's Context. This logger
@@ -97,8 +101,13 @@ func SetUpContextAndRouter(prefix string, jascoRoot *web.Router, gvariables *Con
 		return nil, err
 	}
 
+	cat, err := setUpCatalog(gvars.Config.Storage)
+	if err != nil {
+		return nil, err
+	}
+
 	// Topologies should be created after setting up everything necessary for it.
-	if err := setUpTopologies(gvars.Logger, gvars.Topologies, gvars.Config, udsStorage); err != nil {
+	if err := setUpTopologies(gvars.Logger, gvars.Topologies, gvars.Config, udsStorage, cat); err != nil {
 		return nil, err
 	}
 
@@ -106,6 +115,7 @@ func SetUpContextAndRouter(prefix string, jascoRoot *web.Router, gvariables *Con
 	router.Middleware(func(c *Context, rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
 		c.logger = gvars.Logger
 		c.udsStorage = udsStorage
+		c.catalog = cat
 		c.topologies = gvars.Topologies
 		c.config = gvars.Config
 		next(rw, req)
@@ -130,7 +140,23 @@ func setUpUDSStorage(conf *config.UDSStorage) (udf.UDSStorage, error) {
 	}
 }
 
-func setUpTopologies(logger *logrus.Logger, r TopologyRegistry, conf *config.Config, us udf.UDSStorage) error {
+// setUpCatalog creates the catalog of topologies. It returns nil when the
+// catalog is disabled.
+func setUpCatalog(conf *config.Storage) (catalog.Catalog, error) {
+	if !conf.Catalog.Enabled {
+		return nil, nil
+	}
+	dir := conf.Catalog.Dir
+	if dir == "" {
+		if conf.UDS.Type != "fs" {
+			return nil, fmt.Errorf("catalog.dir is required when the uds storage isn't fs")
+		}
+		dir, _ = data.AsString(conf.UDS.Params["dir"])
+	}
+	return catalog.NewFS(dir)
+}
+
+func setUpTopologies(logger *logrus.Logger, r TopologyRegistry, conf *config.Config, us udf.UDSStorage, cat catalog.Catalog) error {
 	stopAll := true
 	defer func() {
 		if stopAll {
@@ -153,7 +179,7 @@ func setUpTopologies(logger *logrus.Logger, r TopologyRegistry, conf *config.Con
 
 	for name := range conf.Topologies {
 		logger.WithField("topology", name).Info("Setting up the topology")
-		tb, err := setUpTopology(logger, name, conf, us)
+		tb, err := setUpTopology(logger, name, conf, us, cat)
 		if err != nil {
 			return err
 		}
@@ -166,11 +192,38 @@ func setUpTopologies(logger *logrus.Logger, r TopologyRegistry, conf *config.Con
 		}
 	}
 
+	if cat != nil {
+		// Topologies created through the API are rebuilt from the catalog.
+		names, err := cat.List()
+		if err != nil {
+			logger.WithField("err", err).Error("Cannot list topologies in the catalog")
+			return err
+		}
+		for _, name := range names {
+			if _, err := r.Lookup(name); err == nil {
+				continue // already set up by the config
+			}
+			logger.WithField("topology", name).Info("Setting up the topology from the catalog")
+			tb, err := setUpTopology(logger, name, conf, us, cat)
+			if err != nil {
+				return err
+			}
+			if err := r.Register(name, tb); err != nil {
+				tb.Topology().Stop()
+				logger.WithFields(logrus.Fields{
+					"err":      err,
+					"topology": name,
+				}).Error("Cannot register the topology")
+				return err
+			}
+		}
+	}
+
 	stopAll = false
 	return nil
 }
 
-func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us udf.UDSStorage) (*bql.TopologyBuilder, error) {
+func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us udf.UDSStorage, cat catalog.Catalog) (*bql.TopologyBuilder, error) {
 	cc := &core.ContextConfig{
 		Logger: logger,
 	}
@@ -192,9 +245,44 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 	}
 	tb.UDSStorage = us
 
-	bqlFilePath := conf.Topologies[name].BQLFile
-	if bqlFilePath == "" {
-		return tb, nil
+	tconf := conf.Topologies[name]
+	if tconf == nil {
+		// The topology only exists in the catalog.
+		tconf = &config.Topology{Name: name}
+	}
+	cs := udf.NewUDSCheckpointStorage(us)
+	if tconf.CheckpointInterval > 0 {
+		ctp, ok := tp.(core.CheckpointableTopology)
+		if !ok {
+			err := fmt.Errorf("the topology doesn't support checkpoints")
+			logger.WithFields(logrus.Fields{
+				"err":      err,
+				"topology": name,
+			}).Error("Cannot set up checkpoints of the topology")
+			tp.Stop()
+			return nil, err
+		}
+
+		// Nodes created by the BQL file restore their states from the
+		// latest checkpoint.
+		if id, err := ctp.Restore(cs); err == nil {
+			logger.WithFields(logrus.Fields{
+				"topology":   name,
+				"checkpoint": id,
+			}).Info("Restoring the topology from the checkpoint")
+		} else if !core.IsNotExist(err) {
+			logger.WithFields(logrus.Fields{
+				"err":      err,
+				"topology": name,
+			}).Error("Cannot restore the topology from the checkpoint")
+			tp.Stop()
+			return nil, err
+		}
+		defer func() {
+			if tp.State().Get() < core.TSStopping {
+				go runCheckpoints(logger, ctp, cs, time.Duration(tconf.CheckpointInterval)*time.Second)
+			}
+		}()
 	}
 
 	shouldStop := true
@@ -209,34 +297,89 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 		}
 	}()
 
-	queries, err := ioutil.ReadFile(bqlFilePath)
-	if err != nil {
-		logger.WithFields(logrus.Fields{
-			"err":      err,
-			"topology": name,
-			"path":     bqlFilePath,
-		}).Error("Cannot read a BQL file")
-		return nil, err
+	if bqlFilePath := tconf.BQLFile; bqlFilePath != "" {
+		queries, err := ioutil.ReadFile(bqlFilePath)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"err":      err,
+				"topology": name,
+				"path":     bqlFilePath,
+			}).Error("Cannot read a BQL file")
+			return nil, err
+		}
+		if err := addStmts(logger, tb, string(queries)); err != nil {
+			return nil, err
+		}
+	}
+
+	if cat != nil {
+		// Statements in the catalog were issued after the ones in the BQL
+		// file, so they're replayed after it.
+		queries, err := cat.Load(name)
+		if err != nil && !core.IsNotExist(err) {
+			logger.WithFields(logrus.Fields{
+				"err":      err,
+				"topology": name,
+			}).Error("Cannot load the catalog of the topology")
+			return nil, err
+		}
+		if err := addStmts(logger, tb, queries); err != nil {
+			return nil, err
+		}
 	}
 
+	shouldStop = false
+	return tb, nil
+}
+
+// addStmts parses BQL statements and adds them to the topology.
+func addStmts(logger *logrus.Logger, tb *bql.TopologyBuilder, queries string) error {
 	// TODO: improve error handling
 	bp := parser.New()
-	stmts, err := bp.ParseStmts(string(queries))
+	stmts, err := bp.ParseStmts(queries)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	for _, stmt := range stmts {
 		if _, err := tb.AddStmt(stmt); err != nil {
 			logger.WithFields(logrus.Fields{
 				"err":      err,
-				"topology": name,
+				"topology": tb.Topology().Name(),
 				"stmt":     stmt,
 			}).Error("Cannot add a statement to the topology")
-			return nil, err
+			return err
 		}
 	}
+	return nil
+}
 
-	shouldStop = false
-	return tb, nil
+// runCheckpoints takes checkpoints of the topology periodically until the
+// topology stops.
+func runCheckpoints(logger *logrus.Logger, tp core.CheckpointableTopology, cs core.CheckpointStorage, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for _ = range ticker.C {
+		if tp.State().Get() >= core.TSStopping {
+			return
+		}
+
+		// The interval is also used as the timeout so that checkpoints
+		// don't pile up.
+		id, err := tp.Checkpoint(cs, interval)
+		if err != nil {
+			if tp.State().Get() >= core.TSStopping {
+				return
+			}
+			logger.WithFields(logrus.Fields{
+				"err":      err,
+				"topology": tp.Name(),
+			}).Error("Cannot take a checkpoint of the topology")
+			continue
+		}
+		logger.WithFields(logrus.Fields{
+			"topology":   tp.Name(),
+			"checkpoint": id,
+		}).Debug("Took a checkpoint of the topology")
+	}
 }
@@ -37,6 +37,7 @@ func setUpTopologiesRouter(prefix string, router *web.Router) {
 	root.Delete(`/:topologyName`, (*topologies).Destroy)
 	root.Post(`/:topologyName/queries`, (*topologies).Queries)
 	root.Get(`/:topologyName/wsqueries`, (*topologies).WebSocketQueries)
+	root.Get(`/:topologyName/catalog`, (*topologies).Catalog)
 
 	setUpSourcesRouter(prefix, root)
 	setUpStreamsRouter(prefix, root)
@@ -159,6 +160,20 @@ func (tc *topologies) Create(rw web.ResponseWriter, req *web.Request) {
 		return
 	}
 
+	if tc.catalog != nil {
+		if err := tc.catalog.Create(name); err != nil {
+			tc.ErrLog(err).Error("Cannot add the topology to the catalog")
+			if _, err := tc.topologies.Unregister(name); err != nil {
+				tc.ErrLog(err).Error("Cannot unregister the topology")
+			}
+			if err := tp.Stop(); err != nil {
+				tc.ErrLog(err).Error("Cannot stop the created topology")
+			}
+			tc.RenderError(jasco.NewInternalServerError(err))
+			return
+		}
+	}
+
 	// TODO: return 201
 	tc.Render(map[string]interface{}{
 		"topology": response.NewTopology(tb.Topology()),
@@ -210,6 +225,13 @@ func (tc *topologies) Destroy(rw web.ResponseWriter, req *web.Request) {
 			stopped = false
 			tc.ErrLog(err).Error("Cannot stop the topology")
 		}
+		if tc.catalog != nil {
+			if err := tc.catalog.Remove(tb.Topology().Name()); err != nil {
+				tc.ErrLog(err).Error("Cannot remove the topology from the catalog")
+				tc.RenderError(jasco.NewInternalServerError(err))
+				return
+			}
+		}
 	}
 
 	if stopped {
@@ -288,6 +310,10 @@ func (tc *topologies) Queries(rw web.ResponseWriter, req *web.Request) {
 			tc.RenderError(e)
 			return
 		}
+		if err := tc.recordStmt(tb, stmt); err != nil {
+			tc.RenderError(jasco.NewInternalServerError(err))
+			return
+		}
 	}
 
 	// TODO: support the new format
@@ -298,6 +324,51 @@ func (tc *topologies) Queries(rw web.ResponseWriter, req *web.Request) {
 	})
 }
 
+// recordStmt records a statement successfully added to the topology in the
+// catalog. It does nothing when the catalog is disabled.
+func (tc *topologies) recordStmt(tb *bql.TopologyBuilder, stmt interface{}) error {
+	if tc.catalog == nil {
+		return nil
+	}
+	if err := tc.catalog.Add(tb.Topology().Name(), stmt); err != nil {
+		tc.ErrLog(err).WithField("statement", fmt.Sprint(stmt)).
+			Error("Cannot record the statement in the catalog")
+		return err
+	}
+	return nil
+}
+
+// Catalog returns statements recorded in the catalog of the topology as a BQL
+// file.
+func (tc *topologies) Catalog(rw web.ResponseWriter, req *web.Request) {
+	if tc.catalog == nil {
+		tc.Log().Error("The catalog is disabled")
+		tc.RenderError(jasco.NewError(requestResourceNotFoundErrorCode, "The catalog is disabled",
+			http.StatusNotFound, nil))
+		return
+	}
+
+	tb := tc.fetchTopology()
+	if tb == nil {
+		return
+	}
+	name := tb.Topology().Name()
+
+	queries, err := tc.catalog.Load(name)
+	if err != nil && !core.IsNotExist(err) {
+		tc.ErrLog(err).Error("Cannot load the catalog of the topology")
+		tc.RenderError(jasco.NewInternalServerError(err))
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	rw.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%v.bql"`, name))
+	rw.WriteHeader(http.StatusOK)
+	if _, err := io.WriteString(rw, queries); err != nil {
+		tc.ErrLog(err).Error("Cannot write the catalog to the response")
+	}
+}
+
 func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error) {
 	// TODO: use mapstructure when parameters get too many
 	var queries string
@@ -726,6 +797,10 @@ func (tc *topologies) processWebSocketMessage(conn *websocket.Conn, tb *bql.Topo
 				w.sendErr(e)
 				return
 			}
+			if err := tc.recordStmt(tb, stmt); err != nil {
+				w.sendErr(jasco.NewInternalServerError(err))
+				return
+			}
 		}
 
 		// TODO: define a proper response format